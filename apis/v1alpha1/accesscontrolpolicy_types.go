@@ -0,0 +1,102 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=acpolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// AccessControlPolicy is an Inherited Attached Policy. It provides a way to allow or deny access
+// to HTTP and stream servers based on the client IP address.
+type AccessControlPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessControlPolicy.
+	Spec AccessControlPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessControlPolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessControlPolicyList contains a list of AccessControlPolicies.
+type AccessControlPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessControlPolicy `json:"items"`
+}
+
+// AccessControlPolicySpec defines the desired state of the AccessControlPolicy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of rules or defaultAction must be set",rule="has(self.rules) || has(self.defaultAction)"
+//
+//nolint:lll
+type AccessControlPolicySpec struct {
+	// Rules is the ordered list of access rules. The rules are checked in sequence until the first match is found.
+	// The client address is matched after it has been rewritten according to the RewriteClientIP settings
+	// of the NginxProxy, if configured. RewriteClientIP is not applied to TCP listeners, so for TCPRoutes
+	// the address of the connection is matched.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_access_module.html
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_access_module.html
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	Rules []AccessControlRule `json:"rules,omitempty"`
+
+	// DefaultAction is the action taken when the client address does not match any of the rules.
+	// If not set, clients that do not match any of the rules are allowed.
+	//
+	// +optional
+	DefaultAction *AccessControlAction `json:"defaultAction,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// When a policy targets a Route, it overrides the rules of a policy that targets the Gateway.
+	// Support: Gateway, HTTPRoute, GRPCRoute, TCPRoute, TLSRoute
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, GRPCRoute, TCPRoute, or TLSRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute' || t.kind == 'TCPRoute' || t.kind == 'TLSRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group == 'gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name))"
+	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with Route kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind != 'Gateway'))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// AccessControlRule defines an access rule for a set of client addresses.
+type AccessControlRule struct {
+	// Action is the action taken when the client address matches one of the addresses of the rule.
+	Action AccessControlAction `json:"action"`
+
+	// Addresses is the list of client addresses that the rule matches.
+	// An address is either an IP address or a CIDR range, for example 10.0.0.1, 10.0.0.0/8, or 2001:db8::/32.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:MaxLength=43
+	Addresses []string `json:"addresses"`
+}
+
+// AccessControlAction defines the action taken for a client address.
+//
+// +kubebuilder:validation:Enum=allow;deny
+type AccessControlAction string
+
+const (
+	// AccessControlActionAllow allows access for the client address.
+	AccessControlActionAllow AccessControlAction = "allow"
+
+	// AccessControlActionDeny denies access for the client address.
+	// HTTP requests are rejected with a 403 response and stream connections are closed.
+	AccessControlActionDeny AccessControlAction = "deny"
+)
@@ -0,0 +1,237 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,scope=Namespaced,shortName=alpolicy
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=direct"
+
+// AccessLogPolicy is a Direct Attached Policy. It provides a way to override or disable the access logs
+// of the NGINX Gateway Fabric data plane for specific routes. When not targeted by an AccessLogPolicy,
+// a route uses the access logs configured in the NginxProxy logging settings.
+type AccessLogPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the AccessLogPolicy.
+	Spec AccessLogPolicySpec `json:"spec"`
+
+	// Status defines the state of the AccessLogPolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AccessLogPolicyList contains a list of AccessLogPolicies.
+type AccessLogPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AccessLogPolicy `json:"items"`
+}
+
+// AccessLogPolicySpec defines the desired state of the AccessLogPolicy.
+//
+// +kubebuilder:validation:XValidation:message="one of disable or accessLogs must be set",rule="(has(self.disable) && self.disable) || has(self.accessLogs)"
+// +kubebuilder:validation:XValidation:message="accessLogs cannot be set when disable is true",rule="!(has(self.disable) && self.disable && has(self.accessLogs))"
+//
+//nolint:lll
+type AccessLogPolicySpec struct {
+	// Disable turns off access logging for the targeted routes.
+	//
+	// +optional
+	Disable *bool `json:"disable,omitempty"`
+
+	// AccessLogs defines the access logs of the targeted routes. They replace the access logs configured
+	// in the NginxProxy logging settings.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	AccessLogs []NginxAccessLogEntry `json:"accessLogs,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: HTTPRoute, GRPCRoute.
+	//
+	// TargetRefs must be _distinct_. This means that the multi-part key defined by `kind` and `name` must
+	// be unique across all targetRef entries in the AccessLogPolicy.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be: HTTPRoute or GRPCRoute",rule="self.all(t, t.kind=='HTTPRoute' || t.kind=='GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// NginxAccessLogEntry defines the configuration for one of multiple NGINX access logs.
+type NginxAccessLogEntry struct {
+	// Format specifies the custom log format string.
+	// If not specified, NGINX default 'combined' format is used.
+	// Single quotes and line breaks are not allowed because the format is
+	// rendered inside a single-quoted NGINX log_format directive.
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#log_format
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=4096
+	// +kubebuilder:validation:Pattern=`^[^'\x0A\x0D]*$`
+	Format *string `json:"format,omitempty"`
+
+	// Escape specifies how to escape characters in variables for access log.
+	// Possible values are: default, json, none.
+	// If not specified, 'default' escaping is used. Only applies if format is specified.
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#log_format
+	//
+	// +optional
+	Escape *NginxAccessLogEscapeType `json:"escape,omitempty"`
+
+	// Condition limits the requests that are written to the access log.
+	// If not specified, all requests are logged.
+	//
+	// +optional
+	Condition *NginxAccessLogCondition `json:"condition,omitempty"`
+
+	// Destination defines where the access log is written to.
+	Destination NginxAccessLogDestination `json:"destination"`
+}
+
+// NginxAccessLogDestination defines where an access log is written to.
+//
+// +kubebuilder:validation:XValidation:message="file must be set if and only if type is File",rule="(self.type == 'File') == has(self.file)"
+// +kubebuilder:validation:XValidation:message="syslog must be set if and only if type is Syslog",rule="(self.type == 'Syslog') == has(self.syslog)"
+//
+//nolint:lll
+type NginxAccessLogDestination struct {
+	// File defines the file settings of the access log. Required when type is File.
+	//
+	// +optional
+	File *NginxAccessLogFile `json:"file,omitempty"`
+
+	// Syslog defines the syslog server settings of the access log. Required when type is Syslog.
+	//
+	// +optional
+	Syslog *NginxAccessLogSyslog `json:"syslog,omitempty"`
+
+	// Type is the type of the destination.
+	Type NginxAccessLogDestinationType `json:"type"`
+}
+
+// NginxAccessLogDestinationType defines the type of an access log destination.
+//
+// +kubebuilder:validation:Enum=Stdout;File;Syslog
+type NginxAccessLogDestinationType string
+
+const (
+	// NginxAccessLogDestinationStdout writes the access log to /dev/stdout.
+	NginxAccessLogDestinationStdout NginxAccessLogDestinationType = "Stdout"
+
+	// NginxAccessLogDestinationFile writes the access log to a file on a volume that is provisioned
+	// for the NGINX container.
+	NginxAccessLogDestinationFile NginxAccessLogDestinationType = "File"
+
+	// NginxAccessLogDestinationSyslog writes the access log to a syslog server.
+	NginxAccessLogDestinationSyslog NginxAccessLogDestinationType = "Syslog"
+)
+
+// NginxAccessLogFile defines the settings of a file access log.
+type NginxAccessLogFile struct {
+	// Name is the name of the log file. The file is created in the /var/log/nginx/access directory,
+	// which is backed by an emptyDir volume. To retain the logs, a sidecar or a node-level agent
+	// needs to collect them.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9][A-Za-z0-9._-]*$`
+	Name string `json:"name"`
+}
+
+// NginxAccessLogSyslog defines the settings of a syslog access log.
+// See https://nginx.org/en/docs/syslog.html
+type NginxAccessLogSyslog struct {
+	// Facility is the syslog facility of the messages. If not specified, NGINX uses 'local7'.
+	//
+	// +optional
+	Facility *SyslogFacility `json:"facility,omitempty"`
+
+	// Severity is the syslog severity of the messages. If not specified, NGINX uses 'info'.
+	//
+	// +optional
+	Severity *SyslogSeverity `json:"severity,omitempty"`
+
+	// Tag is the syslog tag of the messages. If not specified, NGINX uses 'nginx'.
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9_]{1,32}$`
+	Tag *string `json:"tag,omitempty"`
+
+	// Server is the address of the syslog server that accepts the messages over UDP.
+	// Format: alphanumeric hostname or IPv4 address with an optional port. If not specified, port 514 is used.
+	//
+	//nolint:lll
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?(?:\.[a-z0-9]([a-z0-9-]{0,61}[a-z0-9])?)*(?::\d{1,5})?$`
+	Server string `json:"server"`
+}
+
+// SyslogFacility defines the facility of syslog messages.
+//
+// +kubebuilder:validation:Enum=kern;user;mail;daemon;auth;intern;lpr;news;uucp;clock;authpriv;ftp;ntp;audit;alert;cron;local0;local1;local2;local3;local4;local5;local6;local7
+//
+//nolint:lll
+type SyslogFacility string
+
+// SyslogSeverity defines the severity of syslog messages.
+//
+// +kubebuilder:validation:Enum=debug;info;notice;warn;error;crit;alert;emerg
+type SyslogSeverity string
+
+// NginxAccessLogCondition limits the requests that are written to an access log.
+// If both statusCodes and samplePercent are specified, a request is logged only if it matches both.
+//
+// +kubebuilder:validation:XValidation:message="at least one of statusCodes or samplePercent must be set",rule="has(self.statusCodes) || has(self.samplePercent)"
+//
+//nolint:lll
+type NginxAccessLogCondition struct {
+	// SamplePercent is the percentage of requests that are logged. Integer from 1 to 100.
+	// Requests are sampled randomly.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	SamplePercent *int32 `json:"samplePercent,omitempty"`
+
+	// StatusCodes limits logging to requests with a matching response status code.
+	// Each entry is either an exact status code, such as '404', or a class of status codes, such as '5xx'.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[1-5]([0-9]{2}|xx)$`
+	StatusCodes []string `json:"statusCodes,omitempty"`
+}
+
+// NginxAccessLogEscapeType defines the escape setting for variables in access log format.
+//
+// +kubebuilder:validation:Enum=default;json;none
+type NginxAccessLogEscapeType string
+
+const (
+	// NginxAccessLogEscapeDefault specifies that characters '\"', '\', and other characters with values less
+	// than 32 or above 126 are escaped as '\xXX'.
+	NginxAccessLogEscapeDefault NginxAccessLogEscapeType = "default"
+
+	// NginxAccessLogEscapeJSON specifies that all characters not allowed in JSON strings are escaped.
+	// Characters '\"' and '\' are escaped as '\"' and '\\', characters with values less than 32 are
+	// escaped as '\n', '\r', '\t', '\b', '\f', or '\u00XX'.
+	NginxAccessLogEscapeJSON NginxAccessLogEscapeType = "json"
+
+	// NginxAccessLogEscapeNone disables escaping of characters.
+	NginxAccessLogEscapeNone NginxAccessLogEscapeType = "none"
+)
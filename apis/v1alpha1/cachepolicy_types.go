@@ -0,0 +1,207 @@
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:storageversion
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:categories=nginx-gateway-fabric,shortName=cachepolicy,scope=Namespaced
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+// +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
+
+// CachePolicy is an Inherited Attached Policy. It provides a way to cache the responses from the upstream
+// applications (backends) in NGINX.
+type CachePolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec defines the desired state of the CachePolicy.
+	Spec CachePolicySpec `json:"spec"`
+
+	// Status defines the state of the CachePolicy.
+	Status gatewayv1.PolicyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CachePolicyList contains a list of CachePolicies.
+type CachePolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CachePolicy `json:"items"`
+}
+
+// CachePolicySpec defines the desired state of the CachePolicy.
+type CachePolicySpec struct {
+	// Zone defines the cache zone, which holds the cache keys in shared memory and the cached
+	// responses on disk.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_path
+	//
+	// +optional
+	Zone *CacheZone `json:"zone,omitempty"`
+
+	// Key defines the key for caching. The key can contain text, variables, and their combination.
+	// Default: $scheme$proxy_host$request_uri.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_key
+	//
+	// +optional
+	// +kubebuilder:validation:Pattern=`^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	Key *string `json:"key,omitempty"`
+
+	// Valid sets the caching time for responses with the specified status codes.
+	// If not set, only the responses that include caching headers (for example, Cache-Control or Expires)
+	// are cached.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_valid
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Valid []CacheValid `json:"valid,omitempty"`
+
+	// Bypass defines the conditions under which the response is not taken from the cache.
+	// If at least one condition is not empty and is not equal to "0", the response is not taken from the cache.
+	// Conditions can contain text, variables, and their combination.
+	// Example: $cookie_nocache, $arg_nocache.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_bypass
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	Bypass []string `json:"bypass,omitempty"`
+
+	// UseStale defines the cases in which a stale cached response can be used while communicating
+	// with the upstream server.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_use_stale
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=11
+	// +listType=set
+	UseStale []CacheUseStaleCondition `json:"useStale,omitempty"`
+
+	// Lock enables the cache lock. When enabled, only one request at a time is allowed to populate a new
+	// cache element, and the other requests for the same element wait for the response to appear in the
+	// cache or for the lock to be released.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock
+	//
+	// +optional
+	Lock *CacheLock `json:"lock,omitempty"`
+
+	// TargetRefs identifies the API object(s) to apply the policy to.
+	// Objects must be in the same namespace as the policy.
+	// Support: Gateway, HTTPRoute
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway or HTTPRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group == 'gateway.networking.k8s.io')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(t1, self.exists_one(t2, t1.group == t2.group && t1.kind == t2.kind && t1.name == t2.name))"
+	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute kind in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute'))"
+	//nolint:lll
+	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
+}
+
+// CacheZone defines the settings of a cache zone.
+type CacheZone struct {
+	// Size is the size of the shared memory zone that stores the cache keys and metadata.
+	// One megabyte zone can store about 8 thousand keys.
+	// Default: 10m.
+	//
+	// +optional
+	Size *Size `json:"size,omitempty"`
+
+	// Inactive is the time after which the cached data that is not accessed is removed from the cache,
+	// regardless of its freshness.
+	// Default: 10m.
+	//
+	// +optional
+	Inactive *Duration `json:"inactive,omitempty"`
+
+	// MaxSize is the maximum size of the cache on disk. When the size is exceeded,
+	// the least recently used data is removed.
+	// The cache is stored in an emptyDir volume of the NGINX Pod, so MaxSize should be set to limit
+	// the disk usage of the Pod.
+	// If not set, the cache can use all of the available disk space.
+	//
+	// +optional
+	MaxSize *Size `json:"maxSize,omitempty"`
+}
+
+// CacheValid defines the caching time for responses with the specified status codes.
+type CacheValid struct {
+	// Codes are the status codes of the responses to cache for the specified time.
+	// The special value "any" matches all status codes.
+	// If not set, only 200, 301, and 302 responses are cached.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^(any|[1-5][0-9]{2})$`
+	Codes []string `json:"codes,omitempty"`
+
+	// Time is the caching time of the responses.
+	Time Duration `json:"time"`
+}
+
+// CacheLock defines the settings of the cache lock.
+type CacheLock struct {
+	// Age is the time after which another request is passed to the upstream server to populate the cache
+	// element, if the last request passed to populate it has not completed yet.
+	// Default: 5s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock_age
+	//
+	// +optional
+	Age *Duration `json:"age,omitempty"`
+
+	// Timeout is the time after which a waiting request is passed to the upstream server.
+	// Its response is not cached.
+	// Default: 5s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_cache_lock_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+}
+
+// CacheUseStaleCondition defines a case in which a stale cached response can be used.
+//
+// +kubebuilder:validation:Enum=error;timeout;invalid_header;updating;http_500;http_502;http_503;http_504;http_403;http_404;http_429
+type CacheUseStaleCondition string
+
+const (
+	// CacheUseStaleError allows using a stale response when an error occurs while communicating with
+	// the upstream server.
+	CacheUseStaleError CacheUseStaleCondition = "error"
+
+	// CacheUseStaleTimeout allows using a stale response when a timeout occurs while communicating with
+	// the upstream server.
+	CacheUseStaleTimeout CacheUseStaleCondition = "timeout"
+
+	// CacheUseStaleInvalidHeader allows using a stale response when the upstream server returns
+	// an empty or invalid response.
+	CacheUseStaleInvalidHeader CacheUseStaleCondition = "invalid_header"
+
+	// CacheUseStaleUpdating allows using a stale response when it is currently being updated.
+	CacheUseStaleUpdating CacheUseStaleCondition = "updating"
+
+	// CacheUseStaleHTTP500 allows using a stale response when the upstream server returns a 500 response.
+	CacheUseStaleHTTP500 CacheUseStaleCondition = "http_500"
+
+	// CacheUseStaleHTTP502 allows using a stale response when the upstream server returns a 502 response.
+	CacheUseStaleHTTP502 CacheUseStaleCondition = "http_502"
+
+	// CacheUseStaleHTTP503 allows using a stale response when the upstream server returns a 503 response.
+	CacheUseStaleHTTP503 CacheUseStaleCondition = "http_503"
+
+	// CacheUseStaleHTTP504 allows using a stale response when the upstream server returns a 504 response.
+	CacheUseStaleHTTP504 CacheUseStaleCondition = "http_504"
+
+	// CacheUseStaleHTTP403 allows using a stale response when the upstream server returns a 403 response.
+	CacheUseStaleHTTP403 CacheUseStaleCondition = "http_403"
+
+	// CacheUseStaleHTTP404 allows using a stale response when the upstream server returns a 404 response.
+	CacheUseStaleHTTP404 CacheUseStaleCondition = "http_404"
+
+	// CacheUseStaleHTTP429 allows using a stale response when the upstream server returns a 429 response.
+	CacheUseStaleHTTP429 CacheUseStaleCondition = "http_429"
+)
@@ -13,7 +13,8 @@ import (
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:metadata:labels="gateway.networking.k8s.io/policy=inherited"
 
-// RateLimitPolicy is an Inherited Attached Policy. It provides a way to set local rate limiting rules in NGINX.
+// RateLimitPolicy is an Inherited Attached Policy. It provides a way to set local and global rate limiting
+// and connection limiting rules in NGINX.
 type RateLimitPolicy struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -35,23 +36,38 @@ type RateLimitPolicyList struct {
 }
 
 // RateLimitPolicySpec defines the desired state of the RateLimitPolicy.
+//
+// +kubebuilder:validation:XValidation:message="RateLimit cannot be set when targeting a TCPRoute",rule="!(has(self.rateLimit) && self.targetRefs.exists(t, t.kind == 'TCPRoute'))"
+//
+//nolint:lll
 type RateLimitPolicySpec struct {
 	// RateLimit defines the Rate Limit settings.
+	// RateLimit is not supported for TCPRoutes.
 	//
 	// +optional
 	RateLimit *RateLimit `json:"rateLimit,omitempty"`
 
+	// LimitConn defines the settings for limiting the number of concurrent connections.
+	// For HTTPRoutes and GRPCRoutes, only connections that have a request being processed are counted.
+	// For TCPRoutes, all connections are counted.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_limit_conn_module.html
+	//
+	// +optional
+	LimitConn *LimitConn `json:"limitConn,omitempty"`
+
 	// TargetRefs identifies API object(s) to apply the policy to.
 	// Objects must be in the same namespace as the policy.
 	//
-	// Support: Gateway, HTTPRoute, GRPCRoute
+	// Support: Gateway, HTTPRoute, GRPCRoute, TCPRoute
 	//
 	// +kubebuilder:validation:MinItems=1
 	// +kubebuilder:validation:MaxItems=16
-	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, or GRPCRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute')"
+	// +kubebuilder:validation:XValidation:message="TargetRef Kind must be one of: Gateway, HTTPRoute, GRPCRoute, or TCPRoute",rule="self.all(t, t.kind == 'Gateway' || t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute' || t.kind == 'TCPRoute')"
 	// +kubebuilder:validation:XValidation:message="TargetRef Group must be gateway.networking.k8s.io",rule="self.all(t, t.group=='gateway.networking.k8s.io')"
 	// +kubebuilder:validation:XValidation:message="TargetRef Kind and Name combination must be unique",rule="self.all(p1, self.exists_one(p2, (p1.name == p2.name) && (p1.kind == p2.kind)))"
-	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute or GRPCRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute'))"
+	// +kubebuilder:validation:XValidation:message="Cannot mix Gateway kind with HTTPRoute, GRPCRoute, or TCPRoute kinds in targetRefs",rule="!(self.exists(t, t.kind == 'Gateway') && self.exists(t, t.kind == 'HTTPRoute' || t.kind == 'GRPCRoute' || t.kind == 'TCPRoute'))"
 	//nolint:lll
 	TargetRefs []gatewayv1.LocalPolicyTargetReference `json:"targetRefs"`
 }
@@ -59,10 +75,22 @@ type RateLimitPolicySpec struct {
 // RateLimit contains settings for Rate Limiting.
 type RateLimit struct {
 	// Local defines the local rate limit rules for this policy.
+	// Local rate limits are enforced by each NGINX replica independently.
 	//
 	// +optional
 	Local *LocalRateLimit `json:"local,omitempty"`
 
+	// Global defines the global rate limit rules for this policy.
+	// Global rate limits are enforced across all NGINX replicas of the Gateway by synchronizing
+	// the state of the rate limit zones between the replicas. The replicas connect to each other
+	// on port 12345 using a headless Service created for the Gateway.
+	// Global rate limiting is only supported with NGINX Plus.
+	//
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_zone_sync_module.html
+	//
+	// +optional
+	Global *GlobalRateLimit `json:"global,omitempty"`
+
 	// DryRun enables the dry run mode. In this mode, the rate limit is not actually applied, but the number of excessive
 	// requests is accounted as usual in the shared memory zone.
 	//
@@ -97,6 +125,14 @@ type LocalRateLimit struct {
 	Rules []RateLimitRule `json:"rules,omitempty"`
 }
 
+// GlobalRateLimit contains the global rate limit rules.
+type GlobalRateLimit struct {
+	// Rules contains the list of rate limit rules.
+	//
+	// +optional
+	Rules []RateLimitRule `json:"rules,omitempty"`
+}
+
 // RateLimitRule contains settings for a RateLimit Rule.
 //
 // +kubebuilder:validation:XValidation:message="NoDelay cannot be true when Delay is also set",rule="!(has(self.noDelay) && has(self.delay) && self.noDelay == true)"
@@ -152,6 +188,67 @@ type RateLimitRule struct {
 	Key string `json:"key"`
 }
 
+// LimitConn contains settings for Connection Limiting.
+type LimitConn struct {
+	// DryRun enables the dry run mode. In this mode, the number of connections is not limited, but the number of
+	// excessive connections is accounted as usual in the shared memory zone.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_dry_run
+	//
+	// +optional
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// LogLevel sets the desired logging level for cases when the server limits the number of connections.
+	// Allowed values are info, notice, warn or error.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_log_level
+	//
+	// +optional
+	LogLevel *RateLimitLogLevel `json:"logLevel,omitempty"`
+
+	// RejectCode sets the status code to return in response to rejected requests. Must fall into the range 400-599.
+	// RejectCode is ignored for TCPRoutes, where rejected connections are closed.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_status
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=400
+	// +kubebuilder:validation:Maximum=599
+	RejectCode *int32 `json:"rejectCode,omitempty"`
+
+	// Rules contains the list of connection limit rules.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Rules []LimitConnRule `json:"rules,omitempty"`
+}
+
+// LimitConnRule contains settings for a Connection Limit Rule.
+type LimitConnRule struct {
+	// ZoneSize is the size of the shared memory zone.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_zone
+	//
+	// +optional
+	ZoneSize *Size `json:"zoneSize,omitempty"`
+
+	// Key represents the key to which the connection limit is applied. The key can contain text, variables,
+	// and their combination. Connections with an empty key value are not accounted.
+	// For TCPRoutes, only variables supported in the NGINX stream context can be used.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn_zone
+	//
+	// +kubebuilder:validation:Pattern=`^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	Key string `json:"key"`
+
+	// MaxConnections is the maximum number of concurrent connections allowed per key value.
+	//
+	// Directive: https://nginx.org/en/docs/http/ngx_http_limit_conn_module.html#limit_conn
+	//
+	// +kubebuilder:validation:Minimum=1
+	MaxConnections int32 `json:"maxConnections"`
+}
+
 // Rate is a string value representing a rate. Rate can be specified in r/s or r/m.
 //
 // +kubebuilder:validation:Pattern=`^\d+r/[sm]$`
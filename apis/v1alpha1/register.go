@@ -34,8 +34,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 	scheme.AddKnownTypes(SchemeGroupVersion,
 		&NginxGateway{},
 		&NginxGatewayList{},
+		&AccessControlPolicy{},
+		&AccessControlPolicyList{},
+		&AccessLogPolicy{},
+		&AccessLogPolicyList{},
 		&AuthenticationFilter{},
 		&AuthenticationFilterList{},
+		&CachePolicy{},
+		&CachePolicyList{},
 		&ClientSettingsPolicy{},
 		&ClientSettingsPolicyList{},
 		&ProxySettingsPolicy{},
@@ -79,6 +79,40 @@ type UpstreamSettingsPolicySpec struct {
 	// +optional
 	UseClusterIP *bool `json:"useClusterIP,omitempty"`
 
+	// HealthCheck defines the active health check settings. When set, NGINX periodically sends
+	// health check requests to each upstream server and stops sending client requests to the servers
+	// that fail the checks, until they pass them again.
+	// Supported only with NGINX Plus.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#health_check
+	//
+	// +optional
+	HealthCheck *UpstreamHealthCheck `json:"healthCheck,omitempty"`
+
+	// PassiveHealthCheck defines the passive health check settings of the upstream servers.
+	// NGINX marks an upstream server as unavailable after a number of unsuccessful attempts to
+	// communicate with it, and stops sending client requests to it for a period of time.
+	//
+	// +optional
+	PassiveHealthCheck *UpstreamPassiveHealthCheck `json:"passiveHealthCheck,omitempty"`
+
+	// SlowStart is the time during which an upstream server recovers its weight from zero to its
+	// nominal value, when the server becomes healthy or available again.
+	// It cannot be used along with the hash, ip_hash and random load balancing methods, so
+	// LoadBalancingMethod must be set to one of round_robin, least_conn or least_time.
+	// Supported only with NGINX Plus.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#slow_start
+	//
+	// +optional
+	SlowStart *Duration `json:"slowStart,omitempty"`
+
+	// Stream defines the settings of the connections to the upstream servers of TCPRoutes, UDPRoutes and
+	// TLSRoutes. These settings are not applied to the upstreams of HTTPRoutes and GRPCRoutes.
+	// The settings are ignored for a TCPRoute or UDPRoute whose backends have different settings,
+	// which is reported in the Accepted condition of the Route.
+	//
+	// +optional
+	Stream *UpstreamStreamSettings `json:"stream,omitempty"`
+
 	// TargetRefs identifies API object(s) to apply the policy to.
 	// Objects must be in the same namespace as the policy.
 	// Support: Service
@@ -129,6 +163,178 @@ type UpstreamKeepAlive struct {
 	Timeout *Duration `json:"timeout,omitempty"`
 }
 
+// UpstreamHealthCheck defines the active health check settings for upstreams.
+// Health check requests are sent over plain HTTP, or over gRPC when GRPC is set.
+//
+// +kubebuilder:validation:XValidation:message="uri and match cannot be set when grpc is set",rule="!has(self.grpc) || (!has(self.uri) && !has(self.match))"
+//
+//nolint:lll
+type UpstreamHealthCheck struct {
+	// Interval is the interval between two consecutive health checks.
+	// Default: 5s.
+	//
+	// +optional
+	Interval *Duration `json:"interval,omitempty"`
+
+	// Fails is the number of consecutive failed health checks after which an upstream server
+	// is considered unhealthy.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Fails *int32 `json:"fails,omitempty"`
+
+	// Passes is the number of consecutive passed health checks after which an upstream server
+	// is considered healthy.
+	// Default: 1.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Passes *int32 `json:"passes,omitempty"`
+
+	// Port is the port used to connect to an upstream server for health checks.
+	// Default: the port of the upstream server.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port *int32 `json:"port,omitempty"`
+
+	// URI is the URI used in health check requests.
+	// Default: /.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=2048
+	// +kubebuilder:validation:Pattern=`^/[^\s;{}#$"'\\]*$`
+	URI *string `json:"uri,omitempty"`
+
+	// Match defines the conditions that a health check response must satisfy to pass the health check.
+	// If not set, a response passes the health check if its status code is 2xx or 3xx.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_hc_module.html#match
+	//
+	// +optional
+	Match *UpstreamHealthCheckMatch `json:"match,omitempty"`
+
+	// GRPC enables gRPC health checks, which use the gRPC health checking protocol
+	// instead of HTTP requests. Use it for upstreams that are referenced by GRPCRoutes.
+	//
+	// +optional
+	GRPC *UpstreamGRPCHealthCheck `json:"grpc,omitempty"`
+}
+
+// UpstreamHealthCheckMatch defines the conditions that a health check response must satisfy.
+//
+// +kubebuilder:validation:XValidation:message="at least one of status or body must be set",rule="has(self.status) || has(self.body)"
+//
+//nolint:lll
+type UpstreamHealthCheckMatch struct {
+	// Status is a list of expected status codes or ranges of status codes of the response.
+	// Examples: 200, 200-399.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +kubebuilder:validation:items:Pattern=`^[1-5][0-9]{2}(-[1-5][0-9]{2})?$`
+	Status []string `json:"status,omitempty"`
+
+	// Body is a regular expression that the response body must match.
+	// Format: must have all '"' escaped and must not contain any '$' or end with an unescaped '\'
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Body *string `json:"body,omitempty"`
+}
+
+// UpstreamGRPCHealthCheck defines the settings of gRPC health checks.
+type UpstreamGRPCHealthCheck struct {
+	// Service is the name of the gRPC service to check.
+	// If not set, the overall health of the upstream server is checked.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_.]+$`
+	Service *string `json:"service,omitempty"`
+
+	// Status is the gRPC status code that is expected in the response instead of a SERVING status.
+	// This is useful for upstream servers that do not implement the gRPC health checking protocol,
+	// which respond with the UNIMPLEMENTED (12) status code.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=16
+	Status *int32 `json:"status,omitempty"`
+}
+
+// UpstreamPassiveHealthCheck defines the passive health check settings for upstream servers.
+type UpstreamPassiveHealthCheck struct {
+	// MaxFails is the number of unsuccessful attempts to communicate with an upstream server that
+	// should happen in the duration set by FailTimeout to consider the server unavailable.
+	// Setting it to 0 disables the accounting of attempts.
+	// Default: 1.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#max_fails
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	MaxFails *int32 `json:"maxFails,omitempty"`
+
+	// FailTimeout is the time during which the specified number of unsuccessful attempts to communicate
+	// with an upstream server should happen to consider the server unavailable, and the period of
+	// time the server will be considered unavailable.
+	// Default: 10s.
+	// Directive: https://nginx.org/en/docs/http/ngx_http_upstream_module.html#fail_timeout
+	//
+	// +optional
+	FailTimeout *Duration `json:"failTimeout,omitempty"`
+}
+
+// UpstreamStreamSettings defines the settings of the connections to stream upstream servers.
+// The settings apply to a Route only when all backends of the Route have the same settings,
+// because NGINX applies them to all connections of the listener of the Route.
+type UpstreamStreamSettings struct {
+	// ProxyProtocol enables the PROXY protocol for the connections to the upstream servers,
+	// so that the upstream servers receive the address of the client.
+	// The upstream servers must accept the PROXY protocol.
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_protocol
+	//
+	// +optional
+	ProxyProtocol *bool `json:"proxyProtocol,omitempty"`
+
+	// ConnectTimeout is the timeout for establishing a connection with an upstream server.
+	// Default: 60s.
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_connect_timeout
+	//
+	// +optional
+	ConnectTimeout *Duration `json:"connectTimeout,omitempty"`
+
+	// Timeout is the timeout between two successive read or write operations on the client or the
+	// upstream server connections. The connection is closed if no data is transmitted within this time.
+	// Default: 10m.
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_timeout
+	//
+	// +optional
+	Timeout *Duration `json:"timeout,omitempty"`
+
+	// Responses is the number of datagrams expected from the upstream server in response to a client
+	// datagram. Applies only to UDPRoutes.
+	// By default, the number of datagrams is not limited.
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_responses
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Responses *int32 `json:"responses,omitempty"`
+
+	// Requests is the number of client datagrams after which the binding between the client and the
+	// UDP session is dropped, and the next datagrams of the client start a new session.
+	// Setting it to 0 disables the limit. Applies only to UDPRoutes.
+	// Default: 0.
+	// Directive: https://nginx.org/en/docs/stream/ngx_stream_proxy_module.html#proxy_requests
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	Requests *int32 `json:"requests,omitempty"`
+}
+
 // LoadBalancingType defines the supported load balancing methods.
 //
 // +kubebuilder:validation:Enum=round_robin;least_conn;ip_hash;hash;hash consistent;random;random two;random two least_conn;random two least_time=header;random two least_time=last_byte;least_time header;least_time last_byte;least_time header inflight;least_time last_byte inflight
@@ -51,6 +51,208 @@ func (in *APPolicyReference) DeepCopy() *APPolicyReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicy) DeepCopyInto(out *AccessControlPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicy.
+func (in *AccessControlPolicy) DeepCopy() *AccessControlPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicyList) DeepCopyInto(out *AccessControlPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessControlPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicyList.
+func (in *AccessControlPolicyList) DeepCopy() *AccessControlPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessControlPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlPolicySpec) DeepCopyInto(out *AccessControlPolicySpec) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]AccessControlRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DefaultAction != nil {
+		in, out := &in.DefaultAction, &out.DefaultAction
+		*out = new(AccessControlAction)
+		**out = **in
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlPolicySpec.
+func (in *AccessControlPolicySpec) DeepCopy() *AccessControlPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessControlRule) DeepCopyInto(out *AccessControlRule) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessControlRule.
+func (in *AccessControlRule) DeepCopy() *AccessControlRule {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessControlRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogPolicy) DeepCopyInto(out *AccessLogPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicy.
+func (in *AccessLogPolicy) DeepCopy() *AccessLogPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessLogPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogPolicyList) DeepCopyInto(out *AccessLogPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AccessLogPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicyList.
+func (in *AccessLogPolicyList) DeepCopy() *AccessLogPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AccessLogPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AccessLogPolicySpec) DeepCopyInto(out *AccessLogPolicySpec) {
+	*out = *in
+	if in.Disable != nil {
+		in, out := &in.Disable, &out.Disable
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = make([]NginxAccessLogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AccessLogPolicySpec.
+func (in *AccessLogPolicySpec) DeepCopy() *AccessLogPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AccessLogPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthenticationFilter) DeepCopyInto(out *AuthenticationFilter) {
 	*out = *in
@@ -261,6 +463,192 @@ func (in *BundleValidation) DeepCopy() *BundleValidation {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheLock) DeepCopyInto(out *CacheLock) {
+	*out = *in
+	if in.Age != nil {
+		in, out := &in.Age, &out.Age
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheLock.
+func (in *CacheLock) DeepCopy() *CacheLock {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheLock)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicy) DeepCopyInto(out *CachePolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicy.
+func (in *CachePolicy) DeepCopy() *CachePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicyList) DeepCopyInto(out *CachePolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CachePolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicyList.
+func (in *CachePolicyList) DeepCopy() *CachePolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CachePolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CachePolicySpec) DeepCopyInto(out *CachePolicySpec) {
+	*out = *in
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(CacheZone)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(string)
+		**out = **in
+	}
+	if in.Valid != nil {
+		in, out := &in.Valid, &out.Valid
+		*out = make([]CacheValid, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Bypass != nil {
+		in, out := &in.Bypass, &out.Bypass
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.UseStale != nil {
+		in, out := &in.UseStale, &out.UseStale
+		*out = make([]CacheUseStaleCondition, len(*in))
+		copy(*out, *in)
+	}
+	if in.Lock != nil {
+		in, out := &in.Lock, &out.Lock
+		*out = new(CacheLock)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TargetRefs != nil {
+		in, out := &in.TargetRefs, &out.TargetRefs
+		*out = make([]v1.LocalPolicyTargetReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CachePolicySpec.
+func (in *CachePolicySpec) DeepCopy() *CachePolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CachePolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheValid) DeepCopyInto(out *CacheValid) {
+	*out = *in
+	if in.Codes != nil {
+		in, out := &in.Codes, &out.Codes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheValid.
+func (in *CacheValid) DeepCopy() *CacheValid {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheValid)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CacheZone) DeepCopyInto(out *CacheZone) {
+	*out = *in
+	if in.Size != nil {
+		in, out := &in.Size, &out.Size
+		*out = new(Size)
+		**out = **in
+	}
+	if in.Inactive != nil {
+		in, out := &in.Inactive, &out.Inactive
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		*out = new(Size)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CacheZone.
+func (in *CacheZone) DeepCopy() *CacheZone {
+	if in == nil {
+		return nil
+	}
+	out := new(CacheZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Claim) DeepCopyInto(out *Claim) {
 	*out = *in
@@ -812,6 +1200,28 @@ func (in *GatewayLinkTLS) DeepCopy() *GatewayLinkTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
+	*out = *in
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]RateLimitRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GlobalRateLimit.
+func (in *GlobalRateLimit) DeepCopy() *GlobalRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(GlobalRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPBundleSource) DeepCopyInto(out *HTTPBundleSource) {
 	*out = *in
@@ -862,43 +1272,100 @@ func (in *JWTAuth) DeepCopy() *JWTAuth {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTAuth)
+	out := new(JWTAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTFileKeySource) DeepCopyInto(out *JWTFileKeySource) {
+	*out = *in
+	out.SecretRef = in.SecretRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTFileKeySource.
+func (in *JWTFileKeySource) DeepCopy() *JWTFileKeySource {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTFileKeySource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTRemoteKeySource) DeepCopyInto(out *JWTRemoteKeySource) {
+	*out = *in
+	if in.CACertificateRefs != nil {
+		in, out := &in.CACertificateRefs, &out.CACertificateRefs
+		*out = make([]LocalObjectReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRemoteKeySource.
+func (in *JWTRemoteKeySource) DeepCopy() *JWTRemoteKeySource {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTRemoteKeySource)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTFileKeySource) DeepCopyInto(out *JWTFileKeySource) {
+func (in *LimitConn) DeepCopyInto(out *LimitConn) {
 	*out = *in
-	out.SecretRef = in.SecretRef
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.LogLevel != nil {
+		in, out := &in.LogLevel, &out.LogLevel
+		*out = new(RateLimitLogLevel)
+		**out = **in
+	}
+	if in.RejectCode != nil {
+		in, out := &in.RejectCode, &out.RejectCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Rules != nil {
+		in, out := &in.Rules, &out.Rules
+		*out = make([]LimitConnRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTFileKeySource.
-func (in *JWTFileKeySource) DeepCopy() *JWTFileKeySource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitConn.
+func (in *LimitConn) DeepCopy() *LimitConn {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTFileKeySource)
+	out := new(LimitConn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *JWTRemoteKeySource) DeepCopyInto(out *JWTRemoteKeySource) {
+func (in *LimitConnRule) DeepCopyInto(out *LimitConnRule) {
 	*out = *in
-	if in.CACertificateRefs != nil {
-		in, out := &in.CACertificateRefs, &out.CACertificateRefs
-		*out = make([]LocalObjectReference, len(*in))
-		copy(*out, *in)
+	if in.ZoneSize != nil {
+		in, out := &in.ZoneSize, &out.ZoneSize
+		*out = new(Size)
+		**out = **in
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTRemoteKeySource.
-func (in *JWTRemoteKeySource) DeepCopy() *JWTRemoteKeySource {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LimitConnRule.
+func (in *LimitConnRule) DeepCopy() *LimitConnRule {
 	if in == nil {
 		return nil
 	}
-	out := new(JWTRemoteKeySource)
+	out := new(LimitConnRule)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1140,6 +1607,132 @@ func (in *NIMLogProfileBundleSource) DeepCopy() *NIMLogProfileBundleSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLogCondition) DeepCopyInto(out *NginxAccessLogCondition) {
+	*out = *in
+	if in.SamplePercent != nil {
+		in, out := &in.SamplePercent, &out.SamplePercent
+		*out = new(int32)
+		**out = **in
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLogCondition.
+func (in *NginxAccessLogCondition) DeepCopy() *NginxAccessLogCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLogCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLogDestination) DeepCopyInto(out *NginxAccessLogDestination) {
+	*out = *in
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(NginxAccessLogFile)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Syslog != nil {
+		in, out := &in.Syslog, &out.Syslog
+		*out = new(NginxAccessLogSyslog)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLogDestination.
+func (in *NginxAccessLogDestination) DeepCopy() *NginxAccessLogDestination {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLogDestination)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLogEntry) DeepCopyInto(out *NginxAccessLogEntry) {
+	*out = *in
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+	if in.Escape != nil {
+		in, out := &in.Escape, &out.Escape
+		*out = new(NginxAccessLogEscapeType)
+		**out = **in
+	}
+	if in.Condition != nil {
+		in, out := &in.Condition, &out.Condition
+		*out = new(NginxAccessLogCondition)
+		(*in).DeepCopyInto(*out)
+	}
+	in.Destination.DeepCopyInto(&out.Destination)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLogEntry.
+func (in *NginxAccessLogEntry) DeepCopy() *NginxAccessLogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLogFile) DeepCopyInto(out *NginxAccessLogFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLogFile.
+func (in *NginxAccessLogFile) DeepCopy() *NginxAccessLogFile {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLogFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NginxAccessLogSyslog) DeepCopyInto(out *NginxAccessLogSyslog) {
+	*out = *in
+	if in.Facility != nil {
+		in, out := &in.Facility, &out.Facility
+		*out = new(SyslogFacility)
+		**out = **in
+	}
+	if in.Severity != nil {
+		in, out := &in.Severity, &out.Severity
+		*out = new(SyslogSeverity)
+		**out = **in
+	}
+	if in.Tag != nil {
+		in, out := &in.Tag, &out.Tag
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxAccessLogSyslog.
+func (in *NginxAccessLogSyslog) DeepCopy() *NginxAccessLogSyslog {
+	if in == nil {
+		return nil
+	}
+	out := new(NginxAccessLogSyslog)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NginxGateway) DeepCopyInto(out *NginxGateway) {
 	*out = *in
@@ -1723,6 +2316,11 @@ func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 		*out = new(LocalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Global != nil {
+		in, out := &in.Global, &out.Global
+		*out = new(GlobalRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DryRun != nil {
 		in, out := &in.DryRun, &out.DryRun
 		*out = new(bool)
@@ -1817,6 +2415,11 @@ func (in *RateLimitPolicySpec) DeepCopyInto(out *RateLimitPolicySpec) {
 		*out = new(RateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LimitConn != nil {
+		in, out := &in.LimitConn, &out.LimitConn
+		*out = new(LimitConn)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetRefs != nil {
 		in, out := &in.TargetRefs, &out.TargetRefs
 		*out = make([]v1.LocalPolicyTargetReference, len(*in))
@@ -2166,6 +2769,106 @@ func (in *SpanAttribute) DeepCopy() *SpanAttribute {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamGRPCHealthCheck) DeepCopyInto(out *UpstreamGRPCHealthCheck) {
+	*out = *in
+	if in.Service != nil {
+		in, out := &in.Service, &out.Service
+		*out = new(string)
+		**out = **in
+	}
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamGRPCHealthCheck.
+func (in *UpstreamGRPCHealthCheck) DeepCopy() *UpstreamGRPCHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamGRPCHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamHealthCheck) DeepCopyInto(out *UpstreamHealthCheck) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Fails != nil {
+		in, out := &in.Fails, &out.Fails
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Passes != nil {
+		in, out := &in.Passes, &out.Passes
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Port != nil {
+		in, out := &in.Port, &out.Port
+		*out = new(int32)
+		**out = **in
+	}
+	if in.URI != nil {
+		in, out := &in.URI, &out.URI
+		*out = new(string)
+		**out = **in
+	}
+	if in.Match != nil {
+		in, out := &in.Match, &out.Match
+		*out = new(UpstreamHealthCheckMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(UpstreamGRPCHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamHealthCheck.
+func (in *UpstreamHealthCheck) DeepCopy() *UpstreamHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamHealthCheckMatch) DeepCopyInto(out *UpstreamHealthCheckMatch) {
+	*out = *in
+	if in.Status != nil {
+		in, out := &in.Status, &out.Status
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Body != nil {
+		in, out := &in.Body, &out.Body
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamHealthCheckMatch.
+func (in *UpstreamHealthCheckMatch) DeepCopy() *UpstreamHealthCheckMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamHealthCheckMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamKeepAlive) DeepCopyInto(out *UpstreamKeepAlive) {
 	*out = *in
@@ -2201,6 +2904,31 @@ func (in *UpstreamKeepAlive) DeepCopy() *UpstreamKeepAlive {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamPassiveHealthCheck) DeepCopyInto(out *UpstreamPassiveHealthCheck) {
+	*out = *in
+	if in.MaxFails != nil {
+		in, out := &in.MaxFails, &out.MaxFails
+		*out = new(int32)
+		**out = **in
+	}
+	if in.FailTimeout != nil {
+		in, out := &in.FailTimeout, &out.FailTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamPassiveHealthCheck.
+func (in *UpstreamPassiveHealthCheck) DeepCopy() *UpstreamPassiveHealthCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamPassiveHealthCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpstreamSettingsPolicy) DeepCopyInto(out *UpstreamSettingsPolicy) {
 	*out = *in
@@ -2288,6 +3016,26 @@ func (in *UpstreamSettingsPolicySpec) DeepCopyInto(out *UpstreamSettingsPolicySp
 		*out = new(bool)
 		**out = **in
 	}
+	if in.HealthCheck != nil {
+		in, out := &in.HealthCheck, &out.HealthCheck
+		*out = new(UpstreamHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PassiveHealthCheck != nil {
+		in, out := &in.PassiveHealthCheck, &out.PassiveHealthCheck
+		*out = new(UpstreamPassiveHealthCheck)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SlowStart != nil {
+		in, out := &in.SlowStart, &out.SlowStart
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Stream != nil {
+		in, out := &in.Stream, &out.Stream
+		*out = new(UpstreamStreamSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TargetRefs != nil {
 		in, out := &in.TargetRefs, &out.TargetRefs
 		*out = make([]v1.LocalPolicyTargetReference, len(*in))
@@ -2305,6 +3053,46 @@ func (in *UpstreamSettingsPolicySpec) DeepCopy() *UpstreamSettingsPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpstreamStreamSettings) DeepCopyInto(out *UpstreamStreamSettings) {
+	*out = *in
+	if in.ProxyProtocol != nil {
+		in, out := &in.ProxyProtocol, &out.ProxyProtocol
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ConnectTimeout != nil {
+		in, out := &in.ConnectTimeout, &out.ConnectTimeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(Duration)
+		**out = **in
+	}
+	if in.Responses != nil {
+		in, out := &in.Responses, &out.Responses
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Requests != nil {
+		in, out := &in.Requests, &out.Requests
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpstreamStreamSettings.
+func (in *UpstreamStreamSettings) DeepCopy() *UpstreamStreamSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(UpstreamStreamSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WAFPolicy) DeepCopyInto(out *WAFPolicy) {
 	*out = *in
@@ -169,6 +169,11 @@ type NginxProxySpec struct {
 	//
 	// +optional
 	ZoneSize *v1alpha1.Size `json:"zoneSize,omitempty"`
+	// Rollout defines how new NGINX configuration is rolled out to the NGINX Pods of a Gateway.
+	// If not specified, new configuration is sent to all NGINX Pods at once.
+	//
+	// +optional
+	Rollout *ConfigRollout `json:"rollout,omitempty"`
 	// DisableBaseHeaders specifies which default X-* base headers should be omitted
 	// from being added to the base proxy_set_header directives in the NGINX configuration.
 	// This allows users to set these headers themselves without NGF overriding them.
@@ -182,6 +187,36 @@ type NginxProxySpec struct {
 	DisableBaseHeaders []BaseHeaderName `json:"disableBaseHeaders,omitempty"`
 }
 
+// ConfigRollout defines a staged rollout of NGINX configuration. New configuration is first sent to the
+// canary Pods. If the canary Pods apply the configuration successfully and stay healthy for the soak period,
+// the configuration is sent to the remaining Pods. Otherwise, the rollout is aborted, the canary Pods are
+// reverted to the previous configuration, and the Gateway is marked as not Programmed.
+//
+// While a rollout is in progress, the next configuration update for the Gateway waits for the rollout to finish.
+type ConfigRollout struct {
+	// SoakPeriod is the time to wait after the canary Pods applied new configuration, before the configuration
+	// is sent to the remaining Pods. The maximum soak period is 5 minutes.
+	// Default is 0s.
+	//
+	// +optional
+	SoakPeriod *v1alpha1.Duration `json:"soakPeriod,omitempty"`
+
+	// RequireHealthy aborts the rollout if a canary Pod reports an unhealthy NGINX data plane
+	// at the end of the soak period. The health of the data plane is reported by the NGINX agent.
+	// Default is false.
+	//
+	// +optional
+	RequireHealthy *bool `json:"requireHealthy,omitempty"`
+
+	// Canary is the number of NGINX Pods that receive new configuration first.
+	// Value can be an absolute number (e.g. 1) or a percentage of the connected Pods (e.g. 25%).
+	// A percentage is rounded up, so at least one Pod is a canary. If the canary Pods include
+	// all of the connected Pods, the configuration is sent to all of them at once.
+	//
+	// +kubebuilder:validation:XIntOrString
+	Canary intstr.IntOrString `json:"canary"`
+}
+
 // BaseHeaderName is the name of a base X-* header that can be disabled
 // from being added to the base proxy_set_header directives in the NGINX configuration.
 //
@@ -413,6 +448,7 @@ const (
 // NginxLogging defines logging related settings for NGINX.
 //
 // +kubebuilder:validation:XValidation:message="JSON-formatted error logs are not supported when errorLevel is debug",rule="!(has(self.errorLogFormat) && self.errorLogFormat == 'json' && has(self.errorLevel) && self.errorLevel == 'debug')"
+// +kubebuilder:validation:XValidation:message="only one of accessLog or accessLogs can be set",rule="!(has(self.accessLog) && has(self.accessLogs))"
 //
 //nolint:lll
 type NginxLogging struct {
@@ -444,9 +480,19 @@ type NginxLogging struct {
 
 	// AccessLog defines the access log settings, including format itself and disabling option.
 	// For now only path /dev/stdout can be used.
+	// To write access logs to other destinations, or to configure multiple access logs, use accessLogs.
 	//
 	// +optional
 	AccessLog *NginxAccessLog `json:"accessLog,omitempty"`
+
+	// AccessLogs defines a list of access logs. Each access log has its own format, destination,
+	// and optional condition, and every request is written to all access logs whose condition it matches.
+	// Cannot be set together with accessLog.
+	// See https://nginx.org/en/docs/http/ngx_http_log_module.html#access_log
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	AccessLogs []v1alpha1.NginxAccessLogEntry `json:"accessLogs,omitempty"`
 }
 
 // NginxErrorLogFormat defines the output format for NGINX error logs.
@@ -723,6 +769,13 @@ type DeploymentSpec struct {
 	// +optional
 	WAFContainers *WAFContainerSpec `json:"wafContainers,omitempty"`
 
+	// ExtAuthzContainer defines container fields for the ext-authz shim sidecar container.
+	// This container is only deployed when a Route attached to the Gateway has an ExternalAuth filter
+	// with the GRPC protocol.
+	//
+	// +optional
+	ExtAuthzContainer *SidecarContainerSpec `json:"extAuthzContainer,omitempty"`
+
 	// Pod defines Pod-specific fields.
 	//
 	// +optional
@@ -752,6 +805,13 @@ type DaemonSetSpec struct {
 	// +optional
 	WAFContainers *WAFContainerSpec `json:"wafContainers,omitempty"`
 
+	// ExtAuthzContainer defines container fields for the ext-authz shim sidecar container.
+	// This container is only deployed when a Route attached to the Gateway has an ExternalAuth filter
+	// with the GRPC protocol.
+	//
+	// +optional
+	ExtAuthzContainer *SidecarContainerSpec `json:"extAuthzContainer,omitempty"`
+
 	// Pod defines Pod-specific fields.
 	//
 	// +optional
@@ -992,6 +1052,14 @@ type WAFContainerConfig struct {
 	VolumeMounts []corev1.VolumeMount `json:"volumeMounts,omitempty"`
 }
 
+// SidecarContainerSpec defines the configuration for a sidecar container of the NGINX Pods.
+type SidecarContainerSpec struct {
+	// Resources describes the compute resource requirements for the sidecar container.
+	//
+	// +optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
 // Image is the NGINX image to use.
 type Image struct {
 	// Repository is the image path.
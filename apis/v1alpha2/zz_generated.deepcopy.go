@@ -112,6 +112,32 @@ func (in *CompressionBuffers) DeepCopy() *CompressionBuffers {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ConfigRollout) DeepCopyInto(out *ConfigRollout) {
+	*out = *in
+	if in.SoakPeriod != nil {
+		in, out := &in.SoakPeriod, &out.SoakPeriod
+		*out = new(v1alpha1.Duration)
+		**out = **in
+	}
+	if in.RequireHealthy != nil {
+		in, out := &in.RequireHealthy, &out.RequireHealthy
+		*out = new(bool)
+		**out = **in
+	}
+	out.Canary = in.Canary
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigRollout.
+func (in *ConfigRollout) DeepCopy() *ConfigRollout {
+	if in == nil {
+		return nil
+	}
+	out := new(ConfigRollout)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ContainerSpec) DeepCopyInto(out *ContainerSpec) {
 	*out = *in
@@ -223,6 +249,11 @@ func (in *DaemonSetSpec) DeepCopyInto(out *DaemonSetSpec) {
 		*out = new(WAFContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtAuthzContainer != nil {
+		in, out := &in.ExtAuthzContainer, &out.ExtAuthzContainer
+		*out = new(SidecarContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Pod.DeepCopyInto(&out.Pod)
 	if in.Patches != nil {
 		in, out := &in.Patches, &out.Patches
@@ -266,6 +297,11 @@ func (in *DeploymentSpec) DeepCopyInto(out *DeploymentSpec) {
 		*out = new(WAFContainerSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ExtAuthzContainer != nil {
+		in, out := &in.ExtAuthzContainer, &out.ExtAuthzContainer
+		*out = new(SidecarContainerSpec)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Pod.DeepCopyInto(&out.Pod)
 	in.Container.DeepCopyInto(&out.Container)
 	if in.Patches != nil {
@@ -475,6 +511,13 @@ func (in *NginxLogging) DeepCopyInto(out *NginxLogging) {
 		*out = new(NginxAccessLog)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.AccessLogs != nil {
+		in, out := &in.AccessLogs, &out.AccessLogs
+		*out = make([]v1alpha1.NginxAccessLogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NginxLogging.
@@ -673,6 +716,11 @@ func (in *NginxProxySpec) DeepCopyInto(out *NginxProxySpec) {
 		*out = new(v1alpha1.Size)
 		**out = **in
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(ConfigRollout)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.DisableBaseHeaders != nil {
 		in, out := &in.DisableBaseHeaders, &out.DisableBaseHeaders
 		*out = make([]BaseHeaderName, len(*in))
@@ -1029,6 +1077,26 @@ func (in *ServiceSpec) DeepCopy() *ServiceSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarContainerSpec) DeepCopyInto(out *SidecarContainerSpec) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarContainerSpec.
+func (in *SidecarContainerSpec) DeepCopy() *SidecarContainerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarContainerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Telemetry) DeepCopyInto(out *Telemetry) {
 	*out = *in
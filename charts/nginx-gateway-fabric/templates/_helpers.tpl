@@ -199,6 +199,13 @@ Create namespaced RBAC rules.
   verbs:
   - list
   - watch
+- apiGroups:
+  - multicluster.x-k8s.io
+  resources:
+  - serviceimports
+  verbs:
+  - list
+  - watch
 - apiGroups:
   - gateway.networking.k8s.io
   resources:
@@ -247,6 +254,9 @@ Create namespaced RBAC rules.
   - authenticationfilters
   - proxysettingspolicies
   - ratelimitpolicies
+  - cachepolicies
+  - accesscontrolpolicies
+  - accesslogpolicies
   - wafpolicies
   {{- if .Values.nginxGateway.externalLoadBalancer.enable }}
   - externalloadbalancers
@@ -273,6 +283,9 @@ Create namespaced RBAC rules.
   - authenticationfilters/status
   - proxysettingspolicies/status
   - ratelimitpolicies/status
+  - cachepolicies/status
+  - accesscontrolpolicies/status
+  - accesslogpolicies/status
   - wafpolicies/status
   {{- if .Values.nginxGateway.externalLoadBalancer.enable }}
   - externalloadbalancers/status
@@ -355,20 +368,27 @@ Create cluster RBAC rules.
   - get
   - list
   - watch
-  {{- if or .Values.nginxGateway.productTelemetry.enable .Values.nginx.plus }}
 - apiGroups:
   - ""
   resources:
   - nodes
   verbs:
   - list
-  {{- end }}
+  - watch
 - apiGroups:
   - authentication.k8s.io
   resources:
   - tokenreviews
   verbs:
   - create
+{{- if .Values.nginxGateway.debugServer.enable }}
+- apiGroups:
+  - authorization.k8s.io
+  resources:
+  - subjectaccessreviews
+  verbs:
+  - create
+{{- end }}
 - apiGroups:
   - apiextensions.k8s.io
   resources:
@@ -3,6 +3,7 @@ package main
 import (
 	"errors"
 	"fmt"
+	"net"
 	"os"
 	"runtime/debug"
 	"strconv"
@@ -19,6 +20,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	ctlrZap "sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
@@ -42,6 +44,12 @@ const (
 	endpointPickerDisableTLSFlag    = "endpoint-picker-disable-tls"
 	endpointPickerTLSSkipVerifyFlag = "endpoint-picker-tls-skip-verify"
 	clusterDomainFlag               = "cluster-domain"
+	zoneSyncResolverFlag            = "zone-sync-resolver"
+	zoneSyncResolverUsage           = "The nameserver that NGINX Plus uses to resolve the other replicas of a Gateway " +
+		"when synchronizing the shared memory zones of global rate limits."
+
+	// resolvConfPath is the path of the resolver configuration of this Pod.
+	resolvConfPath = "/etc/resolv.conf"
 
 	plmStorageURLFlag               = "plm-storage-url"
 	plmStorageCredentialsSecretFlag = "plm-storage-credentials-secret" //nolint:gosec // not credentials
@@ -96,8 +104,15 @@ func createControllerCommand() *cobra.Command {
 		metricsDisableFlag                  = "metrics-disable"
 		metricsSecureFlag                   = "metrics-secure-serving"
 		metricsPortFlag                     = "metrics-port"
+		metricsNginxPlusUpstreamsFlag       = "metrics-nginx-plus-upstreams"
+		metricsNginxPlusUpstreamsCIDRFlag   = "metrics-nginx-plus-upstreams-allowed-cidr"
 		healthDisableFlag                   = "health-disable"
 		healthPortFlag                      = "health-port"
+		debugServerFlag                     = "debug-server"
+		debugServerPortFlag                 = "debug-server-port"
+		otlpEndpointFlag                    = "otlp-endpoint"
+		otlpInsecureFlag                    = "otlp-insecure"
+		otlpExportMetricsFlag               = "otlp-export-metrics"
 		leaderElectionDisableFlag           = "leader-election-disable"
 		leaderElectionLockNameFlag          = "leader-election-lock-name"
 		productTelemetryDisableFlag         = "product-telemetry-disable"
@@ -155,17 +170,32 @@ func createControllerCommand() *cobra.Command {
 		nginxSCCName                 = stringValidatingValue{
 			validator: validateResourceName,
 		}
-		disableMetrics    bool
-		metricsSecure     bool
-		metricsListenPort = intValidatingValue{
+		disableMetrics            bool
+		metricsSecure             bool
+		metricsNginxPlusUpstreams bool
+		metricsListenPort         = intValidatingValue{
 			validator: validatePort,
 			value:     9113,
 		}
+		metricsNginxPlusUpstreamsCIDR = stringValidatingValue{
+			validator: validateCIDR,
+		}
 		disableHealth    bool
 		healthListenPort = intValidatingValue{
 			validator: validatePort,
 			value:     8081,
 		}
+		debugServer           bool
+		debugServerListenPort = intValidatingValue{
+			validator: validatePort,
+			value:     8082,
+		}
+
+		otlpEndpoint = stringValidatingValue{
+			validator: validateEndpoint,
+		}
+		otlpInsecure      bool
+		otlpExportMetrics bool
 
 		disableLeaderElection  bool
 		leaderElectionLockName = stringValidatingValue{
@@ -222,6 +252,10 @@ func createControllerCommand() *cobra.Command {
 		},
 	}
 
+	zoneSyncResolver := stringValidatingValue{
+		validator: validateEndpointOptionalPort,
+	}
+
 	usageReportParams := usageReportParams{
 		SecretName: stringValidatingValue{
 			validator: validateResourceName,
@@ -263,10 +297,36 @@ func createControllerCommand() *cobra.Command {
 			)
 			log.SetLogger(logger)
 
-			if err := ensureNoPortCollisions(metricsListenPort.value, healthListenPort.value); err != nil {
+			ports := []int{metricsListenPort.value, healthListenPort.value}
+			if debugServer {
+				ports = append(ports, debugServerListenPort.value)
+			}
+
+			if err := ensureNoPortCollisions(ports...); err != nil {
 				return fmt.Errorf("error validating ports: %w", err)
 			}
 
+			if otlpExportMetrics && otlpEndpoint.value == "" {
+				return fmt.Errorf("--%s requires --%s to be set", otlpExportMetricsFlag, otlpEndpointFlag)
+			}
+
+			if metricsNginxPlusUpstreams && (!plus || disableMetrics) {
+				return fmt.Errorf(
+					"--%s requires --%s to be set and --%s to be unset",
+					metricsNginxPlusUpstreamsFlag,
+					plusFlag,
+					metricsDisableFlag,
+				)
+			}
+
+			if metricsNginxPlusUpstreamsCIDR.value != "" && !metricsNginxPlusUpstreams {
+				return fmt.Errorf(
+					"--%s requires --%s to be set",
+					metricsNginxPlusUpstreamsCIDRFlag,
+					metricsNginxPlusUpstreamsFlag,
+				)
+			}
+
 			imageSource := os.Getenv("BUILD_AGENT")
 			if imageSource != "gha" && imageSource != "local" {
 				imageSource = "unknown"
@@ -294,6 +354,13 @@ func createControllerCommand() *cobra.Command {
 				if err != nil {
 					return err
 				}
+
+				// the NGINX Pods use the same cluster DNS as this Pod
+				if zoneSyncResolver.value == "" {
+					if zoneSyncResolver.value, err = getNameserverFromResolvConf(resolvConfPath); err != nil {
+						return fmt.Errorf("error determining the zone sync resolver, set --%s: %w", zoneSyncResolverFlag, err)
+					}
+				}
 			}
 
 			plmStorageConfig := buildPLMStorageConfig(plmParams)
@@ -317,9 +384,20 @@ func createControllerCommand() *cobra.Command {
 					Port:    healthListenPort.value,
 				},
 				MetricsConfig: config.MetricsConfig{
-					Enabled: !disableMetrics,
-					Port:    metricsListenPort.value,
-					Secure:  metricsSecure,
+					Enabled:                       !disableMetrics,
+					Port:                          metricsListenPort.value,
+					Secure:                        metricsSecure,
+					NginxPlusUpstreams:            metricsNginxPlusUpstreams,
+					NginxPlusUpstreamsAllowedCIDR: metricsNginxPlusUpstreamsCIDR.value,
+				},
+				DebugServerConfig: config.DebugServerConfig{
+					Enabled: debugServer,
+					Port:    debugServerListenPort.value,
+				},
+				OTLPConfig: config.OTLPConfig{
+					Endpoint:      otlpEndpoint.value,
+					Insecure:      otlpInsecure,
+					ExportMetrics: otlpExportMetrics,
 				},
 				LeaderElection: config.LeaderElectionConfig{
 					Enabled:  !disableLeaderElection,
@@ -358,6 +436,7 @@ func createControllerCommand() *cobra.Command {
 				WatchNamespaces:             watchNamespaces.values,
 				ServerTLSDomain:             serverTLSDomain.value,
 				ClusterDomain:               clusterDomain.value,
+				ZoneSyncResolver:            zoneSyncResolver.value,
 				PLMStorageConfig:            plmStorageConfig,
 				ExternalLoadBalancer:        externalLoadBalancer,
 			}
@@ -454,6 +533,25 @@ func createControllerCommand() *cobra.Command {
 			" Please note that this endpoint will be secured with a self-signed certificate.",
 	)
 
+	cmd.Flags().BoolVar(
+		&metricsNginxPlusUpstreams,
+		metricsNginxPlusUpstreamsFlag,
+		false,
+		"Expose the upstream metrics from the NGINX Plus API of the nginx Pods, labeled with the names of the "+
+			"Gateways, routes, and Services they belong to. Requires the "+plusFlag+" flag to be set. "+
+			"The control plane must be allowed to access the NGINX Plus API of the nginx Pods, either with the "+
+			metricsNginxPlusUpstreamsCIDRFlag+" flag or with the allowed addresses of the NginxProxy resource.",
+	)
+
+	cmd.Flags().Var(
+		&metricsNginxPlusUpstreamsCIDR,
+		metricsNginxPlusUpstreamsCIDRFlag,
+		"The CIDR block that is added to the allow list of the NGINX Plus API of the nginx Pods, so that the "+
+			"control plane can read the upstream metrics. It must contain the IP addresses of the control plane "+
+			"Pods, for example the Pod CIDR of the cluster. Requires the "+metricsNginxPlusUpstreamsFlag+
+			" flag to be set.",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableHealth,
 		healthDisableFlag,
@@ -467,6 +565,45 @@ func createControllerCommand() *cobra.Command {
 		"Set the port where the health probe server is exposed. Format: [1024 - 65535]",
 	)
 
+	cmd.Flags().BoolVar(
+		&debugServer,
+		debugServerFlag,
+		false,
+		"Enable the debug server. The debug server serves the graph, the data plane configuration and the nginx "+
+			"configuration files of each Gateway, and the nginx agent connections over HTTPS with a self-signed "+
+			"certificate. Requests must be authenticated with a bearer token of a user that is allowed to get the "+
+			"/debug/* non-resource URLs.",
+	)
+
+	cmd.Flags().Var(
+		&debugServerListenPort,
+		debugServerPortFlag,
+		"Set the port where the debug server is exposed. Format: [1024 - 65535]",
+	)
+
+	cmd.Flags().Var(
+		&otlpEndpoint,
+		otlpEndpointFlag,
+		"The endpoint of the OTLP gRPC receiver to export the control plane traces to. The control plane creates "+
+			"spans for each event batch, including the graph build, the nginx configuration generation and push, "+
+			"and the status updates. If not set, nothing is exported. Format: <host>:<port>",
+	)
+
+	cmd.Flags().BoolVar(
+		&otlpInsecure,
+		otlpInsecureFlag,
+		false,
+		"Disable TLS for the connection to the OTLP receiver.",
+	)
+
+	cmd.Flags().BoolVar(
+		&otlpExportMetrics,
+		otlpExportMetricsFlag,
+		false,
+		"Export the Prometheus metrics of the control plane to the OTLP receiver. Requires the "+
+			otlpEndpointFlag+" flag to be set.",
+	)
+
 	cmd.Flags().BoolVar(
 		&disableLeaderElection,
 		leaderElectionDisableFlag,
@@ -541,6 +678,12 @@ func createControllerCommand() *cobra.Command {
 		"The nameserver used to resolve the NGINX Plus usage reporting endpoint. Used with NGINX Instance Manager.",
 	)
 
+	cmd.Flags().Var(
+		&zoneSyncResolver,
+		zoneSyncResolverFlag,
+		zoneSyncResolverUsage+" Defaults to the first nameserver in "+resolvConfPath+" of the control plane Pod.",
+	)
+
 	cmd.Flags().BoolVar(
 		&usageReportParams.SkipVerify,
 		usageReportSkipVerifyFlag,
@@ -1004,6 +1147,269 @@ func createEndpointPickerCommand() *cobra.Command {
 	return cmd
 }
 
+func createExtAuthzCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "ext-authz",
+		Short: "Shim server for communication between NGINX and Envoy ext_authz gRPC authorization servers",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			logger := ctlrZap.New().WithName("ext-authz-shim")
+			handler := createExtAuthzHandler(realAuthzClientFactory, logger)
+			return extAuthzServer(handler)
+		},
+	}
+}
+
+func createRenderCommand() *cobra.Command {
+	// flag names
+	const (
+		fileFlag                     = "file"
+		outputFlag                   = "output"
+		ipFamilyFlag                 = "ip-family"
+		gwAPIExperimentalFlag        = "gateway-api-experimental-features"
+		snippetsFlag                 = "snippets"
+		payloadProcessorFlag         = "payload-processor"
+		defaultGatewayCtlrName       = domain + "/nginx-gateway-controller"
+		defaultGatewayClassName      = "nginx"
+		defaultRenderOutputDir       = "rendered"
+		defaultRenderClusterIPFamily = string(ngfAPIv1alpha2.Dual)
+	)
+
+	// flag values
+	var (
+		gatewayCtlrName = stringValidatingValue{
+			validator: validateGatewayControllerName,
+			value:     defaultGatewayCtlrName,
+		}
+
+		gatewayClassName = stringValidatingValue{
+			validator: validateResourceName,
+			value:     defaultGatewayClassName,
+		}
+
+		clusterDomain = stringValidatingValue{
+			validator: validateClusterDomain,
+			value:     defaultDomain,
+		}
+
+		ipFamily = stringValidatingValue{
+			validator: validateIPFamily,
+			value:     defaultRenderClusterIPFamily,
+		}
+
+		zoneSyncResolver = stringValidatingValue{
+			validator: validateEndpointOptionalPort,
+		}
+
+		inputs                 []string
+		outputDir              string
+		plus                   bool
+		gwExperimentalFeatures bool
+		snippets               bool
+		payloadProcessor       bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "render",
+		Short: "Render the NGINX configuration for Gateway API resources without a cluster",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			logger := ctlrZap.New()
+			klog.SetLogger(logger)
+			log.SetLogger(logger)
+
+			return render(cmd.Context(), renderConfig{
+				fileManager: file.NewStdLibOSFileManager(),
+				logger:      logger,
+				inputs:      inputs,
+				outputDir:   outputDir,
+				RenderConfig: controller.RenderConfig{
+					Logger:               logger.WithName("render"),
+					GatewayCtlrName:      gatewayCtlrName.value,
+					GatewayClassName:     gatewayClassName.value,
+					ClusterDomain:        clusterDomain.value,
+					ZoneSyncResolver:     zoneSyncResolver.value,
+					ClusterIPFamily:      ngfAPIv1alpha2.IPFamilyType(ipFamily.value),
+					Plus:                 plus,
+					ExperimentalFeatures: gwExperimentalFeatures,
+					Snippets:             snippets,
+					PayloadProcessor:     payloadProcessor,
+				},
+			})
+		},
+	}
+
+	cmd.Flags().StringSliceVarP(
+		&inputs,
+		fileFlag,
+		"f",
+		[]string{},
+		"The files or directories containing the Kubernetes manifests to render the configuration for. "+
+			"Directories are not read recursively. Default values that the Kubernetes API server sets on "+
+			"resources are not applied, so manifests should be complete, for example the output of 'kubectl get -o yaml'.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(fileFlag))
+
+	cmd.Flags().StringVarP(
+		&outputDir,
+		outputFlag,
+		"o",
+		defaultRenderOutputDir,
+		"The directory to write the rendered configuration files and resource statuses to.",
+	)
+
+	cmd.Flags().Var(
+		&gatewayCtlrName,
+		gatewayCtlrNameFlag,
+		fmt.Sprintf(gatewayCtlrNameUsageFmt, domain),
+	)
+
+	cmd.Flags().Var(
+		&gatewayClassName,
+		gatewayClassFlag,
+		gatewayClassNameUsage,
+	)
+
+	cmd.Flags().Var(
+		&clusterDomain,
+		clusterDomainFlag,
+		`The DNS domain of the Kubernetes cluster.`,
+	)
+
+	cmd.Flags().Var(
+		&ipFamily,
+		ipFamilyFlag,
+		`The IP family of the Kubernetes cluster. Must be one of "dual", "ipv4", or "ipv6".`,
+	)
+
+	cmd.Flags().Var(
+		&zoneSyncResolver,
+		zoneSyncResolverFlag,
+		zoneSyncResolverUsage,
+	)
+
+	cmd.Flags().BoolVar(
+		&plus,
+		plusFlag,
+		false,
+		"Render configuration for NGINX Plus",
+	)
+
+	cmd.Flags().BoolVar(
+		&gwExperimentalFeatures,
+		gwAPIExperimentalFlag,
+		false,
+		"Enable the experimental features of Gateway API which are supported by NGINX Gateway Fabric.",
+	)
+
+	cmd.Flags().BoolVar(
+		&snippets,
+		snippetsFlag,
+		false,
+		"Enable SnippetsFilters and SnippetsPolicies.",
+	)
+
+	cmd.Flags().BoolVar(
+		&payloadProcessor,
+		payloadProcessorFlag,
+		false,
+		"Enable PayloadProcessors.",
+	)
+
+	return cmd
+}
+
+func createConfigVersionsCommand() *cobra.Command {
+	// flag names
+	const (
+		gatewayFlag            = "gateway"
+		versionFlag            = "version"
+		serverFlag             = "server"
+		tokenFlag              = "token"
+		tokenFileFlag          = "token-file"
+		insecureSkipVerifyFlag = "insecure-skip-verify"
+		defaultDebugServerURL  = "https://localhost:8082"
+	)
+
+	// flag values
+	var (
+		gateway = stringValidatingValue{
+			validator: validateNamespacedResourceName,
+		}
+
+		server = stringValidatingValue{
+			validator: validateURL,
+			value:     defaultDebugServerURL,
+		}
+
+		version            string
+		token              string
+		tokenFile          string
+		insecureSkipVerify bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "config-versions",
+		Short: "Get the nginx configuration version history of a Gateway from the debug server",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return getConfigVersions(cmd.Context(), configVersionsConfig{
+				out:                cmd.OutOrStdout(),
+				server:             server.value,
+				token:              token,
+				tokenFile:          tokenFile,
+				version:            version,
+				gateway:            parseGatewayName(gateway.value),
+				insecureSkipVerify: insecureSkipVerify,
+			})
+		},
+	}
+
+	cmd.Flags().Var(
+		&gateway,
+		gatewayFlag,
+		"The Gateway to get the configuration versions of. Format: namespace/name. "+
+			"If the namespace is omitted, the default namespace is used.",
+	)
+	utilruntime.Must(cmd.MarkFlagRequired(gatewayFlag))
+
+	cmd.Flags().StringVar(
+		&version,
+		versionFlag,
+		"",
+		"The configuration version to get the nginx configuration files of. If not set, the configuration "+
+			"versions are listed. A version can be pinned with the gateway.nginx.org/pinned-config-version "+
+			"annotation on the Gateway.",
+	)
+
+	cmd.Flags().Var(
+		&server,
+		serverFlag,
+		"The URL of the debug server of the NGINX Gateway Fabric control plane.",
+	)
+
+	cmd.Flags().StringVar(
+		&token,
+		tokenFlag,
+		"",
+		"The bearer token to authenticate with. Must belong to a user that is allowed to get the /debug/* "+
+			"non-resource URLs. If not set, the token is read from the token file.",
+	)
+
+	cmd.Flags().StringVar(
+		&tokenFile,
+		tokenFileFlag,
+		serviceAccountTokenPath,
+		"The file containing the bearer token to authenticate with.",
+	)
+
+	cmd.Flags().BoolVar(
+		&insecureSkipVerify,
+		insecureSkipVerifyFlag,
+		true,
+		"Skip the verification of the debug server certificate, which is self-signed.",
+	)
+
+	return cmd
+}
+
 func addEPPConnectionFlags(cmd *cobra.Command, disableTLS, tlsSkipVerify *bool) {
 	cmd.Flags().BoolVar(
 		disableTLS,
@@ -1107,6 +1513,30 @@ func createGatewayPodConfig(version, svcName string) (config.GatewayPodConfig, e
 	return c, nil
 }
 
+// getNameserverFromResolvConf returns the first nameserver of the given resolv.conf file,
+// in the format of the NGINX resolver directive.
+func getNameserverFromResolvConf(path string) (string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", err
+	}
+
+	for line := range strings.Lines(string(data)) {
+		fields := strings.Fields(line)
+		if len(fields) < 2 || fields[0] != "nameserver" {
+			continue
+		}
+
+		if ip := net.ParseIP(fields[1]); ip != nil && ip.To4() == nil {
+			return "[" + fields[1] + "]", nil
+		}
+
+		return fields[1], nil
+	}
+
+	return "", fmt.Errorf("no nameserver found in %s", path)
+}
+
 func getValueFromEnv(key string) (string, error) {
 	val := os.Getenv(key)
 	if val == "" {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"io"
 	"os"
+	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/gomega"
@@ -142,8 +143,15 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 				"--metrics-port=9114",
 				"--metrics-disable",
 				"--metrics-secure-serving",
+				"--metrics-nginx-plus-upstreams",
+				"--metrics-nginx-plus-upstreams-allowed-cidr=10.0.0.0/16",
 				"--health-port=8081",
 				"--health-disable",
+				"--debug-server",
+				"--debug-server-port=8082",
+				"--otlp-endpoint=otel-collector.monitoring:4317",
+				"--otlp-insecure",
+				"--otlp-export-metrics",
 				"--leader-election-lock-name=my-lock",
 				"--leader-election-disable=false",
 				"--nginx-plus",
@@ -155,6 +163,7 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 				"--usage-report-ca-secret=ca-secret",
 				"--usage-report-client-ssl-secret=client-secret",
 				"--usage-report-enforce-initial-report",
+				"--zone-sync-resolver=10.96.0.10",
 				"--snippets-filters",
 				"--snippets",
 				"--external-load-balancer",
@@ -262,6 +271,24 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "999" for "--metrics-secure-serving" flag: strconv.ParseBool:` +
 				` parsing "999": invalid syntax`,
 		},
+		{
+			name: "metrics-nginx-plus-upstreams is not a bool",
+			args: []string{
+				"--metrics-nginx-plus-upstreams=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--metrics-nginx-plus-upstreams" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
+		{
+			name: "metrics-nginx-plus-upstreams-allowed-cidr is invalid",
+			args: []string{
+				"--metrics-nginx-plus-upstreams-allowed-cidr=10.0.0.1", // not a CIDR
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "10.0.0.1" for "--metrics-nginx-plus-upstreams-allowed-cidr" flag: ` +
+				`"10.0.0.1" must be a valid CIDR block`,
+		},
 		{
 			name: "health-port is invalid type",
 			args: []string{
@@ -289,6 +316,24 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "999" for "--health-disable" flag: strconv.ParseBool:` +
 				` parsing "999": invalid syntax`,
 		},
+		{
+			name: "debug-server-port is outside of range",
+			args: []string{
+				"--debug-server-port=999", // outside of range
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-server-port" flag:` +
+				` port outside of valid port range [1024 - 65535]: 999`,
+		},
+		{
+			name: "debug-server is not a bool",
+			args: []string{
+				"--debug-server=999", // not a bool
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "999" for "--debug-server" flag: strconv.ParseBool:` +
+				` parsing "999": invalid syntax`,
+		},
 		{
 			name: "leader-election-lock-name is set to invalid string",
 			args: []string{
@@ -346,6 +391,23 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			wantErr:           true,
 			expectedErrPrefix: `invalid argument "!@#$" for "--usage-report-secret" flag: invalid format: `,
 		},
+		{
+			name: "otlp-endpoint is set to empty string",
+			args: []string{
+				"--otlp-endpoint=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--otlp-endpoint" flag: "" must be in the format <host>:<port>`,
+		},
+		{
+			name: "otlp-endpoint is an invalid endpoint",
+			args: []string{
+				"--otlp-endpoint=otel-collector",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "otel-collector" for "--otlp-endpoint" flag: ` +
+				`"otel-collector" must be in the format <host>:<port>`,
+		},
 		{
 			name: "usage-report-endpoint is set to empty string",
 			args: []string{
@@ -380,6 +442,23 @@ func TestControllerCmdFlagValidation(t *testing.T) {
 			expectedErrPrefix: `invalid argument "$*(invalid)" for "--usage-report-resolver" flag: ` +
 				`"$*(invalid)" must be in the format [http://|https://]<host>[:<port>]`,
 		},
+		{
+			name: "zone-sync-resolver is set to empty string",
+			args: []string{
+				"--zone-sync-resolver=",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "" for "--zone-sync-resolver" flag: must be set`,
+		},
+		{
+			name: "zone-sync-resolver is an invalid endpoint",
+			args: []string{
+				"--zone-sync-resolver=$*(invalid)",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "$*(invalid)" for "--zone-sync-resolver" flag: ` +
+				`"$*(invalid)" must be in the format [http://|https://]<host>[:<port>]`,
+		},
 		{
 			name: "usage-report-ca-secret is set to empty string",
 			args: []string{
@@ -850,6 +929,134 @@ func TestSleepCmdFlagValidation(t *testing.T) {
 	}
 }
 
+func TestRenderCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--file=gateway.yaml",
+				"--file=routes/",
+				"--output=out",
+				"--gateway-ctlr-name=gateway.nginx.org/nginx-gateway",
+				"--gatewayclass=nginx",
+				"--cluster-domain=cluster.local",
+				"--ip-family=ipv4",
+				"--nginx-plus",
+				"--gateway-api-experimental-features",
+				"--snippets",
+				"--payload-processor",
+			},
+			wantErr: false,
+		},
+		{
+			name: "only required flags",
+			args: []string{
+				"-f=gateway.yaml",
+			},
+			wantErr: false,
+		},
+		{
+			name:              "file is not set",
+			args:              nil,
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "file" not set`,
+		},
+		{
+			name: "gateway-ctlr-name is invalid",
+			args: []string{
+				"--file=gateway.yaml",
+				"--gateway-ctlr-name=nginx-gateway",
+			},
+			wantErr: true,
+			expectedErrPrefix: `invalid argument "nginx-gateway" for "--gateway-ctlr-name" flag: invalid format; ` +
+				"must be DOMAIN/PATH",
+		},
+		{
+			name: "gatewayclass is invalid",
+			args: []string{
+				"--file=gateway.yaml",
+				"--gatewayclass=@",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "@" for "--gatewayclass" flag: invalid format`,
+		},
+		{
+			name: "ip-family is invalid",
+			args: []string{
+				"--file=gateway.yaml",
+				"--ip-family=ipv5",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "ipv5" for "--ip-family" flag: must be one of`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createRenderCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
+
+func TestConfigVersionsCmdFlagValidation(t *testing.T) {
+	t.Parallel()
+	tests := []flagTestCase{
+		{
+			name: "valid flags",
+			args: []string{
+				"--gateway=test/gateway",
+				"--version=abc",
+				"--server=https://nginx-gateway.nginx-gateway:8082",
+				"--token=token",
+				"--token-file=/token",
+				"--insecure-skip-verify=false",
+			},
+			wantErr: false,
+		},
+		{
+			name: "only required flags",
+			args: []string{
+				"--gateway=gateway",
+			},
+			wantErr: false,
+		},
+		{
+			name:              "gateway is not set",
+			args:              nil,
+			wantErr:           true,
+			expectedErrPrefix: `required flag(s) "gateway" not set`,
+		},
+		{
+			name: "gateway is invalid",
+			args: []string{
+				"--gateway=test/gateway/extra",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "test/gateway/extra" for "--gateway" flag: invalid format`,
+		},
+		{
+			name: "server is invalid",
+			args: []string{
+				"--gateway=gateway",
+				"--server=ftp://localhost:8082",
+			},
+			wantErr:           true,
+			expectedErrPrefix: `invalid argument "ftp://localhost:8082" for "--server" flag: unsupported URL scheme`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			cmd := createConfigVersionsCommand()
+			testFlag(t, cmd, test)
+		})
+	}
+}
+
 func TestParseFlags(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -1218,3 +1425,55 @@ func TestEndpointPickerFlags(t *testing.T) {
 		})
 	}
 }
+
+func TestGetNameserverFromResolvConf(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		content       string
+		expNameserver string
+		expErr        bool
+	}{
+		{
+			name: "IPv4 nameserver",
+			content: "search nginx-gateway.svc.cluster.local svc.cluster.local cluster.local\n" +
+				"nameserver 10.96.0.10\n" +
+				"nameserver 10.96.0.11\n" +
+				"options ndots:5\n",
+			expNameserver: "10.96.0.10",
+		},
+		{
+			name:          "IPv6 nameserver",
+			content:       "nameserver fd00:10:96::a\n",
+			expNameserver: "[fd00:10:96::a]",
+		},
+		{
+			name:    "no nameserver",
+			content: "search cluster.local\noptions ndots:5\n",
+			expErr:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			path := filepath.Join(t.TempDir(), "resolv.conf")
+			g.Expect(os.WriteFile(path, []byte(test.content), 0o600)).To(Succeed())
+
+			nameserver, err := getNameserverFromResolvConf(path)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+			g.Expect(nameserver).To(Equal(test.expNameserver))
+		})
+	}
+
+	g := NewWithT(t)
+	_, err := getNameserverFromResolvConf(filepath.Join(t.TempDir(), "missing"))
+	g.Expect(err).To(HaveOccurred())
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/debug"
+)
+
+const (
+	// serviceAccountTokenPath is the path of the token of the Pod's service account.
+	//nolint:gosec // not a credential, the path to one
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	defaultGatewayNamespace = "default"
+)
+
+type configVersionsConfig struct {
+	out io.Writer
+	// server is the URL of the debug server.
+	server string
+	// token authenticates the request. If empty, the token is read from tokenFile.
+	token     string
+	tokenFile string
+	// version is the configuration version to get the files of. If empty, the configuration versions are listed.
+	version            string
+	gateway            types.NamespacedName
+	insecureSkipVerify bool
+}
+
+// getConfigVersions gets the nginx configuration version history of a Gateway, or the files of one of its
+// configuration versions, from the debug server and writes the response to the output.
+func getConfigVersions(ctx context.Context, cfg configVersionsConfig) error {
+	token := cfg.token
+	if token == "" {
+		data, err := os.ReadFile(cfg.tokenFile)
+		if err != nil {
+			return fmt.Errorf("error reading token file: %w", err)
+		}
+		token = strings.TrimSpace(string(data))
+	}
+
+	path := fmt.Sprintf("%s/%s/%s/versions", debug.GatewaysPath, cfg.gateway.Namespace, cfg.gateway.Name)
+	if cfg.version != "" {
+		path = fmt.Sprintf("%s/%s/files", path, url.PathEscape(cfg.version))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(cfg.server, "/")+path, nil)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				InsecureSkipVerify: cfg.insecureSkipVerify, //nolint:gosec // the debug server cert is self-signed
+				MinVersion:         tls.VersionTLS12,
+			},
+		},
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending request to the debug server: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("debug server returned %s: %s", resp.Status, strings.TrimSpace(string(body)))
+	}
+
+	if _, err := io.Copy(cfg.out, resp.Body); err != nil {
+		return fmt.Errorf("error writing response: %w", err)
+	}
+
+	return nil
+}
+
+// parseGatewayName parses a Gateway name in the format "namespace/name" or "name". If the namespace is omitted,
+// the default namespace is used.
+func parseGatewayName(value string) types.NamespacedName {
+	if ns, name, ok := strings.Cut(value, "/"); ok {
+		return types.NamespacedName{Namespace: ns, Name: name}
+	}
+
+	return types.NamespacedName{Namespace: defaultGatewayNamespace, Name: value}
+}
@@ -0,0 +1,98 @@
+package main
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestGetConfigVersions(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		_, _ = w.Write([]byte(r.URL.EscapedPath()))
+	}))
+	t.Cleanup(server.Close)
+
+	tokenFile := filepath.Join(t.TempDir(), "token")
+	g.Expect(os.WriteFile(tokenFile, []byte("token\n"), 0o600)).To(Succeed())
+
+	gateway := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	tests := []struct {
+		name           string
+		token          string
+		tokenFile      string
+		version        string
+		expOutput      string
+		expErrContains string
+	}{
+		{
+			name:      "lists the configuration versions",
+			token:     "token",
+			expOutput: "/debug/gateways/test/gateway/versions",
+		},
+		{
+			name:      "gets the files of a configuration version with the token from the token file",
+			tokenFile: tokenFile,
+			version:   "a/b+c=",
+			expOutput: "/debug/gateways/test/gateway/versions/a%2Fb+c=/files",
+		},
+		{
+			name:           "debug server returns an error",
+			token:          "invalid",
+			expErrContains: "debug server returned 401 Unauthorized: Unauthorized",
+		},
+		{
+			name:           "token file does not exist",
+			tokenFile:      filepath.Join(t.TempDir(), "missing"),
+			expErrContains: "error reading token file",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var out bytes.Buffer
+			err := getConfigVersions(t.Context(), configVersionsConfig{
+				out:                &out,
+				server:             server.URL,
+				token:              test.token,
+				tokenFile:          test.tokenFile,
+				version:            test.version,
+				gateway:            gateway,
+				insecureSkipVerify: true,
+			})
+
+			if test.expErrContains != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErrContains)))
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(out.String()).To(Equal(test.expOutput))
+		})
+	}
+}
+
+func TestParseGatewayName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(parseGatewayName("test/gateway")).To(Equal(types.NamespacedName{Namespace: "test", Name: "gateway"}))
+	g.Expect(parseGatewayName("gateway")).To(Equal(types.NamespacedName{Namespace: "default", Name: "gateway"}))
+}
@@ -0,0 +1,217 @@
+package main
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
+)
+
+// authzClientFactory creates a new AuthorizationClient and returns a close function.
+// If tlsConfig is nil, the connection is not encrypted.
+type authzClientFactory func(target string, tlsConfig *tls.Config) (authv3.AuthorizationClient, func() error, error)
+
+// extAuthzServer starts an HTTP server on the ext_authz shim port with the provided handler.
+func extAuthzServer(handler http.Handler) error {
+	server := &http.Server{
+		Addr:              fmt.Sprintf("127.0.0.1:%d", types.ExtAuthzShimPort),
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	return server.ListenAndServe()
+}
+
+// realAuthzClientFactory creates a new gRPC connection and client per request.
+func realAuthzClientFactory(target string, tlsConfig *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+	creds := insecure.NewCredentials()
+	if tlsConfig != nil {
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.NewClient(target, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return authv3.NewAuthorizationClient(conn), conn.Close, nil
+}
+
+// createExtAuthzHandler returns an http.Handler that translates the auth subrequests of NGINX to Check calls
+// of an Envoy ext_authz gRPC server. NGINX allows the client request if the subrequest returns a 2xx code,
+// returns 401 and 403 codes to the client, and treats any other code as an error.
+func createExtAuthzHandler(factory authzClientFactory, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		target := r.Header.Get(types.ExtAuthzServerHeader)
+		if target == "" {
+			msg := "missing required header: " + types.ExtAuthzServerHeader
+			logger.Error(errors.New(msg), "error contacting ext_authz server")
+			http.Error(w, msg, http.StatusBadRequest)
+			return
+		}
+
+		tlsConfig, err := buildExtAuthzTLSConfig(r.Header)
+		if err != nil {
+			logger.Error(err, "error building TLS configuration", "server", target)
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		client, closeConn, err := factory(target, tlsConfig)
+		if err != nil {
+			logger.Error(err, "error creating gRPC client", "server", target)
+			http.Error(w, fmt.Sprintf("error creating gRPC client: %v", err), http.StatusInternalServerError)
+			return
+		}
+		defer func() {
+			if err := closeConn(); err != nil {
+				logger.Error(err, "error closing gRPC connection")
+			}
+		}()
+
+		checkReq, err := buildCheckRequest(r)
+		if err != nil {
+			logger.Error(err, "error building check request")
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		resp, err := client.Check(r.Context(), checkReq)
+		if err != nil {
+			logger.Error(err, "error calling ext_authz server", "server", target)
+			http.Error(w, fmt.Sprintf("error calling ext_authz server: %v", err), http.StatusBadGateway)
+			return
+		}
+
+		if codes.Code(resp.GetStatus().GetCode()) == codes.OK {
+			setHeaders(w, resp.GetOkResponse().GetHeaders())
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+
+		denied := resp.GetDeniedResponse()
+		setHeaders(w, denied.GetHeaders())
+
+		// NGINX only returns 401 and 403 codes of the auth subrequest to the client,
+		// so any other code is denied as forbidden, like Envoy does by default
+		code := http.StatusForbidden
+		if int(denied.GetStatus().GetCode()) == http.StatusUnauthorized {
+			code = http.StatusUnauthorized
+		}
+
+		logger.V(1).Info("Request denied by ext_authz server", "server", target, "code", code)
+		w.WriteHeader(code)
+	})
+}
+
+// buildExtAuthzTLSConfig returns the TLS configuration for the connection to the ext_authz server,
+// or nil if TLS is not enabled.
+func buildExtAuthzTLSConfig(header http.Header) (*tls.Config, error) {
+	if header.Get(types.ExtAuthzTLSHeader) != "on" {
+		return nil, nil //nolint:nilnil // no TLS configuration when TLS is disabled
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		ServerName: header.Get(types.ExtAuthzTLSServerNameHeader),
+	}
+
+	if caFile := header.Get(types.ExtAuthzTLSTrustedCAHeader); caFile != "" {
+		caCert, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA certificate: %w", err)
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caCert) {
+			return nil, fmt.Errorf("no valid CA certificates in %s", caFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	return tlsConfig, nil
+}
+
+// buildCheckRequest builds the ext_authz check request for the client request that NGINX describes
+// in the auth subrequest. The headers of the shim are not forwarded to the ext_authz server.
+func buildCheckRequest(r *http.Request) (*authv3.CheckRequest, error) {
+	method := r.Header.Get(types.ExtAuthzMethodHeader)
+	path := r.Header.Get(types.ExtAuthzPathHeader)
+	scheme := r.Header.Get(types.ExtAuthzSchemeHeader)
+
+	headers := map[string]string{
+		":authority": r.Host,
+		":method":    method,
+		":path":      path,
+		":scheme":    scheme,
+	}
+	for key, values := range r.Header {
+		if strings.HasPrefix(key, types.ExtAuthzHeaderPrefix) {
+			continue
+		}
+		// ext_authz servers expect lowercase header keys, like HTTP/2 header field names
+		headers[strings.ToLower(key)] = strings.Join(values, ",")
+	}
+
+	httpReq := &authv3.AttributeContext_HttpRequest{
+		Method:   method,
+		Path:     path,
+		Host:     r.Host,
+		Scheme:   scheme,
+		Protocol: r.Header.Get(types.ExtAuthzProtocolHeader),
+		Headers:  headers,
+		Size:     -1,
+	}
+
+	if requestHasBody(r) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		httpReq.RawBody = body
+		httpReq.Size = int64(len(body))
+	}
+
+	checkReq := &authv3.CheckRequest{
+		Attributes: &authv3.AttributeContext{
+			Request: &authv3.AttributeContext_Request{
+				Time: timestamppb.Now(),
+				Http: httpReq,
+			},
+		},
+	}
+
+	if clientAddr := r.Header.Get(types.ExtAuthzClientAddressHeader); clientAddr != "" {
+		checkReq.Attributes.Source = &authv3.AttributeContext_Peer{
+			Address: &corev3.Address{
+				Address: &corev3.Address_SocketAddress{
+					SocketAddress: &corev3.SocketAddress{Address: clientAddr},
+				},
+			},
+		}
+	}
+
+	return checkReq, nil
+}
+
+// setHeaders sets the headers of the ext_authz response on the auth subrequest response.
+func setHeaders(w http.ResponseWriter, headers []*corev3.HeaderValueOption) {
+	for _, h := range headers {
+		w.Header().Add(h.GetHeader().GetKey(), h.GetHeader().GetValue())
+	}
+}
@@ -0,0 +1,346 @@
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	authv3 "github.com/envoyproxy/go-control-plane/envoy/service/auth/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
+)
+
+type mockAuthorizationClient struct {
+	CheckFunc func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error)
+}
+
+func (m *mockAuthorizationClient) Check(
+	ctx context.Context,
+	req *authv3.CheckRequest,
+	_ ...grpc.CallOption,
+) (*authv3.CheckResponse, error) {
+	if m.CheckFunc != nil {
+		return m.CheckFunc(ctx, req)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func newAuthzRequest(body string) *http.Request {
+	var req *http.Request
+	if body == "" {
+		req = httptest.NewRequest(http.MethodGet, "/", nil)
+	} else {
+		req = httptest.NewRequest(http.MethodGet, "/", strings.NewReader(body))
+	}
+
+	req.Host = "cafe.example.com"
+	req.Header.Set(types.ExtAuthzServerHeader, "ext-auth.default.svc:9001")
+	req.Header.Set(types.ExtAuthzMethodHeader, http.MethodPost)
+	req.Header.Set(types.ExtAuthzPathHeader, "/coffee?size=large")
+	req.Header.Set(types.ExtAuthzSchemeHeader, "https")
+	req.Header.Set(types.ExtAuthzProtocolHeader, "HTTP/1.1")
+	req.Header.Set(types.ExtAuthzClientAddressHeader, "10.0.0.1")
+	req.Header.Set("Authorization", "Bearer token")
+	req.Header.Add("X-Custom", "a")
+	req.Header.Add("X-Custom", "b")
+
+	return req
+}
+
+func checkResponse(code codes.Code) *authv3.CheckResponse {
+	return &authv3.CheckResponse{Status: status.New(code, "").Proto()}
+}
+
+func TestExtAuthzHandler(t *testing.T) {
+	t.Parallel()
+
+	headerOption := func(key, value string) *corev3.HeaderValueOption {
+		return &corev3.HeaderValueOption{Header: &corev3.HeaderValue{Key: key, Value: value}}
+	}
+
+	tests := []struct {
+		factory         authzClientFactory
+		expectedHeaders map[string]string
+		name            string
+		removeHeader    string
+		expectedCode    int
+	}{
+		{
+			name:         "missing server header",
+			removeHeader: types.ExtAuthzServerHeader,
+			expectedCode: http.StatusBadRequest,
+		},
+		{
+			name: "error creating client",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				return nil, nil, errors.New("client error")
+			},
+			expectedCode: http.StatusInternalServerError,
+		},
+		{
+			name: "error calling check",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				client := &mockAuthorizationClient{
+					CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+						return nil, errors.New("unavailable")
+					},
+				}
+				return client, func() error { return nil }, nil
+			},
+			expectedCode: http.StatusBadGateway,
+		},
+		{
+			name: "request allowed",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				client := &mockAuthorizationClient{
+					CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+						resp := checkResponse(codes.OK)
+						resp.HttpResponse = &authv3.CheckResponse_OkResponse{
+							OkResponse: &authv3.OkHttpResponse{
+								Headers: []*corev3.HeaderValueOption{headerOption("X-User-Id", "user")},
+							},
+						}
+						return resp, nil
+					},
+				}
+				return client, func() error { return nil }, nil
+			},
+			expectedCode:    http.StatusOK,
+			expectedHeaders: map[string]string{"X-User-Id": "user"},
+		},
+		{
+			name: "request denied as unauthorized",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				client := &mockAuthorizationClient{
+					CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+						resp := checkResponse(codes.Unauthenticated)
+						resp.HttpResponse = &authv3.CheckResponse_DeniedResponse{
+							DeniedResponse: &authv3.DeniedHttpResponse{
+								Status:  &typev3.HttpStatus{Code: typev3.StatusCode_Unauthorized},
+								Headers: []*corev3.HeaderValueOption{headerOption("WWW-Authenticate", "Bearer")},
+							},
+						}
+						return resp, nil
+					},
+				}
+				return client, func() error { return nil }, nil
+			},
+			expectedCode:    http.StatusUnauthorized,
+			expectedHeaders: map[string]string{"WWW-Authenticate": "Bearer"},
+		},
+		{
+			name: "request denied with other code is forbidden",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				client := &mockAuthorizationClient{
+					CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+						resp := checkResponse(codes.PermissionDenied)
+						resp.HttpResponse = &authv3.CheckResponse_DeniedResponse{
+							DeniedResponse: &authv3.DeniedHttpResponse{
+								Status: &typev3.HttpStatus{Code: typev3.StatusCode_TooManyRequests},
+							},
+						}
+						return resp, nil
+					},
+				}
+				return client, func() error { return nil }, nil
+			},
+			expectedCode: http.StatusForbidden,
+		},
+		{
+			name: "request denied without denied response",
+			factory: func(string, *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+				client := &mockAuthorizationClient{
+					CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+						return checkResponse(codes.PermissionDenied), nil
+					},
+				}
+				return client, func() error { return nil }, nil
+			},
+			expectedCode: http.StatusForbidden,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			req := newAuthzRequest("")
+			if test.removeHeader != "" {
+				req.Header.Del(test.removeHeader)
+			}
+
+			w := httptest.NewRecorder()
+			createExtAuthzHandler(test.factory, logr.Discard()).ServeHTTP(w, req)
+
+			g.Expect(w.Code).To(Equal(test.expectedCode))
+			for key, value := range test.expectedHeaders {
+				g.Expect(w.Header().Get(key)).To(Equal(value))
+			}
+		})
+	}
+}
+
+func TestExtAuthzHandlerUsesServerAndTLSHeaders(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var gotTarget string
+	var gotTLSConfig *tls.Config
+	factory := func(target string, tlsConfig *tls.Config) (authv3.AuthorizationClient, func() error, error) {
+		gotTarget = target
+		gotTLSConfig = tlsConfig
+
+		client := &mockAuthorizationClient{
+			CheckFunc: func(context.Context, *authv3.CheckRequest) (*authv3.CheckResponse, error) {
+				return checkResponse(codes.OK), nil
+			},
+		}
+		return client, func() error { return nil }, nil
+	}
+
+	req := newAuthzRequest("")
+	req.Header.Set(types.ExtAuthzTLSHeader, "on")
+	req.Header.Set(types.ExtAuthzTLSServerNameHeader, "auth.example.com")
+
+	w := httptest.NewRecorder()
+	createExtAuthzHandler(factory, logr.Discard()).ServeHTTP(w, req)
+
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+	g.Expect(gotTarget).To(Equal("ext-auth.default.svc:9001"))
+	g.Expect(gotTLSConfig).ToNot(BeNil())
+	g.Expect(gotTLSConfig.ServerName).To(Equal("auth.example.com"))
+	g.Expect(gotTLSConfig.RootCAs).To(BeNil())
+}
+
+func TestBuildExtAuthzTLSConfig(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	invalidCA := filepath.Join(dir, "invalid.crt")
+	if err := os.WriteFile(invalidCA, []byte("not a certificate"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		headers   map[string]string
+		name      string
+		expectNil bool
+		expectErr bool
+	}{
+		{
+			name:      "TLS disabled",
+			headers:   map[string]string{},
+			expectNil: true,
+		},
+		{
+			name: "TLS enabled with system CA certificates",
+			headers: map[string]string{
+				types.ExtAuthzTLSHeader:           "on",
+				types.ExtAuthzTLSServerNameHeader: "auth.example.com",
+			},
+		},
+		{
+			name: "CA certificate file doesn't exist",
+			headers: map[string]string{
+				types.ExtAuthzTLSHeader:          "on",
+				types.ExtAuthzTLSTrustedCAHeader: filepath.Join(dir, "missing.crt"),
+			},
+			expectErr: true,
+		},
+		{
+			name: "CA certificate file is invalid",
+			headers: map[string]string{
+				types.ExtAuthzTLSHeader:          "on",
+				types.ExtAuthzTLSTrustedCAHeader: invalidCA,
+			},
+			expectErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			header := http.Header{}
+			for key, value := range test.headers {
+				header.Set(key, value)
+			}
+
+			tlsConfig, err := buildExtAuthzTLSConfig(header)
+			if test.expectErr {
+				g.Expect(err).To(HaveOccurred())
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			if test.expectNil {
+				g.Expect(tlsConfig).To(BeNil())
+				return
+			}
+
+			g.Expect(tlsConfig.ServerName).To(Equal(test.headers[types.ExtAuthzTLSServerNameHeader]))
+			g.Expect(tlsConfig.MinVersion).To(Equal(uint16(tls.VersionTLS12)))
+		})
+	}
+}
+
+func TestBuildCheckRequest(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	req := newAuthzRequest("request body")
+
+	checkReq, err := buildCheckRequest(req)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpReq := checkReq.GetAttributes().GetRequest().GetHttp()
+	g.Expect(httpReq.GetMethod()).To(Equal(http.MethodPost))
+	g.Expect(httpReq.GetPath()).To(Equal("/coffee?size=large"))
+	g.Expect(httpReq.GetHost()).To(Equal("cafe.example.com"))
+	g.Expect(httpReq.GetScheme()).To(Equal("https"))
+	g.Expect(httpReq.GetProtocol()).To(Equal("HTTP/1.1"))
+	g.Expect(httpReq.GetRawBody()).To(Equal([]byte("request body")))
+	g.Expect(httpReq.GetSize()).To(Equal(int64(len("request body"))))
+	g.Expect(httpReq.GetHeaders()).To(Equal(map[string]string{
+		":authority":    "cafe.example.com",
+		":method":       http.MethodPost,
+		":path":         "/coffee?size=large",
+		":scheme":       "https",
+		"authorization": "Bearer token",
+		"x-custom":      "a,b",
+	}))
+
+	g.Expect(checkReq.GetAttributes().GetSource().GetAddress().GetSocketAddress().GetAddress()).To(Equal("10.0.0.1"))
+	g.Expect(checkReq.GetAttributes().GetRequest().GetTime()).ToNot(BeNil())
+}
+
+func TestBuildCheckRequestWithoutBody(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	req := newAuthzRequest("")
+	req.Header.Del(types.ExtAuthzClientAddressHeader)
+
+	checkReq, err := buildCheckRequest(req)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	httpReq := checkReq.GetAttributes().GetRequest().GetHttp()
+	g.Expect(httpReq.GetRawBody()).To(BeNil())
+	g.Expect(httpReq.GetSize()).To(Equal(int64(-1)))
+	g.Expect(checkReq.GetAttributes().GetSource()).To(BeNil())
+}
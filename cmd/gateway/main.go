@@ -26,6 +26,9 @@ func main() {
 		createInitializeCommand(),
 		createSleepCommand(),
 		createEndpointPickerCommand(),
+		createExtAuthzCommand(),
+		createRenderCommand(),
+		createConfigVersionsCommand(),
 	)
 
 	if err := rootCmd.Execute(); err != nil {
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/go-logr/logr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/file"
+)
+
+const (
+	renderStatusFileName = "status.json"
+	renderDirMode        = 0o755
+)
+
+type renderConfig struct {
+	fileManager file.OSFileManager
+	logger      logr.Logger
+	outputDir   string
+	inputs      []string
+	controller.RenderConfig
+}
+
+// render reads the Kubernetes manifests from the inputs, renders the nginx configuration for every Gateway,
+// and writes the generated files to <outputDir>/<gateway namespace>/<gateway name>/<file path>. The statuses that
+// would be written to the resources are written to <outputDir>/status.json.
+func render(ctx context.Context, cfg renderConfig) error {
+	objects, err := readManifests(cfg.inputs)
+	if err != nil {
+		return err
+	}
+
+	renderCfg := cfg.RenderConfig
+	renderCfg.Objects = objects
+
+	result, err := controller.Render(ctx, renderCfg)
+	if err != nil {
+		return fmt.Errorf("error rendering configuration: %w", err)
+	}
+
+	for _, gw := range result.Gateways {
+		gwDir := filepath.Join(cfg.outputDir, gw.NsName.Namespace, gw.NsName.Name)
+
+		for _, agentFile := range gw.Files {
+			f, err := file.Convert(agentFile)
+			if err != nil {
+				return fmt.Errorf("error converting file %q: %w", agentFile.Meta.GetName(), err)
+			}
+			f.Path = filepath.Join(gwDir, f.Path)
+
+			if err := writeRenderedFile(cfg.fileManager, f); err != nil {
+				return err
+			}
+		}
+
+		cfg.logger.Info(
+			"Rendered nginx configuration",
+			"gateway", gw.NsName.String(),
+			"files", len(gw.Files),
+			"directory", gwDir,
+		)
+	}
+
+	statuses, err := json.MarshalIndent(result.Statuses, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error marshaling statuses: %w", err)
+	}
+
+	return writeRenderedFile(cfg.fileManager, file.File{
+		Path:    filepath.Join(cfg.outputDir, renderStatusFileName),
+		Content: statuses,
+		Type:    file.TypeRegular,
+	})
+}
+
+// readManifests decodes the Kubernetes resources in the input files. Directories are read non-recursively,
+// including only files with a .yaml, .yml, or .json extension.
+func readManifests(inputs []string) ([]client.Object, error) {
+	var objects []client.Object
+
+	for _, input := range inputs {
+		paths, err := manifestPaths(input)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, path := range paths {
+			objs, err := readManifest(path)
+			if err != nil {
+				return nil, err
+			}
+			objects = append(objects, objs...)
+		}
+	}
+
+	return objects, nil
+}
+
+func manifestPaths(input string) ([]string, error) {
+	info, err := os.Stat(input)
+	if err != nil {
+		return nil, fmt.Errorf("error reading input %q: %w", input, err)
+	}
+
+	if !info.IsDir() {
+		return []string{input}, nil
+	}
+
+	entries, err := os.ReadDir(input)
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %q: %w", input, err)
+	}
+
+	var paths []string
+	for _, entry := range entries {
+		if entry.IsDir() || !slices.Contains([]string{".yaml", ".yml", ".json"}, filepath.Ext(entry.Name())) {
+			continue
+		}
+		paths = append(paths, filepath.Join(input, entry.Name()))
+	}
+
+	return paths, nil
+}
+
+func readManifest(path string) ([]client.Object, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening %q: %w", path, err)
+	}
+
+	objects, err := controller.DecodeManifests(f)
+	closeErr := f.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error decoding %q: %w", path, err)
+	}
+	if closeErr != nil {
+		return nil, fmt.Errorf("error closing %q: %w", path, closeErr)
+	}
+
+	return objects, nil
+}
+
+func writeRenderedFile(fileMgr file.OSFileManager, f file.File) error {
+	if err := os.MkdirAll(filepath.Dir(f.Path), renderDirMode); err != nil {
+		return fmt.Errorf("error creating directory for %q: %w", f.Path, err)
+	}
+
+	return file.Write(fileMgr, f)
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/file"
+)
+
+const (
+	renderTestGateway = `apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: test
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+`
+	renderTestRoute = `apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: tea
+  namespace: test
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /tea
+    backendRefs:
+    - name: tea
+      port: 80
+`
+)
+
+func newTestRenderConfig(inputs []string, outputDir string) renderConfig {
+	return renderConfig{
+		fileManager: file.NewStdLibOSFileManager(),
+		logger:      logr.Discard(),
+		inputs:      inputs,
+		outputDir:   outputDir,
+		RenderConfig: controller.RenderConfig{
+			Logger:           logr.Discard(),
+			GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+			GatewayClassName: "nginx",
+			ClusterDomain:    "cluster.local",
+			ClusterIPFamily:  "dual",
+		},
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	inputDir := t.TempDir()
+	routesDir := filepath.Join(inputDir, "routes")
+	g.Expect(os.Mkdir(routesDir, 0o755)).To(Succeed())
+
+	gatewayFile := filepath.Join(inputDir, "gateway.yaml")
+	g.Expect(os.WriteFile(gatewayFile, []byte(renderTestGateway), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(routesDir, "tea.yml"), []byte(renderTestRoute), 0o600)).To(Succeed())
+	g.Expect(os.WriteFile(filepath.Join(routesDir, "README.md"), []byte("not a manifest"), 0o600)).To(Succeed())
+
+	outputDir := filepath.Join(t.TempDir(), "out")
+
+	g.Expect(render(context.Background(), newTestRenderConfig([]string{gatewayFile, routesDir}, outputDir))).To(Succeed())
+
+	httpConf, err := os.ReadFile(filepath.Join(outputDir, "test", "gateway", "etc", "nginx", "conf.d", "http.conf"))
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(string(httpConf)).To(ContainSubstring("location /tea/ {"))
+
+	statusBytes, err := os.ReadFile(filepath.Join(outputDir, renderStatusFileName))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	var statuses []controller.RenderedStatus
+	g.Expect(json.Unmarshal(statusBytes, &statuses)).To(Succeed())
+
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, s.Kind+"/"+s.Namespace+"/"+s.Name)
+	}
+	g.Expect(names).To(Equal([]string{"Gateway/test/gateway", "GatewayClass//nginx", "HTTPRoute/test/tea"}))
+}
+
+func TestRender_Errors(t *testing.T) {
+	t.Parallel()
+
+	inputDir := t.TempDir()
+	invalidFile := filepath.Join(inputDir, "invalid.yaml")
+	if err := os.WriteFile(invalidFile, []byte("apiVersion: v1\nkind: Unknown\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name   string
+		expErr string
+		inputs []string
+	}{
+		{
+			name:   "input does not exist",
+			inputs: []string{filepath.Join(inputDir, "missing.yaml")},
+			expErr: "error reading input",
+		},
+		{
+			name:   "invalid manifest",
+			inputs: []string{invalidFile},
+			expErr: "error decoding",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := render(context.Background(), newTestRenderConfig(test.inputs, t.TempDir()))
+			g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+		})
+	}
+}
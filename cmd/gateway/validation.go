@@ -10,6 +10,8 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 )
 
 const (
@@ -98,6 +100,16 @@ func validateClusterDomain(name string) error {
 	return nil
 }
 
+// validateIPFamily validates the IP family of the cluster.
+func validateIPFamily(value string) error {
+	switch ngfAPIv1alpha2.IPFamilyType(value) {
+	case ngfAPIv1alpha2.Dual, ngfAPIv1alpha2.IPv4, ngfAPIv1alpha2.IPv6:
+		return nil
+	default:
+		return fmt.Errorf("must be one of %q, %q, or %q", ngfAPIv1alpha2.Dual, ngfAPIv1alpha2.IPv4, ngfAPIv1alpha2.IPv6)
+	}
+}
+
 func validateIP(ip string) error {
 	if ip == "" {
 		return errors.New("IP address must be set")
@@ -109,6 +121,14 @@ func validateIP(ip string) error {
 	return nil
 }
 
+func validateCIDR(cidr string) error {
+	if _, _, err := net.ParseCIDR(cidr); err != nil {
+		return fmt.Errorf("%q must be a valid CIDR block", cidr)
+	}
+
+	return nil
+}
+
 // validateEndpoint validates an endpoint, which is <host>:<port> where host is either a hostname or an IP address.
 func validateEndpoint(endpoint string) error {
 	host, port, err := net.SplitHostPort(endpoint)
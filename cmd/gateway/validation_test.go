@@ -285,6 +285,55 @@ func TestValidateClusterDomain(t *testing.T) {
 	}
 }
 
+func TestValidateIPFamily(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		value  string
+		expErr bool
+	}{
+		{
+			name:   "dual",
+			value:  "dual",
+			expErr: false,
+		},
+		{
+			name:   "ipv4",
+			value:  "ipv4",
+			expErr: false,
+		},
+		{
+			name:   "ipv6",
+			value:  "ipv6",
+			expErr: false,
+		},
+		{
+			name:   "empty",
+			value:  "",
+			expErr: true,
+		},
+		{
+			name:   "unknown family",
+			value:  "IPv4",
+			expErr: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateIPFamily(test.value)
+			if test.expErr {
+				g.Expect(err).To(HaveOccurred())
+			} else {
+				g.Expect(err).ToNot(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestValidateIP(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -327,6 +376,50 @@ func TestValidateIP(t *testing.T) {
 	}
 }
 
+func TestValidateCIDR(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name   string
+		cidr   string
+		expErr bool
+	}{
+		{
+			name:   "valid IPv4 CIDR",
+			cidr:   "10.0.0.0/16",
+			expErr: false,
+		},
+		{
+			name:   "valid IPv6 CIDR",
+			cidr:   "fd00::/64",
+			expErr: false,
+		},
+		{
+			name:   "IP address without prefix length",
+			cidr:   "10.0.0.1",
+			expErr: true,
+		},
+		{
+			name:   "invalid CIDR",
+			cidr:   "invalid",
+			expErr: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			err := validateCIDR(tc.cidr)
+			if !tc.expErr {
+				g.Expect(err).ToNot(HaveOccurred())
+			} else {
+				g.Expect(err).To(HaveOccurred())
+			}
+		})
+	}
+}
+
 func TestValidateEndpoint(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -29,6 +29,9 @@ type Config struct {
 	ServerTLSDomain string
 	// ClusterDomain is the Kubernetes cluster DNS domain used for in-cluster Service URLs. Defaults to "cluster.local".
 	ClusterDomain string
+	// ZoneSyncResolver is the nameserver that NGINX Plus uses to resolve the other replicas of a Gateway
+	// when synchronizing shared memory zones.
+	ZoneSyncResolver string
 	// ImageSource is the source of the NGINX Gateway image.
 	ImageSource string
 	// GatewayCtlrName is the name of this controller.
@@ -45,14 +48,18 @@ type Config struct {
 	NginxDockerSecretNames []string
 	// WatchNamespaces is the list of namespaces to watch for resources. If empty, all namespaces are watched.
 	WatchNamespaces []string
+	// OTLPConfig specifies the export of the control plane traces and metrics using OTLP.
+	OTLPConfig OTLPConfig
+	// MetricsConfig specifies the metrics config.
+	MetricsConfig MetricsConfig
 	// NginxOneConsoleTelemetryConfig contains the configuration for NGINX One Console telemetry.
 	NginxOneConsoleTelemetryConfig NginxOneConsoleTelemetryConfig
 	// ProductTelemetryConfig contains the configuration for collecting product telemetry.
 	ProductTelemetryConfig ProductTelemetryConfig
 	// HealthConfig specifies the health probe config.
 	HealthConfig HealthConfig
-	// MetricsConfig specifies the metrics config.
-	MetricsConfig MetricsConfig
+	// DebugServerConfig specifies the debug server config.
+	DebugServerConfig DebugServerConfig
 	// Plus indicates whether NGINX Plus is being used.
 	Plus bool
 	// ExperimentalFeatures indicates if experimental features are enabled.
@@ -108,12 +115,17 @@ type GatewayPodConfig struct {
 
 // MetricsConfig specifies the metrics config.
 type MetricsConfig struct {
+	// NginxPlusUpstreamsAllowedCIDR is the CIDR block that is allowed to access the NGINX Plus API of the
+	// nginx Pods to read the upstream metrics.
+	NginxPlusUpstreamsAllowedCIDR string
 	// Port is the port the metrics should be exposed on.
 	Port int
 	// Enabled is the flag for toggling metrics on or off.
 	Enabled bool
 	// Secure is the flag for toggling the metrics endpoint to https.
 	Secure bool
+	// NginxPlusUpstreams is the flag for toggling the metrics of the NGINX Plus upstreams of the nginx Pods.
+	NginxPlusUpstreams bool
 }
 
 // HealthConfig specifies the health probe config.
@@ -124,6 +136,24 @@ type HealthConfig struct {
 	Enabled bool
 }
 
+// DebugServerConfig specifies the debug server config.
+type DebugServerConfig struct {
+	// Port is the port that the debug server listens on.
+	Port int
+	// Enabled is the flag for toggling the debug server on or off.
+	Enabled bool
+}
+
+// OTLPConfig specifies the export of the control plane traces and metrics using the OpenTelemetry Protocol.
+type OTLPConfig struct {
+	// Endpoint is the address of the OTLP gRPC receiver in the format host:port. If empty, nothing is exported.
+	Endpoint string
+	// Insecure disables TLS for the connection to the OTLP receiver.
+	Insecure bool
+	// ExportMetrics enables exporting the Prometheus metrics of the control plane to the OTLP receiver.
+	ExportMetrics bool
+}
+
 // LeaderElectionConfig contains the configuration for leader election.
 type LeaderElectionConfig struct {
 	// LockName holds the name of the leader election lock.
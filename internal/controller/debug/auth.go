@@ -0,0 +1,127 @@
+package debug
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const reviewTimeout = 10 * time.Second
+
+// authenticator authenticates and authorizes the requests to the debug server.
+//
+// A request is authenticated with a TokenReview of the bearer token in its Authorization header.
+// The authenticated user is then authorized with a SubjectAccessReview of the request path as a non-resource URL,
+// so access is granted with an RBAC rule like:
+//
+//	nonResourceURLs: ["/debug/*"]
+//	verbs: ["get"]
+type authenticator struct {
+	k8sClient client.Client
+	logger    logr.Logger
+}
+
+// wrap returns a handler that only calls the next handler for authenticated and authorized requests.
+func (a authenticator) wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token, ok := bearerToken(r)
+		if !ok {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), reviewTimeout)
+		defer cancel()
+
+		user, authenticated, err := a.authenticate(ctx, token)
+		if err != nil {
+			a.logger.Error(err, "failed to create TokenReview")
+			http.Error(w, "Authentication failed", http.StatusInternalServerError)
+			return
+		}
+
+		if !authenticated {
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		allowed, err := a.authorize(ctx, user, r)
+		if err != nil {
+			a.logger.Error(err, "failed to create SubjectAccessReview")
+			http.Error(w, "Authorization failed", http.StatusInternalServerError)
+			return
+		}
+
+		if !allowed {
+			a.logger.V(1).Info("Debug server request denied", "user", user.Username, "path", r.URL.Path)
+			http.Error(w, "Forbidden", http.StatusForbidden)
+			return
+		}
+
+		next.ServeHTTP(w, r)
+	})
+}
+
+// authenticate returns the user that the token belongs to, and false if the token is not valid.
+func (a authenticator) authenticate(ctx context.Context, token string) (authnv1.UserInfo, bool, error) {
+	review := &authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	}
+
+	if err := a.k8sClient.Create(ctx, review); err != nil {
+		return authnv1.UserInfo{}, false, err
+	}
+
+	if !review.Status.Authenticated {
+		a.logger.V(1).Info("Debug server token review was not authenticated", "reason", review.Status.Error)
+		return authnv1.UserInfo{}, false, nil
+	}
+
+	return review.Status.User, true, nil
+}
+
+// authorize returns true if the user is allowed to perform the request.
+func (a authenticator) authorize(ctx context.Context, user authnv1.UserInfo, r *http.Request) (bool, error) {
+	extra := make(map[string]authzv1.ExtraValue, len(user.Extra))
+	for key, value := range user.Extra {
+		extra[key] = authzv1.ExtraValue(value)
+	}
+
+	review := &authzv1.SubjectAccessReview{
+		Spec: authzv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			NonResourceAttributes: &authzv1.NonResourceAttributes{
+				Path: r.URL.Path,
+				Verb: strings.ToLower(r.Method),
+			},
+		},
+	}
+
+	if err := a.k8sClient.Create(ctx, review); err != nil {
+		return false, err
+	}
+
+	return review.Status.Allowed, nil
+}
+
+func bearerToken(r *http.Request) (string, bool) {
+	token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !ok {
+		return "", false
+	}
+
+	token = strings.TrimSpace(token)
+
+	return token, token != ""
+}
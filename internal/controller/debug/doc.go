@@ -0,0 +1,8 @@
+/*
+Package debug contains the debug server of the control plane.
+
+The debug server exposes the state of the control plane for troubleshooting: the graph of the Gateway API
+resources per Gateway, the latest data plane configuration and the nginx configuration files of each Gateway,
+and the connections of the nginx agents. Every request is authenticated and authorized against the Kubernetes API.
+*/
+package debug
@@ -0,0 +1,135 @@
+package debug
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// redactedStringFields are the names of the string fields that contain secret data.
+var redactedStringFields = map[string]struct{}{
+	"ClientSecret": {},
+}
+
+var (
+	jsonMarshalerType = reflect.TypeFor[json.Marshaler]()
+	textMarshalerType = reflect.TypeFor[encoding.TextMarshaler]()
+)
+
+// redact converts a value into a JSON-compatible value, replacing the data that can contain secrets,
+// like certificates, keys, and authentication data, with a placeholder.
+//
+// All byte slices are replaced, because the data plane configuration stores the contents of Secrets as bytes.
+// Values that implement json.Marshaler or encoding.TextMarshaler, like metav1.Time, are kept as is.
+func redact(v reflect.Value) any {
+	if !v.IsValid() {
+		return nil
+	}
+
+	if isMarshaler(v.Type()) {
+		if v.Kind() == reflect.Pointer && v.IsNil() {
+			return nil
+		}
+
+		return v.Interface()
+	}
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+
+		return redact(v.Elem())
+	case reflect.Struct:
+		fields := make(map[string]any)
+		redactStruct(v, fields)
+
+		return fields
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+
+		entries := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			entries[fmt.Sprint(iter.Key().Interface())] = redact(iter.Value())
+		}
+
+		return entries
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return fmt.Sprintf("<redacted %d bytes>", v.Len())
+		}
+
+		items := make([]any, 0, v.Len())
+		for i := range v.Len() {
+			items = append(items, redact(v.Index(i)))
+		}
+
+		return items
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	default:
+		return v.Interface()
+	}
+}
+
+// redactStruct adds the redacted exported fields of a struct to the fields map. The fields are named after
+// their json tags, and the fields of embedded structs without a tag are inlined, like encoding/json does.
+func redactStruct(v reflect.Value, fields map[string]any) {
+	for i := range v.NumField() {
+		field := v.Type().Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, _, _ := strings.Cut(tag, ",")
+
+		fieldValue := v.Field(i)
+		if field.Anonymous && name == "" && !isMarshaler(field.Type) {
+			if fieldValue.Kind() == reflect.Pointer {
+				if fieldValue.IsNil() {
+					continue
+				}
+
+				fieldValue = fieldValue.Elem()
+			}
+
+			if fieldValue.Kind() == reflect.Struct {
+				redactStruct(fieldValue, fields)
+				continue
+			}
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		if _, ok := redactedStringFields[field.Name]; ok && fieldValue.Kind() == reflect.String {
+			if fieldValue.Len() > 0 {
+				fields[name] = "<redacted>"
+			}
+
+			continue
+		}
+
+		fields[name] = redact(fieldValue)
+	}
+}
+
+func isMarshaler(t reflect.Type) bool {
+	return t.Implements(jsonMarshalerType) || t.Implements(textMarshalerType)
+}
@@ -0,0 +1,99 @@
+package debug
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestRedact(t *testing.T) {
+	t.Parallel()
+
+	type Embedded struct {
+		Data []byte
+	}
+
+	type value struct {
+		Time       metav1.Time
+		Nil        *Embedded
+		Named      string `json:"named,omitempty"`
+		Skipped    string `json:"-"`
+		unexported string
+		Embedded
+		ClientSecret string
+		Nested       map[string][]byte
+		Numbers      []int
+		Key          [2]byte
+	}
+
+	now := metav1.NewTime(time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC))
+
+	tests := []struct {
+		value  any
+		expect any
+		name   string
+	}{
+		{
+			name:   "byte slice",
+			value:  []byte("secret"),
+			expect: "<redacted 6 bytes>",
+		},
+		{
+			name: "struct",
+			value: &value{
+				Time:         now,
+				Named:        "named",
+				Skipped:      "skipped",
+				unexported:   "unexported",
+				Embedded:     Embedded{Data: []byte("data")},
+				ClientSecret: "secret",
+				Nested:       map[string][]byte{"key": []byte("value")},
+				Numbers:      []int{1, 2},
+				Key:          [2]byte{1, 2},
+			},
+			expect: map[string]any{
+				"Time":         now,
+				"Nil":          nil,
+				"named":        "named",
+				"Data":         "<redacted 4 bytes>",
+				"ClientSecret": "<redacted>",
+				"Nested":       map[string]any{"key": "<redacted 5 bytes>"},
+				"Numbers":      []any{1, 2},
+				"Key":          "<redacted 2 bytes>",
+			},
+		},
+		{
+			name:  "empty client secret",
+			value: value{},
+			expect: map[string]any{
+				"Time":    metav1.Time{},
+				"Nil":     nil,
+				"named":   "",
+				"Data":    nil,
+				"Nested":  nil,
+				"Numbers": nil,
+				"Key":     "<redacted 2 bytes>",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(redact(reflect.ValueOf(test.value))).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestRedact_Nil(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(redact(reflect.ValueOf(nil))).To(BeNil())
+	g.Expect(redact(reflect.ValueOf((*metav1.ObjectMeta)(nil)))).To(BeNil())
+}
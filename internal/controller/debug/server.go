@@ -0,0 +1,323 @@
+package debug
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/types"
+	certutil "k8s.io/client-go/util/cert"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/file"
+)
+
+const (
+	// GatewaysPath is the path that lists the Gateways. The state of a Gateway is served under
+	// GatewaysPath/{namespace}/{name}/{graph,configuration,files,versions}, and the files of a previous
+	// configuration version under GatewaysPath/{namespace}/{name}/versions/{version}/files. The version is
+	// base64 encoded, so it must be escaped in the path.
+	GatewaysPath = "/debug/gateways"
+	// AgentsPath is the path that lists the connections of the nginx agents.
+	AgentsPath = "/debug/agents"
+
+	shutdownTimeout   = 5 * time.Second
+	readHeaderTimeout = 10 * time.Second
+)
+
+// GraphGetter gets the latest graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// ConfigurationGetter gets the latest data plane configuration of a Gateway.
+type ConfigurationGetter interface {
+	GetLatestConfigurationForGateway(gateway types.NamespacedName) *dataplane.Configuration
+}
+
+// DeploymentGetter gets the nginx Deployments and the connections of their nginx agents.
+type DeploymentGetter interface {
+	Get(nsName types.NamespacedName) *agent.Deployment
+	ListConnections() map[string]agentgrpc.Connection
+}
+
+// ServerConfig holds the configuration of the debug server.
+type ServerConfig struct {
+	// K8sClient authenticates and authorizes the requests with TokenReviews and SubjectAccessReviews.
+	K8sClient client.Client
+	// GraphGetter gets the latest graph.
+	GraphGetter GraphGetter
+	// ConfigurationGetter gets the latest data plane configuration of a Gateway.
+	ConfigurationGetter ConfigurationGetter
+	// Deployments gets the nginx Deployments and the connections of their nginx agents.
+	Deployments DeploymentGetter
+	// Logger is the logger of the debug server.
+	Logger logr.Logger
+	// Port is the port that the debug server listens on.
+	Port int
+}
+
+// Server serves the state of the control plane for troubleshooting.
+type Server struct {
+	cfg ServerConfig
+}
+
+// NewServer returns a new Server.
+func NewServer(cfg ServerConfig) *Server {
+	return &Server{cfg: cfg}
+}
+
+// Start is a runnable that starts the debug server. The server is stopped when the context is canceled.
+// Like the secure metrics endpoint, the server is served over HTTPS with a self-signed certificate, so that
+// the bearer tokens of the requests are never sent in plain text.
+func (s *Server) Start(ctx context.Context) error {
+	tlsConfig, err := newSelfSignedTLSConfig()
+	if err != nil {
+		return fmt.Errorf("error creating TLS config of debug server: %w", err)
+	}
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(ctx, "tcp", fmt.Sprintf(":%d", s.cfg.Port))
+	if err != nil {
+		return err
+	}
+
+	server := &http.Server{
+		Handler:           s.Handler(),
+		TLSConfig:         tlsConfig,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
+
+	go func() {
+		<-ctx.Done()
+		s.cfg.Logger.Info("Shutting down debug server")
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), shutdownTimeout)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			s.cfg.Logger.Error(err, "error shutting down debug server")
+		}
+	}()
+
+	s.cfg.Logger.Info("Starting debug server", "port", s.cfg.Port)
+
+	if err := server.ServeTLS(listener, "", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return nil
+}
+
+// newSelfSignedTLSConfig returns a TLS config with a self-signed certificate for localhost.
+func newSelfSignedTLSConfig() (*tls.Config, error) {
+	certPEM, keyPEM, err := certutil.GenerateSelfSignedCertKey("localhost", []net.IP{{127, 0, 0, 1}}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	cert, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// Handler returns the handler of the debug server. All requests are authenticated and authorized.
+func (s *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET "+GatewaysPath, s.listGateways)
+	mux.HandleFunc("GET "+GatewaysPath+"/{namespace}/{name}/graph", s.getGraph)
+	mux.HandleFunc("GET "+GatewaysPath+"/{namespace}/{name}/configuration", s.getConfiguration)
+	mux.HandleFunc("GET "+GatewaysPath+"/{namespace}/{name}/files", s.getFiles)
+	mux.HandleFunc("GET "+GatewaysPath+"/{namespace}/{name}/versions", s.listConfigVersions)
+	mux.HandleFunc("GET "+GatewaysPath+"/{namespace}/{name}/versions/{version}/files", s.getConfigVersionFiles)
+	mux.HandleFunc("GET "+AgentsPath, s.listAgents)
+
+	auth := authenticator{
+		k8sClient: s.cfg.K8sClient,
+		logger:    s.cfg.Logger,
+	}
+
+	return auth.wrap(mux)
+}
+
+func (s *Server) listGateways(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, buildGatewaySummaries(s.cfg.GraphGetter.GetLatestGraph()))
+}
+
+func (s *Server) getGraph(w http.ResponseWriter, r *http.Request) {
+	gr := s.cfg.GraphGetter.GetLatestGraph()
+	gwNsName := gatewayNsName(r)
+
+	gw, ok := lookupGateway(gr, gwNsName)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Gateway %s not found", gwNsName), http.StatusNotFound)
+		return
+	}
+
+	s.writeJSON(w, buildGatewayView(gr, gwNsName, gw))
+}
+
+func (s *Server) getConfiguration(w http.ResponseWriter, r *http.Request) {
+	gwNsName := gatewayNsName(r)
+
+	cfg := s.cfg.ConfigurationGetter.GetLatestConfigurationForGateway(gwNsName)
+	if cfg == nil {
+		http.Error(w, fmt.Sprintf("no configuration for Gateway %s", gwNsName), http.StatusNotFound)
+		return
+	}
+
+	s.writeJSON(w, redact(reflect.ValueOf(cfg)))
+}
+
+// getFiles writes the current nginx configuration files of a Gateway as text.
+func (s *Server) getFiles(w http.ResponseWriter, r *http.Request) {
+	deployment, ok := s.getDeployment(w, r)
+	if !ok {
+		return
+	}
+
+	deployment.FileLock.RLock()
+	files := deployment.GetFiles()
+	deployment.FileLock.RUnlock()
+
+	s.writeFiles(w, files)
+}
+
+// listConfigVersions writes the most recent nginx configuration versions of a Gateway, newest first.
+func (s *Server) listConfigVersions(w http.ResponseWriter, r *http.Request) {
+	deployment, ok := s.getDeployment(w, r)
+	if !ok {
+		return
+	}
+
+	deployment.FileLock.RLock()
+	current, versions := deployment.GetConfigVersions()
+	deployment.FileLock.RUnlock()
+
+	s.writeJSON(w, buildConfigVersionViews(current, versions))
+}
+
+// getConfigVersionFiles writes the nginx configuration files of a previous configuration version of a Gateway
+// as text.
+func (s *Server) getConfigVersionFiles(w http.ResponseWriter, r *http.Request) {
+	deployment, ok := s.getDeployment(w, r)
+	if !ok {
+		return
+	}
+
+	deployment.FileLock.RLock()
+	version, found := deployment.FindConfigVersion(r.PathValue("version"))
+	deployment.FileLock.RUnlock()
+
+	if !found {
+		http.Error(
+			w,
+			fmt.Sprintf("configuration version %s not found for Gateway %s", r.PathValue("version"), gatewayNsName(r)),
+			http.StatusNotFound,
+		)
+		return
+	}
+
+	s.writeFiles(w, version.Files)
+}
+
+// getDeployment returns the nginx Deployment of the Gateway in the request path. If the Gateway or its
+// Deployment does not exist, it writes a not found response and returns false.
+func (s *Server) getDeployment(w http.ResponseWriter, r *http.Request) (*agent.Deployment, bool) {
+	gwNsName := gatewayNsName(r)
+
+	gw, ok := lookupGateway(s.cfg.GraphGetter.GetLatestGraph(), gwNsName)
+	if !ok {
+		http.Error(w, fmt.Sprintf("Gateway %s not found", gwNsName), http.StatusNotFound)
+		return nil, false
+	}
+
+	deployment := s.cfg.Deployments.Get(gw.DeploymentName)
+	if deployment == nil {
+		http.Error(w, fmt.Sprintf("no nginx configuration for Gateway %s", gwNsName), http.StatusNotFound)
+		return nil, false
+	}
+
+	return deployment, true
+}
+
+// writeFiles writes the nginx configuration files as text, sorted by name. The contents of the files
+// that contain secrets, and of the binary files, are omitted.
+func (s *Server) writeFiles(w http.ResponseWriter, files []agent.File) {
+	slices.SortFunc(files, func(a, b agent.File) int {
+		return strings.Compare(a.Meta.GetName(), b.Meta.GetName())
+	})
+
+	var b strings.Builder
+	for _, f := range files {
+		fmt.Fprintf(&b, "# %s\n", f.Meta.GetName())
+
+		switch {
+		case f.Meta.GetPermissions() == file.SecretFileMode:
+			fmt.Fprintf(&b, "# <redacted %d bytes>\n", len(f.Contents))
+		case !utf8.Valid(f.Contents):
+			fmt.Fprintf(&b, "# <binary %d bytes>\n", len(f.Contents))
+		default:
+			b.Write(f.Contents)
+		}
+
+		b.WriteString("\n")
+	}
+
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	if _, err := w.Write([]byte(b.String())); err != nil {
+		s.cfg.Logger.Error(err, "error writing debug server response")
+	}
+}
+
+func (s *Server) listAgents(w http.ResponseWriter, _ *http.Request) {
+	s.writeJSON(w, buildAgentConnectionViews(s.cfg.Deployments.ListConnections()))
+}
+
+func (s *Server) writeJSON(w http.ResponseWriter, v any) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		s.cfg.Logger.Error(err, "error marshaling debug server response")
+		http.Error(w, "error marshaling response", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if _, err := w.Write(append(data, '\n')); err != nil {
+		s.cfg.Logger.Error(err, "error writing debug server response")
+	}
+}
+
+func gatewayNsName(r *http.Request) types.NamespacedName {
+	return types.NamespacedName{Namespace: r.PathValue("namespace"), Name: r.PathValue("name")}
+}
+
+func lookupGateway(gr *graph.Graph, gwNsName types.NamespacedName) (*graph.Gateway, bool) {
+	if gr == nil {
+		return nil, false
+	}
+
+	gw, ok := gr.Gateways[gwNsName]
+
+	return gw, ok
+}
@@ -0,0 +1,370 @@
+package debug
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/grpcfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/file"
+)
+
+type fakeGraphGetter struct {
+	graph *graph.Graph
+}
+
+func (f fakeGraphGetter) GetLatestGraph() *graph.Graph {
+	return f.graph
+}
+
+type fakeConfigurationGetter struct {
+	configs map[types.NamespacedName]*dataplane.Configuration
+}
+
+func (f fakeConfigurationGetter) GetLatestConfigurationForGateway(
+	gateway types.NamespacedName,
+) *dataplane.Configuration {
+	return f.configs[gateway]
+}
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	deploymentName := types.NamespacedName{Namespace: "test", Name: "gateway-nginx"}
+
+	tracker := &grpcfakes.FakeConnectionsTracker{}
+	tracker.ListConnectionsReturns(map[string]agentgrpc.Connection{
+		"uuid": {
+			PodName:    "gateway-nginx-pod",
+			ParentType: "Deployment",
+			ParentName: deploymentName,
+			InstanceID: "instance",
+		},
+	})
+
+	store := agent.NewDeploymentStore(tracker)
+	deployment := store.LoadOrStore(t.Context(), deploymentName, gwNsName.Name)
+
+	deployment.FileLock.Lock()
+	deployment.SetFiles([]agent.File{
+		{
+			Meta:     &pb.FileMeta{Name: "/etc/nginx/conf.d/http.conf", Permissions: file.RegularFileMode},
+			Contents: []byte("server {}"),
+		},
+		{
+			Meta:     &pb.FileMeta{Name: "/etc/nginx/secrets/cert.pem", Permissions: file.SecretFileMode},
+			Contents: []byte("private key"),
+		},
+		{
+			Meta:     &pb.FileMeta{Name: "/etc/app_protect/bundles/bundle.tgz", Permissions: file.RegularFileMode},
+			Contents: []byte{0xff, 0xfe},
+		},
+	}, []v1.VolumeMount{})
+	deployment.FileLock.Unlock()
+
+	gr := &graph.Graph{
+		Gateways: map[types.NamespacedName]*graph.Gateway{
+			gwNsName: {
+				Source: &gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				},
+				DeploymentName: deploymentName,
+				Valid:          true,
+			},
+			{Namespace: "test", Name: "no-deployment"}: {
+				Source: &gatewayv1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "no-deployment"},
+				},
+				DeploymentName: types.NamespacedName{Namespace: "test", Name: "no-deployment-nginx"},
+			},
+		},
+	}
+
+	return NewServer(ServerConfig{
+		K8sClient:   newReviewClient(nil),
+		GraphGetter: fakeGraphGetter{graph: gr},
+		ConfigurationGetter: fakeConfigurationGetter{
+			configs: map[types.NamespacedName]*dataplane.Configuration{
+				gwNsName: {
+					SSLKeyPairs: map[dataplane.SSLKeyPairID]dataplane.SSLKeyPair{
+						"ssl_keypair_test_secret": {Cert: []byte("cert"), Key: []byte("key")},
+					},
+					WorkerConnections: 1024,
+				},
+			},
+		},
+		Deployments: store,
+		Logger:      logr.Discard(),
+	})
+}
+
+func TestServerHandler(t *testing.T) {
+	t.Parallel()
+
+	server := newTestServer(t)
+
+	tests := []struct {
+		name          string
+		path          string
+		expBody       string
+		expStatusCode int
+	}{
+		{
+			name:          "list gateways",
+			path:          "/debug/gateways",
+			expStatusCode: http.StatusOK,
+			expBody: `[
+  {
+    "namespace": "test",
+    "name": "gateway",
+    "valid": true
+  },
+  {
+    "namespace": "test",
+    "name": "no-deployment",
+    "valid": false
+  }
+]
+`,
+		},
+		{
+			name:          "gateway graph",
+			path:          "/debug/gateways/test/gateway/graph",
+			expStatusCode: http.StatusOK,
+			expBody: `{
+  "namespace": "test",
+  "name": "gateway",
+  "deployment": "test/gateway-nginx",
+  "valid": true
+}
+`,
+		},
+		{
+			name:          "graph of unknown gateway",
+			path:          "/debug/gateways/test/unknown/graph",
+			expStatusCode: http.StatusNotFound,
+			expBody:       "Gateway test/unknown not found\n",
+		},
+		{
+			name:          "gateway files",
+			path:          "/debug/gateways/test/gateway/files",
+			expStatusCode: http.StatusOK,
+			expBody: "# /etc/app_protect/bundles/bundle.tgz\n# <binary 2 bytes>\n\n" +
+				"# /etc/nginx/conf.d/http.conf\nserver {}\n" +
+				"# /etc/nginx/secrets/cert.pem\n# <redacted 11 bytes>\n\n",
+		},
+		{
+			name:          "files of gateway without deployment",
+			path:          "/debug/gateways/test/no-deployment/files",
+			expStatusCode: http.StatusNotFound,
+			expBody:       "no nginx configuration for Gateway test/no-deployment\n",
+		},
+		{
+			name:          "configuration of unknown gateway",
+			path:          "/debug/gateways/test/unknown/configuration",
+			expStatusCode: http.StatusNotFound,
+			expBody:       "no configuration for Gateway test/unknown\n",
+		},
+		{
+			name:          "agents",
+			path:          "/debug/agents",
+			expStatusCode: http.StatusOK,
+			expBody: `[
+  {
+    "uuid": "uuid",
+    "podName": "gateway-nginx-pod",
+    "parentType": "Deployment",
+    "parent": "test/gateway-nginx",
+    "instanceID": "instance",
+    "ready": true
+  }
+]
+`,
+		},
+		{
+			name:          "unknown path",
+			path:          "/debug/unknown",
+			expStatusCode: http.StatusNotFound,
+			expBody:       "404 page not found\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			req := httptest.NewRequest(http.MethodGet, test.path, nil)
+			req.Header.Set("Authorization", "Bearer "+allowedToken)
+
+			rec := httptest.NewRecorder()
+			server.Handler().ServeHTTP(rec, req)
+
+			g.Expect(rec.Code).To(Equal(test.expStatusCode))
+			g.Expect(rec.Body.String()).To(Equal(test.expBody))
+		})
+	}
+}
+
+func TestServerHandler_Configuration(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/gateways/test/gateway/configuration", nil)
+	req.Header.Set("Authorization", "Bearer "+allowedToken)
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+
+	var cfg map[string]any
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &cfg)).To(Succeed())
+	g.Expect(cfg).To(HaveKeyWithValue("WorkerConnections", BeEquivalentTo(1024)))
+	g.Expect(cfg).To(HaveKeyWithValue("SSLKeyPairs", map[string]any{
+		"ssl_keypair_test_secret": map[string]any{
+			"Cert": "<redacted 4 bytes>",
+			"Key":  "<redacted 3 bytes>",
+		},
+	}))
+}
+
+func TestServerHandler_ConfigVersions(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := newTestServer(t)
+
+	deployment := server.cfg.Deployments.Get(types.NamespacedName{Namespace: "test", Name: "gateway-nginx"})
+	deployment.FileLock.RLock()
+	current, _ := deployment.GetConfigVersions()
+	deployment.FileLock.RUnlock()
+
+	get := func(path string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set("Authorization", "Bearer "+allowedToken)
+
+		rec := httptest.NewRecorder()
+		server.Handler().ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := get("/debug/gateways/test/gateway/versions")
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+
+	var versions []configVersionView
+	g.Expect(json.Unmarshal(rec.Body.Bytes(), &versions)).To(Succeed())
+	g.Expect(versions).To(HaveLen(1))
+	g.Expect(versions[0].Version).To(Equal(current))
+	g.Expect(versions[0].Current).To(BeTrue())
+	g.Expect(versions[0].Files).To(Equal([]string{
+		"/etc/app_protect/bundles/bundle.tgz",
+		"/etc/nginx/conf.d/http.conf",
+		"/etc/nginx/secrets/cert.pem",
+	}))
+
+	rec = get("/debug/gateways/test/gateway/versions/" + url.PathEscape(current) + "/files")
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Body.String()).To(Equal(get("/debug/gateways/test/gateway/files").Body.String()))
+
+	rec = get("/debug/gateways/test/gateway/versions/unknown/files")
+	g.Expect(rec.Code).To(Equal(http.StatusNotFound))
+	g.Expect(rec.Body.String()).To(Equal("configuration version unknown not found for Gateway test/gateway\n"))
+}
+
+func TestServerHandler_Unauthorized(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := newTestServer(t)
+
+	req := httptest.NewRequest(http.MethodGet, "/debug/gateways", nil)
+	req.Header.Set("Authorization", "Bearer "+deniedToken)
+
+	rec := httptest.NewRecorder()
+	server.Handler().ServeHTTP(rec, req)
+
+	g.Expect(rec.Code).To(Equal(http.StatusForbidden))
+}
+
+func TestServerStart(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	var lc net.ListenConfig
+	listener, err := lc.Listen(t.Context(), "tcp", "127.0.0.1:0")
+	g.Expect(err).ToNot(HaveOccurred())
+
+	port := listener.Addr().(*net.TCPAddr).Port
+	g.Expect(listener.Close()).To(Succeed())
+
+	server := newTestServer(t)
+	server.cfg.Port = port
+
+	ctx, cancel := context.WithCancel(t.Context())
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- server.Start(ctx)
+	}()
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // self-signed cert for testing
+		},
+	}
+
+	get := func(scheme string) (int, error) {
+		reqURL := scheme + "://127.0.0.1:" + strconv.Itoa(port) + "/debug/agents"
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+		if err != nil {
+			return 0, err
+		}
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return 0, err
+		}
+		defer resp.Body.Close()
+
+		return resp.StatusCode, nil
+	}
+
+	g.Eventually(func() int {
+		code, _ := get("https")
+		return code
+	}).WithTimeout(5 * time.Second).Should(Equal(http.StatusUnauthorized))
+
+	// plain HTTP requests are rejected
+	code, err := get("http")
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(code).To(Equal(http.StatusBadRequest))
+
+	cancel()
+	g.Eventually(errCh).WithTimeout(5 * time.Second).Should(Receive(BeNil()))
+}
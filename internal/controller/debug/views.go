@@ -0,0 +1,334 @@
+package debug
+
+import (
+	"cmp"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// routeTypeKinds maps the route types to the kinds of the routes. The kind isn't taken from the route itself
+// because the objects in the cache don't have their TypeMeta set.
+var routeTypeKinds = map[graph.RouteType]string{
+	graph.RouteTypeHTTP: kinds.HTTPRoute,
+	graph.RouteTypeGRPC: kinds.GRPCRoute,
+	graph.RouteTypeTLS:  kinds.TLSRoute,
+	graph.RouteTypeTCP:  kinds.TCPRoute,
+	graph.RouteTypeUDP:  kinds.UDPRoute,
+}
+
+// gatewaySummary is a Gateway in the list of Gateways.
+type gatewaySummary struct {
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Valid     bool   `json:"valid"`
+}
+
+// gatewayView is the graph of the resources of a Gateway.
+type gatewayView struct {
+	Namespace  string          `json:"namespace"`
+	Name       string          `json:"name"`
+	Deployment string          `json:"deployment"`
+	Conditions []conditionView `json:"conditions,omitempty"`
+	Listeners  []listenerView  `json:"listeners,omitempty"`
+	Routes     []routeView     `json:"routes,omitempty"`
+	Policies   []policyView    `json:"policies,omitempty"`
+	Valid      bool            `json:"valid"`
+}
+
+// listenerView is a listener of a Gateway.
+type listenerView struct {
+	Name           string          `json:"name"`
+	ListenerSet    string          `json:"listenerSet,omitempty"`
+	Protocol       string          `json:"protocol"`
+	Hostname       string          `json:"hostname,omitempty"`
+	Conditions     []conditionView `json:"conditions,omitempty"`
+	AttachedRoutes []string        `json:"attachedRoutes,omitempty"`
+	Port           int32           `json:"port"`
+	Valid          bool            `json:"valid"`
+	Attachable     bool            `json:"attachable"`
+}
+
+// routeView is a route that references a Gateway, whether it is attached to the Gateway or not.
+type routeView struct {
+	Kind       string          `json:"kind"`
+	Namespace  string          `json:"namespace"`
+	Name       string          `json:"name"`
+	Conditions []conditionView `json:"conditions,omitempty"`
+	ParentRefs []parentRefView `json:"parentRefs,omitempty"`
+	Policies   []policyView    `json:"policies,omitempty"`
+	Valid      bool            `json:"valid"`
+	Attachable bool            `json:"attachable"`
+}
+
+// parentRefView is the attachment of a route to a Gateway.
+type parentRefView struct {
+	SectionName      string          `json:"sectionName,omitempty"`
+	FailedConditions []conditionView `json:"failedConditions,omitempty"`
+	ListenerPort     int32           `json:"listenerPort,omitempty"`
+	Attached         bool            `json:"attached"`
+}
+
+// policyView is a policy attached to a Gateway or a route.
+type policyView struct {
+	Kind       string          `json:"kind"`
+	Namespace  string          `json:"namespace"`
+	Name       string          `json:"name"`
+	Conditions []conditionView `json:"conditions,omitempty"`
+	Valid      bool            `json:"valid"`
+}
+
+// conditionView is a condition of a resource.
+type conditionView struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason"`
+	Message string `json:"message,omitempty"`
+}
+
+// agentConnectionView is a connection of an nginx agent.
+type agentConnectionView struct {
+	UUID       string `json:"uuid"`
+	PodName    string `json:"podName"`
+	ParentType string `json:"parentType"`
+	Parent     string `json:"parent"`
+	InstanceID string `json:"instanceID,omitempty"`
+	Ready      bool   `json:"ready"`
+}
+
+// configVersionView is a version of the nginx configuration files of a Gateway.
+type configVersionView struct {
+	Timestamp time.Time `json:"timestamp"`
+	Version   string    `json:"version"`
+	Changes   []string  `json:"changes,omitempty"`
+	Files     []string  `json:"files"`
+	Current   bool      `json:"current"`
+}
+
+func buildGatewaySummaries(gr *graph.Graph) []gatewaySummary {
+	if gr == nil {
+		return []gatewaySummary{}
+	}
+
+	summaries := make([]gatewaySummary, 0, len(gr.Gateways))
+	for nsName, gw := range gr.Gateways {
+		summaries = append(summaries, gatewaySummary{
+			Namespace: nsName.Namespace,
+			Name:      nsName.Name,
+			Valid:     gw.Valid,
+		})
+	}
+
+	slices.SortFunc(summaries, func(a, b gatewaySummary) int {
+		return cmp.Or(cmp.Compare(a.Namespace, b.Namespace), cmp.Compare(a.Name, b.Name))
+	})
+
+	return summaries
+}
+
+func buildGatewayView(gr *graph.Graph, gwNsName types.NamespacedName, gw *graph.Gateway) gatewayView {
+	policyKinds := make(map[*graph.Policy]string, len(gr.NGFPolicies))
+	for key, pol := range gr.NGFPolicies {
+		policyKinds[pol] = key.GVK.Kind
+	}
+
+	view := gatewayView{
+		Namespace:  gwNsName.Namespace,
+		Name:       gwNsName.Name,
+		Deployment: gw.DeploymentName.String(),
+		Valid:      gw.Valid,
+		Conditions: buildConditionViews(gw.Conditions),
+		Policies:   buildPolicyViews(gw.Policies, policyKinds),
+	}
+
+	for _, l := range gw.Listeners {
+		view.Listeners = append(view.Listeners, buildListenerView(l))
+	}
+
+	for _, r := range gr.Routes {
+		if rv, ok := buildRouteView(r.Source, r.ParentRefs, gwNsName); ok {
+			rv.Kind = routeTypeKinds[r.RouteType]
+			rv.Conditions = buildConditionViews(r.Conditions)
+			rv.Policies = buildPolicyViews(r.Policies, policyKinds)
+			rv.Valid = r.Valid
+			rv.Attachable = r.Attachable
+			view.Routes = append(view.Routes, rv)
+		}
+	}
+
+	for _, r := range gr.L4Routes {
+		if rv, ok := buildRouteView(r.Source, r.ParentRefs, gwNsName); ok {
+			rv.Kind = routeTypeKinds[r.RouteType]
+			rv.Conditions = buildConditionViews(r.Conditions)
+			rv.Policies = buildPolicyViews(r.Policies, policyKinds)
+			rv.Valid = r.Valid
+			rv.Attachable = r.Attachable
+			view.Routes = append(view.Routes, rv)
+		}
+	}
+
+	slices.SortFunc(view.Routes, func(a, b routeView) int {
+		return cmp.Or(
+			cmp.Compare(a.Kind, b.Kind),
+			cmp.Compare(a.Namespace, b.Namespace),
+			cmp.Compare(a.Name, b.Name),
+		)
+	})
+
+	return view
+}
+
+func buildListenerView(l *graph.Listener) listenerView {
+	view := listenerView{
+		Name:       l.Name,
+		Protocol:   string(l.Source.Protocol),
+		Port:       l.Source.Port,
+		Conditions: buildConditionViews(l.Conditions),
+		Valid:      l.Valid,
+		Attachable: l.Attachable,
+	}
+
+	if l.ListenerSetName.Name != "" {
+		view.ListenerSet = l.ListenerSetName.String()
+	}
+
+	if l.Source.Hostname != nil {
+		view.Hostname = string(*l.Source.Hostname)
+	}
+
+	for key := range l.Routes {
+		view.AttachedRoutes = append(view.AttachedRoutes, routeName(key.RouteType, key.NamespacedName))
+	}
+
+	for key := range l.L4Routes {
+		view.AttachedRoutes = append(view.AttachedRoutes, routeName(key.RouteType, key.NamespacedName))
+	}
+
+	slices.Sort(view.AttachedRoutes)
+
+	return view
+}
+
+// buildRouteView returns the view of the parentRefs of a route that reference the Gateway,
+// and false if the route does not reference the Gateway.
+func buildRouteView(
+	source client.Object,
+	parentRefs []graph.ParentRef,
+	gwNsName types.NamespacedName,
+) (routeView, bool) {
+	view := routeView{
+		Namespace: source.GetNamespace(),
+		Name:      source.GetName(),
+	}
+
+	for _, ref := range parentRefs {
+		if ref.GatewayNsName != gwNsName {
+			continue
+		}
+
+		refView := parentRefView{}
+		if ref.SectionName != nil {
+			refView.SectionName = string(*ref.SectionName)
+		}
+
+		if ref.Attachment != nil {
+			refView.Attached = ref.Attachment.Attached
+			refView.ListenerPort = ref.Attachment.ListenerPort
+			refView.FailedConditions = buildConditionViews(ref.Attachment.FailedConditions)
+		}
+
+		view.ParentRefs = append(view.ParentRefs, refView)
+	}
+
+	return view, len(view.ParentRefs) > 0
+}
+
+func buildPolicyViews(policies []*graph.Policy, policyKinds map[*graph.Policy]string) []policyView {
+	if len(policies) == 0 {
+		return nil
+	}
+
+	views := make([]policyView, 0, len(policies))
+	for _, pol := range policies {
+		views = append(views, policyView{
+			Kind:       policyKinds[pol],
+			Namespace:  pol.Source.GetNamespace(),
+			Name:       pol.Source.GetName(),
+			Conditions: buildConditionViews(pol.Conditions),
+			Valid:      pol.Valid,
+		})
+	}
+
+	return views
+}
+
+func buildConditionViews(conds []conditions.Condition) []conditionView {
+	if len(conds) == 0 {
+		return nil
+	}
+
+	views := make([]conditionView, 0, len(conds))
+	for _, cond := range conditions.DeduplicateConditions(conds) {
+		views = append(views, conditionView{
+			Type:    cond.Type,
+			Status:  string(cond.Status),
+			Reason:  cond.Reason,
+			Message: cond.Message,
+		})
+	}
+
+	return views
+}
+
+func buildAgentConnectionViews(connections map[string]agentgrpc.Connection) []agentConnectionView {
+	views := make([]agentConnectionView, 0, len(connections))
+	for uuid, conn := range connections {
+		views = append(views, agentConnectionView{
+			UUID:       uuid,
+			PodName:    conn.PodName,
+			ParentType: conn.ParentType,
+			Parent:     conn.ParentName.String(),
+			InstanceID: conn.InstanceID,
+			Ready:      conn.Ready(),
+		})
+	}
+
+	slices.SortFunc(views, func(a, b agentConnectionView) int {
+		return cmp.Or(cmp.Compare(a.Parent, b.Parent), cmp.Compare(a.PodName, b.PodName), cmp.Compare(a.UUID, b.UUID))
+	})
+
+	return views
+}
+
+func buildConfigVersionViews(current string, versions []agent.ConfigVersion) []configVersionView {
+	views := make([]configVersionView, 0, len(versions))
+	for _, v := range versions {
+		files := make([]string, 0, len(v.Files))
+		for _, f := range v.Files {
+			files = append(files, f.Meta.GetName())
+		}
+		slices.Sort(files)
+
+		views = append(views, configVersionView{
+			Timestamp: v.Timestamp,
+			Version:   v.Version,
+			Changes:   v.Changes,
+			Files:     files,
+			Current:   v.Version == current,
+		})
+	}
+
+	return views
+}
+
+func routeName(routeType graph.RouteType, nsName types.NamespacedName) string {
+	return routeTypeKinds[routeType] + "/" + nsName.String()
+}
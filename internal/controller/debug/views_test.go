@@ -0,0 +1,290 @@
+package debug
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+func TestBuildGatewayView(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	otherGwNsName := types.NamespacedName{Namespace: "test", Name: "other-gateway"}
+
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "route"}
+	routeKey := graph.RouteKey{NamespacedName: routeNsName, RouteType: graph.RouteTypeHTTP}
+
+	tcpRouteNsName := types.NamespacedName{Namespace: "test", Name: "tcp-route"}
+	tcpRouteKey := graph.L4RouteKey{NamespacedName: tcpRouteNsName, RouteType: graph.RouteTypeTCP}
+
+	policy := &graph.Policy{
+		Source: &ngfAPIv1alpha1.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "policy"},
+		},
+		Conditions: []conditions.Condition{conditions.NewPolicyInvalid("invalid")},
+	}
+
+	route := &graph.L7Route{
+		Source: &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: routeNsName.Namespace, Name: routeNsName.Name},
+		},
+		RouteType: graph.RouteTypeHTTP,
+		ParentRefs: []graph.ParentRef{
+			{
+				GatewayNsName: gwNsName,
+				SectionName:   helpers.GetPointer[gatewayv1.SectionName]("http"),
+				Attachment: &graph.ParentRefAttachmentStatus{
+					Attached:     true,
+					ListenerPort: 80,
+				},
+			},
+			{
+				GatewayNsName: otherGwNsName,
+			},
+		},
+		Policies:   []*graph.Policy{policy},
+		Valid:      true,
+		Attachable: true,
+	}
+
+	tcpRoute := &graph.L4Route{
+		Source: &v1alpha2.TCPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: tcpRouteNsName.Namespace, Name: tcpRouteNsName.Name},
+		},
+		RouteType: graph.RouteTypeTCP,
+		ParentRefs: []graph.ParentRef{
+			{
+				GatewayNsName: gwNsName,
+				Attachment: &graph.ParentRefAttachmentStatus{
+					FailedConditions: []conditions.Condition{conditions.NewRouteNotAllowedByListeners()},
+				},
+			},
+		},
+		Valid:      true,
+		Attachable: true,
+	}
+
+	otherRoute := &graph.L7Route{
+		Source: &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other-route"},
+		},
+		RouteType:  graph.RouteTypeHTTP,
+		ParentRefs: []graph.ParentRef{{GatewayNsName: otherGwNsName}},
+	}
+
+	gw := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+		},
+		DeploymentName: types.NamespacedName{Namespace: "test", Name: "gateway-nginx"},
+		Listeners: []*graph.Listener{
+			{
+				Name: "http",
+				Source: gatewayv1.Listener{
+					Name:     "http",
+					Protocol: gatewayv1.HTTPProtocolType,
+					Port:     80,
+					Hostname: helpers.GetPointer[gatewayv1.Hostname]("foo.example.com"),
+				},
+				Routes:     map[graph.RouteKey]*graph.L7Route{routeKey: route},
+				Valid:      true,
+				Attachable: true,
+			},
+			{
+				Name:            "tcp",
+				ListenerSetName: types.NamespacedName{Namespace: "test", Name: "listener-set"},
+				Source: gatewayv1.Listener{
+					Name:     "tcp",
+					Protocol: gatewayv1.TCPProtocolType,
+					Port:     8080,
+				},
+				Conditions: conditions.NewListenerUnsupportedValue("unsupported"),
+			},
+		},
+		Valid: true,
+	}
+
+	gr := &graph.Graph{
+		Gateways: map[types.NamespacedName]*graph.Gateway{gwNsName: gw},
+		Routes: map[graph.RouteKey]*graph.L7Route{
+			routeKey: route,
+			{NamespacedName: types.NamespacedName{Namespace: "test", Name: "other-route"}}: otherRoute,
+		},
+		L4Routes: map[graph.L4RouteKey]*graph.L4Route{tcpRouteKey: tcpRoute},
+		NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+			{
+				NsName: types.NamespacedName{Namespace: "test", Name: "policy"},
+				GVK:    schema.GroupVersionKind{Kind: kinds.AccessLogPolicy},
+			}: policy,
+		},
+	}
+
+	invalidPolicyCond := conditions.NewPolicyInvalid("invalid")
+	notAllowedCond := conditions.NewRouteNotAllowedByListeners()
+
+	expView := gatewayView{
+		Namespace:  "test",
+		Name:       "gateway",
+		Deployment: "test/gateway-nginx",
+		Valid:      true,
+		Listeners: []listenerView{
+			{
+				Name:           "http",
+				Protocol:       "HTTP",
+				Hostname:       "foo.example.com",
+				Port:           80,
+				AttachedRoutes: []string{"HTTPRoute/test/route"},
+				Valid:          true,
+				Attachable:     true,
+			},
+			{
+				Name:        "tcp",
+				ListenerSet: "test/listener-set",
+				Protocol:    "TCP",
+				Port:        8080,
+				Conditions:  buildConditionViews(gw.Listeners[1].Conditions),
+			},
+		},
+		Routes: []routeView{
+			{
+				Kind:      kinds.HTTPRoute,
+				Namespace: "test",
+				Name:      "route",
+				ParentRefs: []parentRefView{
+					{
+						SectionName:  "http",
+						ListenerPort: 80,
+						Attached:     true,
+					},
+				},
+				Policies: []policyView{
+					{
+						Kind:      kinds.AccessLogPolicy,
+						Namespace: "test",
+						Name:      "policy",
+						Conditions: []conditionView{
+							{
+								Type:    invalidPolicyCond.Type,
+								Status:  string(invalidPolicyCond.Status),
+								Reason:  invalidPolicyCond.Reason,
+								Message: invalidPolicyCond.Message,
+							},
+						},
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+			},
+			{
+				Kind:      kinds.TCPRoute,
+				Namespace: "test",
+				Name:      "tcp-route",
+				ParentRefs: []parentRefView{
+					{
+						FailedConditions: []conditionView{
+							{
+								Type:    notAllowedCond.Type,
+								Status:  string(notAllowedCond.Status),
+								Reason:  notAllowedCond.Reason,
+								Message: notAllowedCond.Message,
+							},
+						},
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+			},
+		},
+	}
+
+	g.Expect(buildGatewayView(gr, gwNsName, gw)).To(Equal(expView))
+}
+
+func TestBuildGatewaySummaries(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		graph  *graph.Graph
+		name   string
+		expect []gatewaySummary
+	}{
+		{
+			name:   "nil graph",
+			expect: []gatewaySummary{},
+		},
+		{
+			name: "sorted gateways",
+			graph: &graph.Graph{
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "b", Name: "gateway"}:  {Valid: true},
+					{Namespace: "a", Name: "gateway2"}: {},
+					{Namespace: "a", Name: "gateway1"}: {Valid: true},
+				},
+			},
+			expect: []gatewaySummary{
+				{Namespace: "a", Name: "gateway1", Valid: true},
+				{Namespace: "a", Name: "gateway2"},
+				{Namespace: "b", Name: "gateway", Valid: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildGatewaySummaries(test.graph)).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestBuildAgentConnectionViews(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connections := map[string]agentgrpc.Connection{
+		"uuid2": {
+			PodName:    "pod2",
+			ParentType: "Deployment",
+			ParentName: types.NamespacedName{Namespace: "test", Name: "nginx"},
+		},
+		"uuid1": {
+			PodName:    "pod1",
+			ParentType: "Deployment",
+			ParentName: types.NamespacedName{Namespace: "test", Name: "nginx"},
+			InstanceID: "instance",
+		},
+	}
+
+	g.Expect(buildAgentConnectionViews(connections)).To(Equal([]agentConnectionView{
+		{
+			UUID:       "uuid1",
+			PodName:    "pod1",
+			ParentType: "Deployment",
+			Parent:     "test/nginx",
+			InstanceID: "instance",
+			Ready:      true,
+		},
+		{
+			UUID:       "uuid2",
+			PodName:    "pod2",
+			ParentType: "Deployment",
+			Parent:     "test/nginx",
+		},
+	}))
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimeta "k8s.io/apimachinery/pkg/api/meta"
@@ -28,6 +32,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/licensing"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	ngxConfig "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/otlp"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/provisioner"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
@@ -45,6 +50,11 @@ import (
 
 type handlerMetricsCollector interface {
 	ObserveLastEventBatchProcessTime(time.Duration)
+	ObserveGraphBuildTime(time.Duration)
+	ObserveConfigurationBuildTime(time.Duration)
+	ObserveConfigGenerationTime(time.Duration)
+	ObserveConfigPush(types.NamespacedName, time.Duration, error)
+	DeleteGatewayMetrics(types.NamespacedName)
 }
 
 // eventHandlerConfig holds configuration parameters for eventHandlerImpl.
@@ -73,7 +83,9 @@ type eventHandlerConfig struct {
 	eventRecorder k8sEvents.EventRecorder
 	// deployCtxCollector collects the deployment context for N+ licensing.
 	deployCtxCollector licensing.Collector
-	ctx                context.Context
+	// mustExtractGVK is a function that extracts schema.GroupVersionKind from a client.Object.
+	mustExtractGVK kinds.MustExtractGVK
+	ctx            context.Context
 	// graphBuiltHealthChecker sets the health of the Pod to Ready once we've built our initial graph.
 	graphBuiltHealthChecker *graphBuiltHealthChecker
 	// nginxDeployments contains a map of all nginx Deployments, and data about them.
@@ -94,8 +106,17 @@ type eventHandlerConfig struct {
 	gatewayClassName string
 	// clusterIPFamily is the IP family detected from the cluster at startup.
 	clusterIPFamily ngfAPIv1alpha2.IPFamilyType
+	// clusterDomain is the Kubernetes cluster DNS domain.
+	clusterDomain string
+	// zoneSyncResolver is the nameserver that NGINX Plus uses to find the other replicas of a Gateway.
+	zoneSyncResolver string
+	// plusAPIMetricsAllowedCIDR is the CIDR block that is allowed to access the NGINX Plus API to read
+	// the upstream metrics.
+	plusAPIMetricsAllowedCIDR string
 	// plus is whether or not we are running NGINX Plus.
 	plus bool
+	// experimental indicates if experimental features are enabled.
+	experimental bool
 	// inferenceExtension indicates if Gateway API Inference Extension support is enabled.
 	inferenceExtension bool
 	// plmEnabled indicates whether PLM storage is configured.
@@ -110,6 +131,14 @@ const (
 
 	// apResourceFinalizer prevents deletion of AP resources that are still referenced by WAFPolicy.
 	apResourceFinalizer = "gateway.nginx.org/ap-policy-protection"
+
+	// pinnedConfigVersionAnnotation pins a Gateway to a previous nginx configuration version from the
+	// configuration version history of its nginx Deployment.
+	pinnedConfigVersionAnnotation = "gateway.nginx.org/pinned-config-version"
+
+	// maxRecordedChanges is the maximum number of resource changes of an event batch that are recorded
+	// in the configuration version history.
+	maxRecordedChanges = 20
 )
 
 type apResourceType int
@@ -194,6 +223,13 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 	start := time.Now()
 	logger.V(1).Info("Started processing event batch")
 
+	ctx, span := otlp.Tracer().Start(
+		ctx,
+		"handle event batch",
+		trace.WithAttributes(attribute.Int("event_batch.size", len(batch))),
+	)
+	defer span.End()
+
 	defer func() {
 		duration := time.Since(start)
 		logger.V(1).Info(
@@ -207,14 +243,28 @@ func (h *eventHandlerImpl) HandleEventBatch(ctx context.Context, logger logr.Log
 		h.parseAndCaptureEvent(ctx, logger, event)
 	}
 
-	gr := h.cfg.processor.Process(ctx)
+	changes := h.describeChanges(batch)
+
+	processStart := time.Now()
+	processCtx, processSpan := otlp.Tracer().Start(ctx, "build graph")
+	gr := h.cfg.processor.Process(processCtx)
+	processSpan.SetAttributes(attribute.Bool("graph.changed", gr != nil))
+	processSpan.End()
+	if gr != nil {
+		h.cfg.metricsCollector.ObserveGraphBuildTime(time.Since(processStart))
+	}
 
 	// Once we've processed resources on startup and built our first graph, mark the Pod as ready.
 	if !h.cfg.graphBuiltHealthChecker.ready {
 		h.cfg.graphBuiltHealthChecker.setAsReady()
 	}
 
-	h.sendNginxConfig(ctx, logger, gr)
+	var affected map[types.NamespacedName]struct{}
+	if gr != nil {
+		affected = gr.AffectedGateways
+	}
+
+	h.sendNginxConfig(ctx, logger, gr, affected, changes)
 }
 
 // enable is called when the pod becomes leader to ensure the provisioner has
@@ -224,10 +274,20 @@ func (h *eventHandlerImpl) enable(ctx context.Context) {
 	h.leader = true
 	h.leaderLock.Unlock()
 
-	h.sendNginxConfig(ctx, h.cfg.logger, h.cfg.processor.GetLatestGraph())
+	h.sendNginxConfig(ctx, h.cfg.logger, h.cfg.processor.GetLatestGraph(), nil, []string{"leader elected"})
 }
 
-func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logger, gr *graph.Graph) {
+// sendNginxConfig builds and sends the nginx configuration of all Gateways in the graph. The changes
+// describe the resource changes that triggered the update, and are recorded in the configuration version history.
+// If affected is not nil, the configuration is only rebuilt and sent for the affected Gateways; the other
+// Gateways keep their latest configuration and only have their statuses updated.
+func (h *eventHandlerImpl) sendNginxConfig(
+	ctx context.Context,
+	logger logr.Logger,
+	gr *graph.Graph,
+	affected map[types.NamespacedName]struct{},
+	changes []string,
+) {
 	if gr == nil {
 		return
 	}
@@ -280,6 +340,22 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 				},
 				Error: errors.New("NGINX configuration update withheld: WAF bundle for Gateway is still pending"),
 			}
+		// The Gateway is not affected by the changes, so its configuration is up to date.
+		// Update its status with the result of the latest configuration push.
+		case !isAffectedGateway(affected, gw) && h.hasLatestConfiguration(gw):
+			var statusErr error
+			if deployment := h.cfg.nginxDeployments.Get(gw.DeploymentName); deployment != nil {
+				statusErr = errors.Join(deployment.GetLatestConfigError(), deployment.GetLatestUpstreamError())
+			}
+
+			statusObj = &status.QueueObject{
+				UpdateType: status.UpdateAll,
+				Error:      statusErr,
+				Deployment: status.Deployment{
+					NamespacedName: gw.DeploymentName,
+					GatewayName:    gw.Source.GetName(),
+				},
+			}
 		default:
 			deployment := h.cfg.nginxDeployments.LoadOrStore(ctx, gw.DeploymentName, gw.Source.GetName())
 			if deployment == nil {
@@ -292,31 +368,79 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 				h.cfg.gatewayPodConfig.Version,
 			)
 			deployment.SetImageVersion(nginxImage)
+			deployment.SetRolloutStrategy(rolloutStrategy(gw.EffectiveNginxProxy))
+
+			gwCtx, gwSpan := otlp.Tracer().Start(
+				ctx,
+				"update gateway configuration",
+				trace.WithAttributes(attribute.String("k8s.gateway.name", client.ObjectKeyFromObject(gw.Source).String())),
+			)
 
-			cfg := dataplane.BuildConfiguration(ctx, logger, gr, gw, h.cfg.serviceResolver, h.cfg.plus, h.cfg.clusterIPFamily)
+			buildStart := time.Now()
+			buildCtx, buildSpan := otlp.Tracer().Start(gwCtx, "build configuration")
+			cfg := dataplane.BuildConfiguration(
+				buildCtx,
+				logger,
+				gr,
+				gw,
+				h.cfg.serviceResolver,
+				h.cfg.plus,
+				h.cfg.clusterIPFamily,
+				h.cfg.clusterDomain,
+				h.cfg.zoneSyncResolver,
+			)
+			buildSpan.End()
+			h.cfg.metricsCollector.ObserveConfigurationBuildTime(time.Since(buildStart))
 			depCtx, getErr := h.getDeploymentContext(ctx)
 			if getErr != nil {
 				logger.Error(getErr, "error getting deployment context for usage reporting")
 			}
 			cfg.DeploymentContext = depCtx
+			allowMetricsInPlusAPI(&cfg, h.cfg.plusAPIMetricsAllowedCIDR)
+			nginxPodLabels := client.MatchingLabels{
+				controller.AppNameLabel:     gw.DeploymentName.Name,
+				controller.AppInstanceLabel: h.cfg.gatewayInstanceName,
+				controller.AppManagedByLabel: controller.CreateNginxResourceName(
+					h.cfg.gatewayInstanceName,
+					h.cfg.gatewayClassName,
+				),
+			}
+			if err := setNodeZones(ctx, h.cfg.k8sClient, &cfg, gw.DeploymentName.Namespace, nginxPodLabels); err != nil {
+				logger.Error(err, "error getting the zones of the nodes for topology-aware routing")
+			}
 
 			h.setLatestConfiguration(gw, &cfg)
 
+			pushStart := time.Now()
 			deployment.FileLock.Lock()
-			h.updateNginxConf(deployment, cfg, effectiveVolumeMounts(gw.EffectiveNginxProxy))
+			deployment.SetConfigChanges(changes)
+			h.updateNginxConf(gwCtx, logger, deployment, gw, cfg)
 			deployment.FileLock.Unlock()
 
 			configErr := deployment.GetLatestConfigError()
 			upstreamErr := deployment.GetLatestUpstreamError()
+			pushErr := errors.Join(configErr, upstreamErr)
+			h.cfg.metricsCollector.ObserveConfigPush(
+				client.ObjectKeyFromObject(gw.Source),
+				time.Since(pushStart),
+				pushErr,
+			)
+
+			if pushErr != nil {
+				gwSpan.RecordError(pushErr)
+				gwSpan.SetStatus(otelcodes.Error, pushErr.Error())
+			}
+			gwSpan.End()
 
 			statusObj = &status.QueueObject{
 				UpdateType:        status.UpdateAll,
-				Error:             errors.Join(configErr, upstreamErr),
+				Error:             pushErr,
 				NginxConfigPushed: true,
 				Deployment: status.Deployment{
 					NamespacedName: gw.DeploymentName,
 					GatewayName:    gw.Source.GetName(),
 				},
+				SpanContext: gwSpan.SpanContext(),
 			}
 		}
 
@@ -329,6 +453,18 @@ func (h *eventHandlerImpl) sendNginxConfig(ctx context.Context, logger logr.Logg
 	}
 }
 
+// isAffectedGateway returns whether the Gateway is in the affected Gateways. A nil affected map means
+// that all Gateways are affected.
+func isAffectedGateway(affected map[types.NamespacedName]struct{}, gw *graph.Gateway) bool {
+	if affected == nil {
+		return true
+	}
+
+	_, exists := affected[client.ObjectKeyFromObject(gw.Source)]
+
+	return exists
+}
+
 // effectiveVolumeMounts returns the user-configured volume mounts from the EffectiveNginxProxy,
 // or nil if none are configured.
 func effectiveVolumeMounts(np *graph.EffectiveNginxProxy) []v1.VolumeMount {
@@ -347,6 +483,20 @@ func effectiveVolumeMounts(np *graph.EffectiveNginxProxy) []v1.VolumeMount {
 	return nil
 }
 
+// rolloutStrategy returns the staged configuration rollout strategy from the EffectiveNginxProxy,
+// or nil if none is configured.
+func rolloutStrategy(np *graph.EffectiveNginxProxy) *agent.RolloutStrategy {
+	if np == nil || np.Rollout == nil {
+		return nil
+	}
+
+	return &agent.RolloutStrategy{
+		Canary:         np.Rollout.Canary,
+		SoakPeriod:     graph.RolloutSoakPeriodForNginxProxy(np),
+		RequireHealthy: np.Rollout.RequireHealthy != nil && *np.Rollout.RequireHealthy,
+	}
+}
+
 // reconcileWAFPollers starts, updates, or stops WAF bundle pollers based on the current graph state.
 // For each valid WAFPolicy with polling enabled, a poller is started.
 // For policies that are deleted or no longer have polling enabled, the poller is stopped.
@@ -551,6 +701,12 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 			continue
 		}
 
+		_, span := otlp.Tracer().Start(
+			trace.ContextWithSpanContext(ctx, item.SpanContext),
+			"update statuses",
+			trace.WithAttributes(attribute.Int("status.update_type", int(item.UpdateType))),
+		)
+
 		var nginxReloadRes graph.NginxReloadResult
 		var gw *graph.Gateway
 		if item.Deployment.NamespacedName.Name != "" {
@@ -587,6 +743,8 @@ func (h *eventHandlerImpl) waitForStatusUpdates(ctx context.Context) {
 		default:
 			panic(fmt.Sprintf("unknown update type %d", item.UpdateType))
 		}
+
+		span.End()
 	}
 }
 
@@ -669,10 +827,38 @@ func (h *eventHandlerImpl) updateGatewayStatus(
 		transitionTime,
 		gwAddresses,
 		gw.LatestReloadResult,
+		h.getDataPlaneHealth(gw),
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gatewayStatuses...)
 }
 
+// getDataPlaneHealth returns the data plane health reported by the nginx agents of the Gateway.
+func (h *eventHandlerImpl) getDataPlaneHealth(gw *graph.Gateway) graph.DataPlaneHealth {
+	var health graph.DataPlaneHealth
+	if gw == nil {
+		return health
+	}
+
+	deployment := h.cfg.nginxDeployments.Get(gw.DeploymentName)
+	if deployment == nil {
+		return health
+	}
+
+	for _, podHealth := range deployment.GetPodHealth() {
+		health.Reported = true
+		if podHealth.Healthy {
+			continue
+		}
+
+		if health.UnhealthyPods == nil {
+			health.UnhealthyPods = make(map[string]string)
+		}
+		health.UnhealthyPods[podHealth.PodName] = podHealth.Description
+	}
+
+	return health
+}
+
 // configuredAddress returns the address the attached ExternalLoadBalancer configures up front.
 func configuredAddress(gw *graph.Gateway) string {
 	if gw == nil || gw.ExternalLoadBalancer == nil {
@@ -720,7 +906,12 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 	h.pruneIngressLinkAddresses(gr)
 
 	transitionTime := metav1.Now()
-	gcReqs := status.PrepareGatewayClassRequests(gr.GatewayClass, gr.IgnoredGatewayClasses, transitionTime)
+	gcReqs := status.PrepareGatewayClassRequests(
+		gr.GatewayClass,
+		gr.IgnoredGatewayClasses,
+		transitionTime,
+		h.cfg.experimental,
+	)
 
 	if gw == nil {
 		h.cfg.statusUpdater.UpdateGroup(ctx, groupAllExceptGateways, gcReqs...)
@@ -844,6 +1035,7 @@ func (h *eventHandlerImpl) updateStatuses(ctx context.Context, gr *graph.Graph,
 		transitionTime,
 		gwAddresses,
 		gw.LatestReloadResult,
+		h.getDataPlaneHealth(gw),
 	)
 	h.cfg.statusUpdater.UpdateGroup(ctx, groupGateways, gwReqs...)
 }
@@ -973,6 +1165,10 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 			}
 		}
 
+		if _, ok := e.Type.(*gatewayv1.Gateway); ok {
+			h.cfg.metricsCollector.DeleteGatewayMetrics(e.NamespacedName)
+		}
+
 		h.cfg.processor.CaptureDeleteChange(e.Type, e.NamespacedName)
 	case events.WAFBundleReconcileEvent:
 		// Guard against stale events: the poller may have been stopped (policy deleted) between
@@ -999,12 +1195,33 @@ func (h *eventHandlerImpl) parseAndCaptureEvent(ctx context.Context, logger logr
 }
 
 // updateNginxConf updates nginx conf files and reloads nginx.
+// If the Gateway is pinned to a previous configuration version, the files of that version are sent instead.
+// The configuration pushes to the agents are traced as children of the span in the context.
+// The deployment FileLock MUST already be locked before calling this function.
 func (h *eventHandlerImpl) updateNginxConf(
+	ctx context.Context,
+	logger logr.Logger,
 	deployment *agent.Deployment,
+	gw *graph.Gateway,
 	conf dataplane.Configuration,
-	volumeMounts []v1.VolumeMount,
 ) {
+	generateStart := time.Now()
+	_, generateSpan := otlp.Tracer().Start(ctx, "generate nginx configuration")
 	files := h.cfg.generator.Generate(conf)
+	generateSpan.SetAttributes(attribute.Int("nginx.config.files", len(files)))
+	generateSpan.End()
+	h.cfg.metricsCollector.ObserveConfigGenerationTime(time.Since(generateStart))
+
+	deployment.SetSpanContext(trace.SpanContextFromContext(ctx))
+	defer deployment.SetSpanContext(trace.SpanContext{})
+
+	volumeMounts := effectiveVolumeMounts(gw.EffectiveNginxProxy)
+
+	if version, ok := gw.Source.GetAnnotations()[pinnedConfigVersionAnnotation]; ok {
+		h.updatePinnedNginxConf(logger, deployment, gw, version, volumeMounts)
+		return
+	}
+
 	h.cfg.nginxUpdater.UpdateConfig(deployment, files, volumeMounts)
 
 	// If using NGINX Plus, update upstream servers using the API.
@@ -1013,6 +1230,94 @@ func (h *eventHandlerImpl) updateNginxConf(
 	}
 }
 
+// updatePinnedNginxConf sends the files of the configuration version that the Gateway is pinned to with
+// the pinnedConfigVersionAnnotation.
+// The configuration version history is only kept in memory, so it is lost when the control plane restarts
+// or the leader changes. If the pinned version is not in the history, the current configuration is kept
+// instead of sending the latest configuration that the Gateway was pinned to avoid, and the Gateway is
+// reported as not programmed.
+// The deployment FileLock MUST already be locked before calling this function.
+func (h *eventHandlerImpl) updatePinnedNginxConf(
+	logger logr.Logger,
+	deployment *agent.Deployment,
+	gw *graph.Gateway,
+	version string,
+	volumeMounts []v1.VolumeMount,
+) {
+	pinned, found := deployment.FindConfigVersion(version)
+	if !found {
+		msg := "pinned nginx configuration version not found in the configuration version history, " +
+			"keeping the current configuration"
+		logger.Info(msg, "gateway", client.ObjectKeyFromObject(gw.Source), "version", version)
+		h.cfg.eventRecorder.Eventf(
+			gw.Source,
+			nil,
+			v1.EventTypeWarning,
+			"PinnedConfigVersionNotFound",
+			"None",
+			msg+": %s",
+			version,
+		)
+
+		deployment.SetLatestConfigError(fmt.Errorf("%w: %s", graph.ErrPinnedConfigVersionNotFound, version))
+		return
+	}
+
+	logger.Info(
+		"Gateway is pinned to a previous nginx configuration version",
+		"gateway", client.ObjectKeyFromObject(gw.Source),
+		"version", version,
+	)
+
+	deployment.SetConfigChanges([]string{"pinned by Gateway annotation " + pinnedConfigVersionAnnotation})
+	h.cfg.nginxUpdater.UpdateConfig(deployment, pinned.Files, volumeMounts)
+
+	// The upstream servers of the latest configuration may not match the upstreams of the pinned version,
+	// so they are not updated using the NGINX Plus API while the Gateway is pinned.
+	deployment.SetLatestUpstreamError(nil)
+}
+
+// describeChanges returns a description of the resource changes in the event batch, like
+// "HTTPRoute default/coffee upserted". Only the first maxRecordedChanges changes are described.
+func (h *eventHandlerImpl) describeChanges(batch events.EventBatch) []string {
+	changes := make([]string, 0, min(len(batch), maxRecordedChanges+1))
+
+	for i, event := range batch {
+		if i == maxRecordedChanges {
+			changes = append(changes, fmt.Sprintf("and %d more changes", len(batch)-maxRecordedChanges))
+			break
+		}
+
+		switch e := event.(type) {
+		case *events.UpsertEvent:
+			changes = append(changes, fmt.Sprintf(
+				"%s %s upserted",
+				h.cfg.mustExtractGVK(e.Resource).Kind,
+				objectName(client.ObjectKeyFromObject(e.Resource)),
+			))
+		case *events.DeleteEvent:
+			changes = append(changes, fmt.Sprintf(
+				"%s %s deleted",
+				h.cfg.mustExtractGVK(e.Type).Kind,
+				objectName(e.NamespacedName),
+			))
+		case events.WAFBundleReconcileEvent:
+			changes = append(changes, fmt.Sprintf("%s %s bundle available", kinds.WAFPolicy, e.PolicyNsName))
+		}
+	}
+
+	return changes
+}
+
+// objectName returns the name of an object, prefixed with its namespace if it is namespaced.
+func objectName(nsName types.NamespacedName) string {
+	if nsName.Namespace == "" {
+		return nsName.Name
+	}
+
+	return nsName.String()
+}
+
 // updateControlPlaneAndSetStatus updates the control plane configuration and then sets the status
 // based on the outcome.
 func (h *eventHandlerImpl) updateControlPlaneAndSetStatus(
@@ -1193,6 +1498,25 @@ func (h *eventHandlerImpl) GetLatestConfiguration() []*dataplane.Configuration {
 	return configs
 }
 
+// GetLatestConfigurationForGateway gets the latest configuration of a Gateway,
+// or nil if no configuration was built for the Gateway.
+func (h *eventHandlerImpl) GetLatestConfigurationForGateway(gateway types.NamespacedName) *dataplane.Configuration {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	return h.latestConfigurations[gateway].Snapshot()
+}
+
+// hasLatestConfiguration returns whether a configuration was built for the Gateway.
+func (h *eventHandlerImpl) hasLatestConfiguration(gateway *graph.Gateway) bool {
+	h.lock.RLock()
+	defer h.lock.RUnlock()
+
+	_, exists := h.latestConfigurations[client.ObjectKeyFromObject(gateway.Source)]
+
+	return exists
+}
+
 // setLatestConfiguration sets the latest configuration.
 func (h *eventHandlerImpl) setLatestConfiguration(gateway *graph.Gateway, cfg *dataplane.Configuration) {
 	if gateway == nil || gateway.Source == nil {
@@ -1205,6 +1529,67 @@ func (h *eventHandlerImpl) setLatestConfiguration(gateway *graph.Gateway, cfg *d
 	h.latestConfigurations[client.ObjectKeyFromObject(gateway.Source)] = cfg
 }
 
+// allowMetricsInPlusAPI adds the CIDR block of the control plane to the allow list of the NGINX Plus API, so that
+// the upstream metrics can be read from the nginx Pods. The CIDR block is only set if the metrics are enabled.
+// Unlike the IP address of the control plane Pod, the CIDR block doesn't change when the Pod is restarted,
+// so the nginx configuration doesn't change either.
+func allowMetricsInPlusAPI(cfg *dataplane.Configuration, cidr string) {
+	if cidr == "" || cfg.NginxPlus.AllowedAddresses == nil {
+		return
+	}
+
+	if slices.Contains(cfg.NginxPlus.AllowedAddresses, cidr) {
+		return
+	}
+
+	cfg.NginxPlus.AllowedAddresses = append(slices.Clone(cfg.NginxPlus.AllowedAddresses), cidr)
+}
+
+// setNodeZones sets the topology zones of the nodes that run the nginx Pods of the Gateway on the configuration,
+// if an upstream has topology-aware variants. Only the metadata of the nodes is read.
+func setNodeZones(
+	ctx context.Context,
+	k8sReader client.Reader,
+	cfg *dataplane.Configuration,
+	podNamespace string,
+	podLabels client.MatchingLabels,
+) error {
+	topologyAware := slices.ContainsFunc(cfg.Upstreams, func(up dataplane.Upstream) bool {
+		return len(up.TopologyUpstreams) > 0
+	})
+	if !topologyAware {
+		return nil
+	}
+
+	pods := &v1.PodList{}
+	if err := k8sReader.List(ctx, pods, client.InNamespace(podNamespace), podLabels); err != nil {
+		return err
+	}
+
+	cfg.NodeZones = make(map[string]string)
+	for _, pod := range pods.Items {
+		nodeName := pod.Spec.NodeName
+		if _, exists := cfg.NodeZones[nodeName]; exists || nodeName == "" {
+			continue
+		}
+
+		node := &metav1.PartialObjectMetadata{}
+		node.SetGroupVersionKind(v1.SchemeGroupVersion.WithKind("Node"))
+		if err := k8sReader.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if zone, ok := node.Labels[v1.LabelTopologyZone]; ok {
+			cfg.NodeZones[nodeName] = zone
+		}
+	}
+
+	return nil
+}
+
 func objectFilterKey(obj client.Object, nsName types.NamespacedName) filterKey {
 	return filterKey(fmt.Sprintf("%T_%s_%s", obj, nsName.Namespace, nsName.Name))
 }
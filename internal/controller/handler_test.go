@@ -10,6 +10,7 @@ import (
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 	v1 "k8s.io/api/core/v1"
 	discoveryV1 "k8s.io/api/discovery/v1"
@@ -19,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8sEvents "k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -156,6 +158,7 @@ var _ = Describe("eventHandler", func() {
 			statusUpdater:           fakeStatusUpdater,
 			eventRecorder:           fakeEventRecorder,
 			deployCtxCollector:      &licensingfakes.FakeCollector{},
+			mustExtractGVK:          kinds.NewMustExtractGKV(scheme),
 			graphBuiltHealthChecker: newGraphBuiltHealthChecker(),
 			statusQueue:             queue,
 			nginxDeployments:        agent.NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{}),
@@ -219,6 +222,10 @@ var _ = Describe("eventHandler", func() {
 				config := handler.GetLatestConfiguration()
 				Expect(config).To(HaveLen(1))
 				Expect(helpers.Diff(config[0], &dcfg)).To(BeEmpty())
+
+				gwConfig := handler.GetLatestConfigurationForGateway(types.NamespacedName{Namespace: "test", Name: "gateway"})
+				Expect(helpers.Diff(gwConfig, &dcfg)).To(BeEmpty())
+				Expect(handler.GetLatestConfigurationForGateway(types.NamespacedName{Name: "unknown"})).To(BeNil())
 			})
 			It("should process Delete", func() {
 				e := &events.DeleteEvent{
@@ -237,6 +244,35 @@ var _ = Describe("eventHandler", func() {
 				Expect(config).To(HaveLen(1))
 				Expect(helpers.Diff(config[0], &dcfg)).To(BeEmpty())
 			})
+			It("should record the reconciliation pipeline metrics", func() {
+				metricsCollector := collectors.NewControllerCollector(nil)
+				handler.cfg.metricsCollector = metricsCollector
+
+				registry := prometheus.NewRegistry()
+				registry.MustRegister(metricsCollector)
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				families, err := registry.Gather()
+				Expect(err).ToNot(HaveOccurred())
+
+				gathered := make(map[string]int)
+				for _, family := range families {
+					gathered[family.GetName()] = len(family.GetMetric())
+				}
+
+				for _, name := range []string{
+					"nginx_gateway_fabric_event_batch_processing_milliseconds",
+					"nginx_gateway_fabric_graph_build_milliseconds",
+					"nginx_gateway_fabric_dataplane_configuration_build_milliseconds",
+					"nginx_gateway_fabric_nginx_config_generation_milliseconds",
+					"nginx_gateway_fabric_nginx_config_push_milliseconds",
+					"nginx_gateway_fabric_nginx_config_pushes_total",
+				} {
+					Expect(gathered).To(HaveKeyWithValue(name, 1))
+				}
+			})
 
 			It("should not build anything if Gateway isn't set", func() {
 				fakeProcessor.ProcessReturns(&graph.Graph{})
@@ -376,6 +412,95 @@ var _ = Describe("eventHandler", func() {
 				Expect(helpers.Diff(config[0], &dcfg)).To(BeEmpty())
 			})
 		})
+
+		When("the Gateway is pinned to a configuration version", func() {
+			var pinnedFiles []agent.File
+			var pinnedVersion string
+
+			BeforeEach(func() {
+				pinnedFiles = []agent.File{
+					{
+						Meta:     &pb.FileMeta{Name: "pinned.conf", Hash: "pinned-hash"},
+						Contents: []byte("pinned"),
+					},
+				}
+
+				gw := baseGraph.Gateways[types.NamespacedName{Namespace: "test", Name: "gateway"}]
+				deployment := handler.cfg.nginxDeployments.LoadOrStore(ctx, gw.DeploymentName, "gateway")
+
+				deployment.FileLock.Lock()
+				deployment.SetFiles(pinnedFiles, nil)
+				pinnedVersion, _ = deployment.GetConfigVersions()
+				deployment.FileLock.Unlock()
+			})
+
+			It("should send the files of the pinned version", func() {
+				baseGraph.Gateways[types.NamespacedName{Namespace: "test", Name: "gateway"}].Source.SetAnnotations(
+					map[string]string{pinnedConfigVersionAnnotation: pinnedVersion},
+				)
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeNginxUpdater.UpdateConfigCallCount()).To(Equal(1))
+				_, files, _ := fakeNginxUpdater.UpdateConfigArgsForCall(0)
+				Expect(files).To(Equal(pinnedFiles))
+				Expect(fakeNginxUpdater.UpdateUpstreamServersCallCount()).To(Equal(0))
+				Expect(fakeEventRecorder.Events).To(BeEmpty())
+			})
+
+			It("should keep the current files if the pinned version is not found", func() {
+				gw := baseGraph.Gateways[types.NamespacedName{Namespace: "test", Name: "gateway"}]
+				gw.Source.SetAnnotations(map[string]string{pinnedConfigVersionAnnotation: "unknown"})
+
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				Expect(fakeNginxUpdater.UpdateConfigCallCount()).To(Equal(0))
+				Expect(fakeNginxUpdater.UpdateUpstreamServersCallCount()).To(Equal(0))
+				Expect(fakeEventRecorder.Events).To(Receive(ContainSubstring("PinnedConfigVersionNotFound")))
+
+				deployment := handler.cfg.nginxDeployments.Get(gw.DeploymentName)
+				Expect(deployment.GetFiles()).To(Equal(pinnedFiles))
+				Expect(deployment.GetLatestConfigError()).To(MatchError(graph.ErrPinnedConfigVersionNotFound))
+			})
+		})
+
+		When("the Gateway is not affected by the changes", func() {
+			It("should only update the statuses after the configuration was built", func() {
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+				baseGraph.AffectedGateways = map[types.NamespacedName]struct{}{}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeNginxUpdater.UpdateConfigCallCount()).To(Equal(1))
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(1))
+				Expect(fakeNginxUpdater.UpdateConfigCallCount()).To(Equal(1))
+				Eventually(
+					func() int {
+						return fakeStatusUpdater.UpdateGroupCallCount()
+					}).Should(BeNumerically(">=", 4))
+			})
+
+			It("should rebuild the configuration of an affected Gateway", func() {
+				e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
+
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				baseGraph.AffectedGateways = map[types.NamespacedName]struct{}{
+					{Namespace: "test", Name: "gateway"}: {},
+				}
+				handler.HandleEventBatch(context.Background(), logr.Discard(), []any{e})
+
+				Expect(fakeGenerator.GenerateCallCount()).To(Equal(2))
+				Expect(fakeNginxUpdater.UpdateConfigCallCount()).To(Equal(2))
+			})
+		})
 	})
 
 	When("receiving control plane configuration updates", func() {
@@ -573,6 +698,24 @@ var _ = Describe("eventHandler", func() {
 			}).Should(Equal(1))
 	})
 
+	It("should get the data plane health reported by the nginx agents", func() {
+		deploymentName := types.NamespacedName{Namespace: "test", Name: "gateway-nginx"}
+		gw := &graph.Gateway{DeploymentName: deploymentName}
+
+		Expect(handler.getDataPlaneHealth(nil)).To(Equal(graph.DataPlaneHealth{}))
+		Expect(handler.getDataPlaneHealth(gw)).To(Equal(graph.DataPlaneHealth{}))
+
+		deployment := handler.cfg.nginxDeployments.LoadOrStore(ctx, deploymentName, "gateway")
+		deployment.SetPodHealth("uuid-1", agent.PodHealth{PodName: "pod-1", Healthy: true})
+		Expect(handler.getDataPlaneHealth(gw)).To(Equal(graph.DataPlaneHealth{Reported: true}))
+
+		deployment.SetPodHealth("uuid-2", agent.PodHealth{PodName: "pod-2", Description: "nginx is not running"})
+		Expect(handler.getDataPlaneHealth(gw)).To(Equal(graph.DataPlaneHealth{
+			Reported:      true,
+			UnhealthyPods: map[string]string{"pod-2": "nginx is not running"},
+		}))
+	})
+
 	It("should update nginx conf only when leader", func() {
 		e := &events.UpsertEvent{Resource: &gatewayv1.HTTPRoute{}}
 		batch := []any{e}
@@ -2631,3 +2774,238 @@ func TestGetLatestConfigurationReturnsSnapshots(t *testing.T) {
 	g.Expect(latest[0].WorkerProcesses).To(Equal("auto"))
 	g.Expect(latest[0].Upstreams[0].Endpoints[0].Address).To(Equal("10.0.0.1"))
 }
+
+func TestDescribeChanges(t *testing.T) {
+	t.Parallel()
+
+	handler := &eventHandlerImpl{
+		cfg: eventHandlerConfig{mustExtractGVK: kinds.NewMustExtractGKV(scheme)},
+	}
+
+	tooManyChanges := make(events.EventBatch, 0, maxRecordedChanges+2)
+	for range maxRecordedChanges + 2 {
+		tooManyChanges = append(tooManyChanges, &events.UpsertEvent{
+			Resource: &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route"}},
+		})
+	}
+
+	tests := []struct {
+		name   string
+		batch  events.EventBatch
+		expect []string
+	}{
+		{
+			name:   "empty batch",
+			expect: []string{},
+		},
+		{
+			name: "upsert, delete, and WAF bundle events",
+			batch: events.EventBatch{
+				&events.UpsertEvent{
+					Resource: &gatewayv1.HTTPRoute{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "route"}},
+				},
+				&events.UpsertEvent{
+					Resource: &gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+				},
+				&events.DeleteEvent{
+					Type:           &v1.Service{},
+					NamespacedName: types.NamespacedName{Namespace: "test", Name: "svc"},
+				},
+				events.WAFBundleReconcileEvent{
+					PolicyNsName: types.NamespacedName{Namespace: "test", Name: "waf"},
+				},
+			},
+			expect: []string{
+				"HTTPRoute test/route upserted",
+				"GatewayClass nginx upserted",
+				"Service test/svc deleted",
+				"WAFPolicy test/waf bundle available",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(handler.describeChanges(test.batch)).To(Equal(test.expect))
+		})
+	}
+
+	t.Run("too many changes", func(t *testing.T) {
+		t.Parallel()
+		g := NewWithT(t)
+
+		changes := handler.describeChanges(tooManyChanges)
+		g.Expect(changes).To(HaveLen(maxRecordedChanges + 1))
+		g.Expect(changes[maxRecordedChanges]).To(Equal("and 2 more changes"))
+	})
+}
+
+func TestRolloutStrategy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		np     *graph.EffectiveNginxProxy
+		expect *agent.RolloutStrategy
+		name   string
+	}{
+		{
+			name: "nil NginxProxy",
+		},
+		{
+			name: "rollout not configured",
+			np:   &graph.EffectiveNginxProxy{},
+		},
+		{
+			name: "rollout with defaults",
+			np: &graph.EffectiveNginxProxy{
+				Rollout: &v1alpha2.ConfigRollout{Canary: intstr.FromInt32(1)},
+			},
+			expect: &agent.RolloutStrategy{Canary: intstr.FromInt32(1)},
+		},
+		{
+			name: "rollout with soak period and health check",
+			np: &graph.EffectiveNginxProxy{
+				Rollout: &v1alpha2.ConfigRollout{
+					Canary:         intstr.FromString("25%"),
+					SoakPeriod:     helpers.GetPointer[ngfAPI.Duration]("30s"),
+					RequireHealthy: helpers.GetPointer(true),
+				},
+			},
+			expect: &agent.RolloutStrategy{
+				Canary:         intstr.FromString("25%"),
+				SoakPeriod:     30 * time.Second,
+				RequireHealthy: true,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(rolloutStrategy(test.np)).To(Equal(test.expect))
+		})
+	}
+}
+
+func TestAllowMetricsInPlusAPI(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		cidr      string
+		addresses []string
+		expected  []string
+	}{
+		{
+			name:      "upstream metrics disabled",
+			addresses: []string{"127.0.0.1"},
+			expected:  []string{"127.0.0.1"},
+		},
+		{
+			name: "not running nginx plus",
+			cidr: "10.0.0.0/16",
+		},
+		{
+			name:      "cidr added to allowed addresses",
+			cidr:      "10.0.0.0/16",
+			addresses: []string{"127.0.0.1"},
+			expected:  []string{"127.0.0.1", "10.0.0.0/16"},
+		},
+		{
+			name:      "cidr already allowed",
+			cidr:      "10.0.0.0/16",
+			addresses: []string{"10.0.0.0/16", "127.0.0.1"},
+			expected:  []string{"10.0.0.0/16", "127.0.0.1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			cfg := &dataplane.Configuration{
+				NginxPlus: dataplane.NginxPlus{AllowedAddresses: test.addresses},
+			}
+
+			allowMetricsInPlusAPI(cfg, test.cidr)
+			g.Expect(cfg.NginxPlus.AllowedAddresses).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestSetNodeZones(t *testing.T) {
+	t.Parallel()
+
+	podLabels := map[string]string{controller.AppNameLabel: "gateway-nginx"}
+	createPod := func(name, nodeName string, labels map[string]string) *v1.Pod {
+		return &v1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name, Labels: labels},
+			Spec:       v1.PodSpec{NodeName: nodeName},
+		}
+	}
+
+	objects := []client.Object{
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-1",
+				Labels: map[string]string{v1.LabelTopologyZone: "zone-a"},
+			},
+		},
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "node-2",
+				Labels: map[string]string{v1.LabelTopologyZone: "zone-b"},
+			},
+		},
+		&v1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-without-zone"},
+		},
+		createPod("pod-1", "node-1", podLabels),
+		createPod("pod-2", "node-1", podLabels),
+		createPod("pod-3", "node-without-zone", podLabels),
+		createPod("pod-4", "deleted-node", podLabels),
+		createPod("unscheduled-pod", "", podLabels),
+		createPod("other-pod", "node-2", map[string]string{controller.AppNameLabel: "other"}),
+	}
+
+	tests := []struct {
+		expNodeZones map[string]string
+		name         string
+		upstreams    []dataplane.Upstream
+	}{
+		{
+			name:      "no topology-aware upstreams",
+			upstreams: []dataplane.Upstream{{Name: "up"}},
+		},
+		{
+			name: "topology-aware upstreams",
+			upstreams: []dataplane.Upstream{
+				{Name: "up"},
+				{
+					Name:              "topology-up",
+					TopologyUpstreams: []dataplane.Upstream{{Name: "topology-up_zone_zone-a", Zone: "zone-a"}},
+				},
+			},
+			expNodeZones: map[string]string{"node-1": "zone-a"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			k8sClient := fake.NewClientBuilder().WithObjects(objects...).Build()
+			cfg := &dataplane.Configuration{Upstreams: test.upstreams}
+
+			g.Expect(setNodeZones(context.Background(), k8sClient, cfg, "test", podLabels)).To(Succeed())
+			g.Expect(cfg.NodeZones).To(Equal(test.expNodeZones))
+		})
+	}
+}
@@ -46,21 +46,26 @@ import (
 	wafv1 "github.com/nginx/nginx-gateway-fabric/v2/apis/waf/v1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/crd"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/debug"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/licensing"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics/collectors"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 	ngxcfg "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesslog"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/clientsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/observability"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/payloadprocessor"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxycache"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/ratelimit"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/snippetspolicy"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/waf"
 	ngxvalidation "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/otlp"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/provisioner"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
@@ -89,6 +94,8 @@ const (
 	clusterTimeout = 10 * time.Second
 	// the following are the names of data fields within NGINX Plus related Secrets.
 	grpcServerPort = 8443
+	// otlpShutdownTimeout is a timeout for flushing the remaining spans when the manager stops.
+	otlpShutdownTimeout = 5 * time.Second
 )
 
 var scheme = runtime.NewScheme()
@@ -120,6 +127,13 @@ func init() {
 		kinds.APLogConfGVK.GroupVersion().WithKind(kinds.APLogConf+"List"),
 		kinds.NewAPLogConfList(),
 	)
+
+	// Pre-register the unstructured Multi-Cluster Services ServiceImport type for the same reason.
+	scheme.AddKnownTypeWithName(kinds.ServiceImportGVK, kinds.NewServiceImportObject())
+	scheme.AddKnownTypeWithName(
+		kinds.ServiceImportGVK.GroupVersion().WithKind(kinds.ServiceImport+"List"),
+		kinds.NewServiceImportList(),
+	)
 }
 
 func StartManager(cfg config.Config) error {
@@ -208,23 +222,39 @@ func StartManager(cfg config.Config) error {
 
 	statusQueue := status.NewQueue()
 
-	nginxUpdater, err := createAgentServices(cfg, mgr, statusQueue)
+	nginxUpdater, err := createAgentServices(cfg, mgr, statusQueue, recorder)
 	if err != nil {
 		return err
 	}
 
+	if err = registerDataPlaneMetrics(cfg, mgr, nginxUpdater.NginxDeployments); err != nil {
+		return err
+	}
+
+	registerControlPlaneMetrics(cfg, processor, nginxUpdater.NginxDeployments, statusQueue, statusUpdater)
+
+	metricsCollector := createMetricsCollector(cfg)
+
 	nginxProvisioner, err := createAndRegisterProvisioner(ctx, cfg, mgr, nginxUpdater, statusQueue, recorder)
 	if err != nil {
 		return err
 	}
 
-	wafPollerManager = createWAFPollerManager(ctx, cfg, wafFetcher, nginxUpdater, statusQueue, eventCh)
+	wafPollerManager = createWAFPollerManager(
+		ctx,
+		cfg,
+		wafFetcher,
+		nginxUpdater,
+		statusQueue,
+		eventCh,
+		metricsCollector,
+	)
 
 	eventHandler := newEventHandlerImpl(eventHandlerConfig{
 		ctx:              ctx,
 		nginxUpdater:     nginxUpdater,
 		nginxProvisioner: nginxProvisioner,
-		metricsCollector: createMetricsCollector(cfg),
+		metricsCollector: metricsCollector,
 		statusUpdater:    groupStatusUpdater,
 		processor:        processor,
 		serviceResolver:  resolver.NewServiceResolverImpl(mgr.GetClient()),
@@ -233,26 +263,35 @@ func StartManager(cfg config.Config) error {
 			&cfg.UsageReportConfig,
 			cfg.Logger.WithName("generator"),
 		),
-		k8sClient:               mgr.GetClient(),
-		logger:                  cfg.Logger.WithName("eventHandler"),
-		logLevelSetter:          logLevelSetter,
-		eventRecorder:           recorder,
-		deployCtxCollector:      deployCtxCollector,
-		graphBuiltHealthChecker: healthChecker,
-		gatewayPodConfig:        cfg.GatewayPodConfig,
-		controlConfigNSName:     controlConfigNSName,
-		gatewayCtlrName:         cfg.GatewayCtlrName,
-		gatewayInstanceName:     cfg.GatewayPodConfig.InstanceName,
-		gatewayClassName:        cfg.GatewayClassName,
-		plus:                    cfg.Plus,
-		clusterIPFamily:         nginxProvisioner.ClusterIPFamily(),
-		statusQueue:             statusQueue,
-		nginxDeployments:        nginxUpdater.NginxDeployments,
-		wafPollerManager:        wafPollerManager,
-		inferenceExtension:      cfg.InferenceExtension,
-		plmEnabled:              cfg.PLMStorageConfig != nil,
+		k8sClient:                 mgr.GetClient(),
+		logger:                    cfg.Logger.WithName("eventHandler"),
+		logLevelSetter:            logLevelSetter,
+		eventRecorder:             recorder,
+		deployCtxCollector:        deployCtxCollector,
+		mustExtractGVK:            mustExtractGVK,
+		graphBuiltHealthChecker:   healthChecker,
+		gatewayPodConfig:          cfg.GatewayPodConfig,
+		controlConfigNSName:       controlConfigNSName,
+		gatewayCtlrName:           cfg.GatewayCtlrName,
+		gatewayInstanceName:       cfg.GatewayPodConfig.InstanceName,
+		gatewayClassName:          cfg.GatewayClassName,
+		plus:                      cfg.Plus,
+		experimental:              cfg.ExperimentalFeatures,
+		clusterIPFamily:           nginxProvisioner.ClusterIPFamily(),
+		clusterDomain:             cfg.ClusterDomain,
+		zoneSyncResolver:          cfg.ZoneSyncResolver,
+		plusAPIMetricsAllowedCIDR: cfg.MetricsConfig.NginxPlusUpstreamsAllowedCIDR,
+		statusQueue:               statusQueue,
+		nginxDeployments:          nginxUpdater.NginxDeployments,
+		wafPollerManager:          wafPollerManager,
+		inferenceExtension:        cfg.InferenceExtension,
+		plmEnabled:                cfg.PLMStorageConfig != nil,
 	})
 
+	if err = registerNginxPlusUpstreamMetrics(cfg, mgr, nginxUpdater.NginxDeployments, eventHandler); err != nil {
+		return err
+	}
+
 	objects, objectLists := prepareFirstEventBatchPreparerArgs(cfg, discoveredCRDs)
 
 	firstBatchPreparer := events.NewFirstEventBatchPreparerImpl(mgr.GetCache(), objects, objectLists)
@@ -279,6 +318,14 @@ func StartManager(cfg config.Config) error {
 		return err
 	}
 
+	if err = registerDebugServer(cfg, mgr, processor, eventHandler, nginxUpdater.NginxDeployments); err != nil {
+		return err
+	}
+
+	if err = registerOTLPExport(ctx, cfg, mgr); err != nil {
+		return err
+	}
+
 	cfg.Logger.Info("Starting manager")
 	go func() {
 		<-ctx.Done()
@@ -288,8 +335,15 @@ func StartManager(cfg config.Config) error {
 	return mgr.Start(ctx)
 }
 
-// createMetricsCollector creates a handler metrics collector and registers it with the Prometheus registry if enabled.
-func createMetricsCollector(cfg config.Config) handlerMetricsCollector {
+// controllerMetricsCollector collects the metrics that the event handler and the WAF polling manager push.
+type controllerMetricsCollector interface {
+	handlerMetricsCollector
+	wafpolling.MetricsCollector
+}
+
+// createMetricsCollector creates a controller metrics collector and registers it with the Prometheus registry
+// if enabled.
+func createMetricsCollector(cfg config.Config) controllerMetricsCollector {
 	if !cfg.MetricsConfig.Enabled {
 		return collectors.NewControllerNoopCollector()
 	}
@@ -302,11 +356,93 @@ func createMetricsCollector(cfg config.Config) handlerMetricsCollector {
 	return handlerCollector
 }
 
+// registerDataPlaneMetrics registers the data plane health and configuration drift metrics, and the data plane
+// health debug endpoint on the metrics server if metrics are enabled.
+func registerDataPlaneMetrics(cfg config.Config, mgr manager.Manager, nginxDeployments *agent.DeploymentStore) error {
+	if !cfg.MetricsConfig.Enabled {
+		return nil
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+	metrics.Registry.MustRegister(
+		collectors.NewDataPlaneHealthCollector(nginxDeployments, constLabels),
+		collectors.NewConfigDriftCollector(nginxDeployments, constLabels),
+	)
+
+	handler := agent.NewDataPlaneHealthHandler(nginxDeployments, cfg.Logger.WithName("dataPlaneHealth"))
+	if err := mgr.AddMetricsServerExtraHandler(agent.DataPlaneHealthPath, handler); err != nil {
+		return fmt.Errorf("cannot register data plane health endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// registerControlPlaneMetrics registers the metrics that are collected from the state of the control plane
+// if metrics are enabled.
+func registerControlPlaneMetrics(
+	cfg config.Config,
+	processor *state.ChangeProcessorImpl,
+	nginxDeployments *agent.DeploymentStore,
+	statusQueue *status.Queue,
+	statusUpdater *status.Updater,
+) {
+	if !cfg.MetricsConfig.Enabled {
+		return
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+	metrics.Registry.MustRegister(
+		collectors.NewGraphResourceCollector(processor, constLabels),
+		collectors.NewConnectedAgentsCollector(nginxDeployments, constLabels),
+		collectors.NewStatusCollector(statusQueue, statusUpdater, constLabels),
+	)
+}
+
+// registerNginxPlusUpstreamMetrics registers the metrics of the upstreams that are read from the NGINX Plus API
+// of the nginx Pods if they are enabled. The nginx agents only connect to the leader, so the metrics are only
+// collected while this instance is the leader.
+func registerNginxPlusUpstreamMetrics(
+	cfg config.Config,
+	mgr manager.Manager,
+	nginxDeployments *agent.DeploymentStore,
+	configGetter collectors.GatewayConfigurationGetter,
+) error {
+	if !cfg.MetricsConfig.Enabled || !cfg.MetricsConfig.NginxPlusUpstreams {
+		return nil
+	}
+
+	constLabels := map[string]string{"class": cfg.GatewayClassName}
+	collector := collectors.NewNginxPlusUpstreamsCollector(
+		cfg.Logger.WithName("nginxPlusUpstreamsCollector"),
+		nginxDeployments,
+		configGetter,
+		constLabels,
+	)
+
+	registerCollector := manager.RunnableFunc(func(ctx context.Context) error {
+		if err := metrics.Registry.Register(collector); err != nil {
+			return fmt.Errorf("cannot register NGINX Plus upstream metrics collector: %w", err)
+		}
+
+		<-ctx.Done()
+		metrics.Registry.Unregister(collector)
+
+		return nil
+	})
+
+	if err := mgr.Add(&runnables.Leader{Runnable: registerCollector}); err != nil {
+		return fmt.Errorf("cannot register NGINX Plus upstream metrics collector: %w", err)
+	}
+
+	return nil
+}
+
 // createAgentServices creates the NGINX agent updater and gRPC server, and registers the server with the manager.
 func createAgentServices(
 	cfg config.Config,
 	mgr manager.Manager,
 	statusQueue *status.Queue,
+	recorder k8sEvents.EventRecorder,
 ) (*agent.NginxUpdaterImpl, error) {
 	resetConnChan := make(chan struct{})
 	nginxUpdater := agent.NewNginxUpdater(
@@ -314,6 +450,7 @@ func createAgentServices(
 		mgr.GetAPIReader(),
 		statusQueue,
 		resetConnChan,
+		recorder,
 		cfg.Plus,
 	)
 
@@ -374,6 +511,7 @@ func createAndRegisterProvisioner(
 			PlusUsageConfig:                &cfg.UsageReportConfig,
 			NginxOneConsoleTelemetryConfig: cfg.NginxOneConsoleTelemetryConfig,
 			InferenceExtension:             cfg.InferenceExtension,
+			ExperimentalFeatures:           cfg.ExperimentalFeatures,
 			EndpointPickerDisableTLS:       cfg.EndpointPickerDisableTLS,
 			EndpointPickerTLSSkipVerify:    cfg.EndpointPickerTLSSkipVerify,
 			ServerTLSDomain:                serverTLSDomain,
@@ -400,17 +538,19 @@ func createWAFPollerManager(
 	nginxUpdater *agent.NginxUpdaterImpl,
 	statusQueue *status.Queue,
 	eventCh chan<- any,
+	metricsCollector wafpolling.MetricsCollector,
 ) wafpolling.Manager {
 	if !cfg.Plus {
 		return nil
 	}
 
 	return wafpolling.NewManager(wafpolling.ManagerConfig{
-		Logger:      cfg.Logger.WithName("wafPollingManager"),
-		Fetcher:     wafFetcher,
-		Deployments: nginxUpdater.NginxDeployments,
-		EventCh:     eventCh,
-		Ctx:         ctx,
+		Logger:           cfg.Logger.WithName("wafPollingManager"),
+		Fetcher:          wafFetcher,
+		Deployments:      nginxUpdater.NginxDeployments,
+		EventCh:          eventCh,
+		Ctx:              ctx,
+		MetricsCollector: metricsCollector,
 		StatusCallback: func(targets []types.NamespacedName) {
 			for _, nsName := range targets {
 				dep := nginxUpdater.NginxDeployments.Get(nsName)
@@ -429,6 +569,92 @@ func createWAFPollerManager(
 	})
 }
 
+// registerDebugServer registers the debug server with the manager if enabled.
+func registerDebugServer(
+	cfg config.Config,
+	mgr manager.Manager,
+	processor *state.ChangeProcessorImpl,
+	eventHandler *eventHandlerImpl,
+	nginxDeployments *agent.DeploymentStore,
+) error {
+	if !cfg.DebugServerConfig.Enabled {
+		return nil
+	}
+
+	debugServer := debug.NewServer(debug.ServerConfig{
+		K8sClient:           mgr.GetClient(),
+		GraphGetter:         processor,
+		ConfigurationGetter: eventHandler,
+		Deployments:         nginxDeployments,
+		Logger:              cfg.Logger.WithName("debugServer"),
+		Port:                cfg.DebugServerConfig.Port,
+	})
+
+	if err := mgr.Add(&runnables.LeaderOrNonLeader{Runnable: debugServer}); err != nil {
+		return fmt.Errorf("cannot register debug server: %w", err)
+	}
+
+	return nil
+}
+
+// registerOTLPExport sets up the export of the control plane traces, and optionally metrics, if an OTLP endpoint
+// is configured. The spans are flushed when the manager stops.
+func registerOTLPExport(ctx context.Context, cfg config.Config, mgr manager.Manager) error {
+	if cfg.OTLPConfig.Endpoint == "" {
+		return nil
+	}
+
+	otlpCfg := otlp.Config{
+		Endpoint:       cfg.OTLPConfig.Endpoint,
+		Insecure:       cfg.OTLPConfig.Insecure,
+		ServiceVersion: cfg.GatewayPodConfig.Version,
+		PodName:        cfg.GatewayPodConfig.Name,
+		PodNamespace:   cfg.GatewayPodConfig.Namespace,
+	}
+
+	tracerProvider, err := otlp.RegisterTracerProvider(ctx, otlpCfg)
+	if err != nil {
+		return err
+	}
+
+	flushTraces := manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), otlpShutdownTimeout)
+		defer cancel()
+
+		return tracerProvider.Shutdown(shutdownCtx)
+	})
+
+	if err := mgr.Add(&runnables.LeaderOrNonLeader{Runnable: flushTraces}); err != nil {
+		return fmt.Errorf("cannot register OTLP trace exporter: %w", err)
+	}
+
+	if !cfg.OTLPConfig.ExportMetrics {
+		return nil
+	}
+
+	meterProvider, err := otlp.NewMeterProvider(ctx, otlpCfg, metrics.Registry, otlp.DefaultMetricsExportInterval)
+	if err != nil {
+		return err
+	}
+
+	flushMetrics := manager.RunnableFunc(func(ctx context.Context) error {
+		<-ctx.Done()
+
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), otlpShutdownTimeout)
+		defer cancel()
+
+		return meterProvider.Shutdown(shutdownCtx)
+	})
+
+	if err := mgr.Add(&runnables.LeaderOrNonLeader{Runnable: flushMetrics}); err != nil {
+		return fmt.Errorf("cannot register OTLP metrics exporter: %w", err)
+	}
+
+	return nil
+}
+
 // registerTelemetry sets up product telemetry if enabled.
 func registerTelemetry(
 	cfg config.Config,
@@ -491,7 +717,19 @@ func createPolicyManager(
 		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.RateLimitPolicy{}),
-			Validator: ratelimit.NewValidator(validator),
+			Validator: ratelimit.NewValidator(validator, cfg.Plus),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+			Validator: proxycache.NewValidator(validator),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.AccessControlPolicy{}),
+			Validator: accesscontrol.NewValidator(),
+		},
+		{
+			GVK:       mustExtractGVK(&ngfAPIv1alpha1.AccessLogPolicy{}),
+			Validator: accesslog.NewValidator(validator),
 		},
 		{
 			GVK:       mustExtractGVK(&ngfAPIv1alpha1.WAFPolicy{}),
@@ -804,6 +1042,19 @@ func registerControllers(
 		schema.GroupVersionKind{Group: apiext.GroupName, Version: "v1", Kind: "CustomResourceDefinition"},
 	)
 
+	nodeWithGVK := apiv1.Node{}
+	nodeWithGVK.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Node"))
+
+	nginxPodSelector := metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			controller.AppInstanceLabel: cfg.GatewayPodConfig.InstanceName,
+			controller.AppManagedByLabel: controller.CreateNginxResourceName(
+				cfg.GatewayPodConfig.InstanceName,
+				cfg.GatewayClassName,
+			),
+		},
+	}
+
 	// Note: for any new object type or a change to the existing one,
 	// make sure to also update prepareFirstEventBatchPreparerArgs()
 	controllerRegCfgs := []ctlrCfg{
@@ -822,7 +1073,12 @@ func registerControllers(
 			objectType: &gatewayv1.Gateway{},
 			options: func() []controller.Option {
 				options := []controller.Option{
-					controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+					controller.WithK8sPredicate(
+						k8spredicate.Or(
+							k8spredicate.GenerationChangedPredicate{},
+							predicate.AnnotationPredicate{Annotation: pinnedConfigVersionAnnotation},
+						),
+					),
 				}
 				return options
 			}(),
@@ -883,6 +1139,26 @@ func registerControllers(
 				),
 			},
 		},
+		{
+			// the zones of the Nodes are used for topology-aware routing
+			objectType: &nodeWithGVK,
+			options: []controller.Option{
+				controller.WithOnlyMetadata(),
+				controller.WithK8sPredicate(predicate.LabelPredicate{Label: apiv1.LabelTopologyZone}),
+			},
+		},
+		{
+			// the Nodes of the nginx Pods are used for topology-aware routing
+			objectType: &apiv1.Pod{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(
+					k8spredicate.And(
+						predicate.NginxLabelPredicate(nginxPodSelector),
+						predicate.PodNodeNamePredicate{},
+					),
+				),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha2.NginxProxy{},
 			options: []controller.Option{
@@ -955,6 +1231,14 @@ func registerControllers(
 				Kind:    "ListenerSet",
 			},
 		},
+		{
+			objectType: kinds.NewServiceImportObject(),
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+			requireCRDCheck: true,
+			crdGVK:          &kinds.ServiceImportGVK,
+		},
 		{
 			objectType: &ngfAPIv1alpha1.ClientSettingsPolicy{},
 			options: []controller.Option{
@@ -967,6 +1251,12 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.AccessLogPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.ProxySettingsPolicy{},
 			options: []controller.Option{
@@ -991,6 +1281,18 @@ func registerControllers(
 				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
 			},
 		},
+		{
+			objectType: &ngfAPIv1alpha1.CachePolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
+		{
+			objectType: &ngfAPIv1alpha1.AccessControlPolicy{},
+			options: []controller.Option{
+				controller.WithK8sPredicate(k8spredicate.GenerationChangedPredicate{}),
+			},
+		},
 		{
 			objectType: &ngfAPIv1alpha1.WAFPolicy{},
 			options: []controller.Option{
@@ -1329,6 +1631,9 @@ func prepareFirstEventBatchPreparerArgs(
 		&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 		&ngfAPIv1alpha1.AuthenticationFilterList{},
 		&ngfAPIv1alpha1.RateLimitPolicyList{},
+		&ngfAPIv1alpha1.CachePolicyList{},
+		&ngfAPIv1alpha1.AccessControlPolicyList{},
+		&ngfAPIv1alpha1.AccessLogPolicyList{},
 		&ngfAPIv1alpha1.WAFPolicyList{},
 		partialObjectMetadataList,
 	}
@@ -1369,6 +1674,10 @@ func prepareFirstEventBatchPreparerArgs(
 		objectLists = append(objectLists, &gatewayv1.UDPRouteList{})
 	}
 
+	if discoveredCRDs[kinds.ServiceImport] {
+		objectLists = append(objectLists, kinds.NewServiceImportList())
+	}
+
 	if cfg.InferenceExtension {
 		objectLists = append(objectLists, &inference.InferencePoolList{})
 	}
@@ -81,6 +81,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 				partialObjectMetadataList,
 				apPolicyList,
@@ -88,6 +91,43 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&gatewayv1.GatewayList{},
 			},
 		},
+		{
+			name: "includes ServiceImport when the Multi-Cluster Services CRD is discovered",
+			cfg: config.Config{
+				GatewayClassName: gcName,
+			},
+			discoveredCRDs: map[string]bool{
+				"ReferenceGrant":    true,
+				kinds.ServiceImport: true,
+			},
+			expectedObjects: []client.Object{
+				&gatewayv1.GatewayClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}},
+			},
+			expectedObjectLists: []client.ObjectList{
+				&apiv1.ServiceList{},
+				&apiv1.SecretList{},
+				&apiv1.NamespaceList{},
+				&discoveryV1.EndpointSliceList{},
+				&gatewayv1.HTTPRouteList{},
+				&apiv1.ConfigMapList{},
+				&gatewayv1.ReferenceGrantList{},
+				&ngfAPIv1alpha2.NginxProxyList{},
+				&gatewayv1.GRPCRouteList{},
+				&ngfAPIv1alpha1.ClientSettingsPolicyList{},
+				&ngfAPIv1alpha2.ObservabilityPolicyList{},
+				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
+				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
+				&ngfAPIv1alpha1.AuthenticationFilterList{},
+				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
+				&ngfAPIv1alpha1.WAFPolicyList{},
+				partialObjectMetadataList,
+				kinds.NewServiceImportList(),
+				&gatewayv1.GatewayList{},
+			},
+		},
 		{
 			name: "base case with BackendTLSPolicy v1 and ListenerSet",
 			cfg: config.Config{
@@ -124,6 +164,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -157,6 +200,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				partialObjectMetadataList,
 				&gatewayv1.GatewayList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
@@ -201,6 +247,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -236,6 +285,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.ProxySettingsPolicyList{},
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				partialObjectMetadataList,
 				&inference.InferencePoolList{},
 				&gatewayv1.GatewayList{},
@@ -278,6 +330,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -317,6 +372,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -354,6 +412,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&ngfAPIv1alpha1.ExternalLoadBalancerList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
@@ -403,6 +464,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -436,6 +500,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 				partialObjectMetadataList,
 				&gatewayv1.GatewayList{},
@@ -487,6 +554,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 				&ngfAPIv1alpha1.PayloadProcessorList{},
@@ -524,6 +594,9 @@ func TestPrepareFirstEventBatchPreparerArgs(t *testing.T) {
 				&ngfAPIv1alpha1.UpstreamSettingsPolicyList{},
 				&ngfAPIv1alpha1.AuthenticationFilterList{},
 				&ngfAPIv1alpha1.RateLimitPolicyList{},
+				&ngfAPIv1alpha1.CachePolicyList{},
+				&ngfAPIv1alpha1.AccessControlPolicyList{},
+				&ngfAPIv1alpha1.AccessLogPolicyList{},
 				&gatewayv1.ListenerSetList{},
 				&ngfAPIv1alpha1.WAFPolicyList{},
 			},
@@ -547,8 +620,8 @@ func TestGetMetricsOptions(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
 		name            string
-		expectedOptions metricsserver.Options
 		metricsConfig   config.MetricsConfig
+		expectedOptions metricsserver.Options
 	}{
 		{
 			name:            "Metrics disabled",
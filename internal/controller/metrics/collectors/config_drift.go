@@ -0,0 +1,52 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+)
+
+// ConfigDriftLister lists the number of configuration drift corrections of all Gateways.
+type ConfigDriftLister interface {
+	ListConfigDriftCorrections() []agent.GatewayConfigDrift
+}
+
+// ConfigDriftCollector collects the number of times the desired nginx configuration was pushed back to
+// a Gateway Pod after configuration drift was detected.
+// Implements the prometheus.Collector interface.
+type ConfigDriftCollector struct {
+	lister      ConfigDriftLister
+	corrections *prometheus.Desc
+}
+
+// NewConfigDriftCollector creates a new ConfigDriftCollector.
+func NewConfigDriftCollector(lister ConfigDriftLister, constLabels map[string]string) *ConfigDriftCollector {
+	return &ConfigDriftCollector{
+		lister: lister,
+		corrections: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "config_drift_corrections_total"),
+			"Number of times the desired nginx configuration was restored in a Gateway Pod after it drifted",
+			[]string{"namespace", "gateway"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *ConfigDriftCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.corrections
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *ConfigDriftCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, drift := range c.lister.ListConfigDriftCorrections() {
+		ch <- prometheus.MustNewConstMetric(
+			c.corrections,
+			prometheus.CounterValue,
+			float64(drift.Corrections),
+			drift.Namespace,
+			drift.GatewayName,
+		)
+	}
+}
@@ -0,0 +1,55 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+)
+
+// ConnectedAgentsLister lists the number of connected agents of all Gateways.
+type ConnectedAgentsLister interface {
+	ListConnectedAgents() []agent.GatewayConnectedAgents
+}
+
+// ConnectedAgentsCollector collects the number of nginx agents of each Gateway that are connected to the
+// control plane.
+// Implements the prometheus.Collector interface.
+type ConnectedAgentsCollector struct {
+	lister ConnectedAgentsLister
+	agents *prometheus.Desc
+}
+
+// NewConnectedAgentsCollector creates a new ConnectedAgentsCollector.
+func NewConnectedAgentsCollector(
+	lister ConnectedAgentsLister,
+	constLabels map[string]string,
+) *ConnectedAgentsCollector {
+	return &ConnectedAgentsCollector{
+		lister: lister,
+		agents: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "connected_agents"),
+			"Number of nginx agents of a Gateway that are connected to the control plane",
+			[]string{"namespace", "gateway"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *ConnectedAgentsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.agents
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *ConnectedAgentsCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, agents := range c.lister.ListConnectedAgents() {
+		ch <- prometheus.MustNewConstMetric(
+			c.agents,
+			prometheus.GaugeValue,
+			float64(agents.Agents),
+			agents.Namespace,
+			agents.GatewayName,
+		)
+	}
+}
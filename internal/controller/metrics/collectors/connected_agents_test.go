@@ -0,0 +1,52 @@
+package collectors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+)
+
+type fakeConnectedAgentsLister []agent.GatewayConnectedAgents
+
+func (f fakeConnectedAgentsLister) ListConnectedAgents() []agent.GatewayConnectedAgents {
+	return f
+}
+
+func TestConnectedAgentsCollector(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	lister := fakeConnectedAgentsLister{
+		{Namespace: "test", GatewayName: "gateway-1", Agents: 0},
+		{Namespace: "test", GatewayName: "gateway-2", Agents: 2},
+	}
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(NewConnectedAgentsCollector(lister, map[string]string{"class": "nginx"}))
+
+	families, err := registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(families).To(HaveLen(1))
+	g.Expect(families[0].GetName()).To(Equal("nginx_gateway_fabric_connected_agents"))
+
+	values := make(map[string]float64)
+	for _, metric := range families[0].GetMetric() {
+		labels := make(map[string]string)
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+
+		g.Expect(labels).To(HaveKeyWithValue("class", "nginx"))
+		g.Expect(labels).To(HaveKeyWithValue("namespace", "test"))
+
+		values[labels["gateway"]] = metric.GetGauge().GetValue()
+	}
+
+	g.Expect(values).To(Equal(map[string]float64{
+		"gateway-1": 0,
+		"gateway-2": 2,
+	}))
+}
@@ -4,15 +4,30 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
 )
 
+const (
+	// pushResultSuccess is the result label value of a successful configuration push.
+	pushResultSuccess = "success"
+	// pushResultFailure is the result label value of a failed configuration push.
+	pushResultFailure = "failure"
+)
+
 // ControllerCollector collects metrics for the NGF controller.
 // Implements the prometheus.Collector interface.
 type ControllerCollector struct {
 	// Metrics
-	eventBatchProcessDuration prometheus.Histogram
+	eventBatchProcessDuration  prometheus.Histogram
+	graphBuildDuration         prometheus.Histogram
+	configurationBuildDuration prometheus.Histogram
+	configGenerationDuration   prometheus.Histogram
+	configPushDuration         *prometheus.HistogramVec
+	configPushes               *prometheus.CounterVec
+	wafBundleFetchDuration     *prometheus.HistogramVec
+	wafBundleFetchFailures     *prometheus.CounterVec
 }
 
 // NewControllerCollector creates a new ControllerCollector.
@@ -27,6 +42,72 @@ func NewControllerCollector(constLabels map[string]string) *ControllerCollector
 				Buckets:     []float64{500, 1000, 5000, 10000, 30000},
 			},
 		),
+		graphBuildDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "graph_build_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of building the graph of the cluster resources",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		configurationBuildDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "dataplane_configuration_build_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of building the data plane configuration of a Gateway",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		configGenerationDuration: prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:        "nginx_config_generation_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of generating the nginx configuration files of a Gateway",
+				ConstLabels: constLabels,
+				Buckets:     []float64{10, 50, 100, 500, 1000, 5000},
+			},
+		),
+		configPushDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:      "nginx_config_push_milliseconds",
+				Namespace: metrics.Namespace,
+				Help: "Duration in milliseconds of pushing the nginx configuration of a Gateway to its agents " +
+					"and waiting for them to apply it",
+				ConstLabels: constLabels,
+				Buckets:     []float64{100, 500, 1000, 5000, 10000, 30000},
+			},
+			[]string{"namespace", "gateway"},
+		),
+		configPushes: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "nginx_config_pushes_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of nginx configuration pushes to the agents of a Gateway, by result",
+				ConstLabels: constLabels,
+			},
+			[]string{"namespace", "gateway", "result"},
+		),
+		wafBundleFetchDuration: prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Name:        "waf_bundle_fetch_milliseconds",
+				Namespace:   metrics.Namespace,
+				Help:        "Duration in milliseconds of polling a WAF bundle source",
+				ConstLabels: constLabels,
+				Buckets:     []float64{100, 500, 1000, 5000, 10000, 30000},
+			},
+			[]string{"bundle_type"},
+		),
+		wafBundleFetchFailures: prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name:        "waf_bundle_fetch_failures_total",
+				Namespace:   metrics.Namespace,
+				Help:        "Number of failed polls of a WAF bundle source",
+				ConstLabels: constLabels,
+			},
+			[]string{"bundle_type"},
+		),
 	}
 	return nc
 }
@@ -36,14 +117,74 @@ func (c *ControllerCollector) ObserveLastEventBatchProcessTime(duration time.Dur
 	c.eventBatchProcessDuration.Observe(float64(duration / time.Millisecond))
 }
 
+// ObserveGraphBuildTime adds the graph build time to the histogram.
+func (c *ControllerCollector) ObserveGraphBuildTime(duration time.Duration) {
+	c.graphBuildDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveConfigurationBuildTime adds the data plane configuration build time to the histogram.
+func (c *ControllerCollector) ObserveConfigurationBuildTime(duration time.Duration) {
+	c.configurationBuildDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveConfigGenerationTime adds the nginx configuration generation time to the histogram.
+func (c *ControllerCollector) ObserveConfigGenerationTime(duration time.Duration) {
+	c.configGenerationDuration.Observe(float64(duration / time.Millisecond))
+}
+
+// ObserveConfigPush records the duration and the result of pushing the nginx configuration of a Gateway.
+// The push failed if err is not nil.
+func (c *ControllerCollector) ObserveConfigPush(gateway types.NamespacedName, duration time.Duration, err error) {
+	c.configPushDuration.WithLabelValues(gateway.Namespace, gateway.Name).Observe(float64(duration / time.Millisecond))
+
+	result := pushResultSuccess
+	if err != nil {
+		result = pushResultFailure
+	}
+
+	c.configPushes.WithLabelValues(gateway.Namespace, gateway.Name, result).Inc()
+}
+
+// DeleteGatewayMetrics removes the config push series of a Gateway, so that a deleted Gateway
+// doesn't keep exporting its last values.
+func (c *ControllerCollector) DeleteGatewayMetrics(gateway types.NamespacedName) {
+	c.configPushDuration.DeleteLabelValues(gateway.Namespace, gateway.Name)
+	c.configPushes.DeleteLabelValues(gateway.Namespace, gateway.Name, pushResultSuccess)
+	c.configPushes.DeleteLabelValues(gateway.Namespace, gateway.Name, pushResultFailure)
+}
+
+// ObserveWAFBundleFetch records the duration and the result of polling a WAF bundle source.
+// The poll failed if err is not nil.
+func (c *ControllerCollector) ObserveWAFBundleFetch(bundleType string, duration time.Duration, err error) {
+	c.wafBundleFetchDuration.WithLabelValues(bundleType).Observe(float64(duration / time.Millisecond))
+
+	if err != nil {
+		c.wafBundleFetchFailures.WithLabelValues(bundleType).Inc()
+	}
+}
+
 // Describe implements prometheus.Collector interface Describe method.
 func (c *ControllerCollector) Describe(ch chan<- *prometheus.Desc) {
 	c.eventBatchProcessDuration.Describe(ch)
+	c.graphBuildDuration.Describe(ch)
+	c.configurationBuildDuration.Describe(ch)
+	c.configGenerationDuration.Describe(ch)
+	c.configPushDuration.Describe(ch)
+	c.configPushes.Describe(ch)
+	c.wafBundleFetchDuration.Describe(ch)
+	c.wafBundleFetchFailures.Describe(ch)
 }
 
 // Collect implements the prometheus.Collector interface Collect method.
 func (c *ControllerCollector) Collect(ch chan<- prometheus.Metric) {
 	c.eventBatchProcessDuration.Collect(ch)
+	c.graphBuildDuration.Collect(ch)
+	c.configurationBuildDuration.Collect(ch)
+	c.configGenerationDuration.Collect(ch)
+	c.configPushDuration.Collect(ch)
+	c.configPushes.Collect(ch)
+	c.wafBundleFetchDuration.Collect(ch)
+	c.wafBundleFetchFailures.Collect(ch)
 }
 
 // ControllerNoopCollector used to initialize the ControllerCollector when metrics are disabled to avoid nil pointer
@@ -56,3 +197,16 @@ func NewControllerNoopCollector() *ControllerNoopCollector {
 }
 
 func (c *ControllerNoopCollector) ObserveLastEventBatchProcessTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveGraphBuildTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveConfigurationBuildTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveConfigGenerationTime(_ time.Duration) {}
+
+func (c *ControllerNoopCollector) ObserveConfigPush(_ types.NamespacedName, _ time.Duration, _ error) {
+}
+
+func (c *ControllerNoopCollector) DeleteGatewayMetrics(_ types.NamespacedName) {}
+
+func (c *ControllerNoopCollector) ObserveWAFBundleFetch(_ string, _ time.Duration, _ error) {}
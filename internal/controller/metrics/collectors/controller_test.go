@@ -0,0 +1,90 @@
+package collectors
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestControllerCollector(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	collector := NewControllerCollector(map[string]string{"class": "nginx"})
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(collector)
+
+	gateway := types.NamespacedName{Namespace: "test", Name: "gateway"}
+
+	collector.ObserveLastEventBatchProcessTime(2 * time.Second)
+	collector.ObserveGraphBuildTime(20 * time.Millisecond)
+	collector.ObserveConfigurationBuildTime(5 * time.Millisecond)
+	collector.ObserveConfigGenerationTime(3 * time.Millisecond)
+	collector.ObserveConfigPush(gateway, 200*time.Millisecond, nil)
+	collector.ObserveConfigPush(gateway, 300*time.Millisecond, errors.New("push error"))
+	collector.ObserveWAFBundleFetch("policy", time.Second, nil)
+	collector.ObserveWAFBundleFetch("policy", time.Second, errors.New("fetch error"))
+
+	families, err := registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	metricFamilies := make(map[string]*dto.MetricFamily)
+	for _, family := range families {
+		metricFamilies[family.GetName()] = family
+	}
+
+	for _, name := range []string{
+		"nginx_gateway_fabric_event_batch_processing_milliseconds",
+		"nginx_gateway_fabric_graph_build_milliseconds",
+		"nginx_gateway_fabric_dataplane_configuration_build_milliseconds",
+		"nginx_gateway_fabric_nginx_config_generation_milliseconds",
+	} {
+		g.Expect(metricFamilies).To(HaveKey(name))
+		g.Expect(metricFamilies[name].GetMetric()[0].GetHistogram().GetSampleCount()).To(Equal(uint64(1)))
+	}
+
+	pushDuration := metricFamilies["nginx_gateway_fabric_nginx_config_push_milliseconds"]
+	g.Expect(pushDuration.GetMetric()).To(HaveLen(1))
+	g.Expect(pushDuration.GetMetric()[0].GetHistogram().GetSampleCount()).To(Equal(uint64(2)))
+	g.Expect(pushDuration.GetMetric()[0].GetHistogram().GetSampleSum()).To(Equal(float64(500)))
+
+	pushResults := make(map[string]float64)
+	for _, metric := range metricFamilies["nginx_gateway_fabric_nginx_config_pushes_total"].GetMetric() {
+		labels := make(map[string]string)
+		for _, label := range metric.GetLabel() {
+			labels[label.GetName()] = label.GetValue()
+		}
+
+		g.Expect(labels).To(HaveKeyWithValue("namespace", "test"))
+		g.Expect(labels).To(HaveKeyWithValue("gateway", "gateway"))
+
+		pushResults[labels["result"]] = metric.GetCounter().GetValue()
+	}
+
+	g.Expect(pushResults).To(Equal(map[string]float64{
+		"success": 1,
+		"failure": 1,
+	}))
+
+	fetchDuration := metricFamilies["nginx_gateway_fabric_waf_bundle_fetch_milliseconds"]
+	g.Expect(fetchDuration.GetMetric()[0].GetHistogram().GetSampleCount()).To(Equal(uint64(2)))
+
+	fetchFailures := metricFamilies["nginx_gateway_fabric_waf_bundle_fetch_failures_total"]
+	g.Expect(fetchFailures.GetMetric()[0].GetCounter().GetValue()).To(Equal(float64(1)))
+
+	collector.DeleteGatewayMetrics(gateway)
+
+	families, err = registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	for _, family := range families {
+		g.Expect(family.GetName()).ToNot(Equal("nginx_gateway_fabric_nginx_config_push_milliseconds"))
+		g.Expect(family.GetName()).ToNot(Equal("nginx_gateway_fabric_nginx_config_pushes_total"))
+	}
+}
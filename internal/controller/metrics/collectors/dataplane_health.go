@@ -0,0 +1,57 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+)
+
+// PodHealthLister lists the data plane health of all nginx Pods.
+type PodHealthLister interface {
+	ListPodHealth() []agent.GatewayPodHealth
+}
+
+// DataPlaneHealthCollector collects the data plane health of nginx Pods as reported by the nginx agents.
+// Implements the prometheus.Collector interface.
+type DataPlaneHealthCollector struct {
+	lister  PodHealthLister
+	healthy *prometheus.Desc
+}
+
+// NewDataPlaneHealthCollector creates a new DataPlaneHealthCollector.
+func NewDataPlaneHealthCollector(lister PodHealthLister, constLabels map[string]string) *DataPlaneHealthCollector {
+	return &DataPlaneHealthCollector{
+		lister: lister,
+		healthy: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "data_plane_healthy"),
+			"Whether the nginx data plane of a Gateway Pod is healthy (1) or unhealthy (0), as reported by the nginx agent",
+			[]string{"namespace", "gateway", "pod"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *DataPlaneHealthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.healthy
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *DataPlaneHealthCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, health := range c.lister.ListPodHealth() {
+		var value float64
+		if health.Healthy {
+			value = 1
+		}
+
+		ch <- prometheus.MustNewConstMetric(
+			c.healthy,
+			prometheus.GaugeValue,
+			value,
+			health.Namespace,
+			health.GatewayName,
+			health.PodName,
+		)
+	}
+}
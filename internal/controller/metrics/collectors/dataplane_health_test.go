@@ -0,0 +1,84 @@
+package collectors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+)
+
+type fakePodHealthLister []agent.GatewayPodHealth
+
+func (f fakePodHealthLister) ListPodHealth() []agent.GatewayPodHealth {
+	return f
+}
+
+func TestDataPlaneHealthCollector(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		expected map[string]float64
+		name     string
+		lister   fakePodHealthLister
+	}{
+		{
+			name:     "no pods reported",
+			lister:   fakePodHealthLister{},
+			expected: map[string]float64{},
+		},
+		{
+			name: "healthy and unhealthy pods",
+			lister: fakePodHealthLister{
+				{
+					PodHealth:   agent.PodHealth{PodName: "nginx-1", Healthy: true},
+					Namespace:   "test",
+					GatewayName: "gateway",
+				},
+				{
+					PodHealth:   agent.PodHealth{PodName: "nginx-2", Description: "nginx is not running"},
+					Namespace:   "test",
+					GatewayName: "gateway",
+				},
+			},
+			expected: map[string]float64{
+				"nginx-1": 1,
+				"nginx-2": 0,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			registry := prometheus.NewPedanticRegistry()
+			registry.MustRegister(NewDataPlaneHealthCollector(test.lister, map[string]string{"class": "nginx"}))
+
+			families, err := registry.Gather()
+			g.Expect(err).ToNot(HaveOccurred())
+
+			values := make(map[string]float64)
+			for _, family := range families {
+				g.Expect(family.GetName()).To(Equal("nginx_gateway_fabric_data_plane_healthy"))
+
+				for _, metric := range family.GetMetric() {
+					labels := make(map[string]string)
+					for _, label := range metric.GetLabel() {
+						labels[label.GetName()] = label.GetValue()
+					}
+
+					g.Expect(labels).To(HaveKeyWithValue("class", "nginx"))
+					g.Expect(labels).To(HaveKeyWithValue("namespace", "test"))
+					g.Expect(labels).To(HaveKeyWithValue("gateway", "gateway"))
+
+					values[labels["pod"]] = metric.GetGauge().GetValue()
+				}
+			}
+
+			g.Expect(values).To(Equal(test.expected))
+		})
+	}
+}
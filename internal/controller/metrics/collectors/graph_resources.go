@@ -0,0 +1,121 @@
+package collectors
+
+import (
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// GraphGetter gets the latest Graph.
+type GraphGetter interface {
+	GetLatestGraph() *graph.Graph
+}
+
+// resourceCountKey is the key of a resource count.
+type resourceCountKey struct {
+	kind  string
+	valid bool
+}
+
+// GraphResourceCollector collects the number of resources in the latest graph by kind and validity.
+// Implements the prometheus.Collector interface.
+type GraphResourceCollector struct {
+	getter    GraphGetter
+	resources *prometheus.Desc
+}
+
+// NewGraphResourceCollector creates a new GraphResourceCollector.
+func NewGraphResourceCollector(getter GraphGetter, constLabels map[string]string) *GraphResourceCollector {
+	return &GraphResourceCollector{
+		getter: getter,
+		resources: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "resources"),
+			"Number of resources processed by the controller, by kind and validity",
+			[]string{"kind", "valid"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *GraphResourceCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.resources
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *GraphResourceCollector) Collect(ch chan<- prometheus.Metric) {
+	for key, count := range countGraphResources(c.getter.GetLatestGraph()) {
+		ch <- prometheus.MustNewConstMetric(
+			c.resources,
+			prometheus.GaugeValue,
+			float64(count),
+			key.kind,
+			strconv.FormatBool(key.valid),
+		)
+	}
+}
+
+var routeTypeKinds = map[graph.RouteType]string{
+	graph.RouteTypeHTTP: kinds.HTTPRoute,
+	graph.RouteTypeGRPC: kinds.GRPCRoute,
+	graph.RouteTypeTLS:  kinds.TLSRoute,
+	graph.RouteTypeTCP:  kinds.TCPRoute,
+	graph.RouteTypeUDP:  kinds.UDPRoute,
+}
+
+func countGraphResources(g *graph.Graph) map[resourceCountKey]int {
+	counts := make(map[resourceCountKey]int)
+	if g == nil {
+		return counts
+	}
+
+	count := func(kind string, valid bool) {
+		counts[resourceCountKey{kind: kind, valid: valid}]++
+	}
+
+	if g.GatewayClass != nil {
+		count(kinds.GatewayClass, g.GatewayClass.Valid)
+	}
+
+	for _, gw := range g.Gateways {
+		count(kinds.Gateway, gw.Valid)
+	}
+
+	for _, ls := range g.ListenerSets {
+		count(kinds.ListenerSet, ls.Valid)
+	}
+
+	for _, route := range g.Routes {
+		count(routeTypeKinds[route.RouteType], route.Valid)
+	}
+
+	for _, route := range g.L4Routes {
+		count(routeTypeKinds[route.RouteType], route.Valid)
+	}
+
+	for _, policy := range g.BackendTLSPolicies {
+		count(kinds.BackendTLSPolicy, policy.Valid)
+	}
+
+	for key, policy := range g.NGFPolicies {
+		count(key.GVK.Kind, policy.Valid)
+	}
+
+	for _, np := range g.ReferencedNginxProxies {
+		count(kinds.NginxProxy, np.Valid)
+	}
+
+	for _, filter := range g.SnippetsFilters {
+		count(kinds.SnippetsFilter, filter.Valid)
+	}
+
+	for _, filter := range g.AuthenticationFilters {
+		count(kinds.AuthenticationFilter, filter.Valid)
+	}
+
+	return counts
+}
@@ -0,0 +1,136 @@
+package collectors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type fakeGraphGetter struct {
+	graph *graph.Graph
+}
+
+func (f fakeGraphGetter) GetLatestGraph() *graph.Graph {
+	return f.graph
+}
+
+func TestGraphResourceCollector(t *testing.T) {
+	t.Parallel()
+
+	policyKey := func(name, kind string) graph.PolicyKey {
+		return graph.PolicyKey{
+			NsName: types.NamespacedName{Namespace: "test", Name: name},
+			GVK:    schema.GroupVersionKind{Kind: kind},
+		}
+	}
+
+	tests := []struct {
+		graph     *graph.Graph
+		expValues map[string]float64
+		name      string
+	}{
+		{
+			name:      "nil graph",
+			graph:     nil,
+			expValues: map[string]float64{},
+		},
+		{
+			name: "resources by kind and validity",
+			graph: &graph.Graph{
+				GatewayClass: &graph.GatewayClass{Valid: true},
+				Gateways: map[types.NamespacedName]*graph.Gateway{
+					{Namespace: "test", Name: "gw-1"}: {Valid: true},
+					{Namespace: "test", Name: "gw-2"}: {Valid: false},
+				},
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}: {
+						RouteType: graph.RouteTypeHTTP,
+						Valid:     true,
+					},
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-2"}}: {
+						RouteType: graph.RouteTypeHTTP,
+						Valid:     true,
+					},
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "gr"}}: {
+						RouteType: graph.RouteTypeGRPC,
+						Valid:     false,
+					},
+				},
+				L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+					{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tls"}}: {
+						RouteType: graph.RouteTypeTLS,
+						Valid:     true,
+					},
+				},
+				NGFPolicies: map[graph.PolicyKey]*graph.Policy{
+					policyKey("cp", kinds.ClientSettingsPolicy):    {Valid: true},
+					policyKey("alp", kinds.AccessLogPolicy):        {Valid: false},
+					policyKey("alp-2", kinds.AccessLogPolicy):      {Valid: true},
+					policyKey("rl", kinds.RateLimitPolicy):         {Valid: true},
+					policyKey("rl-invalid", kinds.RateLimitPolicy): {Valid: false},
+				},
+				ReferencedNginxProxies: map[types.NamespacedName]*graph.NginxProxy{
+					{Namespace: "test", Name: "np"}: {Valid: true},
+				},
+				SnippetsFilters: map[types.NamespacedName]*graph.SnippetsFilter{
+					{Namespace: "test", Name: "sf"}: {Valid: false},
+				},
+			},
+			expValues: map[string]float64{
+				"GatewayClass/true":         1,
+				"Gateway/true":              1,
+				"Gateway/false":             1,
+				"HTTPRoute/true":            2,
+				"GRPCRoute/false":           1,
+				"TLSRoute/true":             1,
+				"ClientSettingsPolicy/true": 1,
+				"AccessLogPolicy/true":      1,
+				"AccessLogPolicy/false":     1,
+				"RateLimitPolicy/true":      1,
+				"RateLimitPolicy/false":     1,
+				"NginxProxy/true":           1,
+				"SnippetsFilter/false":      1,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			registry := prometheus.NewPedanticRegistry()
+			registry.MustRegister(NewGraphResourceCollector(
+				fakeGraphGetter{graph: test.graph},
+				map[string]string{"class": "nginx"},
+			))
+
+			families, err := registry.Gather()
+			g.Expect(err).ToNot(HaveOccurred())
+
+			values := make(map[string]float64)
+			for _, family := range families {
+				g.Expect(family.GetName()).To(Equal("nginx_gateway_fabric_resources"))
+
+				for _, metric := range family.GetMetric() {
+					labels := make(map[string]string)
+					for _, label := range metric.GetLabel() {
+						labels[label.GetName()] = label.GetValue()
+					}
+
+					g.Expect(labels).To(HaveKeyWithValue("class", "nginx"))
+
+					values[labels["kind"]+"/"+labels["valid"]] = metric.GetGauge().GetValue()
+				}
+			}
+
+			g.Expect(values).To(Equal(test.expValues))
+		})
+	}
+}
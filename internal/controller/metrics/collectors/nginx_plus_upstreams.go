@@ -0,0 +1,358 @@
+package collectors
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	ngxConfig "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+const (
+	// plusAPIVersion is the version of the NGINX Plus API that the upstreams are read from.
+	plusAPIVersion = 9
+	// plusAPITimeout is the timeout to read the upstreams from the NGINX Plus API of a Pod.
+	plusAPITimeout = 5 * time.Second
+)
+
+// GatewayPodLister lists the connected nginx Pods of all Gateways.
+type GatewayPodLister interface {
+	ListGatewayPods() []agent.GatewayPod
+}
+
+// GatewayConfigurationGetter gets the latest configuration of a Gateway.
+type GatewayConfigurationGetter interface {
+	GetLatestConfigurationForGateway(gateway types.NamespacedName) *dataplane.Configuration
+}
+
+// plusUpstream is an HTTP upstream in the NGINX Plus API.
+type plusUpstream struct {
+	Peers []plusUpstreamPeer `json:"peers"`
+}
+
+// plusUpstreamPeer is a server of an HTTP upstream in the NGINX Plus API. The times are in milliseconds.
+type plusUpstreamPeer struct {
+	Server       string                `json:"server"`
+	State        string                `json:"state"`
+	Responses    plusUpstreamResponses `json:"responses"`
+	Requests     uint64                `json:"requests"`
+	HeaderTime   float64               `json:"header_time"`
+	ResponseTime float64               `json:"response_time"`
+}
+
+type plusUpstreamResponses struct {
+	Responses1xx uint64 `json:"1xx"`
+	Responses2xx uint64 `json:"2xx"`
+	Responses3xx uint64 `json:"3xx"`
+	Responses4xx uint64 `json:"4xx"`
+	Responses5xx uint64 `json:"5xx"`
+}
+
+// upstreamRoute is a route that sends requests to an upstream.
+type upstreamRoute struct {
+	kind   string
+	nsname types.NamespacedName
+}
+
+// NginxPlusUpstreamsCollector collects the metrics of the HTTP upstreams from the NGINX Plus API of each nginx
+// Pod. Instead of the generated upstream names, the metrics are labeled with the Gateway, the route, and
+// the Service of each upstream. An upstream that multiple routes send requests to is reported for each route.
+// Implements the prometheus.Collector interface.
+type NginxPlusUpstreamsCollector struct {
+	podLister     GatewayPodLister
+	configGetter  GatewayConfigurationGetter
+	client        *http.Client
+	requests      *prometheus.Desc
+	responses     *prometheus.Desc
+	responseTime  *prometheus.Desc
+	headerTime    *prometheus.Desc
+	peerHealthy   *prometheus.Desc
+	scrapeSuccess *prometheus.Desc
+	logger        logr.Logger
+	port          string
+}
+
+// NewNginxPlusUpstreamsCollector creates a new NginxPlusUpstreamsCollector.
+func NewNginxPlusUpstreamsCollector(
+	logger logr.Logger,
+	podLister GatewayPodLister,
+	configGetter GatewayConfigurationGetter,
+	constLabels map[string]string,
+) *NginxPlusUpstreamsCollector {
+	peerLabels := []string{
+		"namespace",
+		"gateway",
+		"pod",
+		"route_kind",
+		"route_namespace",
+		"route",
+		"service_namespace",
+		"service",
+		"service_port",
+		"peer",
+	}
+
+	return &NginxPlusUpstreamsCollector{
+		podLister:    podLister,
+		configGetter: configGetter,
+		client:       &http.Client{Timeout: plusAPITimeout},
+		logger:       logger,
+		port:         ngxConfig.PlusAPIPort,
+		requests: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_peer_requests_total"),
+			"Number of client requests forwarded to an upstream peer",
+			peerLabels,
+			constLabels,
+		),
+		responses: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_peer_responses_total"),
+			"Number of responses received from an upstream peer by status code class",
+			append(peerLabels, "code"),
+			constLabels,
+		),
+		responseTime: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_peer_response_time_seconds"),
+			"Average time to get the full response from an upstream peer",
+			peerLabels,
+			constLabels,
+		),
+		headerTime: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_peer_header_time_seconds"),
+			"Average time to get the response header from an upstream peer",
+			peerLabels,
+			constLabels,
+		),
+		peerHealthy: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "upstream_peer_healthy"),
+			"Whether an upstream peer is up (1) or not (0), as reported by the NGINX Plus API",
+			peerLabels,
+			constLabels,
+		),
+		scrapeSuccess: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "nginx_plus_api_scrape_success"),
+			"Whether the upstreams were read from the NGINX Plus API of a Gateway Pod (1) or not (0)",
+			[]string{"namespace", "gateway", "pod"},
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *NginxPlusUpstreamsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.requests
+	ch <- c.responses
+	ch <- c.responseTime
+	ch <- c.headerTime
+	ch <- c.peerHealthy
+	ch <- c.scrapeSuccess
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+// The NGINX Plus API of all Pods is read in parallel.
+func (c *NginxPlusUpstreamsCollector) Collect(ch chan<- prometheus.Metric) {
+	pods := c.podLister.ListGatewayPods()
+
+	routesByGateway := make(map[types.NamespacedName]map[string][]upstreamRoute)
+	for _, pod := range pods {
+		gwNsName := types.NamespacedName{Namespace: pod.Namespace, Name: pod.GatewayName}
+		if _, exists := routesByGateway[gwNsName]; !exists {
+			routesByGateway[gwNsName] = buildUpstreamRoutes(c.configGetter.GetLatestConfigurationForGateway(gwNsName))
+		}
+	}
+
+	var wg sync.WaitGroup
+	for _, pod := range pods {
+		gwNsName := types.NamespacedName{Namespace: pod.Namespace, Name: pod.GatewayName}
+
+		wg.Go(func() {
+			c.collectPod(ch, pod, routesByGateway[gwNsName])
+		})
+	}
+
+	wg.Wait()
+}
+
+func (c *NginxPlusUpstreamsCollector) collectPod(
+	ch chan<- prometheus.Metric,
+	pod agent.GatewayPod,
+	routes map[string][]upstreamRoute,
+) {
+	upstreams, err := c.getUpstreams(pod.PodIP)
+	if err != nil {
+		c.logger.V(1).Info(
+			"Unable to read upstreams from the NGINX Plus API",
+			"namespace", pod.Namespace,
+			"gateway", pod.GatewayName,
+			"pod", pod.PodName,
+			"error", err,
+		)
+	}
+
+	var success float64
+	if err == nil {
+		success = 1
+	}
+
+	ch <- prometheus.MustNewConstMetric(
+		c.scrapeSuccess,
+		prometheus.GaugeValue,
+		success,
+		pod.Namespace,
+		pod.GatewayName,
+		pod.PodName,
+	)
+
+	for name, upstream := range upstreams {
+		svcNamespace, svcName, svcPort := parseUpstreamName(name)
+
+		upstreamRoutes := routes[name]
+		if len(upstreamRoutes) == 0 {
+			upstreamRoutes = []upstreamRoute{{}}
+		}
+
+		for _, route := range upstreamRoutes {
+			for _, peer := range upstream.Peers {
+				labels := []string{
+					pod.Namespace,
+					pod.GatewayName,
+					pod.PodName,
+					route.kind,
+					route.nsname.Namespace,
+					route.nsname.Name,
+					svcNamespace,
+					svcName,
+					svcPort,
+					peer.Server,
+				}
+
+				c.collectPeer(ch, peer, labels)
+			}
+		}
+	}
+}
+
+func (c *NginxPlusUpstreamsCollector) collectPeer(ch chan<- prometheus.Metric, peer plusUpstreamPeer, labels []string) {
+	ch <- prometheus.MustNewConstMetric(c.requests, prometheus.CounterValue, float64(peer.Requests), labels...)
+
+	for code, count := range map[string]uint64{
+		"1xx": peer.Responses.Responses1xx,
+		"2xx": peer.Responses.Responses2xx,
+		"3xx": peer.Responses.Responses3xx,
+		"4xx": peer.Responses.Responses4xx,
+		"5xx": peer.Responses.Responses5xx,
+	} {
+		ch <- prometheus.MustNewConstMetric(
+			c.responses,
+			prometheus.CounterValue,
+			float64(count),
+			append(labels, code)...,
+		)
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.responseTime, prometheus.GaugeValue, peer.ResponseTime/1000, labels...)
+	ch <- prometheus.MustNewConstMetric(c.headerTime, prometheus.GaugeValue, peer.HeaderTime/1000, labels...)
+
+	var healthy float64
+	if peer.State == "up" {
+		healthy = 1
+	}
+	ch <- prometheus.MustNewConstMetric(c.peerHealthy, prometheus.GaugeValue, healthy, labels...)
+}
+
+// getUpstreams reads the HTTP upstreams from the read-only NGINX Plus API of the Pod.
+func (c *NginxPlusUpstreamsCollector) getUpstreams(podIP string) (map[string]plusUpstream, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), plusAPITimeout)
+	defer cancel()
+
+	url := fmt.Sprintf("http://%s/api/%d/http/upstreams", net.JoinHostPort(podIP, c.port), plusAPIVersion)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error getting upstreams: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error getting upstreams: unexpected status code %d", resp.StatusCode)
+	}
+
+	var upstreams map[string]plusUpstream
+	if err := json.NewDecoder(resp.Body).Decode(&upstreams); err != nil {
+		return nil, fmt.Errorf("error decoding upstreams: %w", err)
+	}
+
+	return upstreams, nil
+}
+
+// buildUpstreamRoutes returns the routes that send requests to each upstream in the configuration.
+func buildUpstreamRoutes(cfg *dataplane.Configuration) map[string][]upstreamRoute {
+	routes := make(map[string][]upstreamRoute)
+	if cfg == nil {
+		return routes
+	}
+
+	seen := make(map[string]map[upstreamRoute]struct{})
+
+	for _, servers := range [][]dataplane.VirtualServer{cfg.HTTPServers, cfg.SSLServers} {
+		for _, server := range servers {
+			for _, pathRule := range server.PathRules {
+				kind := kinds.HTTPRoute
+				if pathRule.GRPC {
+					kind = kinds.GRPCRoute
+				}
+
+				for _, matchRule := range pathRule.MatchRules {
+					route := upstreamRoute{kind: kind, nsname: matchRule.BackendGroup.Source}
+
+					for _, backend := range matchRule.BackendGroup.Backends {
+						if backend.UpstreamName == "" {
+							continue
+						}
+
+						if seen[backend.UpstreamName] == nil {
+							seen[backend.UpstreamName] = make(map[upstreamRoute]struct{})
+						}
+						if _, exists := seen[backend.UpstreamName][route]; exists {
+							continue
+						}
+						seen[backend.UpstreamName][route] = struct{}{}
+
+						routes[backend.UpstreamName] = append(routes[backend.UpstreamName], route)
+					}
+				}
+			}
+		}
+	}
+
+	return routes
+}
+
+// parseUpstreamName returns the namespace, name, and port of the Service of an upstream. Upstreams are named
+// <namespace>_<name>_<port>, with an optional session persistence suffix. Kubernetes names can't contain
+// underscores, so they separate the fields of the name.
+func parseUpstreamName(name string) (namespace, svcName, port string) {
+	fields := strings.Split(name, "_")
+	if len(fields) < 3 {
+		return "", "", ""
+	}
+
+	return fields[0], fields[1], fields[2]
+}
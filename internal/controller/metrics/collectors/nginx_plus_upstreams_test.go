@@ -0,0 +1,231 @@
+package collectors
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type fakeGatewayPodLister []agent.GatewayPod
+
+func (f fakeGatewayPodLister) ListGatewayPods() []agent.GatewayPod {
+	return f
+}
+
+type fakeGatewayConfigurationGetter map[types.NamespacedName]*dataplane.Configuration
+
+func (f fakeGatewayConfigurationGetter) GetLatestConfigurationForGateway(
+	gateway types.NamespacedName,
+) *dataplane.Configuration {
+	return f[gateway]
+}
+
+const testPlusUpstreams = `{
+  "test_coffee_80": {
+    "peers": [
+      {
+        "server": "10.0.0.1:8080",
+        "state": "up",
+        "requests": 10,
+        "header_time": 20,
+        "response_time": 50,
+        "responses": {"1xx": 0, "2xx": 8, "3xx": 0, "4xx": 1, "5xx": 1}
+      },
+      {
+        "server": "10.0.0.2:8080",
+        "state": "unhealthy",
+        "requests": 4
+      }
+    ]
+  },
+  "test_tea_8080_0": {
+    "peers": [
+      {"server": "10.0.0.3:8080", "state": "up", "requests": 3}
+    ]
+  }
+}`
+
+func TestNginxPlusUpstreamsCollector(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/9/http/upstreams" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write([]byte(testPlusUpstreams))
+	}))
+	defer server.Close()
+
+	host, port, err := net.SplitHostPort(server.Listener.Addr().String())
+	g.Expect(err).ToNot(HaveOccurred())
+
+	gwNsName := types.NamespacedName{Namespace: "test", Name: "gateway"}
+	coffeeRoute := types.NamespacedName{Namespace: "test", Name: "coffee"}
+	cafeRoute := types.NamespacedName{Namespace: "test", Name: "cafe"}
+
+	configGetter := fakeGatewayConfigurationGetter{
+		gwNsName: {
+			HTTPServers: []dataplane.VirtualServer{
+				{
+					PathRules: []dataplane.PathRule{
+						{
+							MatchRules: []dataplane.MatchRule{
+								{
+									BackendGroup: dataplane.BackendGroup{
+										Source:   coffeeRoute,
+										Backends: []dataplane.Backend{{UpstreamName: "test_coffee_80"}},
+									},
+								},
+							},
+						},
+						{
+							GRPC: true,
+							MatchRules: []dataplane.MatchRule{
+								{
+									BackendGroup: dataplane.BackendGroup{
+										Source:   cafeRoute,
+										Backends: []dataplane.Backend{{UpstreamName: "test_coffee_80"}},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	podLister := fakeGatewayPodLister{
+		{Namespace: "test", GatewayName: "gateway", PodName: "nginx-pod", PodIP: host},
+		// the server only listens on 127.0.0.1, so the upstreams of this Pod can't be read
+		{Namespace: "test", GatewayName: "gateway", PodName: "broken-pod", PodIP: "127.0.0.2"},
+	}
+
+	collector := NewNginxPlusUpstreamsCollector(logr.Discard(), podLister, configGetter, map[string]string{
+		"class": "nginx",
+	})
+	collector.port = port
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(collector)
+
+	families, err := registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+
+	metrics := make(map[string][]map[string]string)
+	values := make(map[string][]float64)
+	for _, family := range families {
+		for _, metric := range family.GetMetric() {
+			labels := make(map[string]string)
+			for _, label := range metric.GetLabel() {
+				labels[label.GetName()] = label.GetValue()
+			}
+			g.Expect(labels).To(HaveKeyWithValue("class", "nginx"))
+
+			var value float64
+			switch {
+			case metric.GetCounter() != nil:
+				value = metric.GetCounter().GetValue()
+			case metric.GetGauge() != nil:
+				value = metric.GetGauge().GetValue()
+			}
+
+			metrics[family.GetName()] = append(metrics[family.GetName()], labels)
+			values[family.GetName()] = append(values[family.GetName()], value)
+		}
+	}
+
+	g.Expect(metrics["nginx_gateway_fabric_nginx_plus_api_scrape_success"]).To(ConsistOf(
+		HaveKeyWithValue("pod", "nginx-pod"),
+		HaveKeyWithValue("pod", "broken-pod"),
+	))
+	g.Expect(values["nginx_gateway_fabric_nginx_plus_api_scrape_success"]).To(ConsistOf(1.0, 0.0))
+
+	// the coffee upstream is reported for both routes, the tea upstream isn't used by any route
+	requests := metrics["nginx_gateway_fabric_upstream_peer_requests_total"]
+	g.Expect(requests).To(HaveLen(5))
+	g.Expect(requests).To(ContainElement(And(
+		HaveKeyWithValue("namespace", "test"),
+		HaveKeyWithValue("gateway", "gateway"),
+		HaveKeyWithValue("pod", "nginx-pod"),
+		HaveKeyWithValue("route_kind", kinds.HTTPRoute),
+		HaveKeyWithValue("route_namespace", "test"),
+		HaveKeyWithValue("route", "coffee"),
+		HaveKeyWithValue("service_namespace", "test"),
+		HaveKeyWithValue("service", "coffee"),
+		HaveKeyWithValue("service_port", "80"),
+		HaveKeyWithValue("peer", "10.0.0.1:8080"),
+	)))
+	g.Expect(requests).To(ContainElement(And(
+		HaveKeyWithValue("route_kind", kinds.GRPCRoute),
+		HaveKeyWithValue("route", "cafe"),
+		HaveKeyWithValue("peer", "10.0.0.2:8080"),
+	)))
+	g.Expect(requests).To(ContainElement(And(
+		HaveKeyWithValue("route_kind", ""),
+		HaveKeyWithValue("route", ""),
+		HaveKeyWithValue("service", "tea"),
+		HaveKeyWithValue("service_port", "8080"),
+	)))
+	g.Expect(values["nginx_gateway_fabric_upstream_peer_requests_total"]).To(ConsistOf(10.0, 10.0, 4.0, 4.0, 3.0))
+
+	g.Expect(metrics["nginx_gateway_fabric_upstream_peer_responses_total"]).To(HaveLen(25))
+	g.Expect(values["nginx_gateway_fabric_upstream_peer_healthy"]).To(ConsistOf(1.0, 1.0, 0.0, 0.0, 1.0))
+	g.Expect(values["nginx_gateway_fabric_upstream_peer_response_time_seconds"]).To(ContainElement(0.05))
+	g.Expect(values["nginx_gateway_fabric_upstream_peer_header_time_seconds"]).To(ContainElement(0.02))
+}
+
+func TestParseUpstreamName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		upstream          string
+		expectedNamespace string
+		expectedService   string
+		expectedPort      string
+	}{
+		{
+			name:              "service upstream",
+			upstream:          "test_coffee_80",
+			expectedNamespace: "test",
+			expectedService:   "coffee",
+			expectedPort:      "80",
+		},
+		{
+			name:              "session persistence upstream",
+			upstream:          "test_coffee_80_1",
+			expectedNamespace: "test",
+			expectedService:   "coffee",
+			expectedPort:      "80",
+		},
+		{
+			name:     "invalid backend upstream",
+			upstream: "invalid-backend-ref",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			namespace, svcName, port := parseUpstreamName(test.upstream)
+			g.Expect(namespace).To(Equal(test.expectedNamespace))
+			g.Expect(svcName).To(Equal(test.expectedService))
+			g.Expect(port).To(Equal(test.expectedPort))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package collectors
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/metrics"
+)
+
+// StatusQueue is the queue of pending status updates.
+type StatusQueue interface {
+	Len() int
+}
+
+// StatusWriteErrorCounter counts the failed status writes.
+type StatusWriteErrorCounter interface {
+	WriteErrors() uint64
+}
+
+// StatusCollector collects metrics about the status updates of the resources.
+// Implements the prometheus.Collector interface.
+type StatusCollector struct {
+	queue       StatusQueue
+	counter     StatusWriteErrorCounter
+	queueDepth  *prometheus.Desc
+	writeErrors *prometheus.Desc
+}
+
+// NewStatusCollector creates a new StatusCollector.
+func NewStatusCollector(
+	queue StatusQueue,
+	counter StatusWriteErrorCounter,
+	constLabels map[string]string,
+) *StatusCollector {
+	return &StatusCollector{
+		queue:   queue,
+		counter: counter,
+		queueDepth: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "status_update_queue_depth"),
+			"Number of status updates that are waiting to be processed",
+			nil,
+			constLabels,
+		),
+		writeErrors: prometheus.NewDesc(
+			prometheus.BuildFQName(metrics.Namespace, "", "status_write_errors_total"),
+			"Number of resource status writes that failed after all retries",
+			nil,
+			constLabels,
+		),
+	}
+}
+
+// Describe implements prometheus.Collector interface Describe method.
+func (c *StatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.queueDepth
+	ch <- c.writeErrors
+}
+
+// Collect implements the prometheus.Collector interface Collect method.
+func (c *StatusCollector) Collect(ch chan<- prometheus.Metric) {
+	ch <- prometheus.MustNewConstMetric(c.queueDepth, prometheus.GaugeValue, float64(c.queue.Len()))
+	ch <- prometheus.MustNewConstMetric(c.writeErrors, prometheus.CounterValue, float64(c.counter.WriteErrors()))
+}
@@ -0,0 +1,56 @@
+package collectors
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type fakeStatusQueue int
+
+func (f fakeStatusQueue) Len() int {
+	return int(f)
+}
+
+type fakeStatusWriteErrorCounter uint64
+
+func (f fakeStatusWriteErrorCounter) WriteErrors() uint64 {
+	return uint64(f)
+}
+
+func TestStatusCollector(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(NewStatusCollector(
+		fakeStatusQueue(3),
+		fakeStatusWriteErrorCounter(5),
+		map[string]string{"class": "nginx"},
+	))
+
+	families, err := registry.Gather()
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(families).To(HaveLen(2))
+
+	values := make(map[string]float64)
+	for _, family := range families {
+		g.Expect(family.GetMetric()).To(HaveLen(1))
+
+		metric := family.GetMetric()[0]
+		g.Expect(metric.GetLabel()).To(HaveLen(1))
+		g.Expect(metric.GetLabel()[0].GetValue()).To(Equal("nginx"))
+
+		if metric.GetGauge() != nil {
+			values[family.GetName()] = metric.GetGauge().GetValue()
+		} else {
+			values[family.GetName()] = metric.GetCounter().GetValue()
+		}
+	}
+
+	g.Expect(values).To(Equal(map[string]float64{
+		"nginx_gateway_fabric_status_update_queue_depth": 3,
+		"nginx_gateway_fabric_status_write_errors_total": 5,
+	}))
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 	"sort"
 	"time"
 
@@ -11,13 +13,17 @@ import (
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	"google.golang.org/protobuf/types/known/structpb"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
 )
@@ -39,6 +45,7 @@ type NginxUpdaterImpl struct {
 	CommandService   *commandService
 	FileService      *fileService
 	NginxDeployments *DeploymentStore
+	statusQueue      *status.Queue
 	logger           logr.Logger
 	plus             bool
 	retryTimeout     time.Duration
@@ -50,6 +57,7 @@ func NewNginxUpdater(
 	reader client.Reader,
 	statusQueue *status.Queue,
 	resetConnChan <-chan struct{},
+	eventRecorder events.EventRecorder,
 	plus bool,
 ) *NginxUpdaterImpl {
 	connTracker := agentgrpc.NewConnectionsTracker()
@@ -62,6 +70,7 @@ func NewNginxUpdater(
 		connTracker,
 		statusQueue,
 		resetConnChan,
+		eventRecorder,
 	)
 	fileService := newFileService(logger.WithName("fileService"), nginxDeployments, connTracker)
 
@@ -69,6 +78,7 @@ func NewNginxUpdater(
 		logger:           logger,
 		plus:             plus,
 		NginxDeployments: nginxDeployments,
+		statusQueue:      statusQueue,
 		CommandService:   commandService,
 		FileService:      fileService,
 		retryTimeout:     retryUpstreamTimeout,
@@ -85,12 +95,28 @@ func NewNginxUpdater(
 // - Agent updates nginx, and responds with a DataPlaneResponse.
 // - Subscriber responds back to the broadcaster to inform that the transaction is complete.
 // - If any errors occurred, they are set on the deployment for the handler to use in the status update.
+//
+// If the deployment has a staged rollout strategy, the configuration is sent to the canary pods first.
+// See rolloutConfig.
 func (n *NginxUpdaterImpl) UpdateConfig(
 	deployment *Deployment,
 	files []File,
 	volumeMounts []v1.VolumeMount,
 ) {
+	// the first configuration of a deployment has nothing to roll back to, so it's sent to all pods at once
+	if deployment.rollout != nil && deployment.configVersion != "" {
+		n.rolloutConfig(deployment, files, volumeMounts)
+		return
+	}
+
+	canceled := deployment.cancelStagedRollout() != nil
+
 	msg := deployment.SetFiles(files, volumeMounts)
+	if msg == nil && canceled {
+		// the pods that were not canaries of the canceled rollout still need the configuration
+		msg = deployment.configApplyMessage()
+	}
+
 	if msg == nil {
 		deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
 		n.logger.V(1).Info("No changes to nginx configuration files, not sending to agent")
@@ -105,6 +131,262 @@ func (n *NginxUpdaterImpl) UpdateConfig(
 	deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
 }
 
+// rolloutConfig sends the nginx configuration to the canary pods of the deployment first. Once the canary pods
+// applied the configuration, and stayed healthy for the soak period, the configuration is sent to the remaining
+// pods. Otherwise, the rollout is aborted and the previous configuration is restored in the canary pods.
+// An aborted configuration version is not rolled out again until the configuration or rollout strategy changes.
+//
+// The soak period is waited out in a goroutine, so that the deployment FileLock is not held while waiting.
+// Pods that connect during the soak period get the previous configuration, unless they're canaries.
+// A newer configuration cancels the staged rollout in progress. The result of a rollout that completes after
+// the soak period is reported with a status update.
+func (n *NginxUpdaterImpl) rolloutConfig(
+	deployment *Deployment,
+	files []File,
+	volumeMounts []v1.VolumeMount,
+) {
+	rollout := *deployment.rollout
+
+	_, version := deployment.fileOverviewsFor(files, volumeMounts)
+	if version == deployment.abortedConfigVersion {
+		n.logger.V(1).Info(
+			"Staged rollout of nginx configuration was previously aborted, not sending to agent",
+			"configVersion", version,
+		)
+		return
+	}
+
+	if inProgress := deployment.stagedRollout; inProgress != nil && inProgress.configVersion == version {
+		n.logger.V(1).Info("Staged rollout of nginx configuration is in progress", "configVersion", version)
+		return
+	}
+
+	previousFiles := deployment.GetFiles()
+	previousVolumeMounts := deployment.volumeMounts
+	previousFileOverviews, previousConfigVersion := deployment.GetFileOverviews()
+
+	// The canaries of a canceled rollout are restored to the configuration that was rolled out before it,
+	// if the newer configuration is aborted.
+	if canceled := deployment.cancelStagedRollout(); canceled != nil {
+		n.logger.Info(
+			"Canceled staged rollout of nginx configuration for a newer configuration",
+			"configVersion", canceled.configVersion,
+		)
+
+		previousFiles = canceled.previousFiles
+		previousVolumeMounts = canceled.previousVolumeMounts
+		previousFileOverviews = canceled.previousFileOverviews
+		previousConfigVersion = canceled.previousConfigVersion
+	}
+
+	msg := deployment.SetFiles(files, volumeMounts)
+	if msg == nil {
+		deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
+		n.logger.V(1).Info("No changes to nginx configuration files, not sending to agent")
+		return
+	}
+
+	broadcaster := deployment.GetBroadcaster()
+	subscriptions := deployment.getSubscriptions()
+	canaries, remaining := selectCanaries(subscriptions, rollout.Canary)
+
+	if len(canaries) == 0 || len(remaining) == 0 {
+		if broadcaster.Send(*msg) {
+			n.logger.Info("Sent nginx configuration to agent")
+		}
+		deployment.abortedConfigVersion = ""
+		deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
+		return
+	}
+
+	n.logger.Info(
+		"Starting staged rollout of nginx configuration",
+		"configVersion", msg.ConfigVersion,
+		"canaries", len(canaries),
+		"soakPeriod", rollout.SoakPeriod,
+	)
+	broadcaster.SendTo(*msg, subscriptionIDs(subscriptions, canaries))
+
+	state := &stagedRollout{
+		configVersion:         msg.ConfigVersion,
+		canaries:              canaries,
+		previousFiles:         previousFiles,
+		previousVolumeMounts:  previousVolumeMounts,
+		previousFileOverviews: previousFileOverviews,
+		previousConfigVersion: previousConfigVersion,
+	}
+
+	if err := n.canaryConfigError(deployment, canaries); err != nil || rollout.SoakPeriod == 0 {
+		n.completeRollout(deployment, *msg, state, rollout, err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	state.cancel = cancel
+	deployment.stagedRollout = state
+
+	go n.soakCanaries(ctx, deployment, *msg, state, rollout)
+
+	deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
+}
+
+// soakCanaries waits for the soak period of the staged rollout, and then completes the rollout, unless
+// the rollout is canceled by a newer configuration. The result is reported with a status update.
+func (n *NginxUpdaterImpl) soakCanaries(
+	ctx context.Context,
+	deployment *Deployment,
+	msg broadcast.NginxAgentMessage,
+	state *stagedRollout,
+	rollout RolloutStrategy,
+) {
+	timer := time.NewTimer(rollout.SoakPeriod)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return
+	case <-timer.C:
+	}
+
+	deployment.FileLock.Lock()
+	defer deployment.FileLock.Unlock()
+
+	// the rollout might have been canceled while waiting for the lock
+	if ctx.Err() != nil {
+		return
+	}
+
+	deployment.cancelStagedRollout()
+
+	n.completeRollout(deployment, msg, state, rollout, n.canaryConfigError(deployment, state.canaries))
+
+	n.statusQueue.Enqueue(&status.QueueObject{
+		Deployment: status.Deployment{
+			NamespacedName: deployment.nsName,
+			GatewayName:    deployment.gatewayName,
+		},
+		Error:             deployment.GetLatestConfigError(),
+		UpdateType:        status.UpdateAll,
+		NginxConfigPushed: true,
+	})
+}
+
+// completeRollout sends the configuration to the pods that are not canaries, or aborts the rollout and restores
+// the previous configuration in the canary pods if the canaries failed, or, if required by the rollout strategy,
+// reported an unhealthy data plane.
+// The deployment FileLock MUST already be locked before calling this function.
+func (n *NginxUpdaterImpl) completeRollout(
+	deployment *Deployment,
+	msg broadcast.NginxAgentMessage,
+	state *stagedRollout,
+	rollout RolloutStrategy,
+	canaryErr error,
+) {
+	err := canaryErr
+	if err == nil && rollout.RequireHealthy {
+		err = n.canaryHealthError(deployment, state.canaries)
+	}
+
+	broadcaster := deployment.GetBroadcaster()
+	subscriptions := deployment.getSubscriptions()
+
+	if err != nil {
+		n.logger.Error(err, "Aborting staged rollout of nginx configuration", "configVersion", msg.ConfigVersion)
+
+		deployment.SetConfigChanges([]string{"staged rollout of version " + msg.ConfigVersion + " aborted"})
+		if revertMsg := deployment.SetFiles(state.previousFiles, state.previousVolumeMounts); revertMsg != nil {
+			broadcaster.SendTo(*revertMsg, subscriptionIDs(subscriptions, state.canaries))
+		}
+
+		deployment.abortedConfigVersion = msg.ConfigVersion
+		deployment.SetLatestConfigError(fmt.Errorf("%w: %w", graph.ErrConfigRolloutAborted, err))
+		return
+	}
+
+	// pods that connected during the soak period received the previous configuration, and are sent
+	// the configuration with the remaining pods
+	remaining := make([]string, 0, len(subscriptions))
+	for _, pod := range slices.Sorted(maps.Keys(subscriptions)) {
+		if !slices.Contains(state.canaries, pod) {
+			remaining = append(remaining, pod)
+		}
+	}
+
+	broadcaster.SendTo(msg, subscriptionIDs(subscriptions, remaining))
+	n.logger.Info("Completed staged rollout of nginx configuration", "configVersion", msg.ConfigVersion)
+
+	deployment.abortedConfigVersion = ""
+	deployment.SetLatestConfigError(deployment.GetConfigurationStatus())
+}
+
+// canaryHealthError returns an error if a canary pod reported an unhealthy data plane.
+func (n *NginxUpdaterImpl) canaryHealthError(deployment *Deployment, canaries []string) error {
+	var errs []error
+	for _, pod := range canaries {
+		if health, ok := deployment.getPodHealth(pod); ok && !health.Healthy {
+			errs = append(errs, fmt.Errorf("data plane in canary pod %s is unhealthy: %s", health.PodName, health.Description))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// canaryConfigError returns the configuration errors of the canary pods. A canary pod that disconnected is
+// treated as a failure, since the configuration might have caused it.
+func (n *NginxUpdaterImpl) canaryConfigError(deployment *Deployment, canaries []string) error {
+	var errs []error
+	for _, pod := range canaries {
+		ok, err := deployment.getPodStatus(pod)
+		switch {
+		case !ok:
+			errs = append(errs, fmt.Errorf("canary pod %s disconnected", n.podName(pod)))
+		case err != nil:
+			errs = append(errs, fmt.Errorf("canary pod %s failed to apply the configuration: %w", n.podName(pod), err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// podName returns the name of the pod of the agent connection, or the agent UUID if the connection is unknown.
+func (n *NginxUpdaterImpl) podName(uuid string) string {
+	if conn, ok := n.NginxDeployments.ListConnections()[uuid]; ok && conn.PodName != "" {
+		return conn.PodName
+	}
+
+	return uuid
+}
+
+// selectCanaries splits the subscribed pods into the canary pods and the remaining pods. At least one pod
+// is a canary if any pod is subscribed. Pods are ordered by name so that the selection is stable.
+func selectCanaries(subscriptions map[string]string, canary intstr.IntOrString) ([]string, []string) {
+	pods := slices.Sorted(maps.Keys(subscriptions))
+	if len(pods) == 0 {
+		return nil, nil
+	}
+
+	count, err := intstr.GetScaledValueFromIntOrPercent(&canary, len(pods), true)
+	if err != nil || count < 1 {
+		count = 1
+	}
+
+	if count >= len(pods) {
+		return pods, nil
+	}
+
+	return pods[:count], pods[count:]
+}
+
+// subscriptionIDs returns the broadcaster subscription IDs of the given pods.
+func subscriptionIDs(subscriptions map[string]string, pods []string) []string {
+	ids := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		ids = append(ids, subscriptions[pod])
+	}
+
+	return ids
+}
+
 // UpdateUpstreamServers sends an APIRequest to the agent to update upstream servers using the NGINX Plus API.
 // Only applicable when using NGINX Plus.
 func (n *NginxUpdaterImpl) UpdateUpstreamServers(
@@ -124,18 +406,20 @@ func (n *NginxUpdaterImpl) UpdateUpstreamServers(
 	var applied bool
 	actions := make([]*pb.NGINXPlusAction, 0, len(conf.Upstreams)+len(conf.StreamUpstreams))
 
-	// HTTP/GRPC Upstreams
+	// HTTP/GRPC Upstreams, including their topology-aware variants
 	for _, upstream := range conf.Upstreams {
-		// Skip upstreams that have resolve servers to avoid "UpstreamServerImmutable" errors
-		if upstreamHasResolveServers(upstream) {
-			continue
-		}
-		action := &pb.NGINXPlusAction{
-			Action: &pb.NGINXPlusAction_UpdateHttpUpstreamServers{
-				UpdateHttpUpstreamServers: buildHTTPUpstreamServers(upstream),
-			},
+		for _, up := range append([]dataplane.Upstream{upstream}, upstream.TopologyUpstreams...) {
+			// Skip upstreams that have resolve servers to avoid "UpstreamServerImmutable" errors
+			if upstreamHasResolveServers(up) {
+				continue
+			}
+			action := &pb.NGINXPlusAction{
+				Action: &pb.NGINXPlusAction_UpdateHttpUpstreamServers{
+					UpdateHttpUpstreamServers: buildHTTPUpstreamServers(up),
+				},
+			}
+			actions = append(actions, action)
 		}
-		actions = append(actions, action)
 	}
 
 	// Stream Upstreams (TLS, TCP, UDP)
@@ -160,6 +444,7 @@ func (n *NginxUpdaterImpl) UpdateUpstreamServers(
 		msg := broadcast.NginxAgentMessage{
 			Type:            broadcast.APIRequest,
 			NGINXPlusAction: action,
+			SpanContext:     deployment.spanContext,
 		}
 
 		requestApplied, err := n.sendRequest(broadcaster, msg, deployment)
@@ -216,6 +501,13 @@ func buildUpstreamServers(upstream dataplane.Upstream) []*structpb.Struct {
 				"server": structpb.NewStringValue(value),
 			},
 		}
+		setUpstreamServerParameters(server, upstream.UpstreamSettings)
+		if endpoint.Backup {
+			server.Fields["backup"] = structpb.NewBoolValue(true)
+		}
+		if endpoint.Drain {
+			server.Fields["drain"] = structpb.NewBoolValue(true)
+		}
 
 		servers = append(servers, server)
 	}
@@ -228,6 +520,22 @@ func buildUpstreamServers(upstream dataplane.Upstream) []*structpb.Struct {
 	return servers
 }
 
+// setUpstreamServerParameters sets the server parameters from the UpstreamSettingsPolicy settings, so that
+// servers updated through the NGINX Plus API keep the same parameters as the ones in the nginx config.
+func setUpstreamServerParameters(server *structpb.Struct, settings upstreamsettings.UpstreamSettings) {
+	if settings.MaxFails != nil {
+		server.Fields["max_fails"] = structpb.NewNumberValue(float64(*settings.MaxFails))
+	}
+
+	if settings.FailTimeout != "" {
+		server.Fields["fail_timeout"] = structpb.NewStringValue(settings.FailTimeout)
+	}
+
+	if settings.SlowStart != "" {
+		server.Fields["slow_start"] = structpb.NewStringValue(settings.SlowStart)
+	}
+}
+
 func (n *NginxUpdaterImpl) sendRequest(
 	broadcaster broadcast.Broadcaster,
 	msg broadcast.NginxAgentMessage,
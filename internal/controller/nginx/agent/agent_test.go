@@ -5,19 +5,26 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	. "github.com/onsi/gomega"
 	"google.golang.org/protobuf/types/known/structpb"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
 func TestUpdateConfig(t *testing.T) {
@@ -46,7 +53,7 @@ func TestUpdateConfig(t *testing.T) {
 			fakeBroadcaster.SendReturns(true)
 
 			plus := false
-			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, plus)
+			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, &events.FakeRecorder{}, plus)
 			deployment := &Deployment{
 				broadcaster: fakeBroadcaster,
 				podStatuses: make(map[string]error),
@@ -92,7 +99,7 @@ func TestUpdateConfig_NoChange(t *testing.T) {
 
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, false)
+	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, &events.FakeRecorder{}, false)
 
 	deployment := &Deployment{
 		broadcaster: fakeBroadcaster,
@@ -125,6 +132,359 @@ func TestUpdateConfig_NoChange(t *testing.T) {
 	g.Expect(deployment.GetLatestConfigError()).ToNot(HaveOccurred())
 }
 
+func TestUpdateConfig_StagedRollout(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		applyToCanaries func(*Deployment)
+		name            string
+		canary          intstr.IntOrString
+		expSendTo       [][]string
+		soakPeriod      time.Duration
+		expSend         int
+		requireHealthy  bool
+		expAborted      bool
+	}{
+		{
+			name:      "canary succeeds",
+			canary:    intstr.FromInt32(1),
+			expSendTo: [][]string{{"sub1"}, {"sub2", "sub3", "sub4"}},
+		},
+		{
+			name:       "percentage of canaries succeed after soak period",
+			canary:     intstr.FromString("50%"),
+			soakPeriod: 10 * time.Millisecond,
+			expSendTo:  [][]string{{"sub1", "sub2"}, {"sub3", "sub4"}},
+		},
+		{
+			name:           "canary is unhealthy after soak period",
+			canary:         intstr.FromInt32(1),
+			soakPeriod:     10 * time.Millisecond,
+			requireHealthy: true,
+			applyToCanaries: func(d *Deployment) {
+				d.SetPodHealth("pod1", PodHealth{PodName: "nginx-1", Description: "worker crashed"})
+			},
+			expSendTo:  [][]string{{"sub1"}, {"sub1"}},
+			expAborted: true,
+		},
+		{
+			name:    "all pods are canaries",
+			canary:  intstr.FromString("100%"),
+			expSend: 1,
+		},
+		{
+			name:   "canary fails to apply configuration",
+			canary: intstr.FromInt32(1),
+			applyToCanaries: func(d *Deployment) {
+				d.SetPodErrorStatus("pod1", errors.New("nginx test failed"))
+			},
+			expSendTo:  [][]string{{"sub1"}, {"sub1"}},
+			expAborted: true,
+		},
+		{
+			name:   "canary disconnects",
+			canary: intstr.FromInt32(1),
+			applyToCanaries: func(d *Deployment) {
+				d.RemovePodStatus("pod1")
+			},
+			expSendTo:  [][]string{{"sub1"}, {"sub1"}},
+			expAborted: true,
+		},
+		{
+			name:           "canary is unhealthy",
+			canary:         intstr.FromInt32(1),
+			requireHealthy: true,
+			applyToCanaries: func(d *Deployment) {
+				d.SetPodHealth("pod1", PodHealth{PodName: "nginx-1", Description: "worker crashed"})
+			},
+			expSendTo:  [][]string{{"sub1"}, {"sub1"}},
+			expAborted: true,
+		},
+		{
+			name:   "canary is unhealthy but health is not required",
+			canary: intstr.FromInt32(1),
+			applyToCanaries: func(d *Deployment) {
+				d.SetPodHealth("pod1", PodHealth{PodName: "nginx-1", Description: "worker crashed"})
+			},
+			expSendTo: [][]string{{"sub1"}, {"sub2", "sub3", "sub4"}},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
+			fakeBroadcaster.SendReturns(true)
+
+			statusQueue := status.NewQueue()
+			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), statusQueue, nil, &events.FakeRecorder{}, false)
+			deployment := newDeployment(fakeBroadcaster, "gateway")
+
+			oldFile := File{
+				Meta:     &pb.FileMeta{Name: "test.conf", Hash: "12345"},
+				Contents: []byte("old content"),
+			}
+			newFile := File{
+				Meta:     &pb.FileMeta{Name: "test.conf", Hash: "67890"},
+				Contents: []byte("new content"),
+			}
+
+			// the first configuration is sent to all pods
+			deployment.SetRolloutStrategy(&RolloutStrategy{
+				Canary:         test.canary,
+				SoakPeriod:     test.soakPeriod,
+				RequireHealthy: test.requireHealthy,
+			})
+			updater.UpdateConfig(deployment, []File{oldFile}, nil)
+			g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(1))
+			_, oldVersion := deployment.GetFileOverviews()
+
+			for i := 1; i <= 4; i++ {
+				deployment.trackSubscription(fmt.Sprintf("pod%d", i), fmt.Sprintf("sub%d", i))
+				deployment.SetPodErrorStatus(fmt.Sprintf("pod%d", i), nil)
+			}
+
+			fakeBroadcaster.SendToCalls(func(_ broadcast.NginxAgentMessage, _ []string) bool {
+				if fakeBroadcaster.SendToCallCount() == 1 && test.applyToCanaries != nil {
+					test.applyToCanaries(deployment)
+				}
+				return true
+			})
+
+			deployment.FileLock.Lock()
+			updater.UpdateConfig(deployment, []File{newFile}, nil)
+			deployment.FileLock.Unlock()
+
+			// the soak period is waited out without holding the lock
+			g.Eventually(fakeBroadcaster.SendToCallCount).Should(Equal(len(test.expSendTo)))
+			g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(1 + test.expSend))
+			for i, ids := range test.expSendTo {
+				_, sentTo := fakeBroadcaster.SendToArgsForCall(i)
+				g.Expect(sentTo).To(Equal(ids))
+			}
+
+			// the result of a rollout that completes after the soak period is reported with a status update
+			if test.soakPeriod > 0 {
+				g.Eventually(statusQueue.Len).Should(Equal(1))
+			}
+
+			deployment.FileLock.RLock()
+			_, version := deployment.GetFileOverviews()
+			deployment.FileLock.RUnlock()
+			if !test.expAborted {
+				g.Expect(version).ToNot(Equal(oldVersion))
+				g.Expect(deployment.GetLatestConfigError()).ToNot(HaveOccurred())
+				return
+			}
+
+			// the canaries are reverted to the previous configuration
+			g.Expect(version).To(Equal(oldVersion))
+			msg, _ := fakeBroadcaster.SendToArgsForCall(1)
+			g.Expect(msg.ConfigVersion).To(Equal(oldVersion))
+			g.Expect(deployment.GetLatestConfigError()).To(MatchError(graph.ErrConfigRolloutAborted))
+
+			// the aborted configuration is not rolled out again
+			updater.UpdateConfig(deployment, []File{newFile}, nil)
+			g.Expect(fakeBroadcaster.SendToCallCount()).To(Equal(len(test.expSendTo)))
+			g.Expect(deployment.GetLatestConfigError()).To(MatchError(graph.ErrConfigRolloutAborted))
+
+			// until the rollout strategy changes
+			deployment.SetRolloutStrategy(nil)
+			updater.UpdateConfig(deployment, []File{newFile}, nil)
+			g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(2))
+		})
+	}
+}
+
+func TestUpdateConfig_StagedRolloutCanceled(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
+	fakeBroadcaster.SendReturns(true)
+	fakeBroadcaster.SendToReturns(true)
+
+	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), status.NewQueue(), nil, &events.FakeRecorder{}, false)
+	deployment := newDeployment(fakeBroadcaster, "gateway")
+
+	newFile := func(content string) File {
+		return File{
+			Meta:     &pb.FileMeta{Name: "test.conf", Hash: content},
+			Contents: []byte(content),
+		}
+	}
+
+	deployment.SetRolloutStrategy(&RolloutStrategy{Canary: intstr.FromInt32(1), SoakPeriod: time.Hour})
+	updater.UpdateConfig(deployment, []File{newFile("v1")}, nil)
+	g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(1))
+
+	for i := 1; i <= 2; i++ {
+		deployment.trackSubscription(fmt.Sprintf("pod%d", i), fmt.Sprintf("sub%d", i))
+		deployment.SetPodErrorStatus(fmt.Sprintf("pod%d", i), nil)
+	}
+
+	update := func(f File) {
+		deployment.FileLock.Lock()
+		defer deployment.FileLock.Unlock()
+
+		updater.UpdateConfig(deployment, []File{f}, nil)
+	}
+
+	// the rollout waits for its soak period without holding the lock
+	update(newFile("v2"))
+	g.Expect(fakeBroadcaster.SendToCallCount()).To(Equal(1))
+	g.Expect(deployment.FileLock.TryLock()).To(BeTrue())
+	deployment.FileLock.Unlock()
+
+	firstRollout := deployment.stagedRollout
+	g.Expect(firstRollout).ToNot(BeNil())
+
+	// the same configuration does not restart the rollout
+	update(newFile("v2"))
+	g.Expect(fakeBroadcaster.SendToCallCount()).To(Equal(1))
+	g.Expect(deployment.stagedRollout).To(BeIdenticalTo(firstRollout))
+
+	// a newer configuration cancels the rollout and starts a new one, which restores the configuration
+	// before the canceled rollout if it's aborted
+	update(newFile("v3"))
+	g.Expect(fakeBroadcaster.SendToCallCount()).To(Equal(2))
+	_, sentTo := fakeBroadcaster.SendToArgsForCall(1)
+	g.Expect(sentTo).To(Equal([]string{"sub1"}))
+	g.Expect(deployment.stagedRollout).ToNot(BeIdenticalTo(firstRollout))
+	g.Expect(deployment.stagedRollout.previousFiles).To(Equal([]File{newFile("v1")}))
+
+	// without a rollout strategy, the rollout is canceled and the configuration is sent to all pods
+	deployment.SetRolloutStrategy(nil)
+	update(newFile("v3"))
+	g.Expect(deployment.stagedRollout).To(BeNil())
+	g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(2))
+	g.Expect(fakeBroadcaster.SendToCallCount()).To(Equal(2))
+}
+
+func TestUpdateConfig_StagedRolloutPodConnectsDuringSoak(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
+	fakeBroadcaster.SendReturns(true)
+	fakeBroadcaster.SendToReturns(true)
+
+	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), status.NewQueue(), nil, &events.FakeRecorder{}, false)
+	deployment := newDeployment(fakeBroadcaster, "gateway")
+
+	newFile := func(content string) File {
+		return File{
+			Meta:     &pb.FileMeta{Name: "test.conf", Hash: content},
+			Contents: []byte(content),
+		}
+	}
+
+	deployment.SetRolloutStrategy(&RolloutStrategy{Canary: intstr.FromInt32(1), SoakPeriod: time.Hour})
+	updater.UpdateConfig(deployment, []File{newFile("v1")}, nil)
+	oldOverviews, oldVersion := deployment.GetFileOverviews()
+
+	for i := 1; i <= 2; i++ {
+		deployment.trackSubscription(fmt.Sprintf("pod%d", i), fmt.Sprintf("sub%d", i))
+		deployment.SetPodErrorStatus(fmt.Sprintf("pod%d", i), nil)
+	}
+
+	deployment.FileLock.Lock()
+	updater.UpdateConfig(deployment, []File{newFile("v2")}, nil)
+	deployment.FileLock.Unlock()
+
+	g.Expect(deployment.stagedRollout).ToNot(BeNil())
+	g.Expect(deployment.stagedRollout.canaries).To(Equal([]string{"pod1"}))
+	newOverviews, newVersion := deployment.GetFileOverviews()
+
+	// a pod that connects during the soak period gets the previous configuration, unless it's a canary
+	overviews, version := deployment.GetFileOverviewsForPod("pod3")
+	g.Expect(overviews).To(Equal(oldOverviews))
+	g.Expect(version).To(Equal(oldVersion))
+
+	overviews, version = deployment.GetFileOverviewsForPod("pod1")
+	g.Expect(overviews).To(Equal(newOverviews))
+	g.Expect(version).To(Equal(newVersion))
+
+	// both configurations can be downloaded
+	contents, _, found := deployment.GetFile("test.conf", "v1")
+	g.Expect(found).To(BeTrue())
+	g.Expect(contents).To(Equal([]byte("v1")))
+
+	contents, _, found = deployment.GetFile("test.conf", "v2")
+	g.Expect(found).To(BeTrue())
+	g.Expect(contents).To(Equal([]byte("v2")))
+
+	// the previous configuration is not a drift in a pod that is not a canary
+	reported := []*pb.File{{FileMeta: &pb.FileMeta{Name: "test.conf", Hash: "v1"}}}
+	g.Expect(deployment.getDriftedFiles("pod3", reported)).To(BeEmpty())
+	g.Expect(deployment.getDriftedFiles("pod1", reported)).To(Equal([]string{"test.conf"}))
+
+	// once the rollout ends, all pods get the current configuration
+	deployment.SetRolloutStrategy(nil)
+	deployment.FileLock.Lock()
+	updater.UpdateConfig(deployment, []File{newFile("v2")}, nil)
+	deployment.FileLock.Unlock()
+
+	g.Expect(deployment.stagedRollout).To(BeNil())
+	_, version = deployment.GetFileOverviewsForPod("pod3")
+	g.Expect(version).To(Equal(newVersion))
+
+	_, _, found = deployment.GetFile("test.conf", "v1")
+	g.Expect(found).To(BeFalse())
+}
+
+func TestSelectCanaries(t *testing.T) {
+	t.Parallel()
+
+	subscriptions := map[string]string{"pod3": "sub3", "pod1": "sub1", "pod2": "sub2"}
+
+	tests := []struct {
+		subscriptions map[string]string
+		name          string
+		canary        intstr.IntOrString
+		expCanaries   []string
+		expRemaining  []string
+	}{
+		{
+			name:          "one canary",
+			subscriptions: subscriptions,
+			canary:        intstr.FromInt32(1),
+			expCanaries:   []string{"pod1"},
+			expRemaining:  []string{"pod2", "pod3"},
+		},
+		{
+			name:          "percentage is rounded up",
+			subscriptions: subscriptions,
+			canary:        intstr.FromString("34%"),
+			expCanaries:   []string{"pod1", "pod2"},
+			expRemaining:  []string{"pod3"},
+		},
+		{
+			name:          "more canaries than pods",
+			subscriptions: subscriptions,
+			canary:        intstr.FromInt32(5),
+			expCanaries:   []string{"pod1", "pod2", "pod3"},
+		},
+		{
+			name:   "no pods",
+			canary: intstr.FromInt32(1),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			canaries, remaining := selectCanaries(test.subscriptions, test.canary)
+			g.Expect(canaries).To(Equal(test.expCanaries))
+			g.Expect(remaining).To(Equal(test.expRemaining))
+		})
+	}
+}
+
 func TestUpdateUpstreamServers(t *testing.T) {
 	t.Parallel()
 
@@ -166,7 +526,14 @@ func TestUpdateUpstreamServers(t *testing.T) {
 
 			fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-			updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, test.plus)
+			updater := NewNginxUpdater(
+				logr.Discard(),
+				fake.NewFakeClient(),
+				&status.Queue{},
+				nil,
+				&events.FakeRecorder{},
+				test.plus,
+			)
 			updater.retryTimeout = 0
 
 			deployment := &Deployment{
@@ -293,7 +660,7 @@ func TestUpdateUpstreamServers_NoChange(t *testing.T) {
 
 	fakeBroadcaster := &broadcastfakes.FakeBroadcaster{}
 
-	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, true)
+	updater := NewNginxUpdater(logr.Discard(), fake.NewFakeClient(), &status.Queue{}, nil, &events.FakeRecorder{}, true)
 	updater.retryTimeout = 0
 
 	deployment := &Deployment{
@@ -384,6 +751,150 @@ func TestUpdateUpstreamServers_NoChange(t *testing.T) {
 	g.Expect(fakeBroadcaster.SendCallCount()).To(Equal(0))
 }
 
+func TestBuildUpstreamServers(t *testing.T) {
+	t.Parallel()
+
+	endpoints := []resolver.Endpoint{
+		{
+			Address: "5.6.7.8",
+			Port:    8080,
+		},
+		{
+			Address: "1.2.3.4",
+			Port:    8080,
+		},
+	}
+
+	tests := []struct {
+		name       string
+		upstream   dataplane.Upstream
+		expServers []*structpb.Struct
+	}{
+		{
+			name: "no upstream settings",
+			upstream: dataplane.Upstream{
+				Name:      "test-upstream",
+				Endpoints: endpoints,
+			},
+			expServers: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("1.2.3.4:8080"),
+					},
+				},
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("5.6.7.8:8080"),
+					},
+				},
+			},
+		},
+		{
+			name: "passive health check and slow start settings",
+			upstream: dataplane.Upstream{
+				Name:      "test-upstream",
+				Endpoints: endpoints,
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					MaxFails:    helpers.GetPointer[int32](0),
+					FailTimeout: "30s",
+					SlowStart:   "1m",
+				},
+			},
+			expServers: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"server":       structpb.NewStringValue("1.2.3.4:8080"),
+						"max_fails":    structpb.NewNumberValue(0),
+						"fail_timeout": structpb.NewStringValue("30s"),
+						"slow_start":   structpb.NewStringValue("1m"),
+					},
+				},
+				{
+					Fields: map[string]*structpb.Value{
+						"server":       structpb.NewStringValue("5.6.7.8:8080"),
+						"max_fails":    structpb.NewNumberValue(0),
+						"fail_timeout": structpb.NewStringValue("30s"),
+						"slow_start":   structpb.NewStringValue("1m"),
+					},
+				},
+			},
+		},
+		{
+			name: "backup endpoints of a topology-aware upstream",
+			upstream: dataplane.Upstream{
+				Name: "test-upstream_zone_zone-a",
+				Zone: "zone-a",
+				Endpoints: []resolver.Endpoint{
+					{Address: "1.2.3.4", Port: 8080, ZoneHints: []string{"zone-a"}},
+					{Address: "5.6.7.8", Port: 8080, ZoneHints: []string{"zone-b"}, Backup: true},
+				},
+			},
+			expServers: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("1.2.3.4:8080"),
+					},
+				},
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("5.6.7.8:8080"),
+						"backup": structpb.NewBoolValue(true),
+					},
+				},
+			},
+		},
+		{
+			name: "draining endpoints",
+			upstream: dataplane.Upstream{
+				Name: "test-upstream",
+				Endpoints: []resolver.Endpoint{
+					{Address: "1.2.3.4", Port: 8080},
+					{Address: "5.6.7.8", Port: 8080, Drain: true},
+				},
+			},
+			expServers: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("1.2.3.4:8080"),
+					},
+				},
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue("5.6.7.8:8080"),
+						"drain":  structpb.NewBoolValue(true),
+					},
+				},
+			},
+		},
+		{
+			name: "no endpoints; settings are not applied to the 503 server",
+			upstream: dataplane.Upstream{
+				Name: "test-upstream",
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					MaxFails:    helpers.GetPointer[int32](3),
+					FailTimeout: "30s",
+				},
+			},
+			expServers: []*structpb.Struct{
+				{
+					Fields: map[string]*structpb.Value{
+						"server": structpb.NewStringValue(types.Nginx503Server),
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildUpstreamServers(test.upstream)).To(Equal(test.expServers))
+		})
+	}
+}
+
 func TestGetPortAndIPFormat(t *testing.T) {
 	t.Parallel()
 
@@ -6,6 +6,7 @@ import (
 	"sync/atomic"
 
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/apimachinery/pkg/util/uuid"
 )
 
@@ -17,6 +18,7 @@ import (
 type Broadcaster interface {
 	Subscribe() SubscriberChannels
 	Send(NginxAgentMessage) bool
+	SendTo(NginxAgentMessage, []string) bool
 	CancelSubscription(string)
 }
 
@@ -42,12 +44,18 @@ type storedChannels struct {
 	id          string
 }
 
+// publishRequest is a message to publish to the listeners with the given IDs, or to all listeners if ids is nil.
+type publishRequest struct {
+	ids     map[string]struct{}
+	message NginxAgentMessage
+}
+
 // DeploymentBroadcaster sends out a signal when an nginx Deployment has updated
 // configuration files. The signal is received by any agent Subscription that cares
 // about this Deployment. The agent Subscription will then send a response of whether or not
 // the configuration was successfully applied.
 type DeploymentBroadcaster struct {
-	publishCh chan NginxAgentMessage
+	publishCh chan publishRequest
 	subCh     chan storedChannels
 	unsubCh   chan string
 	listeners map[string]storedChannels
@@ -69,7 +77,7 @@ func NewDeploymentBroadcaster(ctx context.Context) *DeploymentBroadcaster {
 
 	broadcaster := &DeploymentBroadcaster{
 		listeners:         make(map[string]storedChannels),
-		publishCh:         make(chan NginxAgentMessage),
+		publishCh:         make(chan publishRequest),
 		subCh:             make(chan storedChannels),
 		unsubCh:           make(chan string),
 		doneCh:            make(chan int32),
@@ -121,9 +129,28 @@ func (b *DeploymentBroadcaster) Subscribe() SubscriberChannels {
 // Send the message to all listeners. Wait for all listeners to respond.
 // Returns true if at least one listener received and acknowledged the message.
 func (b *DeploymentBroadcaster) Send(message NginxAgentMessage) bool {
+	return b.publish(publishRequest{message: message})
+}
+
+// SendTo sends the message to the listeners with the given subscription IDs. Wait for those listeners to respond.
+// IDs of listeners that are no longer subscribed are ignored.
+// Returns true if at least one listener received and acknowledged the message.
+func (b *DeploymentBroadcaster) SendTo(message NginxAgentMessage, ids []string) bool {
+	req := publishRequest{
+		message: message,
+		ids:     make(map[string]struct{}, len(ids)),
+	}
+	for _, id := range ids {
+		req.ids[id] = struct{}{}
+	}
+
+	return b.publish(req)
+}
+
+func (b *DeploymentBroadcaster) publish(req publishRequest) bool {
 	// Try to send message, but can be interrupted by shutdown
 	select {
-	case b.publishCh <- message:
+	case b.publishCh <- req:
 	case <-b.broadcasterCtx.Done():
 		return false
 	}
@@ -184,10 +211,15 @@ func (b *DeploymentBroadcaster) publisher() {
 		select {
 		case <-b.broadcasterCtx.Done():
 			return
-		case msg := <-b.publishCh:
+		case req := <-b.publishCh:
+			msg := req.message
+
 			b.mu.RLock()
 			currentListeners := make(map[string]storedChannels, len(b.listeners))
 			for k, v := range b.listeners {
+				if _, ok := req.ids[k]; req.ids != nil && !ok {
+					continue
+				}
 				currentListeners[k] = v
 			}
 			b.mu.RUnlock()
@@ -251,6 +283,9 @@ type NginxAgentMessage struct {
 	NGINXPlusAction *pb.NGINXPlusAction
 	// FileOverviews contain the overviews of all files to be sent.
 	FileOverviews []*pb.File
+	// SpanContext is the span context of the operation that sent the message. It's used to trace
+	// sending the message to each agent as part of that operation.
+	SpanContext trace.SpanContext
 	// Type defines the type of message to be sent.
 	Type MessageType
 }
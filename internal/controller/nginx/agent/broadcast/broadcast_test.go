@@ -81,6 +81,42 @@ func TestSubscribe_MultipleListeners(t *testing.T) {
 	g.Eventually(sendDone).Should(Receive(BeTrue()))
 }
 
+func TestSendTo(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	broadcaster := broadcast.NewDeploymentBroadcaster(t.Context())
+
+	subscriber1 := broadcaster.Subscribe()
+	subscriber2 := broadcaster.Subscribe()
+
+	// Give time for both subscriptions to be processed by the subscriber goroutine
+	time.Sleep(10 * time.Millisecond)
+
+	message := broadcast.NginxAgentMessage{
+		ConfigVersion: "v1",
+		Type:          broadcast.ConfigApplyRequest,
+	}
+
+	sendDone := make(chan bool)
+	go func() {
+		result := broadcaster.SendTo(message, []string{subscriber2.ID, "unknown"})
+		sendDone <- result
+	}()
+
+	// Only the selected subscriber should receive the message
+	g.Eventually(subscriber2.ListenCh).Should(Receive(Equal(message)))
+	g.Consistently(subscriber1.ListenCh).ShouldNot(Receive())
+
+	subscriber2.ResponseCh <- struct{}{}
+
+	g.Eventually(sendDone).Should(Receive(BeTrue()))
+
+	// no selected subscribers
+	g.Expect(broadcaster.SendTo(message, nil)).To(BeFalse())
+	g.Consistently(subscriber1.ListenCh).ShouldNot(Receive())
+}
+
 func TestSubscribe_NoListeners(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -24,6 +24,18 @@ type FakeBroadcaster struct {
 	sendReturnsOnCall map[int]struct {
 		result1 bool
 	}
+	SendToStub        func(broadcast.NginxAgentMessage, []string) bool
+	sendToMutex       sync.RWMutex
+	sendToArgsForCall []struct {
+		arg1 broadcast.NginxAgentMessage
+		arg2 []string
+	}
+	sendToReturns struct {
+		result1 bool
+	}
+	sendToReturnsOnCall map[int]struct {
+		result1 bool
+	}
 	SubscribeStub        func() broadcast.SubscriberChannels
 	subscribeMutex       sync.RWMutex
 	subscribeArgsForCall []struct {
@@ -131,6 +143,73 @@ func (fake *FakeBroadcaster) SendReturnsOnCall(i int, result1 bool) {
 	}{result1}
 }
 
+func (fake *FakeBroadcaster) SendTo(arg1 broadcast.NginxAgentMessage, arg2 []string) bool {
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.sendToMutex.Lock()
+	ret, specificReturn := fake.sendToReturnsOnCall[len(fake.sendToArgsForCall)]
+	fake.sendToArgsForCall = append(fake.sendToArgsForCall, struct {
+		arg1 broadcast.NginxAgentMessage
+		arg2 []string
+	}{arg1, arg2Copy})
+	stub := fake.SendToStub
+	fakeReturns := fake.sendToReturns
+	fake.recordInvocation("SendTo", []interface{}{arg1, arg2Copy})
+	fake.sendToMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBroadcaster) SendToCallCount() int {
+	fake.sendToMutex.RLock()
+	defer fake.sendToMutex.RUnlock()
+	return len(fake.sendToArgsForCall)
+}
+
+func (fake *FakeBroadcaster) SendToCalls(stub func(broadcast.NginxAgentMessage, []string) bool) {
+	fake.sendToMutex.Lock()
+	defer fake.sendToMutex.Unlock()
+	fake.SendToStub = stub
+}
+
+func (fake *FakeBroadcaster) SendToArgsForCall(i int) (broadcast.NginxAgentMessage, []string) {
+	fake.sendToMutex.RLock()
+	defer fake.sendToMutex.RUnlock()
+	argsForCall := fake.sendToArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBroadcaster) SendToReturns(result1 bool) {
+	fake.sendToMutex.Lock()
+	defer fake.sendToMutex.Unlock()
+	fake.SendToStub = nil
+	fake.sendToReturns = struct {
+		result1 bool
+	}{result1}
+}
+
+func (fake *FakeBroadcaster) SendToReturnsOnCall(i int, result1 bool) {
+	fake.sendToMutex.Lock()
+	defer fake.sendToMutex.Unlock()
+	fake.SendToStub = nil
+	if fake.sendToReturnsOnCall == nil {
+		fake.sendToReturnsOnCall = make(map[int]struct {
+			result1 bool
+		})
+	}
+	fake.sendToReturnsOnCall[i] = struct {
+		result1 bool
+	}{result1}
+}
+
 func (fake *FakeBroadcaster) Subscribe() broadcast.SubscriberChannels {
 	fake.subscribeMutex.Lock()
 	ret, specificReturn := fake.subscribeReturnsOnCall[len(fake.subscribeArgsForCall)]
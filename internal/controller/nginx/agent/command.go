@@ -5,31 +5,45 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/google/uuid"
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 	grpcStatus "google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/timestamppb"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 	grpcContext "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/context"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/messenger"
 	nginxTypes "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/otlp"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
 )
 
-const connectionWaitTimeout = 30 * time.Second
+const (
+	connectionWaitTimeout = 30 * time.Second
+
+	// configResyncRetryInterval is how long a configuration drift correction waits before it is retried,
+	// when a config update to the deployment was in progress.
+	configResyncRetryInterval = time.Second
+)
 
 // commandService handles the connection and subscription to the data plane agent.
 type commandService struct {
@@ -39,6 +53,8 @@ type commandService struct {
 	resetConnChan     <-chan struct{}
 	connTracker       agentgrpc.ConnectionsTracker
 	k8sReader         client.Reader
+	eventRecorder     events.EventRecorder
+	configEvents      *configEventRecorder
 	logger            logr.Logger
 	connectionTimeout time.Duration
 }
@@ -50,10 +66,13 @@ func newCommandService(
 	connTracker agentgrpc.ConnectionsTracker,
 	statusQueue *status.Queue,
 	resetConnChan <-chan struct{},
+	eventRecorder events.EventRecorder,
 ) *commandService {
 	return &commandService{
 		connectionTimeout: connectionWaitTimeout,
 		k8sReader:         reader,
+		eventRecorder:     eventRecorder,
+		configEvents:      newConfigEventRecorder(logger, reader, eventRecorder),
 		logger:            logger,
 		connTracker:       connTracker,
 		nginxDeployments:  depStore,
@@ -106,6 +125,8 @@ func (cs *commandService) CreateConnection(
 	conn := agentgrpc.Connection{
 		ParentName: name,
 		ParentType: depType,
+		PodName:    podName,
+		PodIP:      getPeerIP(ctx),
 		InstanceID: getNginxInstanceID(resource.GetInstances()),
 	}
 	cs.connTracker.Track(grpcInfo.UUID, conn)
@@ -172,12 +193,14 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 	// subscribe to the deployment broadcaster to get file updates
 	broadcaster := deployment.GetBroadcaster()
 	channels := broadcaster.Subscribe()
+	deployment.trackSubscription(grpcInfo.UUID, channels.ID)
 
 	if err := cs.setInitialConfig(ctx, &grpcInfo, deployment, conn, msgr); err != nil {
 		// Cancel subscription BEFORE releasing lock, this should help in cleaning
 		// up any channels or goroutines that are waiting on this subscription.
 		// This should also help in preventing the broadcaster from sending messages to this
 		// subscriber while we're trying to clean up and return due to the error.
+		deployment.untrackSubscription(grpcInfo.UUID)
 		broadcaster.CancelSubscription(channels.ID)
 		deployment.FileLock.RUnlock()
 		return err
@@ -185,6 +208,16 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 
 	deployment.FileLock.RUnlock()
 	defer broadcaster.CancelSubscription(channels.ID)
+	defer deployment.untrackSubscription(grpcInfo.UUID)
+
+	// subscribe to drift notifications from the file service, so that the desired files can be pushed
+	// back to the agent if the configuration in the Pod was changed
+	resyncCh := deployment.subscribeConfigResync(grpcInfo.UUID)
+	defer deployment.unsubscribeConfigResync(grpcInfo.UUID)
+
+	// resyncRetryCh fires when a drift correction that was deferred by a config update needs to be retried.
+	// It is nil if no correction is deferred.
+	var resyncRetryCh <-chan time.Time
 
 	// pendingCorrelationID tracks the correlation ID of the in-flight broadcast request so that
 	// a stray or duplicate response (e.g. a delayed response from a previous request) can be
@@ -195,6 +228,42 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 	// An empty value means there is no broadcast request currently in flight.
 	var pendingCorrelationID string
 
+	// pushSpan traces the in-flight broadcast request until the agent responds to it.
+	var pushSpan trace.Span
+	endPushSpan := func(err error) {
+		if pushSpan == nil {
+			return
+		}
+
+		if err != nil {
+			pushSpan.RecordError(err)
+			pushSpan.SetStatus(otelcodes.Error, err.Error())
+		}
+
+		pushSpan.End()
+		pushSpan = nil
+	}
+	defer func() {
+		endPushSpan(errors.New("subscription ended before the agent responded"))
+	}()
+
+	// resync corrects configuration drift in the Pod. The correction is deferred while a config update is in
+	// progress: a broadcast in flight might not push the files to the agent (e.g. an NGINX Plus API request),
+	// and the subscription can't wait for the update to release the deployment FileLock.
+	resync := func() error {
+		if pendingCorrelationID != "" {
+			resyncRetryCh = time.After(configResyncRetryInterval)
+			return nil
+		}
+
+		corrected, err := cs.correctConfigDrift(ctx, &grpcInfo, deployment, conn, msgr)
+		if err == nil && !corrected {
+			resyncRetryCh = time.After(configResyncRetryInterval)
+		}
+
+		return err
+	}
+
 	for {
 		// When a message is received over the ListenCh, it is assumed and required that the
 		// deployment object is already LOCKED. This lock is acquired by the event handler before calling
@@ -215,6 +284,16 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			}
 			return grpcStatus.Error(codes.Unavailable, "TLS files updated")
 		case msg := <-channels.ListenCh:
+			_, pushSpan = otlp.Tracer().Start(
+				trace.ContextWithSpanContext(ctx, msg.SpanContext),
+				"push to nginx agent",
+				trace.WithAttributes(
+					attribute.String("agent.uuid", grpcInfo.UUID),
+					attribute.String("k8s.deployment.name", conn.ParentName.String()),
+					attribute.String("nginx.config.version", msg.ConfigVersion),
+				),
+			)
+
 			var req *pb.ManagementPlaneRequest
 			switch msg.Type {
 			case broadcast.ConfigApplyRequest:
@@ -237,11 +316,13 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			default:
 				panic(fmt.Sprintf("unknown request type %d", msg.Type))
 			}
+			pushSpan.SetAttributes(attribute.String("correlation_id", req.GetMessageMeta().GetCorrelationId()))
 
 			if err := msgr.Send(ctx, req); err != nil {
 				cs.logger.Error(err, "error sending request to agent")
 				deployment.SetPodErrorStatus(grpcInfo.UUID, err)
 				trySignalBroadcastResponse(channels.ResponseCh)
+				endPushSpan(err)
 
 				return grpcStatus.Error(codes.Internal, err.Error())
 			}
@@ -249,11 +330,21 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			// Track this broadcast request to distinguish it from initial config operations.
 			// Only broadcast operations should signal ResponseCh for coordination.
 			pendingCorrelationID = req.GetMessageMeta().GetCorrelationId()
+		case <-resyncCh:
+			if err := resync(); err != nil {
+				return err
+			}
+		case <-resyncRetryCh:
+			resyncRetryCh = nil
+			if err := resync(); err != nil {
+				return err
+			}
 		case err = <-msgr.Errors():
 			cs.logger.Error(err, "connection error", conn.ParentType, conn.ParentName, "uuid", grpcInfo.UUID)
 			deployment.SetPodErrorStatus(grpcInfo.UUID, err)
 			if pendingCorrelationID != "" {
 				trySignalBroadcastResponse(channels.ResponseCh)
+				endPushSpan(err)
 				cs.logger.V(1).Info("Connection error during pending request, operation failed", "uuid", grpcInfo.UUID)
 			}
 
@@ -274,20 +365,27 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 			}
 
 			res := msg.GetCommandResponse()
+			var resErr error
 			if res.GetStatus() != pb.CommandResponse_COMMAND_STATUS_OK {
 				if isRollbackMessage(res.GetMessage()) {
-					// we don't care about these messages, so ignore them
+					// the failure itself was already reported, so only record the rollback
+					cs.configEvents.rolledBack(ctx, deployment, conn, res.GetMessage())
 					continue
 				}
-				err := fmt.Errorf("msg: %s; error: %s", res.GetMessage(), res.GetError())
-				deployment.SetPodErrorStatus(grpcInfo.UUID, err)
-			} else {
-				deployment.SetPodErrorStatus(grpcInfo.UUID, nil)
+				resErr = fmt.Errorf("msg: %s; error: %s", res.GetMessage(), res.GetError())
+			}
+			deployment.SetPodErrorStatus(grpcInfo.UUID, resErr)
+
+			// the config update holds the deployment FileLock until the broadcast response is signaled
+			var files []File
+			if resErr != nil && pendingCorrelationID != "" {
+				files = deployment.getFilesForPod(grpcInfo.UUID)
 			}
 
 			// Signal broadcast completion only for tracked broadcast operations.
 			// Initial config responses are ignored to prevent spurious success messages.
 			if pendingCorrelationID != "" {
+				endPushSpan(resErr)
 				signalBroadcastResponse(ctx, channels.ResponseCh)
 				pendingCorrelationID = ""
 			} else {
@@ -297,6 +395,10 @@ func (cs *commandService) Subscribe(in pb.CommandService_SubscribeServer) error
 					"uuid", grpcInfo.UUID,
 				)
 			}
+
+			if resErr != nil {
+				cs.configEvents.applyFailed(ctx, deployment, conn, files, resErr)
+			}
 		}
 	}
 }
@@ -363,7 +465,7 @@ func (cs *commandService) setInitialConfig(
 		return grpcStatus.Errorf(codes.FailedPrecondition, "nginx image version validation failed: %s", err.Error())
 	}
 
-	fileOverviews, configVersion := deployment.GetFileOverviews()
+	fileOverviews, configVersion := deployment.GetFileOverviewsForPod(grpcInfo.UUID)
 
 	cs.logger.Info(
 		"Sending initial configuration to agent",
@@ -386,6 +488,10 @@ func (cs *commandService) setInitialConfig(
 		return connErr
 	}
 
+	if applyErr != nil {
+		cs.configEvents.applyFailed(ctx, deployment, conn, deployment.getFilesForPod(grpcInfo.UUID), applyErr)
+	}
+
 	errs := []error{applyErr}
 	for _, action := range deployment.GetNGINXPlusActions() {
 		// retry the API update request because sometimes nginx isn't quite ready after the config apply reload
@@ -435,6 +541,98 @@ func (cs *commandService) setInitialConfig(
 	return nil
 }
 
+// correctConfigDrift pushes the desired configuration back to the agent after the file service detected
+// that the configuration in the Pod drifted from it. If a config update holds the deployment FileLock, the
+// correction is not attempted and false is returned, so that the caller can retry it later.
+func (cs *commandService) correctConfigDrift(
+	ctx context.Context,
+	grpcInfo *grpcContext.GrpcInfo,
+	deployment *Deployment,
+	conn *agentgrpc.Connection,
+	msgr messenger.Messenger,
+) (bool, error) {
+	if !deployment.FileLock.TryRLock() {
+		return false, nil
+	}
+	defer deployment.FileLock.RUnlock()
+
+	fileOverviews, configVersion := deployment.GetFileOverviewsForPod(grpcInfo.UUID)
+
+	cs.logger.Info(
+		"Pushing desired configuration to agent to correct configuration drift",
+		conn.ParentType, conn.ParentName,
+		"pod", conn.PodName,
+		"uuid", grpcInfo.UUID,
+		"configVersion", configVersion,
+	)
+
+	req := buildRequest(fileOverviews, conn.InstanceID, configVersion)
+	if err := msgr.Send(ctx, req); err != nil {
+		cs.logger.Error(err, "error sending request to agent")
+		deployment.SetPodErrorStatus(grpcInfo.UUID, err)
+
+		return true, grpcStatus.Error(codes.Internal, err.Error())
+	}
+
+	applyErr, connErr := cs.waitForInitialConfigApply(ctx, msgr, req.GetMessageMeta().GetCorrelationId())
+	if connErr != nil {
+		cs.logger.Error(connErr, "error correcting configuration drift", "uuid", grpcInfo.UUID)
+
+		return true, connErr
+	}
+
+	cs.sendConfigDriftEvent(ctx, deployment, conn, applyErr)
+
+	if applyErr != nil {
+		// the Pod no longer runs the desired configuration, so report the error in the Gateway status
+		cs.logAndSendErrorStatus(grpcInfo, deployment, conn, applyErr)
+	} else {
+		// only restored configurations count as corrections
+		deployment.recordConfigDriftCorrection()
+	}
+
+	return true, nil
+}
+
+// sendConfigDriftEvent emits an Event on the Gateway when configuration drift was corrected in one of its Pods.
+func (cs *commandService) sendConfigDriftEvent(
+	ctx context.Context,
+	deployment *Deployment,
+	conn *agentgrpc.Connection,
+	applyErr error,
+) {
+	var gw gatewayv1.Gateway
+	gwNsName := types.NamespacedName{Namespace: conn.ParentName.Namespace, Name: deployment.GetGatewayName()}
+	if err := cs.k8sReader.Get(ctx, gwNsName, &gw); err != nil {
+		cs.logger.Error(err, "error getting Gateway for configuration drift event", "gateway", gwNsName)
+		return
+	}
+
+	if applyErr != nil {
+		cs.eventRecorder.Eventf(
+			&gw,
+			nil,
+			v1.EventTypeWarning,
+			"ConfigDriftCorrectionFailed",
+			"CorrectConfigDrift",
+			"Failed to restore the desired nginx configuration in Pod %s: %s",
+			conn.PodName,
+			applyErr.Error(),
+		)
+		return
+	}
+
+	cs.eventRecorder.Eventf(
+		&gw,
+		nil,
+		v1.EventTypeNormal,
+		"ConfigDriftCorrected",
+		"CorrectConfigDrift",
+		"Restored the desired nginx configuration in Pod %s after it was changed",
+		conn.PodName,
+	)
+}
+
 // waitForInitialConfigApply waits for the nginx agent to respond after a Subscriber attempts
 // to apply its initial config. It ignores any response whose correlation ID doesn't match
 // expectedCorrelationID.
@@ -535,6 +733,21 @@ func buildRequest(fileOverviews []*pb.File, instanceID, version string) *pb.Mana
 	}
 }
 
+// getPeerIP returns the IP address of the agent that the request came from, or an empty string if it's unknown.
+func getPeerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return ""
+	}
+
+	return host
+}
+
 func isRollbackMessage(msg string) bool {
 	msgToLower := strings.ToLower(msg)
 	return strings.Contains(msgToLower, "rollback successful") ||
@@ -752,9 +965,84 @@ func parseOwnerName(ownerName string) (types.NamespacedName, bool) {
 }
 
 // UpdateDataPlaneHealth includes full health information about the data plane as reported by the agent.
-func (*commandService) UpdateDataPlaneHealth(
-	context.Context,
-	*pb.UpdateDataPlaneHealthRequest,
+// The health of the nginx instance is recorded for the agent's Pod, and the Gateway status is updated
+// if the health changed.
+func (cs *commandService) UpdateDataPlaneHealth(
+	ctx context.Context,
+	req *pb.UpdateDataPlaneHealthRequest,
 ) (*pb.UpdateDataPlaneHealthResponse, error) {
+	if req == nil {
+		return nil, errors.New("empty UpdateDataPlaneHealth request")
+	}
+
+	grpcInfo, ok := grpcContext.FromContext(ctx)
+	if !ok {
+		return nil, agentgrpc.ErrStatusInvalidConnection
+	}
+
+	conn := cs.connTracker.GetConnection(grpcInfo.UUID)
+	if !conn.Ready() {
+		return nil, grpcStatus.Errorf(codes.FailedPrecondition, "connection for agent %q is not ready", grpcInfo.UUID)
+	}
+
+	deployment := cs.nginxDeployments.Get(conn.ParentName)
+	if deployment == nil {
+		return nil, grpcStatus.Errorf(codes.NotFound, "deployment %q not found", conn.ParentName.String())
+	}
+
+	health, reported := buildPodHealth(conn, req.GetInstanceHealths())
+	if !reported {
+		return &pb.UpdateDataPlaneHealthResponse{}, nil
+	}
+
+	if deployment.SetPodHealth(grpcInfo.UUID, health) {
+		if !health.Healthy {
+			cs.logger.Info(
+				"nginx data plane is unhealthy",
+				"pod", health.PodName,
+				"description", health.Description,
+				conn.ParentType, conn.ParentName,
+			)
+		}
+
+		cs.statusQueue.Enqueue(&status.QueueObject{
+			Deployment: status.Deployment{
+				NamespacedName: conn.ParentName,
+				GatewayName:    deployment.GetGatewayName(),
+			},
+			UpdateType: status.UpdateAll,
+		})
+	}
+
 	return &pb.UpdateDataPlaneHealthResponse{}, nil
 }
+
+// buildPodHealth builds the health of the nginx instance for the connection from the instance healths
+// reported by the agent. Healths of other instances, such as the agent itself, are ignored.
+// Returns false if the agent did not report the health of the nginx instance.
+func buildPodHealth(conn agentgrpc.Connection, instanceHealths []*pb.InstanceHealth) (PodHealth, bool) {
+	health := PodHealth{
+		PodName: conn.PodName,
+		Healthy: true,
+	}
+
+	var reported bool
+	for _, instanceHealth := range instanceHealths {
+		if instanceHealth.GetInstanceId() != conn.InstanceID {
+			continue
+		}
+
+		switch instanceHealth.GetInstanceHealthStatus() {
+		case pb.InstanceHealth_INSTANCE_HEALTH_STATUS_HEALTHY:
+			reported = true
+		case pb.InstanceHealth_INSTANCE_HEALTH_STATUS_UNHEALTHY,
+			pb.InstanceHealth_INSTANCE_HEALTH_STATUS_DEGRADED:
+			reported = true
+			health.Healthy = false
+			health.Description = instanceHealth.GetDescription()
+		default:
+		}
+	}
+
+	return health, reported
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"net"
 	"testing"
 	"time"
 
@@ -11,14 +12,18 @@ import (
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	. "github.com/onsi/gomega"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/structpb"
 	appsv1 "k8s.io/api/apps/v1"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/events"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
@@ -412,6 +417,7 @@ func TestCreateConnection(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&events.FakeRecorder{},
 			)
 
 			resp, err := cs.CreateConnection(test.ctx, test.request)
@@ -430,6 +436,7 @@ func TestCreateConnection(t *testing.T) {
 			expConn := agentgrpc.Connection{
 				ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
 				ParentType: nginxTypes.DeploymentType,
+				PodName:    "nginx-pod",
 				InstanceID: "nginx-id",
 			}
 
@@ -489,6 +496,41 @@ func verifyResponse(
 	}).Should(Equal(struct{}{}))
 }
 
+func TestGetPeerIP(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ctx      context.Context
+		name     string
+		expected string
+	}{
+		{
+			name:     "IPv4 peer",
+			ctx:      peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 443}}),
+			expected: "10.0.0.1",
+		},
+		{
+			name:     "IPv6 peer",
+			ctx:      peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("fd00::1"), Port: 443}}),
+			expected: "fd00::1",
+		},
+		{
+			name:     "no peer",
+			ctx:      t.Context(),
+			expected: "",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(getPeerIP(test.ctx)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestSubscribe(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -512,6 +554,7 @@ func TestSubscribe(t *testing.T) {
 		&connTracker,
 		status.NewQueue(),
 		nil,
+		&events.FakeRecorder{},
 	)
 
 	broadcaster := &broadcastfakes.FakeBroadcaster{}
@@ -634,6 +677,256 @@ func TestSubscribe(t *testing.T) {
 	g.Expect(deployment.podStatuses).ToNot(HaveKey("1234567"))
 }
 
+func TestSubscribe_ConfigDrift(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connTracker := agentgrpcfakes.FakeConnectionsTracker{}
+	conn := agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+		ParentType: nginxTypes.DeploymentType,
+		PodName:    "nginx-pod",
+		InstanceID: "nginx-id",
+	}
+	connTracker.GetConnectionReturns(conn)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(Succeed())
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "test",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(append(getDefaultResources(), gw)...).
+		WithIndex(&v1.Pod{}, "metadata.name", func(obj client.Object) []string {
+			return []string{obj.GetName()}
+		}).
+		Build()
+
+	recorder := events.NewFakeRecorder(1)
+
+	store := NewDeploymentStore(&connTracker)
+	cs := newCommandService(
+		logr.Discard(),
+		fakeClient,
+		store,
+		&connTracker,
+		status.NewQueue(),
+		nil,
+		recorder,
+	)
+
+	broadcaster := &broadcastfakes.FakeBroadcaster{}
+	broadcaster.SubscribeReturns(broadcast.SubscriberChannels{
+		ListenCh:   make(chan broadcast.NginxAgentMessage),
+		ResponseCh: make(chan struct{}),
+	})
+
+	deployment := store.StoreWithBroadcaster(conn.ParentName, broadcaster, "gateway")
+	deployment.SetFiles([]File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "nginx.conf",
+				Hash: "12345",
+			},
+			Contents: []byte("file contents"),
+		},
+	}, []v1.VolumeMount{})
+	deployment.SetImageVersion("nginx:v1.0.0")
+
+	ctx, cancel := createGrpcContextWithCancel(t)
+	defer cancel()
+
+	mockServer := newMockSubscribeServer(ctx)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- cs.Subscribe(mockServer)
+	}()
+
+	expFile := &pb.File{
+		FileMeta: &pb.FileMeta{
+			Name: "nginx.conf",
+			Hash: "12345",
+		},
+	}
+
+	// initial config
+	ensureFileWasSent(g, mockServer, expFile)
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status: pb.CommandResponse_COMMAND_STATUS_OK,
+		},
+	}
+
+	g.Eventually(func() bool {
+		return deployment.requestConfigResync("1234567")
+	}).Should(BeTrue())
+
+	// the desired files are pushed back to the agent after drift is detected
+	ensureFileWasSent(g, mockServer, expFile)
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status: pb.CommandResponse_COMMAND_STATUS_OK,
+		},
+	}
+
+	g.Eventually(deployment.GetConfigDriftCorrections).Should(Equal(uint64(1)))
+	g.Eventually(recorder.Events).Should(Receive(ContainSubstring("ConfigDriftCorrected")))
+
+	cancel()
+
+	g.Eventually(func() error {
+		return <-errCh
+	}).Should(MatchError(ContainSubstring("context canceled")))
+
+	g.Expect(deployment.requestConfigResync("1234567")).To(BeFalse())
+}
+
+func TestSubscribe_ConfigDriftDuringBroadcast(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connTracker := agentgrpcfakes.FakeConnectionsTracker{}
+	conn := agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+		ParentType: nginxTypes.DeploymentType,
+		PodName:    "nginx-pod",
+		InstanceID: "nginx-id",
+	}
+	connTracker.GetConnectionReturns(conn)
+
+	scheme := runtime.NewScheme()
+	g.Expect(clientgoscheme.AddToScheme(scheme)).To(Succeed())
+	g.Expect(gatewayv1.Install(scheme)).To(Succeed())
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "gateway",
+			Namespace: "test",
+		},
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithRuntimeObjects(append(getDefaultResources(), gw)...).
+		WithIndex(&v1.Pod{}, "metadata.name", func(obj client.Object) []string {
+			return []string{obj.GetName()}
+		}).
+		Build()
+
+	recorder := events.NewFakeRecorder(1)
+
+	store := NewDeploymentStore(&connTracker)
+	cs := newCommandService(
+		logr.Discard(),
+		fakeClient,
+		store,
+		&connTracker,
+		status.NewQueue(),
+		nil,
+		recorder,
+	)
+
+	listenCh := make(chan broadcast.NginxAgentMessage)
+	responseCh := make(chan struct{})
+	broadcaster := &broadcastfakes.FakeBroadcaster{}
+	broadcaster.SubscribeReturns(broadcast.SubscriberChannels{
+		ListenCh:   listenCh,
+		ResponseCh: responseCh,
+	})
+
+	deployment := store.StoreWithBroadcaster(conn.ParentName, broadcaster, "gateway")
+	deployment.SetFiles([]File{
+		{
+			Meta: &pb.FileMeta{
+				Name: "nginx.conf",
+				Hash: "12345",
+			},
+			Contents: []byte("file contents"),
+		},
+	}, []v1.VolumeMount{})
+	deployment.SetImageVersion("nginx:v1.0.0")
+
+	ctx, cancel := createGrpcContextWithCancel(t)
+	defer cancel()
+
+	mockServer := newMockSubscribeServer(ctx)
+
+	errCh := make(chan error)
+	go func() {
+		errCh <- cs.Subscribe(mockServer)
+	}()
+
+	expFile := &pb.File{
+		FileMeta: &pb.FileMeta{
+			Name: "nginx.conf",
+			Hash: "12345",
+		},
+	}
+
+	// initial config
+	ensureFileWasSent(g, mockServer, expFile)
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status: pb.CommandResponse_COMMAND_STATUS_OK,
+		},
+	}
+
+	// the event handler holds the FileLock during a config update
+	deployment.FileLock.Lock()
+
+	action := &pb.NGINXPlusAction{
+		Action: &pb.NGINXPlusAction_UpdateHttpUpstreamServers{},
+	}
+	listenCh <- broadcast.NginxAgentMessage{
+		Type:            broadcast.APIRequest,
+		NGINXPlusAction: action,
+	}
+	ensureAPIRequestWasSent(g, mockServer, action)
+
+	// drift is detected while the API request is in flight
+	g.Expect(deployment.requestConfigResync("1234567")).To(BeTrue())
+
+	verifyResponse(g, mockServer, responseCh)
+	deployment.FileLock.Unlock()
+
+	// the deferred correction pushes the desired files to the agent after the config update
+	g.Eventually(func() *pb.ManagementPlaneRequest {
+		select {
+		case req := <-mockServer.sendChan:
+			return req
+		default:
+			return nil
+		}
+	}).WithTimeout(3 * configResyncRetryInterval).Should(
+		WithTransform(func(req *pb.ManagementPlaneRequest) []*pb.File {
+			return req.GetConfigApplyRequest().GetOverview().GetFiles()
+		}, ContainElement(expFile)),
+	)
+
+	mockServer.recvChan <- &pb.DataPlaneResponse{
+		CommandResponse: &pb.CommandResponse{
+			Status:  pb.CommandResponse_COMMAND_STATUS_ERROR,
+			Message: "apply failed",
+		},
+	}
+
+	// a failed correction is not counted
+	g.Eventually(recorder.Events).Should(Receive(ContainSubstring("ConfigDriftCorrectionFailed")))
+	g.Expect(deployment.GetConfigDriftCorrections()).To(BeZero())
+
+	cancel()
+
+	g.Eventually(func() error {
+		return <-errCh
+	}).Should(MatchError(ContainSubstring("context canceled")))
+}
+
 func TestSubscribe_Reset(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -658,6 +951,7 @@ func TestSubscribe_Reset(t *testing.T) {
 		&connTracker,
 		status.NewQueue(),
 		resetChan,
+		&events.FakeRecorder{},
 	)
 
 	broadcaster := &broadcastfakes.FakeBroadcaster{}
@@ -764,6 +1058,7 @@ func TestSubscribe_Errors(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&events.FakeRecorder{},
 			)
 
 			if test.setup != nil {
@@ -890,6 +1185,7 @@ func TestSetInitialConfig_Errors(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&events.FakeRecorder{},
 			)
 
 			conn := &agentgrpc.Connection{
@@ -996,6 +1292,7 @@ func TestUpdateDataPlaneStatus(t *testing.T) {
 				&connTracker,
 				status.NewQueue(),
 				nil,
+				&events.FakeRecorder{},
 			)
 
 			resp, err := cs.UpdateDataPlaneStatus(test.ctx, test.request)
@@ -1024,21 +1321,191 @@ func TestUpdateDataPlaneStatus(t *testing.T) {
 
 func TestUpdateDataPlaneHealth(t *testing.T) {
 	t.Parallel()
-	g := NewWithT(t)
 
-	connTracker := agentgrpcfakes.FakeConnectionsTracker{}
+	readyConn := agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+		ParentType: nginxTypes.DeploymentType,
+		PodName:    "nginx-pod",
+		InstanceID: "nginx-id",
+	}
 
-	cs := newCommandService(
-		logr.Discard(),
-		fake.NewFakeClient(),
-		NewDeploymentStore(&connTracker),
-		&connTracker,
-		status.NewQueue(),
-		nil,
-	)
+	unhealthyRequest := &pb.UpdateDataPlaneHealthRequest{
+		InstanceHealths: []*pb.InstanceHealth{
+			{
+				InstanceId:           "agent-id",
+				InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_HEALTHY,
+			},
+			{
+				InstanceId:           "nginx-id",
+				InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_UNHEALTHY,
+				Description:          "nginx is not running",
+			},
+		},
+	}
 
-	resp, err := cs.UpdateDataPlaneHealth(t.Context(), &pb.UpdateDataPlaneHealthRequest{})
+	tests := []struct {
+		ctx           context.Context
+		request       *pb.UpdateDataPlaneHealthRequest
+		existing      *PodHealth
+		conn          agentgrpc.Connection
+		name          string
+		errString     string
+		expHealth     []PodHealth
+		noDeployment  bool
+		expStatusSent bool
+	}{
+		{
+			name:          "records unhealthy instance",
+			ctx:           createGrpcContext(t),
+			conn:          readyConn,
+			request:       unhealthyRequest,
+			expHealth:     []PodHealth{{PodName: "nginx-pod", Description: "nginx is not running"}},
+			expStatusSent: true,
+		},
+		{
+			name: "records degraded instance as unhealthy",
+			ctx:  createGrpcContext(t),
+			conn: readyConn,
+			request: &pb.UpdateDataPlaneHealthRequest{
+				InstanceHealths: []*pb.InstanceHealth{
+					{
+						InstanceId:           "nginx-id",
+						InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_DEGRADED,
+						Description:          "degraded",
+					},
+				},
+			},
+			expHealth:     []PodHealth{{PodName: "nginx-pod", Description: "degraded"}},
+			expStatusSent: true,
+		},
+		{
+			name:     "records healthy instance",
+			ctx:      createGrpcContext(t),
+			conn:     readyConn,
+			existing: &PodHealth{PodName: "nginx-pod", Description: "nginx is not running"},
+			request: &pb.UpdateDataPlaneHealthRequest{
+				InstanceHealths: []*pb.InstanceHealth{
+					{
+						InstanceId:           "nginx-id",
+						InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_HEALTHY,
+					},
+				},
+			},
+			expHealth:     []PodHealth{{PodName: "nginx-pod", Healthy: true}},
+			expStatusSent: true,
+		},
+		{
+			name:      "unchanged health does not update status",
+			ctx:       createGrpcContext(t),
+			conn:      readyConn,
+			existing:  &PodHealth{PodName: "nginx-pod", Description: "nginx is not running"},
+			request:   unhealthyRequest,
+			expHealth: []PodHealth{{PodName: "nginx-pod", Description: "nginx is not running"}},
+		},
+		{
+			name: "ignores unspecified health and other instances",
+			ctx:  createGrpcContext(t),
+			conn: readyConn,
+			request: &pb.UpdateDataPlaneHealthRequest{
+				InstanceHealths: []*pb.InstanceHealth{
+					{
+						InstanceId:           "nginx-id",
+						InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_UNSPECIFIED,
+					},
+					{
+						InstanceId:           "agent-id",
+						InstanceHealthStatus: pb.InstanceHealth_INSTANCE_HEALTH_STATUS_UNHEALTHY,
+					},
+				},
+			},
+			expHealth: []PodHealth{},
+		},
+		{
+			name:      "request is nil",
+			errString: "empty UpdateDataPlaneHealth request",
+		},
+		{
+			name:      "context is missing data",
+			ctx:       t.Context(),
+			request:   &pb.UpdateDataPlaneHealthRequest{},
+			errString: agentgrpc.ErrStatusInvalidConnection.Error(),
+		},
+		{
+			name:      "connection is not ready",
+			ctx:       createGrpcContext(t),
+			request:   unhealthyRequest,
+			errString: "is not ready",
+		},
+		{
+			name:         "deployment does not exist",
+			ctx:          createGrpcContext(t),
+			conn:         readyConn,
+			request:      unhealthyRequest,
+			noDeployment: true,
+			errString:    "not found",
+		},
+	}
 
-	g.Expect(err).ToNot(HaveOccurred())
-	g.Expect(resp).To(Equal(&pb.UpdateDataPlaneHealthResponse{}))
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			connTracker := agentgrpcfakes.FakeConnectionsTracker{}
+			connTracker.GetConnectionReturns(test.conn)
+
+			store := NewDeploymentStore(&connTracker)
+			var deployment *Deployment
+			if !test.noDeployment {
+				deployment = store.LoadOrStore(t.Context(), readyConn.ParentName, "gateway")
+				if test.existing != nil {
+					deployment.SetPodHealth("1234567", *test.existing)
+				}
+			}
+
+			queue := status.NewQueue()
+
+			cs := newCommandService(
+				logr.Discard(),
+				fake.NewFakeClient(),
+				store,
+				&connTracker,
+				queue,
+				nil,
+				&events.FakeRecorder{},
+			)
+
+			resp, err := cs.UpdateDataPlaneHealth(test.ctx, test.request)
+
+			if test.errString != "" {
+				g.Expect(err).To(HaveOccurred())
+				g.Expect(err.Error()).To(ContainSubstring(test.errString))
+				g.Expect(resp).To(BeNil())
+
+				return
+			}
+
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(resp).To(Equal(&pb.UpdateDataPlaneHealthResponse{}))
+			g.Expect(deployment.GetPodHealth()).To(Equal(test.expHealth))
+
+			if test.expStatusSent {
+				ctx, cancel := context.WithTimeout(t.Context(), time.Second)
+				defer cancel()
+
+				g.Expect(queue.Dequeue(ctx)).To(Equal(&status.QueueObject{
+					Deployment: status.Deployment{
+						NamespacedName: readyConn.ParentName,
+						GatewayName:    "gateway",
+					},
+					UpdateType: status.UpdateAll,
+				}))
+			} else {
+				ctx, cancel := context.WithTimeout(t.Context(), 100*time.Millisecond)
+				defer cancel()
+
+				g.Expect(queue.Dequeue(ctx)).To(BeNil())
+			}
+		})
+	}
 }
@@ -4,14 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	filesHelper "github.com/nginx/agent/v3/pkg/files"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
@@ -31,7 +35,12 @@ var ignoreFiles = []string{
 	"/usr/share/nginx/html/nginx-modules-reference.pdf",
 }
 
-const fileMode = "0644"
+const (
+	fileMode = "0644"
+
+	// configVersionHistorySize is the number of nginx configuration versions that are kept for a Deployment.
+	configVersionHistorySize = 10
+)
 
 // Deployment represents an nginx Deployment. It contains its own nginx configuration files,
 // a broadcaster for sending those files to all of its pods that are subscribed, and errors
@@ -40,15 +49,29 @@ type Deployment struct {
 	// podStatuses is a map of all Pods for this Deployment and the most recent error
 	// (or nil if successful) that occurred on a config call to the nginx agent.
 	podStatuses map[string]error
+	// podHealth is a map of all Pods for this Deployment and the most recent data plane
+	// health reported by the nginx agent.
+	podHealth map[string]PodHealth
+	// resyncChannels is a map of all Pods for this Deployment and the channel used to signal
+	// the Pod's subscription to push the desired configuration back to the agent after drift was detected.
+	resyncChannels map[string]chan struct{}
+	// subscriptionIDs is a map of all Pods for this Deployment and the ID of the Pod's broadcaster subscription.
+	subscriptionIDs map[string]string
 
 	broadcaster broadcast.Broadcaster
 
 	// gatewayName is the name of the Gateway associated with this Deployment.
 	gatewayName string
+	// nsName is the namespaced name of this Deployment.
+	nsName types.NamespacedName
 
 	imageVersion string
 
 	configVersion string
+
+	// abortedConfigVersion is the config version of the most recent staged rollout that was aborted.
+	abortedConfigVersion string
+
 	// error that is set if a ConfigApply call failed for a Pod. This is needed
 	// because if subsequent upstream API calls are made within the same update event,
 	// and are successful, the previous error would be lost in the podStatuses map.
@@ -67,16 +90,90 @@ type Deployment struct {
 	latestFileNames []string
 	volumeMounts    []v1.VolumeMount
 
+	// rollout is the staged rollout strategy for configuration updates, or nil if updates are sent to all Pods
+	// at once.
+	rollout *RolloutStrategy
+	// stagedRollout is the staged rollout that is waiting for its soak period, or nil.
+	stagedRollout *stagedRollout
+
+	// configVersions are the most recent nginx configuration versions of this Deployment, newest first.
+	configVersions []ConfigVersion
+	// configChanges are the resource changes that triggered the next configuration version.
+	configChanges []string
+
+	// spanContext is the span context of the operation that updates the configuration. It's added to the
+	// messages to the agents, so that sending the configuration to each agent is traced as part of that operation.
+	spanContext trace.SpanContext
+
+	// driftCorrections is the number of times the desired configuration was pushed back to a Pod
+	// after configuration drift was detected.
+	driftCorrections uint64
+
 	FileLock sync.RWMutex
 	errLock  sync.RWMutex
 }
 
+// PodHealth is the health of the nginx instance in a Pod as reported by the nginx agent.
+type PodHealth struct {
+	// PodName is the name of the Pod.
+	PodName string
+	// Description describes why the nginx instance is unhealthy.
+	Description string
+	// Healthy is true if the nginx instance is healthy.
+	Healthy bool
+}
+
+// ConfigVersion is a version of the nginx configuration files that was set on a Deployment.
+type ConfigVersion struct {
+	// Timestamp is the time when the version was last set on the Deployment.
+	Timestamp time.Time
+	// Version is the config version, which is a hash of the configuration files.
+	Version string
+	// Changes are the resource changes that triggered the version.
+	Changes []string
+	// Files are the nginx configuration files of the version.
+	Files []File
+}
+
+// RolloutStrategy is the strategy for rolling out configuration updates to the Pods of a Deployment in stages.
+type RolloutStrategy struct {
+	// Canary is the number or percentage of Pods that receive a configuration update first.
+	Canary intstr.IntOrString
+	// SoakPeriod is how long to wait after the canary Pods applied the configuration before
+	// updating the remaining Pods.
+	SoakPeriod time.Duration
+	// RequireHealthy aborts the rollout if a canary Pod reports an unhealthy data plane after the soak period.
+	RequireHealthy bool
+}
+
+// stagedRollout is a staged rollout of a configuration version that is waiting for its soak period.
+type stagedRollout struct {
+	// cancel cancels the rollout.
+	cancel context.CancelFunc
+	// configVersion is the configuration version that is rolled out.
+	configVersion string
+	// previousConfigVersion is the configuration version before the rollout.
+	previousConfigVersion string
+	// canaries are the canary Pods.
+	canaries []string
+	// previousFiles and previousVolumeMounts are the configuration that is restored in the canary Pods
+	// if the rollout is aborted.
+	previousFiles        []File
+	previousVolumeMounts []v1.VolumeMount
+	// previousFileOverviews are the file overviews of the configuration before the rollout, which are sent
+	// to the Pods that are not canaries and connect during the soak period.
+	previousFileOverviews []*pb.File
+}
+
 // newDeployment returns a new Deployment object.
 func newDeployment(broadcaster broadcast.Broadcaster, gatewayName string) *Deployment {
 	return &Deployment{
-		broadcaster: broadcaster,
-		podStatuses: make(map[string]error),
-		gatewayName: gatewayName,
+		broadcaster:     broadcaster,
+		podStatuses:     make(map[string]error),
+		podHealth:       make(map[string]PodHealth),
+		resyncChannels:  make(map[string]chan struct{}),
+		subscriptionIDs: make(map[string]string),
+		gatewayName:     gatewayName,
 	}
 }
 
@@ -107,6 +204,28 @@ func (d *Deployment) SetImageVersion(imageVersion string) {
 	d.imageVersion = imageVersion
 }
 
+// SetRolloutStrategy sets the deployment's staged rollout strategy. A nil strategy sends configuration
+// updates to all Pods at once.
+func (d *Deployment) SetRolloutStrategy(rollout *RolloutStrategy) {
+	d.FileLock.Lock()
+	defer d.FileLock.Unlock()
+
+	if !equalRolloutStrategies(d.rollout, rollout) {
+		// a different strategy might succeed, so allow the aborted config version to be rolled out again
+		d.abortedConfigVersion = ""
+	}
+
+	d.rollout = rollout
+}
+
+func equalRolloutStrategies(a, b *RolloutStrategy) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return *a == *b
+}
+
 // SetLatestConfigError sets the latest config apply error for the deployment.
 func (d *Deployment) SetLatestConfigError(err error) {
 	d.errLock.Lock()
@@ -153,6 +272,181 @@ func (d *Deployment) RemovePodStatus(podName string) {
 	defer d.errLock.Unlock()
 
 	delete(d.podStatuses, podName)
+	delete(d.podHealth, podName)
+}
+
+// SetPodHealth sets the data plane health of a Pod in this Deployment.
+// Returns true if the health of the Pod changed.
+func (d *Deployment) SetPodHealth(pod string, health PodHealth) bool {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	if existing, ok := d.podHealth[pod]; ok && existing == health {
+		return false
+	}
+
+	d.podHealth[pod] = health
+
+	return true
+}
+
+// GetPodHealth returns the data plane health of all Pods in this Deployment that have reported it,
+// sorted by Pod name.
+func (d *Deployment) GetPodHealth() []PodHealth {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	health := make([]PodHealth, 0, len(d.podHealth))
+	for _, h := range d.podHealth {
+		health = append(health, h)
+	}
+
+	slices.SortFunc(health, func(a, b PodHealth) int {
+		return strings.Compare(a.PodName, b.PodName)
+	})
+
+	return health
+}
+
+// subscribeConfigResync returns the channel that is signaled when the desired configuration needs to be
+// pushed back to the Pod's agent.
+func (d *Deployment) subscribeConfigResync(pod string) <-chan struct{} {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	ch := make(chan struct{}, 1)
+	d.resyncChannels[pod] = ch
+
+	return ch
+}
+
+// unsubscribeConfigResync removes the resync channel of the Pod.
+func (d *Deployment) unsubscribeConfigResync(pod string) {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	delete(d.resyncChannels, pod)
+}
+
+// requestConfigResync signals the Pod's subscription to push the desired configuration back to the agent.
+// Returns false if the Pod has no subscription.
+func (d *Deployment) requestConfigResync(pod string) bool {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	ch, ok := d.resyncChannels[pod]
+	if !ok {
+		return false
+	}
+
+	// a resync that is already pending will push the latest files, so there's no need to queue another one
+	select {
+	case ch <- struct{}{}:
+	default:
+	}
+
+	return true
+}
+
+// trackSubscription stores the ID of the Pod's broadcaster subscription, so that configuration updates can be
+// sent to a subset of the Pods.
+func (d *Deployment) trackSubscription(pod, id string) {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	d.subscriptionIDs[pod] = id
+}
+
+// untrackSubscription removes the ID of the Pod's broadcaster subscription.
+func (d *Deployment) untrackSubscription(pod string) {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	delete(d.subscriptionIDs, pod)
+}
+
+// getSubscriptions returns the broadcaster subscription IDs of the Pods, keyed by Pod.
+func (d *Deployment) getSubscriptions() map[string]string {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return maps.Clone(d.subscriptionIDs)
+}
+
+// getPodStatus returns false if the Pod has no config status, otherwise true and the most recent config error
+// of the Pod.
+func (d *Deployment) getPodStatus(pod string) (bool, error) {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	err, ok := d.podStatuses[pod]
+	return ok, err
+}
+
+// getPodHealth returns the data plane health of the Pod, and false if the Pod did not report it.
+func (d *Deployment) getPodHealth(pod string) (PodHealth, bool) {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	health, ok := d.podHealth[pod]
+	return health, ok
+}
+
+// GetConnectedAgents returns the number of agents of this Deployment that are currently subscribed
+// to configuration updates.
+func (d *Deployment) GetConnectedAgents() int {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return len(d.resyncChannels)
+}
+
+// recordConfigDriftCorrection increments the number of configuration drift corrections for this Deployment.
+func (d *Deployment) recordConfigDriftCorrection() {
+	d.errLock.Lock()
+	defer d.errLock.Unlock()
+
+	d.driftCorrections++
+}
+
+// GetConfigDriftCorrections returns the number of configuration drift corrections for this Deployment.
+func (d *Deployment) GetConfigDriftCorrections() uint64 {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	return d.driftCorrections
+}
+
+// podConfigApplied returns true if the latest configuration call to the Pod's agent succeeded.
+func (d *Deployment) podConfigApplied(pod string) bool {
+	d.errLock.RLock()
+	defer d.errLock.RUnlock()
+
+	err, ok := d.podStatuses[pod]
+	return ok && err == nil
+}
+
+// getDriftedFiles returns the names of the managed files whose hashes reported by the agent of the Pod differ
+// from the desired files of the Pod. Files the agent does not report are not considered drifted, since the agent only
+// reports the files referenced by the nginx configuration.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) getDriftedFiles(pod string, reported []*pb.File) []string {
+	files := d.getFilesForPod(pod)
+
+	desired := make(map[string]string, len(files))
+	for _, f := range files {
+		desired[f.Meta.GetName()] = f.Meta.GetHash()
+	}
+
+	var drifted []string
+	for _, f := range reported {
+		name := f.GetFileMeta().GetName()
+		if hash, ok := desired[name]; ok && hash != f.GetFileMeta().GetHash() {
+			drifted = append(drifted, name)
+		}
+	}
+
+	return drifted
 }
 
 // GetConfigurationStatus returns the current config status for this Deployment. It combines
@@ -187,6 +481,29 @@ func (d *Deployment) GetFileOverviews() ([]*pb.File, string) {
 	return d.fileOverviews, d.configVersion
 }
 
+// GetFileOverviewsForPod returns the current file overviews and configuration version for the Pod.
+// While a staged rollout is waiting for its soak period, a Pod that is not one of its canaries gets the
+// configuration from before the rollout, since the rolled out configuration isn't verified yet.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) GetFileOverviewsForPod(pod string) ([]*pb.File, string) {
+	if rollout := d.stagedRollout; rollout != nil && !slices.Contains(rollout.canaries, pod) {
+		return rollout.previousFileOverviews, rollout.previousConfigVersion
+	}
+
+	return d.fileOverviews, d.configVersion
+}
+
+// getFilesForPod returns the nginx files of the Pod. While a staged rollout is waiting for its soak period, a Pod
+// that is not one of its canaries has the files from before the rollout.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) getFilesForPod(pod string) []File {
+	if rollout := d.stagedRollout; rollout != nil && !slices.Contains(rollout.canaries, pod) {
+		return rollout.previousFiles
+	}
+
+	return d.files
+}
+
 // GetNGINXPlusActions returns the current NGINX Plus API Actions for the deployment.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) GetNGINXPlusActions() []*pb.NGINXPlusAction {
@@ -196,8 +513,14 @@ func (d *Deployment) GetNGINXPlusActions() []*pb.NGINXPlusAction {
 // GetFile gets the requested file for the deployment and returns its contents.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) GetFile(name, hash string) ([]byte, string, bool) {
+	files := d.files
+	if d.stagedRollout != nil {
+		// Pods that are not canaries of the rollout get the previous files
+		files = slices.Concat(d.files, d.stagedRollout.previousFiles)
+	}
+
 	var fileFoundHash string
-	for _, file := range d.files {
+	for _, file := range files {
 		if name == file.Meta.GetName() {
 			fileFoundHash = file.Meta.GetHash()
 			if hash == file.Meta.GetHash() {
@@ -209,6 +532,12 @@ func (d *Deployment) GetFile(name, hash string) ([]byte, string, bool) {
 	return nil, fileFoundHash, false
 }
 
+// GetFiles returns the current nginx files for the deployment.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) GetFiles() []File {
+	return slices.Clone(d.files)
+}
+
 // SetFiles updates the nginx files and fileOverviews for the deployment and returns the message to send.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) SetFiles(files []File, volumeMounts []v1.VolumeMount) *broadcast.NginxAgentMessage {
@@ -218,6 +547,60 @@ func (d *Deployment) SetFiles(files []File, volumeMounts []v1.VolumeMount) *broa
 	return d.rebuildFileOverviews()
 }
 
+// SetConfigChanges sets the resource changes that triggered the next configuration version.
+// They are recorded in the configuration version history if the configuration files change.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) SetConfigChanges(changes []string) {
+	d.configChanges = changes
+}
+
+// SetSpanContext sets the span context of the operation that updates the configuration.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) SetSpanContext(spanContext trace.SpanContext) {
+	d.spanContext = spanContext
+}
+
+// GetConfigVersions returns the current configuration version of the Deployment, and the most recent
+// configuration versions, newest first.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) GetConfigVersions() (string, []ConfigVersion) {
+	return d.configVersion, slices.Clone(d.configVersions)
+}
+
+// FindConfigVersion returns the configuration version with the given version from the history.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) FindConfigVersion(version string) (ConfigVersion, bool) {
+	for _, v := range d.configVersions {
+		if v.Version == version {
+			v.Files = slices.Clone(v.Files)
+			return v, true
+		}
+	}
+
+	return ConfigVersion{}, false
+}
+
+// recordConfigVersion adds the current configuration files to the version history. If the version is already
+// in the history, it is moved to the front. The oldest versions are dropped once the history is full.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) recordConfigVersion(changes []string) {
+	d.configVersions = slices.DeleteFunc(d.configVersions, func(v ConfigVersion) bool {
+		return v.Version == d.configVersion
+	})
+
+	// the files are cloned, since the WAF bundle updates replace files in place
+	d.configVersions = slices.Insert(d.configVersions, 0, ConfigVersion{
+		Timestamp: time.Now(),
+		Version:   d.configVersion,
+		Changes:   changes,
+		Files:     slices.Clone(d.files),
+	})
+
+	if len(d.configVersions) > configVersionHistorySize {
+		d.configVersions = d.configVersions[:configVersionHistorySize]
+	}
+}
+
 // SetNGINXPlusActions updates the deployment's latest NGINX Plus Actions to perform if using NGINX Plus.
 // Used by a Subscriber when it first connects.
 // The deployment FileLock MUST already be locked before calling this function.
@@ -232,6 +615,8 @@ func (d *Deployment) SetNGINXPlusActions(actions []*pb.NGINXPlusAction) {
 // from what was previously stored), or nil if nothing changed.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) UpdateWAFBundle(bundlePath string, data []byte) *broadcast.NginxAgentMessage {
+	d.configChanges = []string{"WAF bundle " + bundlePath + " updated"}
+
 	newHash := filesHelper.GenerateHash(data)
 	newMeta := &pb.FileMeta{
 		Name:        bundlePath,
@@ -269,6 +654,7 @@ func (d *Deployment) UpdateWAFBundle(bundlePath string, data []byte) *broadcast.
 func (d *Deployment) RemoveWAFBundle(bundlePath string) *broadcast.NginxAgentMessage {
 	for i, f := range d.files {
 		if f.Meta.GetName() == bundlePath {
+			d.configChanges = []string{"WAF bundle " + bundlePath + " removed"}
 			d.files = append(d.files[:i], d.files[i+1:]...)
 			return d.rebuildFileOverviews()
 		}
@@ -277,17 +663,62 @@ func (d *Deployment) RemoveWAFBundle(bundlePath string) *broadcast.NginxAgentMes
 }
 
 // rebuildFileOverviews regenerates the file overviews and config version from the current
-// file list. Returns a broadcast message if the config version changed.
+// file list. Returns a broadcast message if the config version changed. A changed version is recorded
+// in the configuration version history with the pending resource changes.
 // The deployment FileLock MUST already be locked before calling this function.
 func (d *Deployment) rebuildFileOverviews() *broadcast.NginxAgentMessage {
-	fileOverviews := make([]*pb.File, 0, len(d.files))
-	for _, f := range d.files {
+	fileOverviews, newConfigVersion := d.fileOverviewsFor(d.files, d.volumeMounts)
+
+	changes := d.configChanges
+	d.configChanges = nil
+
+	if d.configVersion == newConfigVersion {
+		// files have not changed, nothing to send
+		return nil
+	}
+
+	d.configVersion = newConfigVersion
+	d.fileOverviews = fileOverviews
+	d.recordConfigVersion(changes)
+
+	return d.configApplyMessage()
+}
+
+// cancelStagedRollout cancels the staged rollout that is waiting for its soak period, and returns it.
+// Returns nil if there is no such rollout.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) cancelStagedRollout() *stagedRollout {
+	canceled := d.stagedRollout
+	if canceled != nil {
+		canceled.cancel()
+		d.stagedRollout = nil
+	}
+
+	return canceled
+}
+
+// configApplyMessage returns a broadcast message that applies the current configuration.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) configApplyMessage() *broadcast.NginxAgentMessage {
+	return &broadcast.NginxAgentMessage{
+		Type:          broadcast.ConfigApplyRequest,
+		FileOverviews: d.fileOverviews,
+		ConfigVersion: d.configVersion,
+		SpanContext:   d.spanContext,
+	}
+}
+
+// fileOverviewsFor returns the file overviews and config version of the given files.
+// The deployment FileLock MUST already be locked before calling this function.
+func (d *Deployment) fileOverviewsFor(files []File, volumeMounts []v1.VolumeMount) ([]*pb.File, string) {
+	fileOverviews := make([]*pb.File, 0, len(files))
+	for _, f := range files {
 		fileOverviews = append(fileOverviews, &pb.File{FileMeta: f.Meta})
 	}
 
 	// Build the set of unmanaged files from volume mounts.
 	fileIgnoreSet := make(map[string]struct{})
-	for _, vm := range d.volumeMounts {
+	for _, vm := range volumeMounts {
 		for _, f := range d.latestFileNames {
 			if strings.HasPrefix(f, vm.MountPath) {
 				fileIgnoreSet[f] = struct{}{}
@@ -310,20 +741,7 @@ func (d *Deployment) rebuildFileOverviews() *broadcast.NginxAgentMessage {
 		})
 	}
 
-	newConfigVersion := filesHelper.GenerateConfigVersion(fileOverviews)
-	if d.configVersion == newConfigVersion {
-		// files have not changed, nothing to send
-		return nil
-	}
-
-	d.configVersion = newConfigVersion
-	d.fileOverviews = fileOverviews
-
-	return &broadcast.NginxAgentMessage{
-		Type:          broadcast.ConfigApplyRequest,
-		FileOverviews: fileOverviews,
-		ConfigVersion: d.configVersion,
-	}
+	return fileOverviews, filesHelper.GenerateConfigVersion(fileOverviews)
 }
 
 //counterfeiter:generate . DeploymentStorer
@@ -363,6 +781,11 @@ func (d *DeploymentStore) Get(nsName types.NamespacedName) *Deployment {
 	return deployment
 }
 
+// ListConnections returns all nginx agent connections, keyed by the agent UUID.
+func (d *DeploymentStore) ListConnections() map[string]agentgrpc.Connection {
+	return d.connTracker.ListConnections()
+}
+
 // LoadOrStore returns the existing value for the key if present.
 // Otherwise, it stores and returns the given value.
 func (d *DeploymentStore) LoadOrStore(
@@ -371,6 +794,7 @@ func (d *DeploymentStore) LoadOrStore(
 	gatewayName string,
 ) *Deployment {
 	deployment := newDeployment(nil, gatewayName)
+	deployment.nsName = nsName
 	actual, _ := d.deployments.LoadOrStore(nsName, deployment)
 
 	storedDeployment, ok := actual.(*Deployment)
@@ -389,6 +813,7 @@ func (d *DeploymentStore) StoreWithBroadcaster(
 	gatewayName string,
 ) *Deployment {
 	deployment := newDeployment(broadcaster, gatewayName)
+	deployment.nsName = nsName
 	d.deployments.Store(nsName, deployment)
 
 	return deployment
@@ -398,3 +823,191 @@ func (d *DeploymentStore) StoreWithBroadcaster(
 func (d *DeploymentStore) Remove(nsName types.NamespacedName) {
 	d.deployments.Delete(nsName)
 }
+
+// GatewayConfigDrift is the number of configuration drift corrections of a Gateway.
+type GatewayConfigDrift struct {
+	// Namespace is the namespace of the Gateway.
+	Namespace string
+	// GatewayName is the name of the Gateway.
+	GatewayName string
+	// Corrections is the number of times the desired configuration was pushed back to a Pod of the Gateway
+	// after configuration drift was detected.
+	Corrections uint64
+}
+
+// ListConfigDriftCorrections returns the number of configuration drift corrections of all Deployments
+// in the store, sorted by namespace and Gateway name.
+func (d *DeploymentStore) ListConfigDriftCorrections() []GatewayConfigDrift {
+	var drift []GatewayConfigDrift
+
+	d.deployments.Range(func(key, value any) bool {
+		nsName, ok := key.(types.NamespacedName)
+		if !ok {
+			panic(fmt.Sprintf("expected NamespacedName, got type %T", key))
+		}
+
+		deployment, ok := value.(*Deployment)
+		if !ok {
+			panic(fmt.Sprintf("expected Deployment, got type %T", value))
+		}
+
+		drift = append(drift, GatewayConfigDrift{
+			Namespace:   nsName.Namespace,
+			GatewayName: deployment.GetGatewayName(),
+			Corrections: deployment.GetConfigDriftCorrections(),
+		})
+
+		return true
+	})
+
+	slices.SortFunc(drift, func(a, b GatewayConfigDrift) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GatewayName, b.GatewayName)
+	})
+
+	return drift
+}
+
+// GatewayConnectedAgents is the number of connected agents of a Gateway.
+type GatewayConnectedAgents struct {
+	// Namespace is the namespace of the Gateway.
+	Namespace string
+	// GatewayName is the name of the Gateway.
+	GatewayName string
+	// Agents is the number of agents of the Gateway that are subscribed to configuration updates.
+	Agents int
+}
+
+// ListConnectedAgents returns the number of connected agents of all Deployments in the store,
+// sorted by namespace and Gateway name.
+func (d *DeploymentStore) ListConnectedAgents() []GatewayConnectedAgents {
+	var agents []GatewayConnectedAgents
+
+	d.deployments.Range(func(key, value any) bool {
+		nsName, ok := key.(types.NamespacedName)
+		if !ok {
+			panic(fmt.Sprintf("expected NamespacedName, got type %T", key))
+		}
+
+		deployment, ok := value.(*Deployment)
+		if !ok {
+			panic(fmt.Sprintf("expected Deployment, got type %T", value))
+		}
+
+		agents = append(agents, GatewayConnectedAgents{
+			Namespace:   nsName.Namespace,
+			GatewayName: deployment.GetGatewayName(),
+			Agents:      deployment.GetConnectedAgents(),
+		})
+
+		return true
+	})
+
+	slices.SortFunc(agents, func(a, b GatewayConnectedAgents) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.GatewayName, b.GatewayName)
+	})
+
+	return agents
+}
+
+// GatewayPodHealth is the data plane health of a Pod that belongs to a Gateway.
+type GatewayPodHealth struct {
+	// Namespace is the namespace of the Gateway and its Pods.
+	Namespace string
+	// GatewayName is the name of the Gateway.
+	GatewayName string
+	PodHealth
+}
+
+// ListPodHealth returns the data plane health of all Pods of all Deployments in the store,
+// sorted by namespace, Gateway name, and Pod name.
+func (d *DeploymentStore) ListPodHealth() []GatewayPodHealth {
+	var health []GatewayPodHealth
+
+	d.deployments.Range(func(key, value any) bool {
+		nsName, ok := key.(types.NamespacedName)
+		if !ok {
+			panic(fmt.Sprintf("expected NamespacedName, got type %T", key))
+		}
+
+		deployment, ok := value.(*Deployment)
+		if !ok {
+			panic(fmt.Sprintf("expected Deployment, got type %T", value))
+		}
+
+		for _, podHealth := range deployment.GetPodHealth() {
+			health = append(health, GatewayPodHealth{
+				PodHealth:   podHealth,
+				Namespace:   nsName.Namespace,
+				GatewayName: deployment.GetGatewayName(),
+			})
+		}
+
+		return true
+	})
+
+	slices.SortFunc(health, func(a, b GatewayPodHealth) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.GatewayName, b.GatewayName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PodName, b.PodName)
+	})
+
+	return health
+}
+
+// GatewayPod is a connected nginx Pod that belongs to a Gateway.
+type GatewayPod struct {
+	// Namespace is the namespace of the Gateway and its Pods.
+	Namespace string
+	// GatewayName is the name of the Gateway.
+	GatewayName string
+	// PodName is the name of the Pod.
+	PodName string
+	// PodIP is the IP address of the Pod.
+	PodIP string
+}
+
+// ListGatewayPods returns the nginx Pods of all Deployments in the store that have a connected agent,
+// sorted by namespace, Gateway name, and Pod name. Pods with an unknown IP address are skipped.
+func (d *DeploymentStore) ListGatewayPods() []GatewayPod {
+	var pods []GatewayPod
+
+	for _, conn := range d.connTracker.ListConnections() {
+		if conn.PodIP == "" {
+			continue
+		}
+
+		deployment := d.Get(conn.ParentName)
+		if deployment == nil {
+			continue
+		}
+
+		pods = append(pods, GatewayPod{
+			Namespace:   conn.ParentName.Namespace,
+			GatewayName: deployment.GetGatewayName(),
+			PodName:     conn.PodName,
+			PodIP:       conn.PodIP,
+		})
+	}
+
+	slices.SortFunc(pods, func(a, b GatewayPod) int {
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.GatewayName, b.GatewayName); c != 0 {
+			return c
+		}
+		return strings.Compare(a.PodName, b.PodName)
+	})
+
+	return pods
+}
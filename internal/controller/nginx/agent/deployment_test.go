@@ -2,16 +2,19 @@ package agent
 
 import (
 	"errors"
+	"fmt"
 	"sync"
 	"testing"
 
 	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
 	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
 	agentgrpcfakes "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/grpcfakes"
 )
 
@@ -54,6 +57,8 @@ func TestSetAndGetFiles(t *testing.T) {
 	g.Expect(msg.FileOverviews).To(HaveLen(9)) // 1 file + 8 ignored files
 	g.Expect(fileOverviews).To(Equal(msg.FileOverviews))
 
+	g.Expect(deployment.GetFiles()).To(Equal(files))
+
 	file, _, found := deployment.GetFile("test.conf", "12345")
 	g.Expect(found).To(BeTrue())
 	g.Expect(file).To(Equal([]byte("test content")))
@@ -158,6 +163,109 @@ func TestSetAndGetFiles_VolumeIgnoreFiles(t *testing.T) {
 	g.Expect(newFileOverviews).To(Equal(fileOverviews))
 }
 
+func TestConfigVersionHistory(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+
+	newFiles := func(hash string) []File {
+		return []File{
+			{
+				Meta:     &pb.FileMeta{Name: "test.conf", Hash: hash},
+				Contents: []byte(hash),
+			},
+		}
+	}
+
+	deployment.SetConfigChanges([]string{"HTTPRoute test/route upserted"})
+	deployment.SetFiles(newFiles("first"), nil)
+	firstVersion, versions := deployment.GetConfigVersions()
+
+	g.Expect(versions).To(HaveLen(1))
+	g.Expect(versions[0].Version).To(Equal(firstVersion))
+	g.Expect(versions[0].Changes).To(Equal([]string{"HTTPRoute test/route upserted"}))
+	g.Expect(versions[0].Files).To(Equal(newFiles("first")))
+	g.Expect(versions[0].Timestamp).ToNot(BeZero())
+
+	// setting the same files does not record a new version
+	deployment.SetConfigChanges([]string{"Service test/svc upserted"})
+	deployment.SetFiles(newFiles("first"), nil)
+	_, versions = deployment.GetConfigVersions()
+	g.Expect(versions).To(HaveLen(1))
+
+	// the changes are only used for the next version
+	deployment.SetFiles(newFiles("second"), nil)
+	secondVersion, versions := deployment.GetConfigVersions()
+	g.Expect(versions).To(HaveLen(2))
+	g.Expect(versions[0].Version).To(Equal(secondVersion))
+	g.Expect(versions[0].Changes).To(BeNil())
+	g.Expect(versions[1].Version).To(Equal(firstVersion))
+
+	// WAF bundle updates replace the files in place, but don't change the recorded versions
+	deployment.UpdateWAFBundle("test.conf", []byte("bundle"))
+	_, versions = deployment.GetConfigVersions()
+	g.Expect(versions).To(HaveLen(3))
+	g.Expect(versions[0].Changes).To(Equal([]string{"WAF bundle test.conf updated"}))
+	g.Expect(versions[1].Files).To(Equal(newFiles("second")))
+
+	// setting a previous version moves it to the front
+	pinned, found := deployment.FindConfigVersion(firstVersion)
+	g.Expect(found).To(BeTrue())
+	g.Expect(pinned.Files).To(Equal(newFiles("first")))
+
+	deployment.SetFiles(pinned.Files, nil)
+	currentVersion, versions := deployment.GetConfigVersions()
+	g.Expect(currentVersion).To(Equal(firstVersion))
+	g.Expect(versions).To(HaveLen(3))
+	g.Expect(versions[0].Version).To(Equal(firstVersion))
+
+	_, found = deployment.FindConfigVersion("unknown")
+	g.Expect(found).To(BeFalse())
+
+	// the oldest versions are dropped once the history is full
+	for i := range configVersionHistorySize + 1 {
+		deployment.SetFiles(newFiles(fmt.Sprintf("file-%d", i)), nil)
+	}
+
+	_, versions = deployment.GetConfigVersions()
+	g.Expect(versions).To(HaveLen(configVersionHistorySize))
+
+	_, found = deployment.FindConfigVersion(firstVersion)
+	g.Expect(found).To(BeFalse())
+}
+
+func TestSetSpanContext(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+
+	spanContext := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{2},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	files := []File{
+		{
+			Meta:     &pb.FileMeta{Name: "test.conf", Hash: "12345"},
+			Contents: []byte("test content"),
+		},
+	}
+
+	deployment.SetSpanContext(spanContext)
+	msg := deployment.SetFiles(files, nil)
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.SpanContext).To(Equal(spanContext))
+
+	deployment.SetSpanContext(trace.SpanContext{})
+	files[0].Meta.Hash = "67890"
+	msg = deployment.SetFiles(files, nil)
+	g.Expect(msg).ToNot(BeNil())
+	g.Expect(msg.SpanContext.IsValid()).To(BeFalse())
+}
+
 func TestSetNGINXPlusActions(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -222,6 +330,26 @@ func TestSetPodErrorStatus(t *testing.T) {
 	g.Expect(deployment.podStatuses).ToNot(HaveKey("test-pod"))
 }
 
+func TestSetAndGetPodHealth(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+	g.Expect(deployment.GetPodHealth()).To(BeEmpty())
+
+	unhealthy := PodHealth{PodName: "pod-b", Description: "nginx is not running"}
+	healthy := PodHealth{PodName: "pod-a", Healthy: true}
+
+	g.Expect(deployment.SetPodHealth("uuid-b", unhealthy)).To(BeTrue())
+	g.Expect(deployment.SetPodHealth("uuid-a", healthy)).To(BeTrue())
+	g.Expect(deployment.SetPodHealth("uuid-b", unhealthy)).To(BeFalse())
+
+	g.Expect(deployment.GetPodHealth()).To(Equal([]PodHealth{healthy, unhealthy}))
+
+	deployment.RemovePodStatus("uuid-b")
+	g.Expect(deployment.GetPodHealth()).To(Equal([]PodHealth{healthy}))
+}
+
 func TestSetLatestConfigError(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -472,3 +600,157 @@ func TestDeploymentStore(t *testing.T) {
 	store.Remove(nsName)
 	g.Expect(store.Get(nsName)).To(BeNil())
 }
+
+func TestDeploymentStore_ListConnections(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	connections := map[string]agentgrpc.Connection{
+		"uuid": {PodName: "nginx-pod", InstanceID: "instance"},
+	}
+
+	tracker := &agentgrpcfakes.FakeConnectionsTracker{}
+	tracker.ListConnectionsReturns(connections)
+
+	store := NewDeploymentStore(tracker)
+	g.Expect(store.ListConnections()).To(Equal(connections))
+}
+
+func TestDeploymentStore_ListPodHealth(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{})
+	g.Expect(store.ListPodHealth()).To(BeEmpty())
+
+	deployment1 := store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-b", Name: "gw-nginx"}, "gw")
+	deployment1.SetPodHealth("uuid-1", PodHealth{PodName: "pod-1", Healthy: true})
+
+	deployment2 := store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-a", Name: "gw-nginx"}, "gw")
+	deployment2.SetPodHealth("uuid-2", PodHealth{PodName: "pod-2", Description: "unhealthy"})
+
+	g.Expect(store.ListPodHealth()).To(Equal([]GatewayPodHealth{
+		{
+			PodHealth:   PodHealth{PodName: "pod-2", Description: "unhealthy"},
+			Namespace:   "ns-a",
+			GatewayName: "gw",
+		},
+		{
+			PodHealth:   PodHealth{PodName: "pod-1", Healthy: true},
+			Namespace:   "ns-b",
+			GatewayName: "gw",
+		},
+	}))
+}
+
+func TestDeploymentStore_ListGatewayPods(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	nsName := types.NamespacedName{Namespace: "test", Name: "gw-nginx"}
+
+	connTracker := &agentgrpcfakes.FakeConnectionsTracker{}
+	connTracker.ListConnectionsReturns(map[string]agentgrpc.Connection{
+		"uuid-1": {ParentName: nsName, PodName: "pod-b", PodIP: "10.0.0.2"},
+		"uuid-2": {ParentName: nsName, PodName: "pod-a", PodIP: "10.0.0.1"},
+		"uuid-3": {ParentName: nsName, PodName: "pod-no-ip"},
+		"uuid-4": {
+			ParentName: types.NamespacedName{Namespace: "test", Name: "unknown"},
+			PodName:    "pod-unknown",
+			PodIP:      "10.0.0.3",
+		},
+	})
+
+	store := NewDeploymentStore(connTracker)
+	store.LoadOrStore(t.Context(), nsName, "gw")
+
+	g.Expect(store.ListGatewayPods()).To(Equal([]GatewayPod{
+		{Namespace: "test", GatewayName: "gw", PodName: "pod-a", PodIP: "10.0.0.1"},
+		{Namespace: "test", GatewayName: "gw", PodName: "pod-b", PodIP: "10.0.0.2"},
+	}))
+}
+
+func TestGetDriftedFiles(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+	deployment.files = []File{
+		{Meta: &pb.FileMeta{Name: "http.conf", Hash: "http-hash"}},
+		{Meta: &pb.FileMeta{Name: "stream.conf", Hash: "stream-hash"}},
+	}
+
+	reported := []*pb.File{
+		{FileMeta: &pb.FileMeta{Name: "nginx.conf", Hash: "unmanaged"}},
+		{FileMeta: &pb.FileMeta{Name: "http.conf", Hash: "http-hash"}},
+		{FileMeta: &pb.FileMeta{Name: "stream.conf", Hash: "changed"}},
+	}
+
+	g.Expect(deployment.getDriftedFiles("pod1", reported)).To(Equal([]string{"stream.conf"}))
+	g.Expect(deployment.getDriftedFiles("pod1", reported[:2])).To(BeEmpty())
+}
+
+func TestConfigResync(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "")
+	g.Expect(deployment.requestConfigResync("test-pod")).To(BeFalse())
+
+	ch := deployment.subscribeConfigResync("test-pod")
+	g.Expect(deployment.requestConfigResync("test-pod")).To(BeTrue())
+	// a pending resync is not queued twice
+	g.Expect(deployment.requestConfigResync("test-pod")).To(BeTrue())
+	g.Expect(ch).To(Receive())
+	g.Expect(ch).ToNot(Receive())
+
+	g.Expect(deployment.podConfigApplied("test-pod")).To(BeFalse())
+	deployment.SetPodErrorStatus("test-pod", errors.New("test error"))
+	g.Expect(deployment.podConfigApplied("test-pod")).To(BeFalse())
+	deployment.SetPodErrorStatus("test-pod", nil)
+	g.Expect(deployment.podConfigApplied("test-pod")).To(BeTrue())
+
+	deployment.unsubscribeConfigResync("test-pod")
+	g.Expect(deployment.requestConfigResync("test-pod")).To(BeFalse())
+}
+
+func TestDeploymentStore_ListConfigDriftCorrections(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{})
+	g.Expect(store.ListConfigDriftCorrections()).To(BeEmpty())
+
+	deployment1 := store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-b", Name: "gw-nginx"}, "gw")
+	deployment1.recordConfigDriftCorrection()
+	deployment1.recordConfigDriftCorrection()
+
+	store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-a", Name: "gw-nginx"}, "gw")
+
+	g.Expect(store.ListConfigDriftCorrections()).To(Equal([]GatewayConfigDrift{
+		{Namespace: "ns-a", GatewayName: "gw", Corrections: 0},
+		{Namespace: "ns-b", GatewayName: "gw", Corrections: 2},
+	}))
+}
+
+func TestDeploymentStore_ListConnectedAgents(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{})
+	g.Expect(store.ListConnectedAgents()).To(BeEmpty())
+
+	deployment1 := store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-b", Name: "gw-nginx"}, "gw")
+	deployment1.subscribeConfigResync("pod-1")
+	deployment1.subscribeConfigResync("pod-2")
+
+	store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "ns-a", Name: "gw-nginx"}, "gw")
+
+	g.Expect(store.ListConnectedAgents()).To(Equal([]GatewayConnectedAgents{
+		{Namespace: "ns-a", GatewayName: "gw", Agents: 0},
+		{Namespace: "ns-b", GatewayName: "gw", Agents: 2},
+	}))
+
+	deployment1.unsubscribeConfigResync("pod-1")
+	g.Expect(store.ListConnectedAgents()[1].Agents).To(Equal(1))
+}
@@ -0,0 +1,442 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-logr/logr"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+const (
+	// configEventInterval is the minimum interval between two Events with the same reason for the same resource.
+	configEventInterval = time.Minute
+	// maxEventNoteLength is the maximum length of the note of an Event accepted by the API server.
+	maxEventNoteLength = 1024
+
+	configEventAction = "ApplyNginxConfig"
+
+	reasonConfigApplyFailed    = "NginxConfigApplyFailed"
+	reasonConfigRolledBack     = "NginxConfigRolledBack"
+	reasonConfigRollbackFailed = "NginxConfigRollbackFailed"
+)
+
+// includeFileRegex matches the include files in an nginx error, like
+// "in /etc/nginx/includes/ClientSettingsPolicy_default_csp.conf:3". The first group is the path of the include file,
+// the second group is the kind of the resource that the include file was generated for, and the third group holds
+// the namespace and name of the resource.
+var includeFileRegex = regexp.MustCompile(`(/[^\s:]*includes/([A-Za-z]+)_([^/\s:]+)\.conf)`)
+
+// configLineRegex matches the configuration file and line of an nginx error, like
+// "in /etc/nginx/conf.d/http.conf:42".
+var configLineRegex = regexp.MustCompile(`in (/[^\s:]+\.conf):(\d+)`)
+
+// routeCommentRegex matches the comments that name the routes of a location in the http configuration,
+// like "# HTTPRoute default/coffee".
+var routeCommentRegex = regexp.MustCompile(`^# ([A-Za-z]+) ([^/\s]+)/(\S+)$`)
+
+// includeFileResource describes the resources with include files named <kind>_[<prefix>_]<namespace>_<name>.
+type includeFileResource struct {
+	newObject func() client.Object
+	// contentsRegex, if set, matches the namespace and name of the resource in the contents of the include file.
+	// It's used for the include files whose names don't separate the namespace from the name.
+	contentsRegex *regexp.Regexp
+	// prefixFields is the number of fields between the kind and the namespace in the file name.
+	prefixFields int
+}
+
+var includeFileResources = map[string]includeFileResource{
+	kinds.AccessControlPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.AccessControlPolicy{} },
+	},
+	kinds.AccessLogPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.AccessLogPolicy{} },
+	},
+	kinds.CachePolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.CachePolicy{} },
+	},
+	kinds.ClientSettingsPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.ClientSettingsPolicy{} },
+	},
+	kinds.ObservabilityPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha2.ObservabilityPolicy{} },
+	},
+	kinds.ProxySettingsPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.ProxySettingsPolicy{} },
+	},
+	kinds.RateLimitPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.RateLimitPolicy{} },
+	},
+	kinds.WAFPolicy: {
+		newObject: func() client.Object { return &ngfAPIv1alpha1.WAFPolicy{} },
+	},
+	// the prefix is the nginx context of the snippet
+	kinds.SnippetsFilter: {
+		newObject:    func() client.Object { return &ngfAPIv1alpha1.SnippetsFilter{} },
+		prefixFields: 1,
+	},
+	// the file name joins the namespace and name with a hyphen, so they are read from the comment at the top
+	// of the file, like "# SnippetsPolicy default/snippets http context"
+	kinds.SnippetsPolicy: {
+		newObject:     func() client.Object { return &ngfAPIv1alpha1.SnippetsPolicy{} },
+		contentsRegex: regexp.MustCompile(`# SnippetsPolicy ([^/\s]+)/(\S+) `),
+	},
+}
+
+// routeObjects creates the routes that are named in the comments of the locations in the http configuration.
+var routeObjects = map[string]func() client.Object{
+	kinds.HTTPRoute: func() client.Object { return &gatewayv1.HTTPRoute{} },
+	kinds.GRPCRoute: func() client.Object { return &gatewayv1.GRPCRoute{} },
+}
+
+type configEventKey struct {
+	kind   string
+	reason string
+	nsname types.NamespacedName
+}
+
+type configEventState struct {
+	lastSent   time.Time
+	suppressed int
+}
+
+// configEventRecorder emits Events about nginx configuration apply failures and rollbacks on the Gateway, and
+// on the resources whose generated configuration is named in the nginx error: the resources of the include files,
+// and the routes of the location that the error is in. Other errors in the main http and stream configuration
+// can't be attributed to a single resource, so they are only reported on the Gateway.
+//
+// Events with the same reason for the same resource are sent at most once per interval, so that a flapping
+// configuration doesn't flood the API server. The next Event reports how many Events were suppressed.
+type configEventRecorder struct {
+	recorder events.EventRecorder
+	reader   client.Reader
+	sent     map[configEventKey]*configEventState
+	now      func() time.Time
+	logger   logr.Logger
+	interval time.Duration
+	lock     sync.Mutex
+}
+
+func newConfigEventRecorder(
+	logger logr.Logger,
+	reader client.Reader,
+	recorder events.EventRecorder,
+) *configEventRecorder {
+	return &configEventRecorder{
+		recorder: recorder,
+		reader:   reader,
+		logger:   logger,
+		sent:     make(map[configEventKey]*configEventState),
+		now:      time.Now,
+		interval: configEventInterval,
+	}
+}
+
+// applyFailed emits Events for an nginx configuration that failed to apply in the Pod.
+// The files are the nginx files sent to the Pod. They are used to find the routes of the locations in the error.
+func (r *configEventRecorder) applyFailed(
+	ctx context.Context,
+	deployment *Deployment,
+	conn *agentgrpc.Connection,
+	files []File,
+	applyErr error,
+) {
+	gw, ok := r.getGateway(ctx, deployment, conn)
+	if !ok {
+		return
+	}
+
+	r.emit(
+		gw,
+		nil,
+		v1.EventTypeWarning,
+		reasonConfigApplyFailed,
+		fmt.Sprintf("Failed to apply the nginx configuration in Pod %s: %s", conn.PodName, applyErr.Error()),
+	)
+
+	for _, obj := range r.resourcesInError(ctx, applyErr.Error(), files) {
+		r.emit(
+			obj,
+			gw,
+			v1.EventTypeWarning,
+			reasonConfigApplyFailed,
+			fmt.Sprintf(
+				"Failed to apply the nginx configuration generated for this resource in Pod %s of Gateway %s: %s",
+				conn.PodName,
+				client.ObjectKeyFromObject(gw),
+				applyErr.Error(),
+			),
+		)
+	}
+}
+
+// rolledBack emits an Event for the rollback of an nginx configuration that failed to apply in the Pod.
+func (r *configEventRecorder) rolledBack(
+	ctx context.Context,
+	deployment *Deployment,
+	conn *agentgrpc.Connection,
+	message string,
+) {
+	gw, ok := r.getGateway(ctx, deployment, conn)
+	if !ok {
+		return
+	}
+
+	reason := reasonConfigRolledBack
+	note := fmt.Sprintf(
+		"The nginx configuration failed to apply and Pod %s was rolled back to the previous configuration: %s",
+		conn.PodName,
+		message,
+	)
+	if strings.Contains(strings.ToLower(message), "rollback failed") {
+		reason = reasonConfigRollbackFailed
+		note = fmt.Sprintf(
+			"The nginx configuration failed to apply and Pod %s could not be rolled back to the previous configuration: %s",
+			conn.PodName,
+			message,
+		)
+	}
+
+	r.emit(gw, nil, v1.EventTypeWarning, reason, note)
+}
+
+func (r *configEventRecorder) getGateway(
+	ctx context.Context,
+	deployment *Deployment,
+	conn *agentgrpc.Connection,
+) (*gatewayv1.Gateway, bool) {
+	var gw gatewayv1.Gateway
+	gwNsName := types.NamespacedName{Namespace: conn.ParentName.Namespace, Name: deployment.GetGatewayName()}
+	if err := r.reader.Get(ctx, gwNsName, &gw); err != nil {
+		r.logger.Error(err, "error getting Gateway for nginx configuration event", "gateway", gwNsName)
+		return nil, false
+	}
+
+	return &gw, true
+}
+
+// resourcesInError returns the resources whose include files are named in the nginx error, and the routes of the
+// locations that the error is in.
+func (r *configEventRecorder) resourcesInError(ctx context.Context, errMsg string, files []File) []client.Object {
+	var objects []client.Object
+	seen := make(map[configEventKey]struct{})
+
+	for _, key := range slices.Concat(includeFileKeys(errMsg, files), locationRouteKeys(errMsg, files)) {
+		if _, exists := seen[key]; exists {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		var obj client.Object
+		if resource, ok := includeFileResources[key.kind]; ok {
+			obj = resource.newObject()
+		} else {
+			obj = routeObjects[key.kind]()
+		}
+
+		if err := r.reader.Get(ctx, key.nsname, obj); err != nil {
+			r.logger.V(1).Info(
+				"Unable to get resource for nginx configuration event",
+				"kind", key.kind,
+				"resource", key.nsname,
+				"error", err,
+			)
+			continue
+		}
+
+		objects = append(objects, obj)
+	}
+
+	return objects
+}
+
+// includeFileKeys returns the resources of the include files that are named in the nginx error.
+func includeFileKeys(errMsg string, files []File) []configEventKey {
+	var keys []configEventKey
+
+	for _, match := range includeFileRegex.FindAllStringSubmatch(errMsg, -1) {
+		kind := match[2]
+		resource, ok := includeFileResources[kind]
+		if !ok {
+			continue
+		}
+
+		if resource.contentsRegex != nil {
+			contentsMatch := resource.contentsRegex.FindSubmatch(fileContents(files, match[1]))
+			if contentsMatch == nil {
+				continue
+			}
+
+			keys = append(keys, configEventKey{
+				kind:   kind,
+				nsname: types.NamespacedName{Namespace: string(contentsMatch[1]), Name: string(contentsMatch[2])},
+			})
+
+			continue
+		}
+
+		// Kubernetes names can't contain underscores, so they separate the fields of the file name
+		fields := strings.Split(match[3], "_")
+		if len(fields) < resource.prefixFields+2 {
+			continue
+		}
+
+		keys = append(keys, configEventKey{
+			kind: kind,
+			nsname: types.NamespacedName{
+				Namespace: fields[resource.prefixFields],
+				Name:      fields[resource.prefixFields+1],
+			},
+		})
+	}
+
+	return keys
+}
+
+// locationRouteKeys returns the routes of the locations that the lines of the nginx error are in. The routes of a
+// location are named in the comments at the start of the location.
+func locationRouteKeys(errMsg string, files []File) []configEventKey {
+	var keys []configEventKey
+
+	for _, match := range configLineRegex.FindAllStringSubmatch(errMsg, -1) {
+		line, err := strconv.Atoi(match[2])
+		if err != nil {
+			continue
+		}
+
+		lines := strings.Split(string(fileContents(files, match[1])), "\n")
+		if line < 1 || line > len(lines) {
+			continue
+		}
+
+		start, ok := enclosingLocation(lines, line-1)
+		if !ok {
+			continue
+		}
+
+		for _, l := range lines[start+1:] {
+			commentMatch := routeCommentRegex.FindStringSubmatch(strings.TrimSpace(l))
+			if commentMatch == nil {
+				break
+			}
+
+			if _, ok := routeObjects[commentMatch[1]]; !ok {
+				continue
+			}
+
+			keys = append(keys, configEventKey{
+				kind:   commentMatch[1],
+				nsname: types.NamespacedName{Namespace: commentMatch[2], Name: commentMatch[3]},
+			})
+		}
+	}
+
+	return keys
+}
+
+// enclosingLocation returns the index of the line that opens the location block that the line is in.
+func enclosingLocation(lines []string, line int) (int, bool) {
+	// closed is the number of blocks that are closed between a line and the line of the error
+	var closed int
+
+	for i := line; i >= 0; i-- {
+		text, _, _ := strings.Cut(lines[i], "#")
+
+		closed += strings.Count(text, "}")
+		opened := strings.Count(text, "{")
+
+		if opened > closed && strings.HasPrefix(strings.TrimSpace(text), "location ") {
+			return i, true
+		}
+
+		closed = max(closed-opened, 0)
+	}
+
+	return 0, false
+}
+
+// fileContents returns the contents of the file with the name.
+func fileContents(files []File, name string) []byte {
+	for _, file := range files {
+		if file.Meta.GetName() == name {
+			return file.Contents
+		}
+	}
+
+	return nil
+}
+
+// emit sends the Event unless an Event with the same reason was sent for the resource within the interval.
+func (r *configEventRecorder) emit(regarding, related client.Object, eventType, reason, note string) {
+	suppressed, ok := r.allow(configEventKey{
+		kind:   fmt.Sprintf("%T", regarding),
+		reason: reason,
+		nsname: client.ObjectKeyFromObject(regarding),
+	})
+	if !ok {
+		return
+	}
+
+	if suppressed > 0 {
+		note = fmt.Sprintf("%s (%d similar events were suppressed)", note, suppressed)
+	}
+
+	r.recorder.Eventf(regarding, related, eventType, reason, configEventAction, "%s", truncateEventNote(note))
+}
+
+// allow returns whether an Event can be sent for the key, and the number of Events that were suppressed
+// since the last Event for the key.
+func (r *configEventRecorder) allow(key configEventKey) (int, bool) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.now()
+
+	state, exists := r.sent[key]
+	if exists && now.Sub(state.lastSent) < r.interval {
+		state.suppressed++
+		return 0, false
+	}
+
+	// forget the resources that had no Events within the interval, so that the map doesn't grow unbounded.
+	// Resources with suppressed Events are kept, so that the next Event for them reports the suppressed Events.
+	for k, s := range r.sent {
+		if s.suppressed == 0 && now.Sub(s.lastSent) >= r.interval {
+			delete(r.sent, k)
+		}
+	}
+
+	var suppressed int
+	if exists {
+		suppressed = state.suppressed
+	}
+
+	r.sent[key] = &configEventState{lastSent: now}
+
+	return suppressed, true
+}
+
+func truncateEventNote(note string) string {
+	if len(note) <= maxEventNoteLength {
+		return note
+	}
+
+	const ellipsis = "..."
+
+	return note[:maxEventNoteLength-len(ellipsis)] + ellipsis
+}
@@ -0,0 +1,317 @@
+package agent
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	pb "github.com/nginx/agent/v3/api/grpc/mpi/v1"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/broadcast/broadcastfakes"
+	agentgrpc "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc"
+)
+
+func createConfigEventsClient(t *testing.T, objs ...client.Object) client.Client {
+	t.Helper()
+	g := NewWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(gatewayv1.Install(scheme)).To(Succeed())
+	g.Expect(ngfAPIv1alpha1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(ngfAPIv1alpha2.AddToScheme(scheme)).To(Succeed())
+
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func TestResourcesInError(t *testing.T) {
+	t.Parallel()
+
+	csp := &ngfAPIv1alpha1.ClientSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "csp"},
+	}
+	obs := &ngfAPIv1alpha2.ObservabilityPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "obs"},
+	}
+	sf := &ngfAPIv1alpha1.SnippetsFilter{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "sf.name"},
+	}
+	psp := &ngfAPIv1alpha1.ProxySettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "psp"},
+	}
+	sp := &ngfAPIv1alpha1.SnippetsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test-ns", Name: "my-sp"},
+	}
+	hr := &gatewayv1.HTTPRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "coffee"},
+	}
+	gr := &gatewayv1.GRPCRoute{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "tea"},
+	}
+
+	fakeClient := createConfigEventsClient(t, csp, obs, sf, psp, sp, hr, gr)
+
+	httpConf := `http {
+server {
+    listen 80;
+    server_name cafe.example.com;
+
+    location /coffee {
+        # HTTPRoute test/coffee
+        # GRPCRoute test/tea
+        # HTTPRoute test/missing
+        proxy_pass http://coffee;
+        if ($bad) {
+            foo;
+        }
+    }
+
+    location /tea {
+        proxy_pass http://tea;
+    }
+    bar;
+}
+}
+`
+
+	files := []File{
+		{
+			Meta:     &pb.FileMeta{Name: "/etc/nginx/conf.d/http.conf"},
+			Contents: []byte(httpConf),
+		},
+		{
+			Meta: &pb.FileMeta{Name: "/etc/nginx/includes/SnippetsPolicy_http_test-ns-my-sp.conf"},
+			Contents: []byte(
+				"# SnippetsPolicy test-ns/my-sp http context\nfoo;\n",
+			),
+		},
+		{
+			Meta: &pb.FileMeta{Name: "/etc/nginx/main-includes/SnippetsPolicy_main_test-ns-my-sp.conf"},
+			Contents: []byte(
+				"# SnippetsPolicy test-ns/my-sp main context\nfoo;\n",
+			),
+		},
+	}
+
+	tests := []struct {
+		name     string
+		errMsg   string
+		expected []types.NamespacedName
+	}{
+		{
+			name:   "error in the http configuration",
+			errMsg: `nginx: [emerg] unknown directive "foo" in /etc/nginx/conf.d/http.conf:3`,
+		},
+		{
+			name:     "error in a policy include file",
+			errMsg:   `nginx: [emerg] invalid value "1x" in /etc/nginx/includes/ClientSettingsPolicy_test_csp.conf:3`,
+			expected: []types.NamespacedName{{Namespace: "test", Name: "csp"}},
+		},
+		{
+			name:     "error in a policy include file with a suffix",
+			errMsg:   `nginx: [emerg] invalid value in /etc/nginx/includes/ObservabilityPolicy_test_obs_int.conf:1`,
+			expected: []types.NamespacedName{{Namespace: "test", Name: "obs"}},
+		},
+		{
+			name: "error in a snippets filter include file",
+			errMsg: `nginx: [emerg] unknown directive "foo" in ` +
+				`/etc/nginx/includes/SnippetsFilter_http.server.location_test_sf.name.conf:1`,
+			expected: []types.NamespacedName{{Namespace: "test", Name: "sf.name"}},
+		},
+		{
+			name: "multiple errors in the same include file",
+			errMsg: `in /etc/nginx/includes/ClientSettingsPolicy_test_csp.conf:3; ` +
+				`in /etc/nginx/includes/ClientSettingsPolicy_test_csp.conf:4`,
+			expected: []types.NamespacedName{{Namespace: "test", Name: "csp"}},
+		},
+		{
+			name:     "error in a proxy settings policy include file",
+			errMsg:   `nginx: [emerg] invalid value in /etc/nginx/includes/ProxySettingsPolicy_test_psp.conf:2`,
+			expected: []types.NamespacedName{{Namespace: "test", Name: "psp"}},
+		},
+		{
+			name: "error in a snippets policy include file",
+			errMsg: `nginx: [emerg] unknown directive "foo" in ` +
+				`/etc/nginx/includes/SnippetsPolicy_http_test-ns-my-sp.conf:2`,
+			expected: []types.NamespacedName{{Namespace: "test-ns", Name: "my-sp"}},
+		},
+		{
+			name: "error in a snippets policy main include file",
+			errMsg: `nginx: [emerg] unknown directive "foo" in ` +
+				`/etc/nginx/main-includes/SnippetsPolicy_main_test-ns-my-sp.conf:2`,
+			expected: []types.NamespacedName{{Namespace: "test-ns", Name: "my-sp"}},
+		},
+		{
+			name: "error in a snippets policy include file that isn't sent to the Pod",
+			errMsg: `nginx: [emerg] unknown directive "foo" in ` +
+				`/etc/nginx/includes/SnippetsPolicy_server_test-ns-my-sp.conf:2`,
+		},
+		{
+			name:   "error in a location of the http configuration",
+			errMsg: `nginx: [emerg] invalid URL prefix in /etc/nginx/conf.d/http.conf:10`,
+			expected: []types.NamespacedName{
+				{Namespace: "test", Name: "coffee"},
+				{Namespace: "test", Name: "tea"},
+			},
+		},
+		{
+			name:   "error in a block of a location of the http configuration",
+			errMsg: `nginx: [emerg] unknown directive "foo" in /etc/nginx/conf.d/http.conf:12`,
+			expected: []types.NamespacedName{
+				{Namespace: "test", Name: "coffee"},
+				{Namespace: "test", Name: "tea"},
+			},
+		},
+		{
+			name:   "error in a location and a policy include file",
+			errMsg: `in /etc/nginx/includes/ProxySettingsPolicy_test_psp.conf:2; in /etc/nginx/conf.d/http.conf:6`,
+			expected: []types.NamespacedName{
+				{Namespace: "test", Name: "psp"},
+				{Namespace: "test", Name: "coffee"},
+				{Namespace: "test", Name: "tea"},
+			},
+		},
+		{
+			name:   "error in a location without routes",
+			errMsg: `nginx: [emerg] invalid URL prefix in /etc/nginx/conf.d/http.conf:17`,
+		},
+		{
+			name:   "error in a server after its locations",
+			errMsg: `nginx: [emerg] unknown directive "bar" in /etc/nginx/conf.d/http.conf:19`,
+		},
+		{
+			name:   "error in a line that doesn't exist",
+			errMsg: `nginx: [emerg] unexpected end of file in /etc/nginx/conf.d/http.conf:100`,
+		},
+		{
+			name:   "resource doesn't exist",
+			errMsg: `in /etc/nginx/includes/WAFPolicy_test_waf.conf:3`,
+		},
+		{
+			name:   "unknown include file",
+			errMsg: `in /etc/nginx/includes/test_authz_require_all.conf:3`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			r := newConfigEventRecorder(logr.Discard(), fakeClient, &events.FakeRecorder{})
+
+			objs := r.resourcesInError(t.Context(), test.errMsg, files)
+
+			nsnames := make([]types.NamespacedName, 0, len(objs))
+			for _, obj := range objs {
+				nsnames = append(nsnames, client.ObjectKeyFromObject(obj))
+			}
+
+			if test.expected == nil {
+				g.Expect(nsnames).To(BeEmpty())
+			} else {
+				g.Expect(nsnames).To(Equal(test.expected))
+			}
+		})
+	}
+}
+
+func TestConfigEventRecorderApplyFailed(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gw := &gatewayv1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"},
+	}
+	csp := &ngfAPIv1alpha1.ClientSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "csp"},
+	}
+
+	recorder := events.NewFakeRecorder(10)
+	r := newConfigEventRecorder(logr.Discard(), createConfigEventsClient(t, gw, csp), recorder)
+
+	now := time.Now()
+	r.now = func() time.Time { return now }
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "gateway")
+	conn := &agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+		PodName:    "nginx-pod",
+	}
+	applyErr := errors.New(`nginx: [emerg] invalid value in /etc/nginx/includes/ClientSettingsPolicy_test_csp.conf:3`)
+
+	r.applyFailed(t.Context(), deployment, conn, nil, applyErr)
+
+	g.Expect(recorder.Events).To(Receive(And(
+		ContainSubstring("Warning NginxConfigApplyFailed"),
+		ContainSubstring("Failed to apply the nginx configuration in Pod nginx-pod"),
+		ContainSubstring("ClientSettingsPolicy_test_csp.conf:3"),
+	)))
+	g.Expect(recorder.Events).To(Receive(And(
+		ContainSubstring("Warning NginxConfigApplyFailed"),
+		ContainSubstring("generated for this resource in Pod nginx-pod of Gateway test/gateway"),
+	)))
+
+	// the same failures are suppressed within the interval
+	r.applyFailed(t.Context(), deployment, conn, nil, applyErr)
+	r.applyFailed(t.Context(), deployment, conn, nil, applyErr)
+	g.Expect(recorder.Events).ToNot(Receive())
+
+	// a different reason isn't suppressed
+	r.rolledBack(t.Context(), deployment, conn, "Config apply failed, rollback successful")
+	g.Expect(recorder.Events).To(Receive(ContainSubstring(
+		"Warning NginxConfigRolledBack The nginx configuration failed to apply and Pod nginx-pod was rolled back",
+	)))
+
+	r.rolledBack(t.Context(), deployment, conn, "Config apply failed, rollback failed")
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("Warning NginxConfigRollbackFailed")))
+
+	// after the interval, the suppressed Events are reported
+	now = now.Add(configEventInterval)
+	r.applyFailed(t.Context(), deployment, conn, nil, applyErr)
+
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("(2 similar events were suppressed)")))
+	g.Expect(recorder.Events).To(Receive(ContainSubstring("(2 similar events were suppressed)")))
+	g.Expect(r.sent).To(HaveLen(2))
+}
+
+func TestConfigEventRecorderGatewayNotFound(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	recorder := events.NewFakeRecorder(1)
+	r := newConfigEventRecorder(logr.Discard(), createConfigEventsClient(t), recorder)
+
+	deployment := newDeployment(&broadcastfakes.FakeBroadcaster{}, "gateway")
+	conn := &agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "test", Name: "nginx-deployment"},
+	}
+
+	r.applyFailed(t.Context(), deployment, conn, nil, errors.New("error"))
+	r.rolledBack(t.Context(), deployment, conn, "rollback successful")
+
+	g.Expect(recorder.Events).ToNot(Receive())
+}
+
+func TestTruncateEventNote(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	short := "short note"
+	g.Expect(truncateEventNote(short)).To(Equal(short))
+
+	long := string(make([]byte, maxEventNoteLength+10))
+	truncated := truncateEventNote(long)
+	g.Expect(truncated).To(HaveLen(maxEventNoteLength))
+	g.Expect(truncated).To(HaveSuffix("..."))
+}
@@ -3,6 +3,8 @@ package agent
 import (
 	"bytes"
 	"context"
+	"errors"
+	"io"
 	"math"
 
 	"github.com/go-logr/logr"
@@ -202,7 +204,8 @@ func (*fileService) GetOverview(context.Context, *pb.GetOverviewRequest) (*pb.Ge
 // UpdateOverview is called by agent on startup and whenever any files change on the instance.
 // Since directly changing nginx configuration on the instance is not supported, NGF will send back an empty response.
 // However, we do use this call to gather the list of referenced files in the nginx configuration in order to
-// mark user mounted files as unmanaged so the agent does not attempt to modify them.
+// mark user mounted files as unmanaged so the agent does not attempt to modify them. We also compare the
+// reported file hashes against the desired files, and push the desired files back to the agent if they drifted.
 func (fs *fileService) UpdateOverview(
 	ctx context.Context,
 	req *pb.UpdateOverviewRequest,
@@ -212,14 +215,9 @@ func (fs *fileService) UpdateOverview(
 		return &pb.UpdateOverviewResponse{}, agentgrpc.ErrStatusInvalidConnection
 	}
 
-	conn := fs.connTracker.GetConnection(grpcInfo.UUID)
-	if conn.InstanceID == "" {
-		return &pb.UpdateOverviewResponse{}, status.Errorf(codes.Internal, "connection not found")
-	}
-
-	deployment := fs.nginxDeployments.Get(conn.ParentName)
-	if deployment == nil {
-		return &pb.UpdateOverviewResponse{}, status.Errorf(codes.Internal, "deployment not found in store")
+	conn, deployment, err := fs.getConnectionAndDeployment(grpcInfo.UUID)
+	if err != nil {
+		return &pb.UpdateOverviewResponse{}, err
 	}
 
 	requestFiles := req.GetOverview().GetFiles()
@@ -231,19 +229,121 @@ func (fs *fileService) UpdateOverview(
 
 	deployment.FileLock.Lock()
 	deployment.latestFileNames = fileNames
+	fs.detectConfigDrift(deployment, conn, grpcInfo.UUID, requestFiles)
 	deployment.FileLock.Unlock()
 
 	return &pb.UpdateOverviewResponse{}, nil
 }
 
 // UpdateFile is called by agent whenever any files change on the instance.
-// Since directly changing nginx configuration on the instance is not supported, this is a no-op for NGF.
-func (*fileService) UpdateFile(context.Context, *pb.UpdateFileRequest) (*pb.UpdateFileResponse, error) {
+// Since directly changing nginx configuration on the instance is not supported, NGF does not store the file,
+// but pushes the desired files back to the agent if the file drifted from the desired configuration.
+func (fs *fileService) UpdateFile(ctx context.Context, req *pb.UpdateFileRequest) (*pb.UpdateFileResponse, error) {
+	grpcInfo, ok := grpcContext.FromContext(ctx)
+	if !ok {
+		return &pb.UpdateFileResponse{}, agentgrpc.ErrStatusInvalidConnection
+	}
+
+	if err := fs.checkFileDrift(grpcInfo.UUID, req.GetFile().GetFileMeta()); err != nil {
+		return &pb.UpdateFileResponse{}, err
+	}
+
 	return &pb.UpdateFileResponse{}, nil
 }
 
 // UpdateFileStream is called by agent whenever any files change on the instance.
-// Since directly changing nginx configuration on the instance is not supported, this is a no-op for NGF.
-func (*fileService) UpdateFileStream(grpc.ClientStreamingServer[pb.FileDataChunk, pb.UpdateFileResponse]) error {
+// Since directly changing nginx configuration on the instance is not supported, NGF discards the file contents,
+// but pushes the desired files back to the agent if the file drifted from the desired configuration.
+func (fs *fileService) UpdateFileStream(
+	server grpc.ClientStreamingServer[pb.FileDataChunk, pb.UpdateFileResponse],
+) error {
+	grpcInfo, ok := grpcContext.FromContext(server.Context())
+	if !ok {
+		return agentgrpc.ErrStatusInvalidConnection
+	}
+
+	var fileMeta *pb.FileMeta
+	for {
+		chunk, err := server.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		if header := chunk.GetHeader(); header != nil {
+			fileMeta = header.GetFileMeta()
+		}
+	}
+
+	if err := fs.checkFileDrift(grpcInfo.UUID, fileMeta); err != nil {
+		return err
+	}
+
+	return server.SendAndClose(&pb.UpdateFileResponse{})
+}
+
+// checkFileDrift pushes the desired files back to the agent if the updated file drifted from
+// the desired configuration.
+func (fs *fileService) checkFileDrift(connKey string, fileMeta *pb.FileMeta) error {
+	if fileMeta == nil {
+		return status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	conn, deployment, err := fs.getConnectionAndDeployment(connKey)
+	if err != nil {
+		return err
+	}
+
+	deployment.FileLock.RLock()
+	fs.detectConfigDrift(deployment, conn, connKey, []*pb.File{{FileMeta: fileMeta}})
+	deployment.FileLock.RUnlock()
+
 	return nil
 }
+
+// detectConfigDrift compares the files reported by the agent against the desired files, and signals
+// the Pod's subscription to push the desired files back to the agent if they drifted. Drift is only
+// detected once the desired configuration was successfully applied to the Pod, since the files differ
+// while the configuration is being applied.
+// The deployment FileLock MUST already be locked before calling this function.
+func (fs *fileService) detectConfigDrift(
+	deployment *Deployment,
+	conn agentgrpc.Connection,
+	connKey string,
+	reported []*pb.File,
+) {
+	if !deployment.podConfigApplied(connKey) {
+		return
+	}
+
+	drifted := deployment.getDriftedFiles(connKey, reported)
+	if len(drifted) == 0 {
+		return
+	}
+
+	fs.logger.Info(
+		"Detected nginx configuration drift",
+		"files", drifted,
+		conn.ParentType, conn.ParentName,
+		"pod", conn.PodName,
+		"uuid", connKey,
+	)
+
+	deployment.requestConfigResync(connKey)
+}
+
+func (fs *fileService) getConnectionAndDeployment(connKey string) (agentgrpc.Connection, *Deployment, error) {
+	conn := fs.connTracker.GetConnection(connKey)
+	if conn.InstanceID == "" {
+		return conn, nil, status.Errorf(codes.Internal, "connection not found")
+	}
+
+	deployment := fs.nginxDeployments.Get(conn.ParentName)
+	if deployment == nil {
+		return conn, nil, status.Errorf(codes.Internal, "deployment not found in store")
+	}
+
+	return conn, deployment, nil
+}
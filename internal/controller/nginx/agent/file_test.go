@@ -2,6 +2,8 @@ package agent
 
 import (
 	"context"
+	"errors"
+	"io"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -639,21 +641,187 @@ func TestUpdateOverview_DeploymentNotFound(t *testing.T) {
 	g.Expect(resp).To(Equal(&pb.UpdateOverviewResponse{}))
 }
 
+func TestUpdateOverview_ConfigDrift(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		podStatus    error
+		name         string
+		reportedHash string
+		noPodStatus  bool
+		expectResync bool
+	}{
+		{
+			name:         "drifted file requests resync",
+			reportedHash: "changed-hash",
+			expectResync: true,
+		},
+		{
+			name:         "matching file does not request resync",
+			reportedHash: "desired-hash",
+		},
+		{
+			name:         "configuration not yet applied to pod",
+			reportedHash: "changed-hash",
+			noPodStatus:  true,
+		},
+		{
+			name:         "configuration failed to apply to pod",
+			reportedHash: "changed-hash",
+			podStatus:    errors.New("apply failed"),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			dep, fs, ctx := newDriftTestFileService(t)
+			if !test.noPodStatus {
+				dep.SetPodErrorStatus("1234567", test.podStatus)
+			}
+			resyncCh := dep.subscribeConfigResync("1234567")
+
+			resp, err := fs.UpdateOverview(ctx, &pb.UpdateOverviewRequest{
+				Overview: &pb.FileOverview{
+					Files: []*pb.File{
+						{FileMeta: &pb.FileMeta{Name: "nginx.conf", Hash: "unmanaged-hash"}},
+						{FileMeta: &pb.FileMeta{Name: "http.conf", Hash: test.reportedHash}},
+					},
+				},
+			})
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(resp).To(Equal(&pb.UpdateOverviewResponse{}))
+
+			if test.expectResync {
+				g.Expect(resyncCh).To(Receive())
+			} else {
+				g.Expect(resyncCh).ToNot(Receive())
+			}
+		})
+	}
+}
+
+func newDriftTestFileService(t *testing.T) (*Deployment, *fileService, context.Context) {
+	t.Helper()
+
+	deploymentName := types.NamespacedName{Name: "nginx-deployment", Namespace: "default"}
+
+	connTracker := &agentgrpcfakes.FakeConnectionsTracker{}
+	connTracker.GetConnectionReturns(agentgrpc.Connection{
+		InstanceID: "12345",
+		PodName:    "nginx-pod",
+		ParentName: deploymentName,
+	})
+
+	depStore := NewDeploymentStore(connTracker)
+	dep := depStore.LoadOrStore(t.Context(), deploymentName, "gateway")
+	dep.files = []File{
+		{
+			Meta:     &pb.FileMeta{Name: "http.conf", Hash: "desired-hash"},
+			Contents: []byte("desired"),
+		},
+	}
+
+	ctx := grpcContext.NewGrpcContext(t.Context(), grpcContext.GrpcInfo{
+		UUID: "1234567",
+	})
+
+	return dep, newFileService(logr.Discard(), depStore, connTracker), ctx
+}
+
 func TestUpdateFile(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	fs := newFileService(logr.Discard(), nil, nil)
-	resp, err := fs.UpdateFile(t.Context(), &pb.UpdateFileRequest{})
+	dep, fs, ctx := newDriftTestFileService(t)
+	dep.SetPodErrorStatus("1234567", nil)
+	resyncCh := dep.subscribeConfigResync("1234567")
 
+	resp, err := fs.UpdateFile(ctx, &pb.UpdateFileRequest{
+		File: &pb.File{FileMeta: &pb.FileMeta{Name: "http.conf", Hash: "desired-hash"}},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(resp).To(Equal(&pb.UpdateFileResponse{}))
+	g.Expect(resyncCh).ToNot(Receive())
+
+	resp, err = fs.UpdateFile(ctx, &pb.UpdateFileRequest{
+		File: &pb.File{FileMeta: &pb.FileMeta{Name: "http.conf", Hash: "changed-hash"}},
+	})
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(resp).To(Equal(&pb.UpdateFileResponse{}))
+	g.Expect(resyncCh).To(Receive())
+
+	resp, err = fs.UpdateFile(ctx, &pb.UpdateFileRequest{})
+	g.Expect(err).To(Equal(status.Error(codes.InvalidArgument, "invalid request")))
+	g.Expect(resp).To(Equal(&pb.UpdateFileResponse{}))
+
+	resp, err = fs.UpdateFile(t.Context(), &pb.UpdateFileRequest{})
+	g.Expect(err).To(Equal(agentgrpc.ErrStatusInvalidConnection))
+	g.Expect(resp).To(Equal(&pb.UpdateFileResponse{}))
 }
 
+type mockClientStreamingServer struct {
+	grpc.ServerStream
+	ctx      context.Context
+	response *pb.UpdateFileResponse
+	chunks   []*pb.FileDataChunk
+}
+
+func (m *mockClientStreamingServer) Recv() (*pb.FileDataChunk, error) {
+	if len(m.chunks) == 0 {
+		return nil, io.EOF
+	}
+
+	chunk := m.chunks[0]
+	m.chunks = m.chunks[1:]
+
+	return chunk, nil
+}
+
+func (m *mockClientStreamingServer) SendAndClose(resp *pb.UpdateFileResponse) error {
+	m.response = resp
+
+	return nil
+}
+
+func (m *mockClientStreamingServer) Context() context.Context { return m.ctx }
+
 func TestUpdateFileStream(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	fs := newFileService(logr.Discard(), nil, nil)
-	g.Expect(fs.UpdateFileStream(nil)).To(Succeed())
+	dep, fs, ctx := newDriftTestFileService(t)
+	dep.SetPodErrorStatus("1234567", nil)
+	resyncCh := dep.subscribeConfigResync("1234567")
+
+	server := &mockClientStreamingServer{
+		ctx: ctx,
+		chunks: []*pb.FileDataChunk{
+			{
+				Chunk: &pb.FileDataChunk_Header{
+					Header: &pb.FileDataChunkHeader{
+						FileMeta: &pb.FileMeta{Name: "http.conf", Hash: "changed-hash"},
+						Chunks:   1,
+					},
+				},
+			},
+			{
+				Chunk: &pb.FileDataChunk_Content{
+					Content: &pb.FileDataChunkContent{Data: []byte("changed")},
+				},
+			},
+		},
+	}
+
+	g.Expect(fs.UpdateFileStream(server)).To(Succeed())
+	g.Expect(server.response).To(Equal(&pb.UpdateFileResponse{}))
+	g.Expect(resyncCh).To(Receive())
+
+	server = &mockClientStreamingServer{ctx: ctx}
+	g.Expect(fs.UpdateFileStream(server)).To(MatchError(status.Error(codes.InvalidArgument, "invalid request")))
+
+	server = &mockClientStreamingServer{ctx: t.Context()}
+	g.Expect(fs.UpdateFileStream(server)).To(MatchError(agentgrpc.ErrStatusInvalidConnection))
 }
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"maps"
 	"sync"
 
 	"k8s.io/apimachinery/pkg/types"
@@ -15,6 +16,7 @@ import (
 type ConnectionsTracker interface {
 	Track(key string, conn Connection)
 	GetConnection(key string) Connection
+	ListConnections() map[string]Connection
 	SetInstanceID(key, id string)
 	RemoveConnection(key string)
 }
@@ -23,6 +25,10 @@ type ConnectionsTracker interface {
 type Connection struct {
 	InstanceID string
 	ParentType string
+	// PodName is the name of the nginx Pod that the agent runs in.
+	PodName string
+	// PodIP is the IP address of the nginx Pod that the agent connected from.
+	PodIP      string
 	ParentName types.NamespacedName
 }
 
@@ -63,6 +69,14 @@ func (c *AgentConnectionsTracker) GetConnection(key string) Connection {
 	return c.connections[key]
 }
 
+// ListConnections returns a copy of all tracked connections, keyed by the agent UUID.
+func (c *AgentConnectionsTracker) ListConnections() map[string]Connection {
+	c.lock.RLock()
+	defer c.lock.RUnlock()
+
+	return maps.Clone(c.connections)
+}
+
 // SetInstanceID sets the nginx instanceID for a connection.
 func (c *AgentConnectionsTracker) SetInstanceID(key, id string) {
 	c.lock.Lock()
@@ -28,6 +28,34 @@ func TestGetConnection(t *testing.T) {
 	g.Expect(nonExistent).To(Equal(agentgrpc.Connection{}))
 }
 
+func TestListConnections(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	tracker := agentgrpc.NewConnectionsTracker()
+	g.Expect(tracker.ListConnections()).To(BeEmpty())
+
+	conn1 := agentgrpc.Connection{
+		InstanceID: "instance1",
+		ParentName: types.NamespacedName{Namespace: "default", Name: "parent1"},
+	}
+	conn2 := agentgrpc.Connection{
+		ParentName: types.NamespacedName{Namespace: "default", Name: "parent2"},
+	}
+	tracker.Track("key1", conn1)
+	tracker.Track("key2", conn2)
+
+	connections := tracker.ListConnections()
+	g.Expect(connections).To(Equal(map[string]agentgrpc.Connection{
+		"key1": conn1,
+		"key2": conn2,
+	}))
+
+	// modifying the returned map does not affect the tracker
+	delete(connections, "key1")
+	g.Expect(tracker.ListConnections()).To(HaveLen(2))
+}
+
 func TestConnectionIsReady(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
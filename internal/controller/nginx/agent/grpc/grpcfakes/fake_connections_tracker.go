@@ -19,6 +19,16 @@ type FakeConnectionsTracker struct {
 	getConnectionReturnsOnCall map[int]struct {
 		result1 grpc.Connection
 	}
+	ListConnectionsStub        func() map[string]grpc.Connection
+	listConnectionsMutex       sync.RWMutex
+	listConnectionsArgsForCall []struct {
+	}
+	listConnectionsReturns struct {
+		result1 map[string]grpc.Connection
+	}
+	listConnectionsReturnsOnCall map[int]struct {
+		result1 map[string]grpc.Connection
+	}
 	RemoveConnectionStub        func(string)
 	removeConnectionMutex       sync.RWMutex
 	removeConnectionArgsForCall []struct {
@@ -101,6 +111,59 @@ func (fake *FakeConnectionsTracker) GetConnectionReturnsOnCall(i int, result1 gr
 	}{result1}
 }
 
+func (fake *FakeConnectionsTracker) ListConnections() map[string]grpc.Connection {
+	fake.listConnectionsMutex.Lock()
+	ret, specificReturn := fake.listConnectionsReturnsOnCall[len(fake.listConnectionsArgsForCall)]
+	fake.listConnectionsArgsForCall = append(fake.listConnectionsArgsForCall, struct {
+	}{})
+	stub := fake.ListConnectionsStub
+	fakeReturns := fake.listConnectionsReturns
+	fake.recordInvocation("ListConnections", []interface{}{})
+	fake.listConnectionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConnectionsTracker) ListConnectionsCallCount() int {
+	fake.listConnectionsMutex.RLock()
+	defer fake.listConnectionsMutex.RUnlock()
+	return len(fake.listConnectionsArgsForCall)
+}
+
+func (fake *FakeConnectionsTracker) ListConnectionsCalls(stub func() map[string]grpc.Connection) {
+	fake.listConnectionsMutex.Lock()
+	defer fake.listConnectionsMutex.Unlock()
+	fake.ListConnectionsStub = stub
+}
+
+func (fake *FakeConnectionsTracker) ListConnectionsReturns(result1 map[string]grpc.Connection) {
+	fake.listConnectionsMutex.Lock()
+	defer fake.listConnectionsMutex.Unlock()
+	fake.ListConnectionsStub = nil
+	fake.listConnectionsReturns = struct {
+		result1 map[string]grpc.Connection
+	}{result1}
+}
+
+func (fake *FakeConnectionsTracker) ListConnectionsReturnsOnCall(i int, result1 map[string]grpc.Connection) {
+	fake.listConnectionsMutex.Lock()
+	defer fake.listConnectionsMutex.Unlock()
+	fake.ListConnectionsStub = nil
+	if fake.listConnectionsReturnsOnCall == nil {
+		fake.listConnectionsReturnsOnCall = make(map[int]struct {
+			result1 map[string]grpc.Connection
+		})
+	}
+	fake.listConnectionsReturnsOnCall[i] = struct {
+		result1 map[string]grpc.Connection
+	}{result1}
+}
+
 func (fake *FakeConnectionsTracker) RemoveConnection(arg1 string) {
 	fake.removeConnectionMutex.Lock()
 	fake.removeConnectionArgsForCall = append(fake.removeConnectionArgsForCall, struct {
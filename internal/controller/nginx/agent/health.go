@@ -0,0 +1,55 @@
+package agent
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-logr/logr"
+)
+
+// DataPlaneHealthPath is the path of the debug endpoint that lists the nginx instances with an unhealthy
+// data plane.
+const DataPlaneHealthPath = "/debug/dataplane-health"
+
+// UnhealthyInstance is an nginx instance with an unhealthy data plane, as served by the data plane health
+// debug endpoint.
+type UnhealthyInstance struct {
+	// Namespace is the namespace of the Gateway and its Pod.
+	Namespace string `json:"namespace"`
+	// Gateway is the name of the Gateway.
+	Gateway string `json:"gateway"`
+	// Pod is the name of the Pod.
+	Pod string `json:"pod"`
+	// Description describes why the nginx instance is unhealthy.
+	Description string `json:"description,omitempty"`
+}
+
+// NewDataPlaneHealthHandler returns an http.Handler that lists the nginx instances with an unhealthy
+// data plane as JSON.
+func NewDataPlaneHealthHandler(store *DeploymentStore, logger logr.Logger) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		unhealthy := make([]UnhealthyInstance, 0)
+		for _, health := range store.ListPodHealth() {
+			if health.Healthy {
+				continue
+			}
+
+			unhealthy = append(unhealthy, UnhealthyInstance{
+				Namespace:   health.Namespace,
+				Gateway:     health.GatewayName,
+				Pod:         health.PodName,
+				Description: health.Description,
+			})
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(unhealthy); err != nil {
+			logger.Error(err, "error writing data plane health response")
+		}
+	})
+}
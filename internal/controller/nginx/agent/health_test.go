@@ -0,0 +1,46 @@
+package agent
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/types"
+
+	agentgrpcfakes "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent/grpc/grpcfakes"
+)
+
+func TestDataPlaneHealthHandler(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	store := NewDeploymentStore(&agentgrpcfakes.FakeConnectionsTracker{})
+	handler := NewDataPlaneHealthHandler(store, logr.Discard())
+
+	get := func() *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodGet, DataPlaneHealthPath, nil))
+		return rec
+	}
+
+	rec := get()
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Header().Get("Content-Type")).To(Equal("application/json"))
+	g.Expect(rec.Body.String()).To(MatchJSON(`[]`))
+
+	deployment := store.LoadOrStore(t.Context(), types.NamespacedName{Namespace: "test", Name: "gw-nginx"}, "gw")
+	deployment.SetPodHealth("uuid-1", PodHealth{PodName: "pod-1", Healthy: true})
+	deployment.SetPodHealth("uuid-2", PodHealth{PodName: "pod-2", Description: "nginx is not running"})
+
+	rec = get()
+	g.Expect(rec.Code).To(Equal(http.StatusOK))
+	g.Expect(rec.Body.String()).To(MatchJSON(
+		`[{"namespace":"test","gateway":"gw","pod":"pod-2","description":"nginx is not running"}]`,
+	))
+
+	rec = httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequestWithContext(t.Context(), http.MethodPost, DataPlaneHealthPath, nil))
+	g.Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+}
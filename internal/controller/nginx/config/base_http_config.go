@@ -7,6 +7,7 @@ import (
 	gotemplate "text/template"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesslog"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -14,6 +15,9 @@ import (
 
 var baseHTTPTemplate = gotemplate.Must(gotemplate.New("baseHttp").Parse(baseHTTPTemplateText))
 
+// accessLogsIncludeFileName is the name of the include file with the access logs configured in the NginxProxy.
+const accessLogsIncludeFileName = "access-logs.conf"
+
 type AccessLog struct {
 	Format     string // User's format string
 	Escape     string // Escape setting for variables (default, json, none)
@@ -82,6 +86,13 @@ func executeBaseHTTPConfig(conf dataplane.Configuration, generator policies.Gene
 	authZIncludes := createIncludesFromAuthZConfigs(conf.BaseHTTPConfig.AuthZConfigs)
 	includes = append(includes, authZIncludes...)
 
+	if len(conf.Logging.AccessLogs) > 0 {
+		includes = append(includes, shared.Include{
+			Name:    includesFolder + "/" + accessLogsIncludeFileName,
+			Content: accesslog.GenerateAccessLogs(conf.Logging.AccessLogs),
+		})
+	}
+
 	claimSets := collectAuthZClaimSets(conf.BaseHTTPConfig.AuthZConfigs)
 
 	hc := httpConfig{
@@ -122,6 +122,39 @@ func TestLoggingSettingsTemplate(t *testing.T) {
 	}
 }
 
+func TestExecuteBaseHttp_AccessLogs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		Logging: dataplane.Logging{
+			AccessLogs: []dataplane.AccessLog{
+				{Path: "/dev/stdout"},
+				{Path: "/var/log/nginx/access/access.log", Format: "$remote_addr", StatusCodes: []string{"5xx"}},
+			},
+		},
+	}
+
+	res := executeBaseHTTPConfig(conf, policies.UnimplementedGenerator{})
+	g.Expect(res).To(HaveLen(2))
+
+	sort.Slice(res, func(i, j int) bool {
+		return res[i].dest < res[j].dest
+	})
+
+	httpRes := string(res[0].data)
+	g.Expect(httpRes).To(ContainSubstring("include /etc/nginx/includes/access-logs.conf;"))
+	g.Expect(httpRes).ToNot(ContainSubstring("log_format"))
+
+	g.Expect(res[1].dest).To(Equal("/etc/nginx/includes/access-logs.conf"))
+	accessLogsRes := string(res[1].data)
+	g.Expect(accessLogsRes).To(ContainSubstring("access_log /dev/stdout combined;"))
+	g.Expect(accessLogsRes).To(ContainSubstring("log_format ngf_access_log_1 '$remote_addr';"))
+	g.Expect(accessLogsRes).To(ContainSubstring(
+		"access_log /var/log/nginx/access/access.log ngf_access_log_1 if=$ngf_access_log_1_status;",
+	))
+}
+
 func TestExecuteBaseHttp_HTTP2(t *testing.T) {
 	t.Parallel()
 	confOn := dataplane.Configuration{
@@ -13,8 +13,11 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesslog"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/clientsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/observability"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxycache"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxysettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/ratelimit"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/snippetspolicy"
@@ -134,6 +137,9 @@ func (g GeneratorImpl) Generate(conf dataplane.Configuration) []agent.File {
 		snippetspolicy.NewGenerator(),
 		proxysettings.NewGenerator(),
 		ratelimit.NewGenerator(),
+		proxycache.NewGenerator(),
+		accesscontrol.NewGenerator(),
+		accesslog.NewGenerator(),
 		waf.NewGenerator(),
 	)
 
@@ -184,7 +190,7 @@ func (g GeneratorImpl) executeConfigTemplates(
 ) []agent.File {
 	fileBytes := make(map[string][]byte)
 
-	httpUpstreams := g.createUpstreams(conf.Upstreams)
+	httpUpstreams := g.createUpstreams(conf.Upstreams, conf.BackendGroups)
 	keepAliveCheck := newKeepAliveChecker(httpUpstreams)
 
 	for _, execute := range g.getExecuteFuncs(generator, httpUpstreams, keepAliveCheck) {
@@ -230,7 +236,7 @@ func (g GeneratorImpl) getExecuteFuncs(
 		executeSplitClients,
 		executeMaps,
 		executeTelemetry,
-		g.executeStreamServers,
+		g.newExecuteStreamServersFunc(generator),
 		g.executeStreamUpstreams,
 		executeStreamMaps,
 		executePlusAPI,
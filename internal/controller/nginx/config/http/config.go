@@ -69,6 +69,10 @@ type Location struct {
 	AuthBasic *AuthBasic
 	// Guardrails holds the ai-guardrails (PayloadProcessor ExtProcess) configuration for this location.
 	Guardrails *GuardrailsConfig
+	// Timeouts holds the timeouts for proxying requests from this location.
+	Timeouts *LocationTimeouts
+	// Retry holds the configuration for retrying requests from this location on other backends.
+	Retry *LocationRetry
 	// ProxyPassRequestBody renders proxy_pass_request_body ("on"/"off"); unset leaves the directive out.
 	ProxyPassRequestBody string
 	// ProxyPassRequestHeaders renders proxy_pass_request_headers ("on"/"off"); unset leaves the directive out.
@@ -111,6 +115,9 @@ type Location struct {
 	Rewrites []string
 	// MirrorPaths are paths to which requests are mirrored.
 	MirrorPaths []string
+	// Routes are the routes that this location was generated for, as "<kind> <namespace>/<name>". They are
+	// rendered as comments, so that nginx errors in the location can be attributed to the routes.
+	Routes []string
 	// Includes are additional NGINX config snippets or policies to include in this location.
 	Includes []shared.Include
 	// CORSHeaders are the CORS headers to be added for this location.
@@ -123,6 +130,27 @@ type Location struct {
 	GRPC bool
 }
 
+// LocationTimeouts holds the timeouts for proxying requests from a location.
+type LocationTimeouts struct {
+	// Connect renders the connect timeout for establishing a connection with a backend.
+	Connect string
+	// Read renders the timeout between two successive read operations from a backend.
+	Read string
+	// Send renders the timeout between two successive write operations to a backend.
+	Send string
+	// NextUpstream renders the overall time limit for passing a request to backends, including retries.
+	NextUpstream string
+}
+
+// LocationRetry holds the configuration for retrying requests from a location on other backends.
+type LocationRetry struct {
+	// Conditions are the cases in which a request is passed to the next backend, such as error or http_503.
+	Conditions []string
+	// Tries limits the number of possible tries for passing a request to the next backend.
+	// Zero means no limit.
+	Tries int
+}
+
 // AuthOIDC holds the OIDC authentication configuration for a location.
 type AuthOIDC struct {
 	// AuthZConfig holds the authorization configuration for OIDC.
@@ -136,6 +164,9 @@ type AuthOIDC struct {
 type AuthExternalRequest struct {
 	// ProxySSLVerify holds TLS verification config for the auth backend.
 	ProxySSLVerify *ProxySSLVerify
+	// GRPC holds the gRPC auth server that the ext_authz shim sends the auth subrequest to.
+	// If nil, the auth subrequest is proxied to the HTTP auth server upstream.
+	GRPC *AuthExternalGRPC
 	// InternalPath is the auth subrequest location path.
 	InternalPath string
 	// UpstreamName is the upstream to proxy_pass to in the internal location.
@@ -150,6 +181,15 @@ type AuthExternalRequest struct {
 	ForwardBody bool
 }
 
+// AuthExternalGRPC holds the configuration of a gRPC auth server that implements the Envoy ext_authz API.
+type AuthExternalGRPC struct {
+	// Server is the <host>:<port> of the auth server.
+	Server string
+	// TrustedCertificate is the CA certificate file to verify the auth server if TLS is enabled.
+	// If empty, the system CA certificates are used.
+	TrustedCertificate string
+}
+
 // Header defines an HTTP header to be passed to the proxied server.
 type Header struct {
 	Name  string
@@ -200,6 +240,7 @@ const (
 
 // Upstream holds all configuration for an HTTP upstream.
 type Upstream struct {
+	HealthCheck         *UpstreamHealthCheck
 	SessionPersistence  UpstreamSessionPersistence
 	Name                string
 	ZoneSize            string // format: 512k, 1m
@@ -226,10 +267,37 @@ type UpstreamKeepAlive struct {
 	Requests    int32
 }
 
+// UpstreamHealthCheck holds the active health check configuration for an HTTP upstream.
+type UpstreamHealthCheck struct {
+	Match *UpstreamHealthCheckMatch
+	// ProxySSLVerify holds the backend TLS verification settings, if the upstream has a BackendTLSPolicy.
+	ProxySSLVerify *ProxySSLVerify
+	GRPCStatus     *int32
+	Interval       string
+	URI            string
+	GRPCService    string
+	Fails          int32
+	Passes         int32
+	Port           int32
+	GRPC           bool
+}
+
+// UpstreamHealthCheckMatch holds the conditions that a health check response must satisfy.
+type UpstreamHealthCheckMatch struct {
+	Name   string
+	Body   string
+	Status []string
+}
+
 // UpstreamServer holds all configuration for an HTTP upstream server.
 type UpstreamServer struct {
-	Address string
-	Resolve bool
+	MaxFails    *int32
+	Address     string
+	FailTimeout string
+	SlowStart   string
+	Resolve     bool
+	Backup      bool
+	Drain       bool
 }
 
 // SplitClient holds all configuration for an HTTP split client.
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"maps"
 	"slices"
 	"sort"
 	"strconv"
@@ -28,6 +29,11 @@ const (
 	// connectionClosedStreamServerSocket is used when we want to listen on a port but have no service configured,
 	// so we pass to this server that just returns an empty string to tell users that we are listening.
 	connectionClosedStreamServerSocket = SocketBasePath + "connection-closed-server.sock"
+
+	// nodeNameVariable is the name of the node of the nginx replica, which is set by the topology njs module.
+	nodeNameVariable = "$ngf_node_name"
+	// topologyZoneVariable is the topology zone of the node of the nginx replica.
+	topologyZoneVariable = "$ngf_topology_zone"
 )
 
 func executeMaps(conf dataplane.Configuration) []executeResult {
@@ -38,6 +44,7 @@ func executeMaps(conf dataplane.Configuration) []executeResult {
 	maps := buildAddHeaderMaps(httpAndSSLServers)
 	maps = append(maps, buildInferenceMaps(conf.BackendGroups)...)
 	maps = append(maps, buildCorsMaps(conf.HTTPServers, conf.SSLServers)...)
+	maps = append(maps, buildTopologyMaps(conf.Upstreams, conf.NodeZones)...)
 
 	if !conf.BaseHTTPConfig.DisableSNIHostValidation {
 		maps = append(maps, buildMisdirectedRequestMaps(conf.SSLListenerHostnames)...)
@@ -51,6 +58,56 @@ func executeMaps(conf dataplane.Configuration) []executeResult {
 	return []executeResult{result}
 }
 
+// buildTopologyMaps builds the maps that select the topology-aware variants of the upstreams for the zone
+// of the node of the nginx replica. If the zone of the node is unknown, or an upstream has no variant for the zone,
+// the upstream itself is selected.
+func buildTopologyMaps(upstreams []dataplane.Upstream, nodeZones map[string]string) []shared.Map {
+	var topologyMaps []shared.Map
+
+	for _, up := range upstreams {
+		if len(up.TopologyUpstreams) == 0 {
+			continue
+		}
+
+		params := make([]shared.MapParameter, 0, len(up.TopologyUpstreams)+1)
+		params = append(params, shared.MapParameter{Value: "default", Result: up.Name})
+		for _, topologyUpstream := range up.TopologyUpstreams {
+			params = append(params, shared.MapParameter{
+				Value:  fmt.Sprintf("%q", topologyUpstream.Zone),
+				Result: topologyUpstream.Name,
+			})
+		}
+
+		topologyMaps = append(topologyMaps, shared.Map{
+			Source:     topologyZoneVariable,
+			Variable:   generateTopologyUpstreamVariableName(up.Name),
+			Parameters: params,
+		})
+	}
+
+	if len(topologyMaps) == 0 {
+		return nil
+	}
+
+	nodes := slices.Sorted(maps.Keys(nodeZones))
+	params := make([]shared.MapParameter, 0, len(nodes)+1)
+	params = append(params, shared.MapParameter{Value: "default", Result: `""`})
+	for _, node := range nodes {
+		params = append(params, shared.MapParameter{
+			Value:  fmt.Sprintf("%q", node),
+			Result: fmt.Sprintf("%q", nodeZones[node]),
+		})
+	}
+
+	zoneMap := shared.Map{
+		Source:     nodeNameVariable,
+		Variable:   topologyZoneVariable,
+		Parameters: params,
+	}
+
+	return append([]shared.Map{zoneMap}, topologyMaps...)
+}
+
 func buildCorsMaps(httpServers, sslServers []dataplane.VirtualServer) []shared.Map {
 	originMaps := make([]shared.Map, 0)
 
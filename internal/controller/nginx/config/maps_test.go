@@ -1348,3 +1348,82 @@ func TestBuildMisdirectedRequestMaps(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildTopologyMaps(t *testing.T) {
+	t.Parallel()
+
+	topologyUpstream := dataplane.Upstream{
+		Name: "test_svc-1_80",
+		TopologyUpstreams: []dataplane.Upstream{
+			{Name: "test_svc-1_80_zone_zone-a", Zone: "zone-a"},
+			{Name: "test_svc-1_80_zone_zone-b", Zone: "zone-b"},
+		},
+	}
+
+	tests := []struct {
+		nodeZones map[string]string
+		name      string
+		upstreams []dataplane.Upstream
+		expMaps   []shared.Map
+	}{
+		{
+			name:      "no topology-aware upstreams",
+			upstreams: []dataplane.Upstream{{Name: "test_svc_80"}},
+			nodeZones: map[string]string{"node-1": "zone-a"},
+		},
+		{
+			name:      "topology-aware upstream",
+			upstreams: []dataplane.Upstream{{Name: "test_svc_80"}, topologyUpstream},
+			nodeZones: map[string]string{"node-2": "zone-b", "node-1": "zone-a"},
+			expMaps: []shared.Map{
+				{
+					Source:   "$ngf_node_name",
+					Variable: "$ngf_topology_zone",
+					Parameters: []shared.MapParameter{
+						{Value: "default", Result: `""`},
+						{Value: `"node-1"`, Result: `"zone-a"`},
+						{Value: `"node-2"`, Result: `"zone-b"`},
+					},
+				},
+				{
+					Source:   "$ngf_topology_zone",
+					Variable: "$ngf_topology_test_svc_1_80",
+					Parameters: []shared.MapParameter{
+						{Value: "default", Result: "test_svc-1_80"},
+						{Value: `"zone-a"`, Result: "test_svc-1_80_zone_zone-a"},
+						{Value: `"zone-b"`, Result: "test_svc-1_80_zone_zone-b"},
+					},
+				},
+			},
+		},
+		{
+			name:      "topology-aware upstream without node zones",
+			upstreams: []dataplane.Upstream{topologyUpstream},
+			expMaps: []shared.Map{
+				{
+					Source:     "$ngf_node_name",
+					Variable:   "$ngf_topology_zone",
+					Parameters: []shared.MapParameter{{Value: "default", Result: `""`}},
+				},
+				{
+					Source:   "$ngf_topology_zone",
+					Variable: "$ngf_topology_test_svc_1_80",
+					Parameters: []shared.MapParameter{
+						{Value: "default", Result: "test_svc-1_80"},
+						{Value: `"zone-a"`, Result: "test_svc-1_80_zone_zone-a"},
+						{Value: `"zone-b"`, Result: "test_svc-1_80_zone_zone-b"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildTopologyMaps(test.upstreams, test.nodeZones)).To(Equal(test.expMaps))
+		})
+	}
+}
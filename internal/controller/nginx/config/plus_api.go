@@ -7,6 +7,9 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
+// PlusAPIPort is the port of the read-only NGINX Plus API, which is accessible from the allowed addresses.
+const PlusAPIPort = "8765"
+
 var plusAPITemplate = gotemplate.Must(gotemplate.New("plusAPI").Parse(plusAPITemplateText))
 
 func executePlusAPI(conf dataplane.Configuration) []executeResult {
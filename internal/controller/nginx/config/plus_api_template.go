@@ -11,7 +11,7 @@ server {
 }
 
 server {
-    listen 8765;
+    listen ` + PlusAPIPort + `;
     root /usr/share/nginx/html;
     access_log off;
     {{ range $address := .AllowedAddresses }}
@@ -0,0 +1,86 @@
+package accesscontrol
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// accessTemplate generates the allow and deny directives. The directives are the same in the http and
+// stream contexts, and NGINX checks them in order until the first match is found.
+const accessTemplate = `
+{{- range $r := .Rules }}
+{{- range $addr := $r.Addresses }}
+{{ $r.Action }} {{ $addr }};
+{{- end }}
+{{- end }}
+{{- if .DefaultAction }}
+{{ .DefaultAction }} all;
+{{- end }}
+`
+
+var tmpl = template.Must(template.New("access control policy").Parse(accessTemplate))
+
+const (
+	// fileNamePrefix is the prefix for all generated access control policy config file names.
+	fileNamePrefix = "AccessControlPolicy"
+	// fileNameSuffixStream is the suffix of the file included in stream servers.
+	fileNameSuffixStream = "stream"
+)
+
+// Generator generates nginx configuration based on an access control policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols, "")
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols, "")
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, "")
+}
+
+// GenerateForStreamServer generates policy configuration for a server block in the stream context.
+func (g Generator) GenerateForStreamServer(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, fileNameSuffixStream)
+}
+
+func generate(pols []policies.Policy, suffix string) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		acp, ok := pol.(*ngfAPI.AccessControlPolicy)
+		if !ok {
+			continue
+		}
+
+		name := fmt.Sprintf("%s_%s_%s.conf", fileNamePrefix, acp.Namespace, acp.Name)
+		if suffix != "" {
+			name = fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, acp.Namespace, acp.Name, suffix)
+		}
+
+		files = append(files, policies.File{
+			Name:    name,
+			Content: helpers.MustExecuteTemplate(tmpl, acp.Spec),
+		})
+	}
+
+	return files
+}
@@ -0,0 +1,135 @@
+package accesscontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	objectMeta := v1.ObjectMeta{
+		Name:      "test-policy",
+		Namespace: "default",
+	}
+
+	tests := []struct {
+		name       string
+		policy     policies.Policy
+		expContent string
+	}{
+		{
+			name: "rules and default action",
+			policy: &ngfAPIv1alpha1.AccessControlPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.AccessControlPolicySpec{
+					Rules: []ngfAPIv1alpha1.AccessControlRule{
+						{
+							Action:    ngfAPIv1alpha1.AccessControlActionDeny,
+							Addresses: []string{"10.0.0.1", "2001:db8::1"},
+						},
+						{
+							Action:    ngfAPIv1alpha1.AccessControlActionAllow,
+							Addresses: []string{"10.0.0.0/8"},
+						},
+					},
+					DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionDeny),
+				},
+			},
+			expContent: "\ndeny 10.0.0.1;\ndeny 2001:db8::1;\nallow 10.0.0.0/8;\ndeny all;\n",
+		},
+		{
+			name: "rules only",
+			policy: &ngfAPIv1alpha1.AccessControlPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.AccessControlPolicySpec{
+					Rules: []ngfAPIv1alpha1.AccessControlRule{
+						{
+							Action:    ngfAPIv1alpha1.AccessControlActionDeny,
+							Addresses: []string{"192.168.1.0/24"},
+						},
+					},
+				},
+			},
+			expContent: "\ndeny 192.168.1.0/24;\n",
+		},
+		{
+			name: "default action only",
+			policy: &ngfAPIv1alpha1.AccessControlPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.AccessControlPolicySpec{
+					DefaultAction: helpers.GetPointer(ngfAPIv1alpha1.AccessControlActionAllow),
+				},
+			},
+			expContent: "\nallow all;\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := accesscontrol.NewGenerator()
+
+			checkResults := func(resFiles policies.GenerateResultFiles, expName string) {
+				g.Expect(resFiles).To(HaveLen(1))
+				g.Expect(resFiles[0].Name).To(Equal(expName))
+				g.Expect(string(resFiles[0].Content)).To(Equal(test.expContent))
+			}
+
+			expName := "AccessControlPolicy_default_test-policy.conf"
+
+			checkResults(generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{}), expName)
+			checkResults(generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{}), expName)
+			checkResults(generator.GenerateForInternalLocation([]policies.Policy{test.policy}), expName)
+			checkResults(
+				generator.GenerateForStreamServer([]policies.Policy{test.policy}),
+				"AccessControlPolicy_default_test-policy_stream.conf",
+			)
+
+			g.Expect(generator.GenerateForHTTP([]policies.Policy{test.policy})).To(BeEmpty())
+			g.Expect(generator.GenerateForStream([]policies.Policy{test.policy})).To(BeEmpty())
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := accesscontrol.NewGenerator()
+
+	resFiles := generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForStreamServer([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForStreamServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
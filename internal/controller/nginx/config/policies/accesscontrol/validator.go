@@ -0,0 +1,88 @@
+package accesscontrol
+
+import (
+	"errors"
+	"net"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// Validator validates an AccessControlPolicy.
+// Implements policies.Validator interface.
+type Validator struct{}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator() *Validator {
+	return &Validator{}
+}
+
+// Validate validates the spec of an AccessControlPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	acp := helpers.MustCastObject[*ngfAPI.AccessControlPolicy](policy)
+
+	if err := validateSettings(acp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates an AccessControlPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two AccessControlPolicies conflict.
+// The rules of an AccessControlPolicy are ordered, so the rules of two policies attached to the same target
+// cannot be merged and any two AccessControlPolicies attached to the same target conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.AccessControlPolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.AccessControlPolicy](polB)
+
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func validateSettings(spec ngfAPI.AccessControlPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	for i, rule := range spec.Rules {
+		rulePath := fieldPath.Child("rules").Index(i)
+
+		for j, addr := range rule.Addresses {
+			if err := validateAddress(addr); err != nil {
+				allErrs = append(allErrs, field.Invalid(rulePath.Child("addresses").Index(j), addr, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateAddress validates that the address is an IP address or a CIDR range.
+func validateAddress(addr string) error {
+	if strings.Contains(addr, "/") {
+		if _, _, err := net.ParseCIDR(addr); err != nil {
+			return errors.New("must be a valid CIDR range, for example 10.0.0.0/8 or 2001:db8::/32")
+		}
+
+		return nil
+	}
+
+	if net.ParseIP(addr) == nil {
+		return errors.New("must be a valid IP address, for example 10.0.0.1 or 2001:db8::1")
+	}
+
+	return nil
+}
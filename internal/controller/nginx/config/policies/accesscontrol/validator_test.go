@@ -0,0 +1,165 @@
+package accesscontrol_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesscontrol"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy
+
+func createValidPolicy() *ngfAPI.AccessControlPolicy {
+	return &ngfAPI.AccessControlPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.AccessControlPolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Rules: []ngfAPI.AccessControlRule{
+				{
+					Action:    ngfAPI.AccessControlActionDeny,
+					Addresses: []string{"10.0.0.1", "2001:db8::1"},
+				},
+				{
+					Action:    ngfAPI.AccessControlActionAllow,
+					Addresses: []string{"10.0.0.0/8", "2001:db8::/32"},
+				},
+			},
+			DefaultAction: helpers.GetPointer(ngfAPI.AccessControlActionDeny),
+		},
+		Status: v1.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.AccessControlPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.AccessControlPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid IP address",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.Rules[0].Addresses = append(p.Spec.Rules[0].Addresses, "10.0.0.256")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rules[0].addresses[2]: Invalid value: \"10.0.0.256\": " +
+					"must be a valid IP address, for example 10.0.0.1 or 2001:db8::1"),
+			},
+		},
+		{
+			name: "invalid CIDR ranges",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.Rules[1].Addresses = []string{"10.0.0.0/33", "all; return 200/8"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.rules[1].addresses[0]: Invalid value: \"10.0.0.0/33\": " +
+					"must be a valid CIDR range, for example 10.0.0.0/8 or 2001:db8::/32, " +
+					"spec.rules[1].addresses[1]: Invalid value: \"all; return 200/8\": " +
+					"must be a valid CIDR range, for example 10.0.0.0/8 or 2001:db8::/32]"),
+			},
+		},
+		{
+			name: "invalid keyword",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.Rules[0].Addresses = []string{"all"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rules[0].addresses[0]: Invalid value: \"all\": " +
+					"must be a valid IP address, for example 10.0.0.1 or 2001:db8::1"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid with only default action",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessControlPolicy) *ngfAPI.AccessControlPolicy {
+				p.Spec.Rules = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := accesscontrol.NewValidator()
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := accesscontrol.NewValidator()
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := accesscontrol.NewValidator()
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := accesscontrol.NewValidator()
+
+	g.Expect(v.Conflicts(createValidPolicy(), &ngfAPI.AccessControlPolicy{})).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := accesscontrol.NewValidator()
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
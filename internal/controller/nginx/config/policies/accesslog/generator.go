@@ -0,0 +1,239 @@
+package accesslog
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// accessLogDefinitionsTemplate generates the log_format directives and the variables that the access_log
+// directives depend on. These directives are only allowed in the http context.
+const accessLogDefinitionsTemplate = `
+{{- range $l := . }}
+  {{- if $l.Format }}
+log_format {{ $l.FormatName }}{{ if $l.Escape }} escape={{ $l.Escape }}{{ end }} '{{ $l.Format }}';
+  {{- end }}
+  {{- if $l.StatusCodes }}
+map $status {{ $l.StatusVariable }} {
+    {{- range $code := $l.StatusCodes }}
+    {{ $code }} 1;
+    {{- end }}
+    default 0;
+}
+  {{- end }}
+  {{- if $l.SamplePercent }}
+split_clients $request_id {{ $l.SampleVariable }} {
+    {{ $l.SamplePercent }}% 1;
+    * 0;
+}
+  {{- end }}
+  {{- if and $l.StatusCodes $l.SamplePercent }}
+map "{{ $l.StatusVariable }}{{ $l.SampleVariable }}" {{ $l.ConditionVariable }} {
+    11 1;
+    default 0;
+}
+  {{- end }}
+{{- end }}
+`
+
+//nolint:lll
+const accessLogTemplate = `
+{{- range $l := . }}
+access_log {{ $l.Path }} {{ if $l.Format }}{{ $l.FormatName }}{{ else }}combined{{ end }}{{ if $l.ConditionVariable }} if={{ $l.ConditionVariable }}{{ end }};
+{{- end }}
+`
+
+const accessLogOffTemplate = `
+access_log off;
+`
+
+var (
+	tmplDefinitions  = template.Must(template.New("access log definitions").Parse(accessLogDefinitionsTemplate))
+	tmplAccessLog    = template.Must(template.New("access log").Parse(accessLogTemplate))
+	tmplAccessLogOff = template.Must(template.New("access log off").Parse(accessLogOffTemplate))
+	tmplGlobal       = template.Must(
+		template.New("access log global").Parse(accessLogDefinitionsTemplate + accessLogTemplate),
+	)
+)
+
+const (
+	// fileNamePrefix is the prefix for all generated access log policy config file names.
+	fileNamePrefix = "AccessLogPolicy"
+
+	fileNameSuffixHTTP = "http"
+
+	// globalNamePrefix is the prefix of the names of the access logs that are configured in the NginxProxy.
+	globalNamePrefix = "ngf_access_log"
+)
+
+// accessLog holds the settings of an access log that are used by the templates.
+type accessLog struct {
+	// FormatName is the name of the log_format. Only used if Format is set.
+	FormatName string
+	// Format is the access log format template. If empty, the predefined combined format is used.
+	Format string
+	// Escape specifies how to escape characters in variables.
+	Escape string
+	// Path is where the access log is written to.
+	Path string
+	// StatusVariable is the variable that is set to 1 if the response status matches StatusCodes.
+	StatusVariable string
+	// SampleVariable is the variable that is set to 1 for the sampled requests.
+	SampleVariable string
+	// ConditionVariable is the variable that determines whether a request is logged.
+	ConditionVariable string
+	// StatusCodes are the map keys matching the response status codes that are logged.
+	StatusCodes []string
+	// SamplePercent is the percentage of requests that are logged.
+	SamplePercent int32
+}
+
+// Generator generates nginx configuration based on an AccessLogPolicy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForHTTP generates the log formats and the condition variables of AccessLogPolicies for the http context.
+func (g Generator) GenerateForHTTP(pols []policies.Policy) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		alp, ok := pol.(*ngfAPI.AccessLogPolicy)
+		if !ok || isDisabled(alp) {
+			continue
+		}
+
+		content := helpers.MustExecuteTemplate(tmplDefinitions, buildPolicyAccessLogs(alp))
+		if len(bytes.TrimSpace(content)) == 0 {
+			continue
+		}
+
+		files = append(files, policies.File{
+			Name:    fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, alp.Namespace, alp.Name, fileNameSuffixHTTP),
+			Content: content,
+		})
+	}
+
+	return files
+}
+
+// GenerateForLocation generates the access_log directives of an AccessLogPolicy for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generateAccessLogs(pols)
+}
+
+// GenerateForInternalLocation generates the access_log directives of an AccessLogPolicy for an internal
+// location block. Requests are logged in the location where the request processing finishes, so the
+// internal location needs the same directives as the normal location that redirects to it.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generateAccessLogs(pols)
+}
+
+// GenerateAccessLogs generates the configuration of the access logs that are configured in the NginxProxy.
+// The configuration applies to the http context.
+func GenerateAccessLogs(logs []dataplane.AccessLog) []byte {
+	return helpers.MustExecuteTemplate(tmplGlobal, buildAccessLogs(globalNamePrefix, logs))
+}
+
+func generateAccessLogs(pols []policies.Policy) policies.GenerateResultFiles {
+	for _, pol := range pols {
+		alp, ok := pol.(*ngfAPI.AccessLogPolicy)
+		if !ok {
+			continue
+		}
+
+		var content []byte
+		if isDisabled(alp) {
+			content = helpers.MustExecuteTemplate(tmplAccessLogOff, nil)
+		} else {
+			content = helpers.MustExecuteTemplate(tmplAccessLog, buildPolicyAccessLogs(alp))
+		}
+
+		return policies.GenerateResultFiles{
+			{
+				Name:    fmt.Sprintf("%s_%s_%s.conf", fileNamePrefix, alp.Namespace, alp.Name),
+				Content: content,
+			},
+		}
+	}
+
+	return nil
+}
+
+func isDisabled(alp *ngfAPI.AccessLogPolicy) bool {
+	return alp.Spec.Disable != nil && *alp.Spec.Disable
+}
+
+func buildPolicyAccessLogs(alp *ngfAPI.AccessLogPolicy) []accessLog {
+	prefix := sanitizeName(fmt.Sprintf("%s_alp_%s", alp.Namespace, alp.Name))
+
+	return buildAccessLogs(prefix, dataplane.BuildAccessLogs(alp.Spec.AccessLogs))
+}
+
+func buildAccessLogs(prefix string, logs []dataplane.AccessLog) []accessLog {
+	accessLogs := make([]accessLog, 0, len(logs))
+
+	for i, log := range logs {
+		name := fmt.Sprintf("%s_%d", prefix, i)
+
+		al := accessLog{
+			FormatName:    name,
+			Format:        log.Format,
+			Escape:        log.Escape,
+			Path:          log.Path,
+			SamplePercent: log.SamplePercent,
+		}
+
+		if al.Path == "" {
+			al.Path = dataplane.DefaultAccessLogPath
+		}
+
+		if len(log.StatusCodes) > 0 {
+			al.StatusVariable = "$" + name + "_status"
+			al.StatusCodes = make([]string, 0, len(log.StatusCodes))
+			for _, code := range log.StatusCodes {
+				al.StatusCodes = append(al.StatusCodes, statusCodeMapKey(code))
+			}
+			al.ConditionVariable = al.StatusVariable
+		}
+
+		if log.SamplePercent > 0 {
+			al.SampleVariable = "$" + name + "_sample"
+			al.ConditionVariable = al.SampleVariable
+		}
+
+		if al.StatusVariable != "" && al.SampleVariable != "" {
+			al.ConditionVariable = "$" + name + "_condition"
+		}
+
+		accessLogs = append(accessLogs, al)
+	}
+
+	return accessLogs
+}
+
+// statusCodeMapKey returns the map key that matches a status code, or a class of status codes like 5xx.
+func statusCodeMapKey(code string) string {
+	if class, ok := strings.CutSuffix(code, "xx"); ok {
+		return "~^" + class
+	}
+
+	return code
+}
+
+// sanitizeName converts a name into a valid NGINX variable name.
+func sanitizeName(name string) string {
+	return strings.NewReplacer("-", "_", ".", "_").Replace(name)
+}
@@ -0,0 +1,279 @@
+package accesslog_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesslog"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	objectMeta := v1.ObjectMeta{
+		Name:      "test-policy",
+		Namespace: "test-ns",
+	}
+
+	tests := []struct {
+		name              string
+		policy            policies.Policy
+		expHTTPStrings    []string
+		notExpHTTPStrings []string
+		expStrings        []string
+		notExpStrings     []string
+	}{
+		{
+			name: "stdout with default format",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					AccessLogs: []ngfAPI.NginxAccessLogEntry{
+						{
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationStdout,
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"access_log /dev/stdout combined;",
+			},
+			notExpStrings: []string{
+				"if=",
+			},
+		},
+		{
+			name: "file with custom format",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					AccessLogs: []ngfAPI.NginxAccessLogEntry{
+						{
+							Format: helpers.GetPointer("$remote_addr $status"),
+							Escape: helpers.GetPointer(ngfAPI.NginxAccessLogEscapeJSON),
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationFile,
+								File: &ngfAPI.NginxAccessLogFile{Name: "route.log"},
+							},
+						},
+					},
+				},
+			},
+			expHTTPStrings: []string{
+				"log_format test_ns_alp_test_policy_0 escape=json '$remote_addr $status';",
+			},
+			expStrings: []string{
+				"access_log /var/log/nginx/access/route.log test_ns_alp_test_policy_0;",
+			},
+		},
+		{
+			name: "syslog with status code condition",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					AccessLogs: []ngfAPI.NginxAccessLogEntry{
+						{
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationSyslog,
+								Syslog: &ngfAPI.NginxAccessLogSyslog{
+									Server:   "syslog.example.com:514",
+									Facility: helpers.GetPointer[ngfAPI.SyslogFacility]("local7"),
+									Severity: helpers.GetPointer[ngfAPI.SyslogSeverity]("info"),
+									Tag:      helpers.GetPointer("ngf"),
+								},
+							},
+							Condition: &ngfAPI.NginxAccessLogCondition{
+								StatusCodes: []string{"404", "5xx"},
+							},
+						},
+					},
+				},
+			},
+			expHTTPStrings: []string{
+				"map $status $test_ns_alp_test_policy_0_status {",
+				"404 1;",
+				"~^5 1;",
+				"default 0;",
+			},
+			notExpHTTPStrings: []string{
+				"log_format",
+				"split_clients",
+			},
+			expStrings: []string{
+				"access_log syslog:server=syslog.example.com:514,facility=local7,severity=info,tag=ngf combined " +
+					"if=$test_ns_alp_test_policy_0_status;",
+			},
+		},
+		{
+			name: "sampling",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					AccessLogs: []ngfAPI.NginxAccessLogEntry{
+						{
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationStdout,
+							},
+							Condition: &ngfAPI.NginxAccessLogCondition{
+								SamplePercent: helpers.GetPointer[int32](10),
+							},
+						},
+					},
+				},
+			},
+			expHTTPStrings: []string{
+				"split_clients $request_id $test_ns_alp_test_policy_0_sample {",
+				"10% 1;",
+				"* 0;",
+			},
+			notExpHTTPStrings: []string{
+				"map $status",
+			},
+			expStrings: []string{
+				"access_log /dev/stdout combined if=$test_ns_alp_test_policy_0_sample;",
+			},
+		},
+		{
+			name: "status codes and sampling",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					AccessLogs: []ngfAPI.NginxAccessLogEntry{
+						{
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationStdout,
+							},
+						},
+						{
+							Destination: ngfAPI.NginxAccessLogDestination{
+								Type: ngfAPI.NginxAccessLogDestinationStdout,
+							},
+							Condition: &ngfAPI.NginxAccessLogCondition{
+								StatusCodes:   []string{"4xx"},
+								SamplePercent: helpers.GetPointer[int32](50),
+							},
+						},
+					},
+				},
+			},
+			expHTTPStrings: []string{
+				"map $status $test_ns_alp_test_policy_1_status {",
+				"split_clients $request_id $test_ns_alp_test_policy_1_sample {",
+				"map \"$test_ns_alp_test_policy_1_status$test_ns_alp_test_policy_1_sample\" " +
+					"$test_ns_alp_test_policy_1_condition {",
+				"11 1;",
+			},
+			expStrings: []string{
+				"access_log /dev/stdout combined;",
+				"access_log /dev/stdout combined if=$test_ns_alp_test_policy_1_condition;",
+			},
+		},
+		{
+			name: "disabled",
+			policy: &ngfAPI.AccessLogPolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPI.AccessLogPolicySpec{
+					Disable: helpers.GetPointer(true),
+				},
+			},
+			expStrings: []string{
+				"access_log off;",
+			},
+		},
+	}
+
+	checkResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings, notExpStrings []string) {
+		t.Helper()
+		g := NewWithT(t)
+
+		if len(expStrings) == 0 {
+			g.Expect(resFiles).To(BeEmpty())
+			return
+		}
+
+		g.Expect(resFiles).To(HaveLen(1))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := accesslog.NewGenerator()
+
+			resFiles := generator.GenerateForHTTP([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expHTTPStrings, test.notExpHTTPStrings)
+			if len(resFiles) > 0 {
+				g.Expect(resFiles[0].Name).To(Equal("AccessLogPolicy_test-ns_test-policy_http.conf"))
+			}
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+			g.Expect(resFiles[0].Name).To(Equal("AccessLogPolicy_test-ns_test-policy.conf"))
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := accesslog.NewGenerator()
+
+	resFiles := generator.GenerateForHTTP([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestGenerateAccessLogs(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	logs := []dataplane.AccessLog{
+		{
+			Format: "$remote_addr",
+			Path:   "/dev/stdout",
+		},
+		{
+			Path:          "syslog:server=syslog.example.com",
+			StatusCodes:   []string{"500"},
+			SamplePercent: 20,
+		},
+	}
+
+	content := string(accesslog.GenerateAccessLogs(logs))
+
+	g.Expect(content).To(ContainSubstring("log_format ngf_access_log_0 '$remote_addr';"))
+	g.Expect(content).To(ContainSubstring("access_log /dev/stdout ngf_access_log_0;"))
+	g.Expect(content).To(ContainSubstring("map $status $ngf_access_log_1_status {"))
+	g.Expect(content).To(ContainSubstring("split_clients $request_id $ngf_access_log_1_sample {"))
+	g.Expect(content).To(ContainSubstring(
+		"access_log syslog:server=syslog.example.com combined if=$ngf_access_log_1_condition;",
+	))
+}
@@ -0,0 +1,84 @@
+package accesslog
+
+import (
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// Validator validates an AccessLogPolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of an AccessLogPolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	alp := helpers.MustCastObject[*ngfAPI.AccessLogPolicy](policy)
+
+	targetRefPath := field.NewPath("spec").Child("targetRefs")
+	supportedKinds := []gatewayv1.Kind{kinds.HTTPRoute, kinds.GRPCRoute}
+	supportedGroups := []gatewayv1.Group{gatewayv1.GroupName}
+
+	for _, ref := range alp.Spec.TargetRefs {
+		if err := policies.ValidateTargetRef(ref, targetRefPath, supportedGroups, supportedKinds); err != nil {
+			return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+		}
+	}
+
+	if err := v.validateSettings(alp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates an AccessLogPolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(_ policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two AccessLogPolicies conflict.
+// Each AccessLogPolicy replaces all access logs of its targets, so two AccessLogPolicies always conflict.
+func (v *Validator) Conflicts(_, _ policies.Policy) bool {
+	return true
+}
+
+func (v *Validator) validateSettings(spec ngfAPI.AccessLogPolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	disabled := spec.Disable != nil && *spec.Disable
+
+	switch {
+	case disabled && len(spec.AccessLogs) > 0:
+		allErrs = append(
+			allErrs,
+			field.Forbidden(fieldPath.Child("accessLogs"), "cannot be set when disable is true"),
+		)
+	case !disabled && len(spec.AccessLogs) == 0:
+		allErrs = append(
+			allErrs,
+			field.Required(fieldPath.Child("accessLogs"), "required when disable is not true"),
+		)
+	}
+
+	allErrs = append(
+		allErrs,
+		graph.ValidateAccessLogEntries(v.genericValidator, spec.AccessLogs, fieldPath.Child("accessLogs"))...,
+	)
+
+	return allErrs.ToAggregate()
+}
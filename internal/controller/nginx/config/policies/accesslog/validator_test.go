@@ -0,0 +1,188 @@
+package accesslog_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/accesslog"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy
+
+func createValidPolicy() *ngfAPI.AccessLogPolicy {
+	return &ngfAPI.AccessLogPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.AccessLogPolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.HTTPRoute,
+					Name:  "route",
+				},
+			},
+			AccessLogs: []ngfAPI.NginxAccessLogEntry{
+				{
+					Format: helpers.GetPointer("$remote_addr $status"),
+					Destination: ngfAPI.NginxAccessLogDestination{
+						Type: ngfAPI.NginxAccessLogDestinationSyslog,
+						Syslog: &ngfAPI.NginxAccessLogSyslog{
+							Server: "syslog.example.com:514",
+							Tag:    helpers.GetPointer("ngf"),
+						},
+					},
+					Condition: &ngfAPI.NginxAccessLogCondition{
+						StatusCodes: []string{"404", "5xx"},
+					},
+				},
+			},
+		},
+		Status: v1.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.AccessLogPolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.AccessLogPolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid target ref; unsupported group",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.TargetRefs[0].Group = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.group: Unsupported value: \"Unsupported\": " +
+					"supported values: \"gateway.networking.k8s.io\""),
+			},
+		},
+		{
+			name: "invalid target ref; unsupported kind",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.TargetRefs[0].Kind = "Unsupported"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.targetRefs.kind: Unsupported value: \"Unsupported\": " +
+					"supported values: \"HTTPRoute\", \"GRPCRoute\""),
+			},
+		},
+		{
+			name: "neither disable nor access logs set",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.AccessLogs = nil
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessLogs: Required value: required when disable is not true"),
+			},
+		},
+		{
+			name: "disable and access logs set",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.Disable = helpers.GetPointer(true)
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessLogs: Forbidden: cannot be set when disable is true"),
+			},
+		},
+		{
+			name: "invalid syslog server",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.AccessLogs[0].Destination.Syslog.Server = "udp://syslog.example.com"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessLogs[0].destination.syslog.server: Invalid value: " +
+					"\"udp://syslog.example.com\": must not contain a scheme"),
+			},
+		},
+		{
+			name: "invalid status code",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.AccessLogs[0].Condition.StatusCodes = []string{"600"}
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.accessLogs[0].condition.statusCodes[0]: Invalid value: " +
+					"\"600\": must be a status code, such as 404, or a class of status codes, such as 5xx"),
+			},
+		},
+		{
+			name: "valid disabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.AccessLogPolicy) *ngfAPI.AccessLogPolicy {
+				p.Spec.Disable = helpers.GetPointer(true)
+				p.Spec.AccessLogs = nil
+				return p
+			}),
+			expConditions: nil,
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+	}
+
+	v := accesslog.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := accesslog.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := accesslog.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(createValidPolicy(), nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := accesslog.NewValidator(nil)
+
+	g.Expect(v.Conflicts(createValidPolicy(), createValidPolicy())).To(BeTrue())
+}
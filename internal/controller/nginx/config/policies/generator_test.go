@@ -23,6 +23,12 @@ var _ = Describe("Policy Generator", func() {
 		fakeGen1.GenerateForInternalLocationReturns(policies.GenerateResultFiles{
 			{Name: "gen1IntLocation", Content: []byte("gen1IntLocation-content")},
 		})
+		fakeGen1.GenerateForStreamReturns(policies.GenerateResultFiles{
+			{Name: "gen1Stream", Content: []byte("gen1Stream-content")},
+		})
+		fakeGen1.GenerateForStreamServerReturns(policies.GenerateResultFiles{
+			{Name: "gen1StreamServer", Content: []byte("gen1StreamServer-content")},
+		})
 
 		fakeGen2.GenerateForServerReturns(policies.GenerateResultFiles{
 			{Name: "gen2Server", Content: []byte("gen2Server-content")},
@@ -33,6 +39,12 @@ var _ = Describe("Policy Generator", func() {
 		fakeGen2.GenerateForInternalLocationReturns(policies.GenerateResultFiles{
 			{Name: "gen2IntLocation", Content: []byte("gen2IntLocation-content")},
 		})
+		fakeGen2.GenerateForStreamReturns(policies.GenerateResultFiles{
+			{Name: "gen2Stream", Content: []byte("gen2Stream-content")},
+		})
+		fakeGen2.GenerateForStreamServerReturns(policies.GenerateResultFiles{
+			{Name: "gen2StreamServer", Content: []byte("gen2StreamServer-content")},
+		})
 
 		generator := policies.NewCompositeGenerator(fakeGen1, fakeGen2)
 
@@ -62,6 +74,24 @@ var _ = Describe("Policy Generator", func() {
 
 			Expect(generator.GenerateForInternalLocation(nil)).To(BeEquivalentTo(expFiles))
 		})
+
+		It("returns proper stream content", func() {
+			expFiles := policies.GenerateResultFiles{
+				{Name: "gen1Stream", Content: []byte("gen1Stream-content")},
+				{Name: "gen2Stream", Content: []byte("gen2Stream-content")},
+			}
+
+			Expect(generator.GenerateForStream(nil)).To(BeEquivalentTo(expFiles))
+		})
+
+		It("returns proper stream server content", func() {
+			expFiles := policies.GenerateResultFiles{
+				{Name: "gen1StreamServer", Content: []byte("gen1StreamServer-content")},
+				{Name: "gen2StreamServer", Content: []byte("gen2StreamServer-content")},
+			}
+
+			Expect(generator.GenerateForStreamServer(nil)).To(BeEquivalentTo(expFiles))
+		})
 	})
 
 	Context("Unimplemented Generator", func() {
@@ -78,5 +108,13 @@ var _ = Describe("Policy Generator", func() {
 		It("returns nil for GenerateForInternalLocation", func() {
 			Expect(generator.GenerateForInternalLocation(nil)).To(BeNil())
 		})
+
+		It("returns nil for GenerateForStream", func() {
+			Expect(generator.GenerateForStream(nil)).To(BeNil())
+		})
+
+		It("returns nil for GenerateForStreamServer", func() {
+			Expect(generator.GenerateForStreamServer(nil)).To(BeNil())
+		})
 	})
 })
@@ -66,6 +66,28 @@ type FakeGenerator struct {
 	generateForServerReturnsOnCall map[int]struct {
 		result1 policies.GenerateResultFiles
 	}
+	GenerateForStreamStub        func([]policies.Policy) policies.GenerateResultFiles
+	generateForStreamMutex       sync.RWMutex
+	generateForStreamArgsForCall []struct {
+		arg1 []policies.Policy
+	}
+	generateForStreamReturns struct {
+		result1 policies.GenerateResultFiles
+	}
+	generateForStreamReturnsOnCall map[int]struct {
+		result1 policies.GenerateResultFiles
+	}
+	GenerateForStreamServerStub        func([]policies.Policy) policies.GenerateResultFiles
+	generateForStreamServerMutex       sync.RWMutex
+	generateForStreamServerArgsForCall []struct {
+		arg1 []policies.Policy
+	}
+	generateForStreamServerReturns struct {
+		result1 policies.GenerateResultFiles
+	}
+	generateForStreamServerReturnsOnCall map[int]struct {
+		result1 policies.GenerateResultFiles
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -402,6 +424,138 @@ func (fake *FakeGenerator) GenerateForServerReturnsOnCall(i int, result1 policie
 	}{result1}
 }
 
+func (fake *FakeGenerator) GenerateForStream(arg1 []policies.Policy) policies.GenerateResultFiles {
+	var arg1Copy []policies.Policy
+	if arg1 != nil {
+		arg1Copy = make([]policies.Policy, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.generateForStreamMutex.Lock()
+	ret, specificReturn := fake.generateForStreamReturnsOnCall[len(fake.generateForStreamArgsForCall)]
+	fake.generateForStreamArgsForCall = append(fake.generateForStreamArgsForCall, struct {
+		arg1 []policies.Policy
+	}{arg1Copy})
+	stub := fake.GenerateForStreamStub
+	fakeReturns := fake.generateForStreamReturns
+	fake.recordInvocation("GenerateForStream", []interface{}{arg1Copy})
+	fake.generateForStreamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenerator) GenerateForStreamCallCount() int {
+	fake.generateForStreamMutex.RLock()
+	defer fake.generateForStreamMutex.RUnlock()
+	return len(fake.generateForStreamArgsForCall)
+}
+
+func (fake *FakeGenerator) GenerateForStreamCalls(stub func([]policies.Policy) policies.GenerateResultFiles) {
+	fake.generateForStreamMutex.Lock()
+	defer fake.generateForStreamMutex.Unlock()
+	fake.GenerateForStreamStub = stub
+}
+
+func (fake *FakeGenerator) GenerateForStreamArgsForCall(i int) []policies.Policy {
+	fake.generateForStreamMutex.RLock()
+	defer fake.generateForStreamMutex.RUnlock()
+	argsForCall := fake.generateForStreamArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenerator) GenerateForStreamReturns(result1 policies.GenerateResultFiles) {
+	fake.generateForStreamMutex.Lock()
+	defer fake.generateForStreamMutex.Unlock()
+	fake.GenerateForStreamStub = nil
+	fake.generateForStreamReturns = struct {
+		result1 policies.GenerateResultFiles
+	}{result1}
+}
+
+func (fake *FakeGenerator) GenerateForStreamReturnsOnCall(i int, result1 policies.GenerateResultFiles) {
+	fake.generateForStreamMutex.Lock()
+	defer fake.generateForStreamMutex.Unlock()
+	fake.GenerateForStreamStub = nil
+	if fake.generateForStreamReturnsOnCall == nil {
+		fake.generateForStreamReturnsOnCall = make(map[int]struct {
+			result1 policies.GenerateResultFiles
+		})
+	}
+	fake.generateForStreamReturnsOnCall[i] = struct {
+		result1 policies.GenerateResultFiles
+	}{result1}
+}
+
+func (fake *FakeGenerator) GenerateForStreamServer(arg1 []policies.Policy) policies.GenerateResultFiles {
+	var arg1Copy []policies.Policy
+	if arg1 != nil {
+		arg1Copy = make([]policies.Policy, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	fake.generateForStreamServerMutex.Lock()
+	ret, specificReturn := fake.generateForStreamServerReturnsOnCall[len(fake.generateForStreamServerArgsForCall)]
+	fake.generateForStreamServerArgsForCall = append(fake.generateForStreamServerArgsForCall, struct {
+		arg1 []policies.Policy
+	}{arg1Copy})
+	stub := fake.GenerateForStreamServerStub
+	fakeReturns := fake.generateForStreamServerReturns
+	fake.recordInvocation("GenerateForStreamServer", []interface{}{arg1Copy})
+	fake.generateForStreamServerMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeGenerator) GenerateForStreamServerCallCount() int {
+	fake.generateForStreamServerMutex.RLock()
+	defer fake.generateForStreamServerMutex.RUnlock()
+	return len(fake.generateForStreamServerArgsForCall)
+}
+
+func (fake *FakeGenerator) GenerateForStreamServerCalls(stub func([]policies.Policy) policies.GenerateResultFiles) {
+	fake.generateForStreamServerMutex.Lock()
+	defer fake.generateForStreamServerMutex.Unlock()
+	fake.GenerateForStreamServerStub = stub
+}
+
+func (fake *FakeGenerator) GenerateForStreamServerArgsForCall(i int) []policies.Policy {
+	fake.generateForStreamServerMutex.RLock()
+	defer fake.generateForStreamServerMutex.RUnlock()
+	argsForCall := fake.generateForStreamServerArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeGenerator) GenerateForStreamServerReturns(result1 policies.GenerateResultFiles) {
+	fake.generateForStreamServerMutex.Lock()
+	defer fake.generateForStreamServerMutex.Unlock()
+	fake.GenerateForStreamServerStub = nil
+	fake.generateForStreamServerReturns = struct {
+		result1 policies.GenerateResultFiles
+	}{result1}
+}
+
+func (fake *FakeGenerator) GenerateForStreamServerReturnsOnCall(i int, result1 policies.GenerateResultFiles) {
+	fake.generateForStreamServerMutex.Lock()
+	defer fake.generateForStreamServerMutex.Unlock()
+	fake.GenerateForStreamServerStub = nil
+	if fake.generateForStreamServerReturnsOnCall == nil {
+		fake.generateForStreamServerReturnsOnCall = make(map[int]struct {
+			result1 policies.GenerateResultFiles
+		})
+	}
+	fake.generateForStreamServerReturnsOnCall[i] = struct {
+		result1 policies.GenerateResultFiles
+	}{result1}
+}
+
 func (fake *FakeGenerator) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,229 @@
+package proxycache
+
+import (
+	"fmt"
+	"text/template"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+// cacheHTTPTemplate generates only the proxy_cache_path directive at the http context.
+// Temporary files are written directly to the cache directory (use_temp_path=off), so that NGINX does not
+// need to copy them from the proxy temp path, which is on a different volume.
+//
+//nolint:lll
+const cacheHTTPTemplate = `
+proxy_cache_path {{ .Path }} levels=1:2 use_temp_path=off keys_zone={{ .ZoneName }}:{{ .ZoneSize }}{{ if .Inactive }} inactive={{ .Inactive }}{{ end }}{{ if .MaxSize }} max_size={{ .MaxSize }}{{ end }};
+`
+
+const cacheTemplate = `
+proxy_cache {{ .ZoneName }};
+{{- if .Key }}
+proxy_cache_key {{ .Key }};
+{{- end }}
+{{- range $v := .Valid }}
+proxy_cache_valid{{ range $c := $v.Codes }} {{ $c }}{{ end }} {{ $v.Time }};
+{{- end }}
+{{- if .Bypass }}
+proxy_cache_bypass{{ range $b := .Bypass }} {{ $b }}{{ end }};
+{{- end }}
+{{- if .UseStale }}
+proxy_cache_use_stale{{ range $s := .UseStale }} {{ $s }}{{ end }};
+{{- end }}
+{{- if .Lock }}
+proxy_cache_lock on;
+{{- if .Lock.Age }}
+proxy_cache_lock_age {{ .Lock.Age }};
+{{- end }}
+{{- if .Lock.Timeout }}
+proxy_cache_lock_timeout {{ .Lock.Timeout }};
+{{- end }}
+{{- end }}
+`
+
+var (
+	tmplHTTP  = template.Must(template.New("cache policy http").Parse(cacheHTTPTemplate))
+	tmplCache = template.Must(template.New("cache policy").Parse(cacheTemplate))
+)
+
+const (
+	// BasePath is the directory where NGINX stores the cached responses. The provisioner mounts an emptyDir
+	// volume at this path in the NGINX container.
+	BasePath = "/var/cache/nginx/proxy-cache"
+
+	// fileNamePrefix is the prefix for all generated cache policy config file names.
+	fileNamePrefix = "CachePolicy"
+	// fileNameSuffixHTTP is the suffix of the file that defines the cache zone in the http context.
+	fileNameSuffixHTTP = "zone"
+
+	// defaultZoneSize is the default size of the shared memory zone in the proxy_cache_path NGINX directive.
+	defaultZoneSize = "10m"
+)
+
+// cacheSettings represents the settings for a cache policy.
+type cacheSettings struct {
+	// Lock contains the cache lock settings. If nil, the cache lock is disabled.
+	Lock *cacheLock
+	// Path is the directory where the cached responses are stored.
+	Path string
+	// ZoneName is the name of the cache zone.
+	ZoneName string
+	// ZoneSize is the size of the shared memory zone.
+	ZoneSize string
+	// Inactive is the time after which the cached data that is not accessed is removed.
+	Inactive string
+	// MaxSize is the maximum size of the cache on disk.
+	MaxSize string
+	// Key is the key for caching.
+	Key string
+	// Valid is the list of caching times for the response status codes.
+	Valid []cacheValid
+	// Bypass is the list of conditions under which the response is not taken from the cache.
+	Bypass []string
+	// UseStale is the list of cases in which a stale cached response can be used.
+	UseStale []string
+}
+
+// cacheValid represents the caching time for responses with the specified status codes.
+type cacheValid struct {
+	// Time is the caching time.
+	Time string
+	// Codes are the response status codes.
+	Codes []string
+}
+
+// cacheLock represents the cache lock settings.
+type cacheLock struct {
+	// Age is the value of the proxy_cache_lock_age directive.
+	Age string
+	// Timeout is the value of the proxy_cache_lock_timeout directive.
+	Timeout string
+}
+
+func getCacheSettings(cp ngfAPI.CachePolicy) cacheSettings {
+	zoneName := fmt.Sprintf("%s_cache_%s", cp.Namespace, cp.Name)
+
+	settings := cacheSettings{
+		Path:     fmt.Sprintf("%s/%s", BasePath, zoneName),
+		ZoneName: zoneName,
+		ZoneSize: defaultZoneSize,
+		Bypass:   cp.Spec.Bypass,
+	}
+
+	if cp.Spec.Zone != nil {
+		if cp.Spec.Zone.Size != nil {
+			settings.ZoneSize = string(*cp.Spec.Zone.Size)
+		}
+
+		if cp.Spec.Zone.Inactive != nil {
+			settings.Inactive = string(*cp.Spec.Zone.Inactive)
+		}
+
+		if cp.Spec.Zone.MaxSize != nil {
+			settings.MaxSize = string(*cp.Spec.Zone.MaxSize)
+		}
+	}
+
+	if cp.Spec.Key != nil {
+		settings.Key = *cp.Spec.Key
+	}
+
+	for _, valid := range cp.Spec.Valid {
+		settings.Valid = append(settings.Valid, cacheValid{
+			Time:  string(valid.Time),
+			Codes: valid.Codes,
+		})
+	}
+
+	for _, useStale := range cp.Spec.UseStale {
+		settings.UseStale = append(settings.UseStale, string(useStale))
+	}
+
+	if cp.Spec.Lock != nil {
+		settings.Lock = &cacheLock{}
+
+		if cp.Spec.Lock.Age != nil {
+			settings.Lock.Age = string(*cp.Spec.Lock.Age)
+		}
+
+		if cp.Spec.Lock.Timeout != nil {
+			settings.Lock.Timeout = string(*cp.Spec.Lock.Timeout)
+		}
+	}
+
+	return settings
+}
+
+// Generator generates nginx configuration based on a cache policy.
+type Generator struct {
+	policies.UnimplementedGenerator
+}
+
+// NewGenerator returns a new instance of Generator.
+func NewGenerator() *Generator {
+	return &Generator{}
+}
+
+// GenerateForHTTP generates policy configuration for the http block.
+func (g Generator) GenerateForHTTP(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplHTTP)
+}
+
+// GenerateForServer generates policy configuration for the server block.
+func (g Generator) GenerateForServer(pols []policies.Policy, _ http.Server) policies.GenerateResultFiles {
+	return generate(pols, tmplCache)
+}
+
+// GenerateForLocation generates policy configuration for a normal location block.
+func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location) policies.GenerateResultFiles {
+	return generate(pols, tmplCache)
+}
+
+// GenerateForInternalLocation generates policy configuration for an internal location block.
+func (g Generator) GenerateForInternalLocation(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplCache)
+}
+
+func generate(pols []policies.Policy, tmpl *template.Template) policies.GenerateResultFiles {
+	files := make(policies.GenerateResultFiles, 0, len(pols))
+
+	for _, pol := range pols {
+		cp, ok := pol.(*ngfAPI.CachePolicy)
+		if !ok {
+			continue
+		}
+
+		// Route-attached policies generate proxy_cache_path at the http context via an internally-created
+		// copy (marked with the http-context-only annotation). That copy should never enable the cache
+		// outside the http context.
+		if tmpl != tmplHTTP && isShadowPolicy(cp) {
+			continue
+		}
+
+		name := fmt.Sprintf("%s_%s_%s.conf", fileNamePrefix, cp.Namespace, cp.Name)
+		if tmpl == tmplHTTP {
+			name = fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, cp.Namespace, cp.Name, fileNameSuffixHTTP)
+		}
+
+		files = append(files, policies.File{
+			Name:    name,
+			Content: helpers.MustExecuteTemplate(tmpl, getCacheSettings(*cp)),
+		})
+	}
+
+	return files
+}
+
+// isShadowPolicy checks if a CachePolicy is intended to
+// generate configuration only for the http context by looking for a specific annotation.
+func isShadowPolicy(cp *ngfAPI.CachePolicy) bool {
+	if cp.Annotations == nil {
+		return false
+	}
+	val, exists := cp.Annotations[dataplane.InternalHTTPContextAnnotationKey]
+	return exists && val == dataplane.InternalHTTPContextAnnotationValue
+}
@@ -0,0 +1,247 @@
+package proxycache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/http"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+func TestGenerate(t *testing.T) {
+	t.Parallel()
+
+	objectMeta := v1.ObjectMeta{
+		Name:      "test-policy",
+		Namespace: "default",
+	}
+
+	tests := []struct {
+		name           string
+		policy         policies.Policy
+		expHTTPStrings []string
+		expStrings     []string
+		notExpStrings  []string
+	}{
+		{
+			name: "default values",
+			policy: &ngfAPIv1alpha1.CachePolicy{
+				ObjectMeta: objectMeta,
+			},
+			expHTTPStrings: []string{
+				"proxy_cache_path /var/cache/nginx/proxy-cache/default_cache_test-policy levels=1:2 " +
+					"use_temp_path=off keys_zone=default_cache_test-policy:10m;",
+			},
+			expStrings: []string{
+				"proxy_cache default_cache_test-policy;",
+			},
+			notExpStrings: []string{
+				"proxy_cache_key",
+				"proxy_cache_valid",
+				"proxy_cache_bypass",
+				"proxy_cache_use_stale",
+				"proxy_cache_lock",
+			},
+		},
+		{
+			name: "zone settings",
+			policy: &ngfAPIv1alpha1.CachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.CachePolicySpec{
+					Zone: &ngfAPIv1alpha1.CacheZone{
+						Size:     helpers.GetPointer[ngfAPIv1alpha1.Size]("20m"),
+						Inactive: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1h"),
+						MaxSize:  helpers.GetPointer[ngfAPIv1alpha1.Size]("1g"),
+					},
+				},
+			},
+			expHTTPStrings: []string{
+				"proxy_cache_path /var/cache/nginx/proxy-cache/default_cache_test-policy levels=1:2 " +
+					"use_temp_path=off keys_zone=default_cache_test-policy:20m inactive=1h max_size=1g;",
+			},
+			expStrings: []string{
+				"proxy_cache default_cache_test-policy;",
+			},
+		},
+		{
+			name: "all cache settings",
+			policy: &ngfAPIv1alpha1.CachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.CachePolicySpec{
+					Key: helpers.GetPointer("$scheme$host$request_uri"),
+					Valid: []ngfAPIv1alpha1.CacheValid{
+						{
+							Codes: []string{"200", "302"},
+							Time:  "10m",
+						},
+						{
+							Codes: []string{"404"},
+							Time:  "1m",
+						},
+						{
+							Time: "5m",
+						},
+					},
+					Bypass: []string{"$cookie_nocache", "$arg_nocache"},
+					UseStale: []ngfAPIv1alpha1.CacheUseStaleCondition{
+						ngfAPIv1alpha1.CacheUseStaleError,
+						ngfAPIv1alpha1.CacheUseStaleTimeout,
+						ngfAPIv1alpha1.CacheUseStaleUpdating,
+					},
+					Lock: &ngfAPIv1alpha1.CacheLock{
+						Age:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+						Timeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("3s"),
+					},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache default_cache_test-policy;",
+				"proxy_cache_key $scheme$host$request_uri;",
+				"proxy_cache_valid 200 302 10m;",
+				"proxy_cache_valid 404 1m;",
+				"proxy_cache_valid 5m;",
+				"proxy_cache_bypass $cookie_nocache $arg_nocache;",
+				"proxy_cache_use_stale error timeout updating;",
+				"proxy_cache_lock on;",
+				"proxy_cache_lock_age 10s;",
+				"proxy_cache_lock_timeout 3s;",
+			},
+		},
+		{
+			name: "lock without age and timeout",
+			policy: &ngfAPIv1alpha1.CachePolicy{
+				ObjectMeta: objectMeta,
+				Spec: ngfAPIv1alpha1.CachePolicySpec{
+					Lock: &ngfAPIv1alpha1.CacheLock{},
+				},
+			},
+			expStrings: []string{
+				"proxy_cache_lock on;",
+			},
+			notExpStrings: []string{
+				"proxy_cache_lock_age",
+				"proxy_cache_lock_timeout",
+			},
+		},
+	}
+
+	checkResults := func(
+		t *testing.T,
+		resFiles policies.GenerateResultFiles,
+		expName string,
+		expStrings []string,
+		notExpStrings []string,
+	) {
+		t.Helper()
+		g := NewWithT(t)
+
+		g.Expect(resFiles).To(HaveLen(1))
+		g.Expect(resFiles[0].Name).To(Equal(expName))
+
+		for _, str := range expStrings {
+			g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+		}
+
+		for _, str := range notExpStrings {
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+		}
+
+		g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("proxy_cache_path"))
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			generator := proxycache.NewGenerator()
+
+			resFiles := generator.GenerateForHTTP([]policies.Policy{test.policy})
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal("CachePolicy_default_test-policy_zone.conf"))
+			for _, str := range test.expHTTPStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+			g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring("proxy_cache "))
+
+			expName := "CachePolicy_default_test-policy.conf"
+
+			resFiles = generator.GenerateForServer([]policies.Policy{test.policy}, http.Server{})
+			checkResults(t, resFiles, expName, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForLocation([]policies.Policy{test.policy}, http.Location{})
+			checkResults(t, resFiles, expName, test.expStrings, test.notExpStrings)
+
+			resFiles = generator.GenerateForInternalLocation([]policies.Policy{test.policy})
+			checkResults(t, resFiles, expName, test.expStrings, test.notExpStrings)
+		})
+	}
+}
+
+func TestGenerateNoPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxycache.NewGenerator()
+
+	resFiles := generator.GenerateForHTTP([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForHTTP([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+}
+
+func TestGenerateSkipsShadowPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	generator := proxycache.NewGenerator()
+
+	shadowPolicy := &ngfAPIv1alpha1.CachePolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "shadow-policy",
+			Namespace: "default",
+			Annotations: map[string]string{
+				dataplane.InternalHTTPContextAnnotationKey: dataplane.InternalHTTPContextAnnotationValue,
+			},
+		},
+	}
+
+	resFiles := generator.GenerateForServer([]policies.Policy{shadowPolicy}, http.Server{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForLocation([]policies.Policy{shadowPolicy}, http.Location{})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForInternalLocation([]policies.Policy{shadowPolicy})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForHTTP([]policies.Policy{shadowPolicy})
+	g.Expect(resFiles).To(HaveLen(1))
+	g.Expect(string(resFiles[0].Content)).To(ContainSubstring("keys_zone=default_cache_shadow-policy:10m"))
+}
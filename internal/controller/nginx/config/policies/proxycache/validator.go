@@ -0,0 +1,174 @@
+package proxycache
+
+import (
+	"errors"
+	"regexp"
+
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+const (
+	// ?: is a non-capturing group
+	// [^ \t\r\n;{}#$]+ matches any run of characters except the separators that
+	//   would make nginx stop parsing the argument.
+	// $\w+ matches an nginx variable.
+	cacheArgFmt    = `^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
+	cacheArgErrMsg = "must consist of nginx variables and/or strings without spaces or special characters"
+
+	statusCodeFmt    = `^(any|[1-5][0-9]{2})$`
+	statusCodeErrMsg = "must be a 3-digit HTTP status code or 'any'"
+)
+
+var (
+	cacheArgRegexp   = regexp.MustCompile(cacheArgFmt)
+	statusCodeRegexp = regexp.MustCompile(statusCodeFmt)
+)
+
+// Validator validates a CachePolicy.
+// Implements policies.Validator interface.
+type Validator struct {
+	genericValidator validation.GenericValidator
+}
+
+// NewValidator returns a new instance of Validator.
+func NewValidator(genericValidator validation.GenericValidator) *Validator {
+	return &Validator{genericValidator: genericValidator}
+}
+
+// Validate validates the spec of a CachePolicy.
+func (v *Validator) Validate(policy policies.Policy) []conditions.Condition {
+	cp := helpers.MustCastObject[*ngfAPI.CachePolicy](policy)
+
+	if err := v.validateSettings(cp.Spec); err != nil {
+		return []conditions.Condition{conditions.NewPolicyInvalid(err.Error())}
+	}
+
+	return nil
+}
+
+// ValidateGlobalSettings validates a CachePolicy with respect to the NginxProxy global settings.
+func (v *Validator) ValidateGlobalSettings(
+	_ policies.Policy,
+	_ *policies.GlobalSettings,
+) []conditions.Condition {
+	return nil
+}
+
+// Conflicts returns true if the two CachePolicies conflict.
+// A target can only use a single cache zone, so any two CachePolicies attached to the same target conflict.
+func (v *Validator) Conflicts(polA, polB policies.Policy) bool {
+	_ = helpers.MustCastObject[*ngfAPI.CachePolicy](polA)
+	_ = helpers.MustCastObject[*ngfAPI.CachePolicy](polB)
+
+	return true
+}
+
+// validateSettings performs validation on fields in the spec that are vulnerable to code injection.
+// For all other fields, we rely on the CRD validation.
+func (v *Validator) validateSettings(spec ngfAPI.CachePolicySpec) error {
+	var allErrs field.ErrorList
+	fieldPath := field.NewPath("spec")
+
+	if spec.Zone != nil {
+		zonePath := fieldPath.Child("zone")
+
+		if spec.Zone.Size != nil {
+			if err := v.genericValidator.ValidateNginxSize(string(*spec.Zone.Size)); err != nil {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("size"), *spec.Zone.Size, err.Error()))
+			}
+		}
+
+		if spec.Zone.Inactive != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.Zone.Inactive)); err != nil {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("inactive"), *spec.Zone.Inactive, err.Error()))
+			}
+		}
+
+		if spec.Zone.MaxSize != nil {
+			if err := v.genericValidator.ValidateNginxSize(string(*spec.Zone.MaxSize)); err != nil {
+				allErrs = append(allErrs, field.Invalid(zonePath.Child("maxSize"), *spec.Zone.MaxSize, err.Error()))
+			}
+		}
+	}
+
+	if spec.Key != nil {
+		if err := validateCacheArg(*spec.Key); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("key"), *spec.Key, err.Error()))
+		}
+	}
+
+	for i, valid := range spec.Valid {
+		validPath := fieldPath.Child("valid").Index(i)
+
+		for j, code := range valid.Codes {
+			if err := validateStatusCode(code); err != nil {
+				allErrs = append(allErrs, field.Invalid(validPath.Child("codes").Index(j), code, err.Error()))
+			}
+		}
+
+		if err := v.genericValidator.ValidateNginxDuration(string(valid.Time)); err != nil {
+			allErrs = append(allErrs, field.Invalid(validPath.Child("time"), valid.Time, err.Error()))
+		}
+	}
+
+	for i, bypass := range spec.Bypass {
+		if err := validateCacheArg(bypass); err != nil {
+			allErrs = append(allErrs, field.Invalid(fieldPath.Child("bypass").Index(i), bypass, err.Error()))
+		}
+	}
+
+	if spec.Lock != nil {
+		lockPath := fieldPath.Child("lock")
+
+		if spec.Lock.Age != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.Lock.Age)); err != nil {
+				allErrs = append(allErrs, field.Invalid(lockPath.Child("age"), *spec.Lock.Age, err.Error()))
+			}
+		}
+
+		if spec.Lock.Timeout != nil {
+			if err := v.genericValidator.ValidateNginxDuration(string(*spec.Lock.Timeout)); err != nil {
+				allErrs = append(allErrs, field.Invalid(lockPath.Child("timeout"), *spec.Lock.Timeout, err.Error()))
+			}
+		}
+	}
+
+	return allErrs.ToAggregate()
+}
+
+// validateCacheArg validates a proxy_cache_key or proxy_cache_bypass argument that nginx can understand.
+func validateCacheArg(arg string) error {
+	if !cacheArgRegexp.MatchString(arg) {
+		examples := []string{
+			"$scheme$proxy_host$request_uri",
+			"$cookie_nocache",
+			"my_fixed_key",
+		}
+
+		return errors.New(k8svalidation.RegexError(cacheArgErrMsg, cacheArgFmt, examples...))
+	}
+
+	return nil
+}
+
+// validateStatusCode validates a status code of the proxy_cache_valid directive.
+func validateStatusCode(code string) error {
+	if !statusCodeRegexp.MatchString(code) {
+		examples := []string{
+			"200",
+			"404",
+			"any",
+		}
+
+		return errors.New(k8svalidation.RegexError(statusCodeErrMsg, statusCodeFmt, examples...))
+	}
+
+	return nil
+}
@@ -0,0 +1,225 @@
+package proxycache_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/proxycache"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+type policyModFunc func(policy *ngfAPI.CachePolicy) *ngfAPI.CachePolicy
+
+func createValidPolicy() *ngfAPI.CachePolicy {
+	return &ngfAPI.CachePolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+		},
+		Spec: ngfAPI.CachePolicySpec{
+			TargetRefs: []v1.LocalPolicyTargetReference{
+				{
+					Group: v1.GroupName,
+					Kind:  kinds.Gateway,
+					Name:  "gateway",
+				},
+			},
+			Zone: &ngfAPI.CacheZone{
+				Size:     helpers.GetPointer[ngfAPI.Size]("10m"),
+				Inactive: helpers.GetPointer[ngfAPI.Duration]("1h"),
+				MaxSize:  helpers.GetPointer[ngfAPI.Size]("1g"),
+			},
+			Key: helpers.GetPointer("$scheme$host$request_uri"),
+			Valid: []ngfAPI.CacheValid{
+				{
+					Codes: []string{"200", "any"},
+					Time:  "10m",
+				},
+			},
+			Bypass: []string{"$cookie_nocache"},
+			Lock: &ngfAPI.CacheLock{
+				Age:     helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Timeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+			},
+		},
+		Status: v1.PolicyStatus{},
+	}
+}
+
+func createModifiedPolicy(mod policyModFunc) *ngfAPI.CachePolicy {
+	return mod(createValidPolicy())
+}
+
+func TestValidator_Validate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.CachePolicy
+		expConditions []conditions.Condition
+	}{
+		{
+			name: "invalid zone size",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Zone.Size = helpers.GetPointer[ngfAPI.Size]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.zone.size: Invalid value: \"invalid\": " +
+					"must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is '^\\d{1,4}(k|m|g)?$')"),
+			},
+		},
+		{
+			name: "invalid zone inactive and max size",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Zone.Inactive = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Zone.MaxSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.zone.inactive: Invalid value: \"invalid\": " +
+					"must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'^[0-9]{1,4}(ms|s|m|h)?'), spec.zone.maxSize: Invalid value: \"invalid\": " +
+					"must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is '^\\d{1,4}(k|m|g)?$')]"),
+			},
+		},
+		{
+			name: "invalid key",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Key = helpers.GetPointer("$host; return 200")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.key: Invalid value: \"$host; return 200\": " +
+					"must consist of nginx variables and/or strings without spaces or special characters " +
+					"(e.g. '$scheme$proxy_host$request_uri',  or '$cookie_nocache',  or 'my_fixed_key', " +
+					"regex used for validation is '^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "invalid bypass",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Bypass = append(p.Spec.Bypass, "{bad}")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.bypass[1]: Invalid value: \"{bad}\": " +
+					"must consist of nginx variables and/or strings without spaces or special characters " +
+					"(e.g. '$scheme$proxy_host$request_uri',  or '$cookie_nocache',  or 'my_fixed_key', " +
+					"regex used for validation is '^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')"),
+			},
+		},
+		{
+			name: "invalid valid code and time",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Valid[0].Codes = []string{"200", "600"}
+				p.Spec.Valid[0].Time = "invalid"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.valid[0].codes[1]: Invalid value: \"600\": " +
+					"must be a 3-digit HTTP status code or 'any' (e.g. '200',  or '404',  or 'any', " +
+					"regex used for validation is '^(any|[1-5][0-9]{2})$'), " +
+					"spec.valid[0].time: Invalid value: \"invalid\": " +
+					"must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'^[0-9]{1,4}(ms|s|m|h)?')]"),
+			},
+		},
+		{
+			name: "invalid lock age and timeout",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec.Lock.Age = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				p.Spec.Lock.Timeout = helpers.GetPointer[ngfAPI.Duration]("invalid")
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.lock.age: Invalid value: \"invalid\": " +
+					"must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'^[0-9]{1,4}(ms|s|m|h)?'), spec.lock.timeout: Invalid value: \"invalid\": " +
+					"must contain an, at most, four digit number followed by 'ms', 's', 'm', or 'h' " +
+					"(e.g. '5ms',  or '10s',  or '500m',  or '1000h', regex used for validation is " +
+					"'^[0-9]{1,4}(ms|s|m|h)?')]"),
+			},
+		},
+		{
+			name:          "valid",
+			policy:        createValidPolicy(),
+			expConditions: nil,
+		},
+		{
+			name: "valid with only target refs",
+			policy: createModifiedPolicy(func(p *ngfAPI.CachePolicy) *ngfAPI.CachePolicy {
+				p.Spec = ngfAPI.CachePolicySpec{TargetRefs: p.Spec.TargetRefs}
+				return p
+			}),
+			expConditions: nil,
+		},
+	}
+
+	v := proxycache.NewValidator(validation.GenericValidator{})
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidatePanics(t *testing.T) {
+	t.Parallel()
+	v := proxycache.NewValidator(nil)
+
+	validate := func() {
+		_ = v.Validate(&policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(validate).To(Panic())
+}
+
+func TestValidator_ValidateGlobalSettings(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := proxycache.NewValidator(validation.GenericValidator{})
+
+	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
+}
+
+func TestValidator_Conflicts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	v := proxycache.NewValidator(nil)
+
+	g.Expect(v.Conflicts(createValidPolicy(), &ngfAPI.CachePolicy{})).To(BeTrue())
+}
+
+func TestValidator_ConflictsPanics(t *testing.T) {
+	t.Parallel()
+	v := proxycache.NewValidator(nil)
+
+	conflicts := func() {
+		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(conflicts).To(Panic())
+}
@@ -11,10 +11,13 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
-// rateLimitHTTPTemplate generates only the limit_req_zone directive at the http context.
+// rateLimitHTTPTemplate generates only the limit_req_zone and limit_conn_zone directives at the http context.
 const rateLimitHTTPTemplate = `
 {{ range $r := .Rule }}
-limit_req_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }} rate={{ .Rate }};
+limit_req_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }} rate={{ .Rate }}{{ if .Sync }} sync{{ end }};
+{{ end }}
+{{ range $r := .LimitConn.Rule }}
+limit_conn_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }};
 {{ end }}
 `
 
@@ -32,12 +35,50 @@ limit_req_status {{ .RejectCode }};
 {{- if .DryRun }}
 limit_req_dry_run on;
 {{- end }}
+{{ range $r := .LimitConn.Rule }}
+limit_conn {{ .ZoneName }} {{ .MaxConnections }};
+{{ end }}
+{{- if .LimitConn.LogLevel }}
+limit_conn_log_level {{ .LimitConn.LogLevel }};
+{{- end }}
+{{- if .LimitConn.RejectCode }}
+limit_conn_status {{ .LimitConn.RejectCode }};
+{{- end }}
+{{- if .LimitConn.DryRun }}
+limit_conn_dry_run on;
+{{- end }}
+`
+
+// limitConnStreamTemplate generates only the limit_conn_zone directive at the stream context.
+const limitConnStreamTemplate = `
+{{ range $r := .LimitConn.Rule }}
+limit_conn_zone {{ .Key }} zone={{ .ZoneName }}:{{ .ZoneSize }};
+{{ end }}
+`
+
+// limitConnStreamServerTemplate generates the limit_conn directives at the stream server context.
+// The stream limit_conn module has no status directive since rejected connections are closed.
+const limitConnStreamServerTemplate = `
+{{ range $r := .LimitConn.Rule }}
+limit_conn {{ .ZoneName }} {{ .MaxConnections }};
+{{ end }}
+{{- if .LimitConn.LogLevel }}
+limit_conn_log_level {{ .LimitConn.LogLevel }};
+{{- end }}
+{{- if .LimitConn.DryRun }}
+limit_conn_dry_run on;
+{{- end }}
 `
 
 var (
 	tmplHTTP     = template.Must(template.New("rate limit policy http").Parse(rateLimitHTTPTemplate))
 	tmplServer   = template.Must(template.New("rate limit policy server").Parse(rateLimitReqTemplate))
 	tmplLocation = template.Must(template.New("rate limit policy location").Parse(rateLimitReqTemplate))
+
+	tmplStream       = template.Must(template.New("rate limit policy stream").Parse(limitConnStreamTemplate))
+	tmplStreamServer = template.Must(
+		template.New("rate limit policy stream server").Parse(limitConnStreamServerTemplate),
+	)
 )
 
 const (
@@ -49,6 +90,9 @@ const (
 	fileNameSuffixServer   = "gateway_server"
 	fileNameSuffixLocation = "route"
 
+	fileNameSuffixStream       = "stream"
+	fileNameSuffixStreamServer = "stream_server"
+
 	// defaultZoneSize is the default size of the shared memory zone in the limit_req_zone NGINX directive.
 	defaultZoneSize = "10m"
 	// defaultRate is the default request rate in the limit_req_zone NGINX directive.
@@ -64,6 +108,8 @@ type rateLimitSettings struct {
 	LogLevel string
 	// Rule is the list of rate limit rules.
 	Rule []rateLimitRule
+	// LimitConn contains the connection limit settings.
+	LimitConn limitConnSettings
 	// RejectCode is the status code to return in response to rejected requests.
 	RejectCode int
 	// DryRun enables the dry run mode, where the rate limit is not actually applied, but the number
@@ -87,9 +133,38 @@ type rateLimitRule struct {
 	Burst int
 	// NoDelay indicates whether excessive requests are processed without delay.
 	NoDelay bool
+	// Sync indicates whether the state of the shared memory zone is synchronized between the NGINX replicas.
+	Sync bool
+}
+
+// limitConnSettings represents the connection limit settings for a rate limit policy.
+type limitConnSettings struct {
+	// LogLevel is the log level for cases when the server limits the number of connections.
+	LogLevel string
+	// Rule is the list of connection limit rules.
+	Rule []limitConnRule
+	// RejectCode is the status code to return in response to rejected requests.
+	RejectCode int
+	// DryRun enables the dry run mode, where the number of connections is not limited, but the number
+	// of excessive connections is accounted as usual in the shared memory zone.
+	DryRun bool
 }
 
-func getRateLimitSettings(rlp ngfAPI.RateLimitPolicy) rateLimitSettings {
+// limitConnRule represents a single connection limit rule.
+type limitConnRule struct {
+	// ZoneName is the name of the shared memory zone.
+	ZoneName string
+	// ZoneSize is the size of the shared memory zone.
+	ZoneSize string
+	// Key is the key to use for connection limiting.
+	Key string
+	// MaxConnections is the maximum number of connections allowed per key value.
+	MaxConnections int
+}
+
+// getRateLimitSettings converts the policy into the settings used by the templates. stream indicates if
+// the settings are generated for the stream context.
+func getRateLimitSettings(rlp ngfAPI.RateLimitPolicy, stream bool) rateLimitSettings {
 	settings := rateLimitSettings{}
 
 	if rlp.Spec.RateLimit != nil {
@@ -106,41 +181,105 @@ func getRateLimitSettings(rlp ngfAPI.RateLimitPolicy) rateLimitSettings {
 		}
 
 		if rlp.Spec.RateLimit.Local != nil {
-			for i, rule := range rlp.Spec.RateLimit.Local.Rules {
-				rlRule := rateLimitRule{}
+			settings.Rule = append(settings.Rule, getRateLimitRules(rlp, rlp.Spec.RateLimit.Local.Rules, false)...)
+		}
 
-				rlRule.ZoneSize = defaultZoneSize
-				if rule.ZoneSize != nil {
-					rlRule.ZoneSize = string(*rule.ZoneSize)
-				}
+		if rlp.Spec.RateLimit.Global != nil {
+			settings.Rule = append(settings.Rule, getRateLimitRules(rlp, rlp.Spec.RateLimit.Global.Rules, true)...)
+		}
+	}
 
-				if rule.Delay != nil {
-					rlRule.Delay = int(*rule.Delay)
-				}
+	if rlp.Spec.LimitConn != nil {
+		settings.LimitConn = getLimitConnSettings(rlp, stream)
+	}
 
-				if rule.Burst != nil {
-					rlRule.Burst = int(*rule.Burst)
-				}
+	return settings
+}
 
-				if rule.NoDelay != nil {
-					rlRule.NoDelay = *rule.NoDelay
-				}
+// getRateLimitRules converts the rate limit rules of the policy. Global rules use separate zones
+// that are synchronized between the NGINX replicas.
+func getRateLimitRules(rlp ngfAPI.RateLimitPolicy, rules []ngfAPI.RateLimitRule, global bool) []rateLimitRule {
+	rlRules := make([]rateLimitRule, 0, len(rules))
 
-				rlRule.Rate = defaultRate
-				if rule.Rate != "" {
-					rlRule.Rate = string(rule.Rate)
-				}
+	for i, rule := range rules {
+		rlRule := rateLimitRule{}
 
-				rlRule.Key = defaultKey
-				if rule.Key != "" {
-					rlRule.Key = rule.Key
-				}
+		rlRule.ZoneSize = defaultZoneSize
+		if rule.ZoneSize != nil {
+			rlRule.ZoneSize = string(*rule.ZoneSize)
+		}
 
-				rlRule.ZoneName = fmt.Sprintf("%s_rl_%s_rule%d", rlp.Namespace, rlp.Name, i)
+		if rule.Delay != nil {
+			rlRule.Delay = int(*rule.Delay)
+		}
 
-				settings.Rule = append(settings.Rule, rlRule)
-			}
+		if rule.Burst != nil {
+			rlRule.Burst = int(*rule.Burst)
+		}
+
+		if rule.NoDelay != nil {
+			rlRule.NoDelay = *rule.NoDelay
+		}
+
+		rlRule.Rate = defaultRate
+		if rule.Rate != "" {
+			rlRule.Rate = string(rule.Rate)
+		}
+
+		rlRule.Key = defaultKey
+		if rule.Key != "" {
+			rlRule.Key = rule.Key
 		}
+
+		rlRule.ZoneName = fmt.Sprintf("%s_rl_%s_rule%d", rlp.Namespace, rlp.Name, i)
+		if global {
+			rlRule.ZoneName = fmt.Sprintf("%s_rl_%s_global_rule%d", rlp.Namespace, rlp.Name, i)
+			rlRule.Sync = true
+		}
+
+		rlRules = append(rlRules, rlRule)
+	}
+
+	return rlRules
+}
+
+// getLimitConnSettings converts the connection limit settings of the policy. Shared memory zone names are
+// global across the http and stream contexts, so stream zones use a different name. Otherwise, a policy that
+// targets both HTTP and L4 routes would declare the same zone twice.
+func getLimitConnSettings(rlp ngfAPI.RateLimitPolicy, stream bool) limitConnSettings {
+	lc := rlp.Spec.LimitConn
+	settings := limitConnSettings{}
+
+	zoneNameFormat := "%s_lc_%s_rule%d"
+	if stream {
+		zoneNameFormat = "%s_slc_%s_rule%d"
+	}
+
+	if lc.DryRun != nil {
+		settings.DryRun = *lc.DryRun
+	}
+
+	if lc.LogLevel != nil {
+		settings.LogLevel = string(*lc.LogLevel)
+	}
+
+	if lc.RejectCode != nil {
+		settings.RejectCode = int(*lc.RejectCode)
+	}
+
+	for i, rule := range lc.Rules {
+		lcRule := limitConnRule{
+			ZoneName:       fmt.Sprintf(zoneNameFormat, rlp.Namespace, rlp.Name, i),
+			ZoneSize:       defaultZoneSize,
+			Key:            rule.Key,
+			MaxConnections: int(rule.MaxConnections),
+		}
+
+		if rule.ZoneSize != nil {
+			lcRule.ZoneSize = string(*rule.ZoneSize)
+		}
+
+		settings.Rule = append(settings.Rule, lcRule)
 	}
 
 	return settings
@@ -171,6 +310,16 @@ func (g Generator) GenerateForLocation(pols []policies.Policy, _ http.Location)
 	return generate(pols, tmplLocation)
 }
 
+// GenerateForStream generates policy configuration for the stream block.
+func (g Generator) GenerateForStream(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplStream)
+}
+
+// GenerateForStreamServer generates policy configuration for a stream server block.
+func (g Generator) GenerateForStreamServer(pols []policies.Policy) policies.GenerateResultFiles {
+	return generate(pols, tmplStreamServer)
+}
+
 func generate(pols []policies.Policy, tmpl *template.Template) policies.GenerateResultFiles {
 	files := make(policies.GenerateResultFiles, 0, len(pols))
 
@@ -190,7 +339,7 @@ func generate(pols []policies.Policy, tmpl *template.Template) policies.Generate
 			continue
 		}
 
-		settings := getRateLimitSettings(*rlp)
+		settings := getRateLimitSettings(*rlp, tmpl == tmplStream || tmpl == tmplStreamServer)
 
 		var suffix string
 		switch tmpl {
@@ -204,6 +353,10 @@ func generate(pols []policies.Policy, tmpl *template.Template) policies.Generate
 			suffix = fileNameSuffixServer
 		case tmplLocation:
 			suffix = fileNameSuffixLocation
+		case tmplStream:
+			suffix = fileNameSuffixStream
+		case tmplStreamServer:
+			suffix = fileNameSuffixStreamServer
 		}
 
 		name := fmt.Sprintf("%s_%s_%s_%s.conf", fileNamePrefix, rlp.Namespace, rlp.Name, suffix)
@@ -223,6 +376,6 @@ func isShadowPolicy(rlp *ngfAPI.RateLimitPolicy) bool {
 	if rlp.Annotations == nil {
 		return false
 	}
-	val, exists := rlp.Annotations[dataplane.InternalRLPAnnotationKey]
-	return exists && val == dataplane.InternalRLPAnnotationValue
+	val, exists := rlp.Annotations[dataplane.InternalHTTPContextAnnotationKey]
+	return exists && val == dataplane.InternalHTTPContextAnnotationValue
 }
@@ -246,16 +246,97 @@ func TestGenerate(t *testing.T) {
 				"limit_req_dry_run on;",
 			},
 		},
+		{
+			name: "rate limit and connection limit rules",
+			policy: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      policyName,
+					Namespace: policyNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Local: &ngfAPIv1alpha1.LocalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{{}},
+						},
+					},
+					LimitConn: &ngfAPIv1alpha1.LimitConn{
+						LogLevel:   &logLevel,
+						RejectCode: helpers.GetPointer[int32](503),
+						DryRun:     &dryRun,
+						Rules: []ngfAPIv1alpha1.LimitConnRule{
+							{
+								Key:            "$binary_remote_addr",
+								MaxConnections: 10,
+							},
+							{
+								Key:            "$server_name",
+								ZoneSize:       &zoneSize,
+								MaxConnections: 100,
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_req_zone $binary_remote_addr zone=default_rl_test-policy_rule0:10m rate=100r/s;",
+				"limit_req zone=default_rl_test-policy_rule0;",
+				"limit_conn_zone $binary_remote_addr zone=default_lc_test-policy_rule0:10m;",
+				"limit_conn default_lc_test-policy_rule0 10;",
+				"limit_conn_zone $server_name zone=default_lc_test-policy_rule1:20m;",
+				"limit_conn default_lc_test-policy_rule1 100;",
+				"limit_conn_log_level warn;",
+				"limit_conn_status 503;",
+				"limit_conn_dry_run on;",
+			},
+		},
+		{
+			name: "local and global rate limit rules",
+			policy: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: v1.ObjectMeta{
+					Name:      policyName,
+					Namespace: policyNamespace,
+				},
+				Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+					RateLimit: &ngfAPIv1alpha1.RateLimit{
+						Local: &ngfAPIv1alpha1.LocalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{{}},
+						},
+						Global: &ngfAPIv1alpha1.GlobalRateLimit{
+							Rules: []ngfAPIv1alpha1.RateLimitRule{
+								{
+									Key:      key,
+									Rate:     rate,
+									ZoneSize: &zoneSize,
+									Burst:    &burst,
+								},
+							},
+						},
+					},
+				},
+			},
+			expStrings: []string{
+				"limit_req_zone $binary_remote_addr zone=default_rl_test-policy_rule0:10m rate=100r/s;",
+				"limit_req zone=default_rl_test-policy_rule0;",
+				"limit_req_zone $binary_remote_addr:$request_uri zone=default_rl_test-policy_global_rule0:20m " +
+					"rate=10r/s sync;",
+				"limit_req zone=default_rl_test-policy_global_rule0 burst=5;",
+			},
+		},
+	}
+
+	// isZoneDirective returns whether the expected string is an http-context zone directive.
+	isZoneDirective := func(str string) bool {
+		return strings.Contains(str, "limit_req_zone") || strings.Contains(str, "limit_conn_zone")
 	}
 
-	// checkHTTPResults verifies that the http-context output contains only limit_req_zone directives.
+	// checkHTTPResults verifies that the http-context output contains only zone directives.
 	checkHTTPResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings []string) {
 		t.Helper()
 		g := NewWithT(t)
 		g.Expect(resFiles).To(HaveLen(1))
 
 		for _, str := range expStrings {
-			if strings.Contains(str, "limit_req_zone") {
+			if isZoneDirective(str) {
 				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
 			} else {
 				g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
@@ -264,14 +345,14 @@ func TestGenerate(t *testing.T) {
 	}
 
 	// checkLimitReqResults verifies that server/location-context output contains limit_req
-	// directives but not limit_req_zone.
+	// and limit_conn directives but not zone directives.
 	checkLimitReqResults := func(t *testing.T, resFiles policies.GenerateResultFiles, expStrings []string) {
 		t.Helper()
 		g := NewWithT(t)
 		g.Expect(resFiles).To(HaveLen(1))
 
 		for _, str := range expStrings {
-			if strings.Contains(str, "limit_req_zone") {
+			if isZoneDirective(str) {
 				g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
 			} else {
 				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
@@ -323,6 +404,12 @@ func TestGenerateNoPolicies(t *testing.T) {
 
 	resFiles = generator.GenerateForInternalLocation([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
 	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForStream([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
+
+	resFiles = generator.GenerateForStreamServer([]policies.Policy{&ngfAPIv1alpha2.ObservabilityPolicy{}})
+	g.Expect(resFiles).To(BeEmpty())
 }
 
 func TestGenerateServerSkipsShadowPolicies(t *testing.T) {
@@ -336,7 +423,7 @@ func TestGenerateServerSkipsShadowPolicies(t *testing.T) {
 			Name:      "shadow-policy",
 			Namespace: "default",
 			Annotations: map[string]string{
-				dataplane.InternalRLPAnnotationKey: dataplane.InternalRLPAnnotationValue,
+				dataplane.InternalHTTPContextAnnotationKey: dataplane.InternalHTTPContextAnnotationValue,
 			},
 		},
 		Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
@@ -355,3 +442,125 @@ func TestGenerateServerSkipsShadowPolicies(t *testing.T) {
 	g.Expect(resFiles).To(HaveLen(1))
 	g.Expect(resFiles[0].Name).To(ContainSubstring("internal_http"))
 }
+
+func TestGenerateHTTPAndStreamZoneNames(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	// a policy can target both HTTP and L4 routes, so it generates limit_conn_zone in both contexts
+	policy := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+		Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+			LimitConn: &ngfAPIv1alpha1.LimitConn{
+				Rules: []ngfAPIv1alpha1.LimitConnRule{
+					{
+						Key:            "$binary_remote_addr",
+						MaxConnections: 3,
+					},
+				},
+			},
+		},
+	}
+
+	generator := ratelimit.NewGenerator()
+
+	httpFiles := generator.GenerateForHTTP([]policies.Policy{policy})
+	g.Expect(httpFiles).To(HaveLen(1))
+	g.Expect(string(httpFiles[0].Content)).To(ContainSubstring(
+		"limit_conn_zone $binary_remote_addr zone=default_lc_test-policy_rule0:10m;",
+	))
+
+	streamFiles := generator.GenerateForStream([]policies.Policy{policy})
+	g.Expect(streamFiles).To(HaveLen(1))
+	g.Expect(string(streamFiles[0].Content)).To(ContainSubstring(
+		"limit_conn_zone $binary_remote_addr zone=default_slc_test-policy_rule0:10m;",
+	))
+}
+
+func TestGenerateStream(t *testing.T) {
+	t.Parallel()
+
+	policy := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: v1.ObjectMeta{
+			Name:      "test-policy",
+			Namespace: "default",
+		},
+		Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
+			LimitConn: &ngfAPIv1alpha1.LimitConn{
+				LogLevel:   helpers.GetPointer(ngfAPIv1alpha1.RateLimitLogLevelNotice),
+				RejectCode: helpers.GetPointer[int32](503),
+				DryRun:     helpers.GetPointer(true),
+				Rules: []ngfAPIv1alpha1.LimitConnRule{
+					{
+						Key:            "$binary_remote_addr",
+						ZoneSize:       helpers.GetPointer(ngfAPIv1alpha1.Size("5m")),
+						MaxConnections: 3,
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		generate      func(*ratelimit.Generator) policies.GenerateResultFiles
+		name          string
+		expName       string
+		expStrings    []string
+		notExpStrings []string
+	}{
+		{
+			name: "stream",
+			generate: func(g *ratelimit.Generator) policies.GenerateResultFiles {
+				return g.GenerateForStream([]policies.Policy{policy})
+			},
+			expName: "RateLimitPolicy_default_test-policy_stream.conf",
+			expStrings: []string{
+				"limit_conn_zone $binary_remote_addr zone=default_slc_test-policy_rule0:5m;",
+			},
+			notExpStrings: []string{
+				"limit_conn default_slc_test-policy_rule0",
+				"limit_conn_log_level",
+				"limit_conn_dry_run",
+			},
+		},
+		{
+			name: "stream server",
+			generate: func(g *ratelimit.Generator) policies.GenerateResultFiles {
+				return g.GenerateForStreamServer([]policies.Policy{policy})
+			},
+			expName: "RateLimitPolicy_default_test-policy_stream_server.conf",
+			expStrings: []string{
+				"limit_conn default_slc_test-policy_rule0 3;",
+				"limit_conn_log_level notice;",
+				"limit_conn_dry_run on;",
+			},
+			notExpStrings: []string{
+				"limit_conn_zone",
+				"limit_conn_status",
+				"limit_req",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			resFiles := test.generate(ratelimit.NewGenerator())
+			g.Expect(resFiles).To(HaveLen(1))
+			g.Expect(resFiles[0].Name).To(Equal(test.expName))
+
+			for _, str := range test.expStrings {
+				g.Expect(string(resFiles[0].Content)).To(ContainSubstring(str))
+			}
+
+			for _, str := range test.notExpStrings {
+				g.Expect(string(resFiles[0].Content)).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
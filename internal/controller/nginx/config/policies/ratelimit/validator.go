@@ -25,6 +25,8 @@ const (
 	limitReqKeyFmt = `^(?:[^ \t\r\n;{}#$]+|\$\w+)+$`
 	limitReqErrMsg = "must be a valid limit_req key consisting of nginx variables " +
 		"and/or strings without spaces or special characters"
+	limitConnErrMsg = "must be a valid limit_conn key consisting of nginx variables " +
+		"and/or strings without spaces or special characters"
 )
 
 var (
@@ -36,11 +38,15 @@ var (
 // Implements policies.Validator interface.
 type Validator struct {
 	genericValidator validation.GenericValidator
+	plusEnabled      bool
 }
 
 // NewValidator returns a new instance of Validator.
-func NewValidator(genericValidator validation.GenericValidator) *Validator {
-	return &Validator{genericValidator: genericValidator}
+func NewValidator(genericValidator validation.GenericValidator, plusEnabled bool) *Validator {
+	return &Validator{
+		genericValidator: genericValidator,
+		plusEnabled:      plusEnabled,
+	}
 }
 
 // Validate validates the spec of a RateLimitPolicy.
@@ -85,6 +91,20 @@ func conflicts(a, b ngfAPI.RateLimitPolicySpec) bool {
 		}
 	}
 
+	if a.LimitConn != nil && b.LimitConn != nil {
+		if a.LimitConn.DryRun != nil && b.LimitConn.DryRun != nil {
+			return true
+		}
+
+		if a.LimitConn.LogLevel != nil && b.LimitConn.LogLevel != nil {
+			return true
+		}
+
+		if a.LimitConn.RejectCode != nil && b.LimitConn.RejectCode != nil {
+			return true
+		}
+	}
+
 	return false
 }
 
@@ -94,9 +114,36 @@ func (v *Validator) validateSettings(spec ngfAPI.RateLimitPolicySpec) error {
 	var allErrs field.ErrorList
 	fieldPath := field.NewPath("spec")
 
-	if spec.RateLimit != nil && spec.RateLimit.Local != nil {
-		for _, rule := range spec.RateLimit.Local.Rules {
-			path := fieldPath.Child("rateLimit").Child("local").Child("rules")
+	if spec.RateLimit != nil {
+		rateLimitPath := fieldPath.Child("rateLimit")
+
+		if spec.RateLimit.Local != nil {
+			allErrs = append(
+				allErrs,
+				v.validateRateLimitRules(rateLimitPath.Child("local").Child("rules"), spec.RateLimit.Local.Rules)...,
+			)
+		}
+
+		if spec.RateLimit.Global != nil {
+			globalPath := rateLimitPath.Child("global")
+
+			if !v.plusEnabled {
+				allErrs = append(allErrs, field.Forbidden(
+					globalPath,
+					"global rate limiting is only supported with NGINX Plus",
+				))
+			}
+
+			allErrs = append(
+				allErrs,
+				v.validateRateLimitRules(globalPath.Child("rules"), spec.RateLimit.Global.Rules)...,
+			)
+		}
+	}
+
+	if spec.LimitConn != nil {
+		for _, rule := range spec.LimitConn.Rules {
+			path := fieldPath.Child("limitConn").Child("rules")
 
 			if rule.ZoneSize != nil {
 				if err := v.genericValidator.ValidateNginxSize(string(*rule.ZoneSize)); err != nil {
@@ -110,33 +157,64 @@ func (v *Validator) validateSettings(spec ngfAPI.RateLimitPolicySpec) error {
 				}
 			}
 
-			if rule.Rate != "" {
-				if err := validateNginxRate(string(rule.Rate)); err != nil {
-					allErrs = append(allErrs,
-						field.Invalid(
-							path.Child("rate"),
-							rule.Rate,
-							err.Error(),
-						),
-					)
-				}
+			if err := validateLimitConnKey(rule.Key); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("key"),
+						rule.Key,
+						err.Error(),
+					),
+				)
 			}
+		}
+	}
 
-			if rule.Key != "" {
-				if err := validateLimitReqKey(rule.Key); err != nil {
-					allErrs = append(allErrs,
-						field.Invalid(
-							path.Child("key"),
-							rule.Key,
-							err.Error(),
-						),
-					)
-				}
+	return allErrs.ToAggregate()
+}
+
+// validateRateLimitRules validates the fields of the rate limit rules that are vulnerable to code injection.
+func (v *Validator) validateRateLimitRules(path *field.Path, rules []ngfAPI.RateLimitRule) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for _, rule := range rules {
+		if rule.ZoneSize != nil {
+			if err := v.genericValidator.ValidateNginxSize(string(*rule.ZoneSize)); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("zoneSize"),
+						*rule.ZoneSize,
+						err.Error(),
+					),
+				)
+			}
+		}
+
+		if rule.Rate != "" {
+			if err := validateNginxRate(string(rule.Rate)); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("rate"),
+						rule.Rate,
+						err.Error(),
+					),
+				)
+			}
+		}
+
+		if rule.Key != "" {
+			if err := validateLimitReqKey(rule.Key); err != nil {
+				allErrs = append(allErrs,
+					field.Invalid(
+						path.Child("key"),
+						rule.Key,
+						err.Error(),
+					),
+				)
 			}
 		}
 	}
 
-	return allErrs.ToAggregate()
+	return allErrs
 }
 
 // validateNginxRate validates a rate string that nginx can understand.
@@ -167,3 +245,17 @@ func validateLimitReqKey(key string) error {
 
 	return nil
 }
+
+// validateLimitConnKey validates a limit_conn key string that nginx can understand.
+func validateLimitConnKey(key string) error {
+	if !limitReqKeyRegexp.MatchString(key) {
+		examples := []string{
+			"$binary_remote_addr",
+			"$server_name",
+		}
+
+		return errors.New(k8svalidation.RegexError(limitConnErrMsg, limitReqKeyFmt, examples...))
+	}
+
+	return nil
+}
@@ -16,6 +16,8 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
 
+const plusDisabled = false
+
 type policyModFunc func(policy *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy
 
 func createValidPolicy() *ngfAPI.RateLimitPolicy {
@@ -47,6 +49,18 @@ func createValidPolicy() *ngfAPI.RateLimitPolicy {
 					},
 				},
 			},
+			LimitConn: &ngfAPI.LimitConn{
+				DryRun:     helpers.GetPointer(true),
+				LogLevel:   helpers.GetPointer[ngfAPI.RateLimitLogLevel]("warn"),
+				RejectCode: helpers.GetPointer[int32](503),
+				Rules: []ngfAPI.LimitConnRule{
+					{
+						ZoneSize:       helpers.GetPointer[ngfAPI.Size]("10m"),
+						Key:            "$binary_remote_addr",
+						MaxConnections: 10,
+					},
+				},
+			},
 		},
 		Status: v1.PolicyStatus{},
 	}
@@ -101,6 +115,23 @@ func TestValidator_Validate(t *testing.T) {
 					"'^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')"),
 			},
 		},
+		{
+			name: "invalid limit conn zone size and key",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p.Spec.LimitConn.Rules[0].ZoneSize = helpers.GetPointer[ngfAPI.Size]("invalid")
+				p.Spec.LimitConn.Rules[0].Key = "$invalid_key{}"
+				return p
+			}),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("[spec.limitConn.rules.zoneSize: Invalid value: \"invalid\": " +
+					"must contain a number. May be followed by 'k', 'm', or 'g', otherwise bytes are assumed " +
+					"(e.g. '1024',  or '8k',  or '20m',  or '1g', regex used for validation is '^\\d{1,4}(k|m|g)?$'), " +
+					"spec.limitConn.rules.key: Invalid value: \"$invalid_key{}\": must be a valid limit_conn key " +
+					"consisting of nginx variables and/or strings without spaces or special characters " +
+					"(e.g. '$binary_remote_addr',  or '$server_name', regex used for validation is " +
+					"'^(?:[^ \\t\\r\\n;{}#$]+|\\$\\w+)+$')]"),
+			},
+		},
 		{
 			name:          "valid",
 			policy:        createValidPolicy(),
@@ -137,13 +168,77 @@ func TestValidator_Validate(t *testing.T) {
 		},
 	}
 
-	v := ratelimit.NewValidator(validation.GenericValidator{})
+	v := ratelimit.NewValidator(validation.GenericValidator{}, plusDisabled)
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			conds := v.Validate(test.policy)
+			g.Expect(conds).To(Equal(test.expConditions))
+		})
+	}
+}
+
+func TestValidator_ValidateGlobal(t *testing.T) {
+	t.Parallel()
+
+	withGlobal := func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+		p.Spec.RateLimit.Global = &ngfAPI.GlobalRateLimit{
+			Rules: []ngfAPI.RateLimitRule{
+				{
+					Rate: ngfAPI.Rate("100r/s"),
+					Key:  "$binary_remote_addr",
+				},
+			},
+		}
+		return p
+	}
+
+	tests := []struct {
+		name          string
+		policy        *ngfAPI.RateLimitPolicy
+		expConditions []conditions.Condition
+		plusEnabled   bool
+	}{
+		{
+			name:   "global rate limit with Plus disabled",
+			policy: createModifiedPolicy(withGlobal),
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.global: Forbidden: " +
+					"global rate limiting is only supported with NGINX Plus"),
+			},
+		},
+		{
+			name:          "global rate limit with Plus enabled",
+			policy:        createModifiedPolicy(withGlobal),
+			plusEnabled:   true,
+			expConditions: nil,
+		},
+		{
+			name: "invalid global rate with Plus enabled",
+			policy: createModifiedPolicy(func(p *ngfAPI.RateLimitPolicy) *ngfAPI.RateLimitPolicy {
+				p = withGlobal(p)
+				p.Spec.RateLimit.Global.Rules[0].Rate = "100rs"
+				return p
+			}),
+			plusEnabled: true,
+			expConditions: []conditions.Condition{
+				conditions.NewPolicyInvalid("spec.rateLimit.global.rules.rate: Invalid value: \"100rs\": " +
+					"must contain a number followed by 'r/s' or 'r/m' " +
+					"(e.g. '10r/s',  or '500r/m', regex used for validation is '^\\d+r/[sm]$')"),
+			},
+		},
+	}
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
+			v := ratelimit.NewValidator(validation.GenericValidator{}, test.plusEnabled)
+
 			conds := v.Validate(test.policy)
 			g.Expect(conds).To(Equal(test.expConditions))
 		})
@@ -152,7 +247,7 @@ func TestValidator_Validate(t *testing.T) {
 
 func TestValidator_ValidatePanics(t *testing.T) {
 	t.Parallel()
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, plusDisabled)
 
 	validate := func() {
 		_ = v.Validate(&policiesfakes.FakePolicy{})
@@ -167,7 +262,7 @@ func TestValidator_ValidateGlobalSettings(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
 
-	v := ratelimit.NewValidator(validation.GenericValidator{})
+	v := ratelimit.NewValidator(validation.GenericValidator{}, plusDisabled)
 
 	g.Expect(v.ValidateGlobalSettings(nil, nil)).To(BeNil())
 }
@@ -250,9 +345,62 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "limit conn dryrun conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.RateLimitPolicy{
+				Spec: ngfAPI.RateLimitPolicySpec{
+					LimitConn: &ngfAPI.LimitConn{
+						DryRun: helpers.GetPointer(false),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "limit conn log level conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.RateLimitPolicy{
+				Spec: ngfAPI.RateLimitPolicySpec{
+					LimitConn: &ngfAPI.LimitConn{
+						LogLevel: helpers.GetPointer[ngfAPI.RateLimitLogLevel]("error"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "limit conn reject code conflicts",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.RateLimitPolicy{
+				Spec: ngfAPI.RateLimitPolicySpec{
+					LimitConn: &ngfAPI.LimitConn{
+						RejectCode: helpers.GetPointer[int32](429),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "limit conn rules do not conflict",
+			polA: createValidPolicy(),
+			polB: &ngfAPI.RateLimitPolicy{
+				Spec: ngfAPI.RateLimitPolicySpec{
+					LimitConn: &ngfAPI.LimitConn{
+						Rules: []ngfAPI.LimitConnRule{
+							{
+								Key:            "$server_name",
+								MaxConnections: 100,
+							},
+						},
+					},
+				},
+			},
+			conflicts: false,
+		},
 	}
 
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, plusDisabled)
 
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -266,7 +414,7 @@ func TestValidator_Conflicts(t *testing.T) {
 
 func TestValidator_ConflictsPanics(t *testing.T) {
 	t.Parallel()
-	v := ratelimit.NewValidator(nil)
+	v := ratelimit.NewValidator(nil, plusDisabled)
 
 	conflicts := func() {
 		_ = v.Conflicts(&policiesfakes.FakePolicy{}, &policiesfakes.FakePolicy{})
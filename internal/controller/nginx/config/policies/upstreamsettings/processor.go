@@ -23,8 +23,33 @@ type UpstreamSettings struct {
 	LoadBalancingMethod string
 	// HashMethodKey is the key to be used for hash-based load balancing methods.
 	HashMethodKey string
+	// HealthCheck contains the active health check settings.
+	HealthCheck *ngfAPI.UpstreamHealthCheck
+	// MaxFails is the max_fails parameter of the upstream servers.
+	// A nil value means the policy did not set this field and the NGINX default is used.
+	MaxFails *int32
+	// FailTimeout is the fail_timeout parameter of the upstream servers.
+	FailTimeout string
+	// SlowStart is the slow_start parameter of the upstream servers.
+	SlowStart string
 	// KeepAlive contains the keepalive settings.
 	KeepAlive http.UpstreamKeepAlive
+	// Stream contains the settings of the connections to stream upstream servers.
+	Stream StreamSettings
+}
+
+// StreamSettings contains the stream settings from UpstreamSettingsPolicy.
+type StreamSettings struct {
+	// Responses is the number of datagrams expected from the upstream server in response to a client datagram.
+	Responses *int32
+	// Requests is the number of client datagrams after which the UDP session is dropped.
+	Requests *int32
+	// ConnectTimeout is the timeout for establishing a connection with an upstream server.
+	ConnectTimeout string
+	// Timeout is the timeout between two successive read or write operations on the connections.
+	Timeout string
+	// ProxyProtocol indicates whether to enable the PROXY protocol for the connections to the upstream servers.
+	ProxyProtocol bool
 }
 
 // NewProcessor returns a new Processor.
@@ -84,7 +109,51 @@ func processPolicies(pols []policies.Policy) UpstreamSettings {
 		if usp.Spec.UseClusterIP != nil {
 			upstreamSettings.UseClusterIP = usp.Spec.UseClusterIP
 		}
+
+		if usp.Spec.HealthCheck != nil {
+			upstreamSettings.HealthCheck = usp.Spec.HealthCheck
+		}
+
+		if usp.Spec.PassiveHealthCheck != nil {
+			if usp.Spec.PassiveHealthCheck.MaxFails != nil {
+				upstreamSettings.MaxFails = usp.Spec.PassiveHealthCheck.MaxFails
+			}
+
+			if usp.Spec.PassiveHealthCheck.FailTimeout != nil {
+				upstreamSettings.FailTimeout = string(*usp.Spec.PassiveHealthCheck.FailTimeout)
+			}
+		}
+
+		if usp.Spec.SlowStart != nil {
+			upstreamSettings.SlowStart = string(*usp.Spec.SlowStart)
+		}
+
+		if usp.Spec.Stream != nil {
+			processStreamSettings(*usp.Spec.Stream, &upstreamSettings.Stream)
+		}
 	}
 
 	return upstreamSettings
 }
+
+func processStreamSettings(spec ngfAPI.UpstreamStreamSettings, settings *StreamSettings) {
+	if spec.ProxyProtocol != nil {
+		settings.ProxyProtocol = *spec.ProxyProtocol
+	}
+
+	if spec.ConnectTimeout != nil {
+		settings.ConnectTimeout = string(*spec.ConnectTimeout)
+	}
+
+	if spec.Timeout != nil {
+		settings.Timeout = string(*spec.Timeout)
+	}
+
+	if spec.Responses != nil {
+		settings.Responses = spec.Responses
+	}
+
+	if spec.Requests != nil {
+		settings.Requests = spec.Requests
+	}
+}
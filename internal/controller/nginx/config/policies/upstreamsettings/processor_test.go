@@ -436,6 +436,101 @@ func TestProcess(t *testing.T) {
 				UseClusterIP: helpers.GetPointer(false),
 			},
 		},
+		{
+			name: "health check",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp-health-check",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+						HealthCheck: &ngfAPIv1alpha1.UpstreamHealthCheck{
+							Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+							URI:      helpers.GetPointer("/healthz"),
+						},
+					},
+				},
+			},
+			expUpstreamSettings: UpstreamSettings{
+				HealthCheck: &ngfAPIv1alpha1.UpstreamHealthCheck{
+					Interval: helpers.GetPointer[ngfAPIv1alpha1.Duration]("10s"),
+					URI:      helpers.GetPointer("/healthz"),
+				},
+			},
+		},
+		{
+			name: "passive health check and slow start from multiple policies",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp-max-fails",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+						PassiveHealthCheck: &ngfAPIv1alpha1.UpstreamPassiveHealthCheck{
+							MaxFails: helpers.GetPointer[int32](3),
+						},
+						SlowStart: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1m"),
+					},
+				},
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp-fail-timeout",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+						PassiveHealthCheck: &ngfAPIv1alpha1.UpstreamPassiveHealthCheck{
+							FailTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("30s"),
+						},
+					},
+				},
+			},
+			expUpstreamSettings: UpstreamSettings{
+				MaxFails:    helpers.GetPointer[int32](3),
+				FailTimeout: "30s",
+				SlowStart:   "1m",
+			},
+		},
+		{
+			name: "stream settings from multiple policies",
+			policies: []policies.Policy{
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp-stream-proxy-protocol",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+						Stream: &ngfAPIv1alpha1.UpstreamStreamSettings{
+							ProxyProtocol:  helpers.GetPointer(true),
+							ConnectTimeout: helpers.GetPointer[ngfAPIv1alpha1.Duration]("5s"),
+						},
+					},
+				},
+				&ngfAPIv1alpha1.UpstreamSettingsPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "usp-stream-udp",
+						Namespace: "test",
+					},
+					Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+						Stream: &ngfAPIv1alpha1.UpstreamStreamSettings{
+							Timeout:   helpers.GetPointer[ngfAPIv1alpha1.Duration]("1m"),
+							Responses: helpers.GetPointer[int32](1),
+							Requests:  helpers.GetPointer[int32](0),
+						},
+					},
+				},
+			},
+			expUpstreamSettings: UpstreamSettings{
+				Stream: StreamSettings{
+					ProxyProtocol:  true,
+					ConnectTimeout: "5s",
+					Timeout:        "1m",
+					Responses:      helpers.GetPointer[int32](1),
+					Requests:       helpers.GetPointer[int32](0),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
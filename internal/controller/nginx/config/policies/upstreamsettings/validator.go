@@ -1,10 +1,13 @@
 package upstreamsettings
 
 import (
+	"errors"
 	"fmt"
+	"regexp"
 	"sort"
 	"strings"
 
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -17,6 +20,34 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
 
+const (
+	healthCheckURIFmt    = `^/[^\s;{}#$"'\\]*$`
+	healthCheckURIErrMsg = "must start with '/' and must not contain whitespace or any of the following " +
+		`characters: ';', '{', '}', '#', '$', '"', ''', '\'`
+
+	healthCheckStatusFmt    = `^[1-5][0-9]{2}(-[1-5][0-9]{2})?$`
+	healthCheckStatusErrMsg = "must be a status code or a range of status codes"
+
+	grpcServiceNameFmt    = `^[a-zA-Z0-9_.]+$`
+	grpcServiceNameErrMsg = "must contain only alphanumeric characters or '.' or '_'"
+)
+
+var (
+	healthCheckURIRegexp    = regexp.MustCompile(healthCheckURIFmt)
+	healthCheckStatusRegexp = regexp.MustCompile(healthCheckStatusFmt)
+	grpcServiceNameRegexp   = regexp.MustCompile(grpcServiceNameFmt)
+
+	// slowStartAllowedLBMethods are the load balancing methods that can be used along with slow start.
+	slowStartAllowedLBMethods = map[ngfAPI.LoadBalancingType]struct{}{
+		ngfAPI.LoadBalancingTypeRoundRobin:                {},
+		ngfAPI.LoadBalancingTypeLeastConnection:           {},
+		ngfAPI.LoadBalancingTypeLeastTimeHeader:           {},
+		ngfAPI.LoadBalancingTypeLeastTimeLastByte:         {},
+		ngfAPI.LoadBalancingTypeLeastTimeHeaderInflight:   {},
+		ngfAPI.LoadBalancingTypeLeastTimeLastByteInflight: {},
+	}
+)
+
 // Validator validates an UpstreamSettingsPolicy.
 // Implements policies.Validator interface.
 type Validator struct {
@@ -92,10 +123,48 @@ func conflicts(a, b ngfAPI.UpstreamSettingsPolicySpec) bool {
 		}
 	}
 
+	if a.PassiveHealthCheck != nil && b.PassiveHealthCheck != nil {
+		if a.PassiveHealthCheck.MaxFails != nil && b.PassiveHealthCheck.MaxFails != nil {
+			return true
+		}
+
+		if a.PassiveHealthCheck.FailTimeout != nil && b.PassiveHealthCheck.FailTimeout != nil {
+			return true
+		}
+	}
+
 	if resolveConflictsInUpstreamSettings(a, b) {
 		return true
 	}
 
+	if a.Stream != nil && b.Stream != nil {
+		return streamConflicts(*a.Stream, *b.Stream)
+	}
+
+	return false
+}
+
+func streamConflicts(a, b ngfAPI.UpstreamStreamSettings) bool {
+	if a.ProxyProtocol != nil && b.ProxyProtocol != nil {
+		return true
+	}
+
+	if a.ConnectTimeout != nil && b.ConnectTimeout != nil {
+		return true
+	}
+
+	if a.Timeout != nil && b.Timeout != nil {
+		return true
+	}
+
+	if a.Responses != nil && b.Responses != nil {
+		return true
+	}
+
+	if a.Requests != nil && b.Requests != nil {
+		return true
+	}
+
 	return false
 }
 
@@ -112,6 +181,14 @@ func resolveConflictsInUpstreamSettings(a, b ngfAPI.UpstreamSettingsPolicySpec)
 		return true
 	}
 
+	if a.HealthCheck != nil && b.HealthCheck != nil {
+		return true
+	}
+
+	if a.SlowStart != nil && b.SlowStart != nil {
+		return true
+	}
+
 	return false
 }
 
@@ -134,9 +211,185 @@ func (v Validator) validateSettings(spec ngfAPI.UpstreamSettingsPolicySpec) erro
 
 	allErrs = append(allErrs, v.validateLoadBalancingMethod(spec)...)
 
+	if spec.HealthCheck != nil {
+		allErrs = append(allErrs, v.validateHealthCheck(*spec.HealthCheck, fieldPath.Child("healthCheck"))...)
+	}
+
+	if spec.PassiveHealthCheck != nil && spec.PassiveHealthCheck.FailTimeout != nil {
+		failTimeout := *spec.PassiveHealthCheck.FailTimeout
+		if err := v.genericValidator.ValidateNginxDuration(string(failTimeout)); err != nil {
+			path := fieldPath.Child("passiveHealthCheck").Child("failTimeout")
+
+			allErrs = append(allErrs, field.Invalid(path, failTimeout, err.Error()))
+		}
+	}
+
+	if spec.SlowStart != nil {
+		allErrs = append(allErrs, v.validateSlowStart(spec, fieldPath.Child("slowStart"))...)
+	}
+
+	if spec.Stream != nil {
+		allErrs = append(allErrs, v.validateStream(*spec.Stream, fieldPath.Child("stream"))...)
+	}
+
 	return allErrs.ToAggregate()
 }
 
+func (v Validator) validateStream(stream ngfAPI.UpstreamStreamSettings, fieldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+
+	if stream.ConnectTimeout != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*stream.ConnectTimeout)); err != nil {
+			path := fieldPath.Child("connectTimeout")
+
+			allErrs = append(allErrs, field.Invalid(path, *stream.ConnectTimeout, err.Error()))
+		}
+	}
+
+	if stream.Timeout != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*stream.Timeout)); err != nil {
+			path := fieldPath.Child("timeout")
+
+			allErrs = append(allErrs, field.Invalid(path, *stream.Timeout, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateSlowStart validates the slow start setting. NGINX does not allow slow_start to be used along with
+// the hash, ip_hash and random load balancing methods, which includes the default method used by
+// NGINX Gateway Fabric, so a compatible load balancing method must be set in the same policy.
+func (v Validator) validateSlowStart(spec ngfAPI.UpstreamSettingsPolicySpec, fieldPath *field.Path) field.ErrorList {
+	if !v.plusEnabled {
+		return field.ErrorList{field.Forbidden(fieldPath, "slow start is only supported with NGINX Plus")}
+	}
+
+	var allErrs field.ErrorList
+
+	if err := v.genericValidator.ValidateNginxDuration(string(*spec.SlowStart)); err != nil {
+		allErrs = append(allErrs, field.Invalid(fieldPath, *spec.SlowStart, err.Error()))
+	}
+
+	if spec.LoadBalancingMethod == nil {
+		allErrs = append(allErrs, field.Required(
+			field.NewPath("spec").Child("loadBalancingMethod"),
+			"must be set to a method compatible with slow start",
+		))
+	} else if _, ok := slowStartAllowedLBMethods[*spec.LoadBalancingMethod]; !ok {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec").Child("loadBalancingMethod"),
+			*spec.LoadBalancingMethod,
+			fmt.Sprintf(
+				"slow start can only be used with the following load balancing methods: %s",
+				getLoadBalancingMethodList(slowStartAllowedLBMethods),
+			),
+		))
+	}
+
+	return allErrs
+}
+
+func (v Validator) validateHealthCheck(
+	healthCheck ngfAPI.UpstreamHealthCheck,
+	fieldPath *field.Path,
+) field.ErrorList {
+	if !v.plusEnabled {
+		return field.ErrorList{field.Forbidden(fieldPath, "active health checks are only supported with NGINX Plus")}
+	}
+
+	var allErrs field.ErrorList
+
+	if healthCheck.Interval != nil {
+		if err := v.genericValidator.ValidateNginxDuration(string(*healthCheck.Interval)); err != nil {
+			path := fieldPath.Child("interval")
+
+			allErrs = append(allErrs, field.Invalid(path, *healthCheck.Interval, err.Error()))
+		}
+	}
+
+	if healthCheck.URI != nil {
+		if err := validateHealthCheckURI(*healthCheck.URI); err != nil {
+			path := fieldPath.Child("uri")
+
+			allErrs = append(allErrs, field.Invalid(path, *healthCheck.URI, err.Error()))
+		}
+	}
+
+	if healthCheck.Match != nil {
+		matchPath := fieldPath.Child("match")
+
+		for i, status := range healthCheck.Match.Status {
+			if err := validateHealthCheckStatus(status); err != nil {
+				path := matchPath.Child("status").Index(i)
+
+				allErrs = append(allErrs, field.Invalid(path, status, err.Error()))
+			}
+		}
+
+		if healthCheck.Match.Body != nil {
+			if err := v.genericValidator.ValidateEscapedStringNoVarExpansion(*healthCheck.Match.Body); err != nil {
+				path := matchPath.Child("body")
+
+				allErrs = append(allErrs, field.Invalid(path, *healthCheck.Match.Body, err.Error()))
+			}
+		}
+	}
+
+	if healthCheck.GRPC != nil && healthCheck.GRPC.Service != nil {
+		if err := validateGRPCServiceName(*healthCheck.GRPC.Service); err != nil {
+			path := fieldPath.Child("grpc").Child("service")
+
+			allErrs = append(allErrs, field.Invalid(path, *healthCheck.GRPC.Service, err.Error()))
+		}
+	}
+
+	return allErrs
+}
+
+// validateHealthCheckURI validates a health check URI that nginx can understand.
+func validateHealthCheckURI(uri string) error {
+	if !healthCheckURIRegexp.MatchString(uri) {
+		examples := []string{
+			"/",
+			"/healthz",
+			"/status?full=true",
+		}
+
+		return errors.New(k8svalidation.RegexError(healthCheckURIErrMsg, healthCheckURIFmt, examples...))
+	}
+
+	return nil
+}
+
+// validateHealthCheckStatus validates an expected status code or a range of status codes.
+func validateHealthCheckStatus(status string) error {
+	if !healthCheckStatusRegexp.MatchString(status) {
+		examples := []string{
+			"200",
+			"200-399",
+		}
+
+		return errors.New(k8svalidation.RegexError(healthCheckStatusErrMsg, healthCheckStatusFmt, examples...))
+	}
+
+	return nil
+}
+
+// validateGRPCServiceName validates the name of a gRPC service to health check.
+func validateGRPCServiceName(name string) error {
+	if !grpcServiceNameRegexp.MatchString(name) {
+		examples := []string{
+			"grpc.health.v1.Health",
+			"helloworld.Greeter",
+		}
+
+		return errors.New(k8svalidation.RegexError(grpcServiceNameErrMsg, grpcServiceNameFmt, examples...))
+	}
+
+	return nil
+}
+
 func (v Validator) validateUpstreamKeepAlive(
 	keepAlive ngfAPI.UpstreamKeepAlive,
 	fieldPath *field.Path,
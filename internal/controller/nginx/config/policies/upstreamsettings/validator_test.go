@@ -300,6 +300,130 @@ func TestValidator_Conflicts(t *testing.T) {
 			},
 			conflicts: true,
 		},
+		{
+			name: "health check conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{
+						URI: helpers.GetPointer("/healthz"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "no conflict when passive health check sets different fields",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						MaxFails: helpers.GetPointer[int32](3),
+					},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						FailTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+			conflicts: false,
+		},
+		{
+			name: "passive health check max fails conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						MaxFails: helpers.GetPointer[int32](3),
+					},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						MaxFails: helpers.GetPointer[int32](5),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "passive health check fail timeout conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						FailTimeout: helpers.GetPointer[ngfAPI.Duration]("10s"),
+					},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					PassiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+						FailTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "slow start conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					SlowStart: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					SlowStart: helpers.GetPointer[ngfAPI.Duration]("30s"),
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "stream conflicts",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Stream: &ngfAPI.UpstreamStreamSettings{
+						ProxyProtocol: helpers.GetPointer(true),
+						Timeout:       helpers.GetPointer[ngfAPI.Duration]("1m"),
+					},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Stream: &ngfAPI.UpstreamStreamSettings{
+						Timeout: helpers.GetPointer[ngfAPI.Duration]("10m"),
+					},
+				},
+			},
+			conflicts: true,
+		},
+		{
+			name: "no conflict when stream settings are different",
+			polA: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Stream: &ngfAPI.UpstreamStreamSettings{
+						ProxyProtocol:  helpers.GetPointer(true),
+						ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+					},
+				},
+			},
+			polB: &ngfAPI.UpstreamSettingsPolicy{
+				Spec: ngfAPI.UpstreamSettingsPolicySpec{
+					Stream: &ngfAPI.UpstreamStreamSettings{
+						Timeout:   helpers.GetPointer[ngfAPI.Duration]("10m"),
+						Responses: helpers.GetPointer[int32](1),
+						Requests:  helpers.GetPointer[int32](1),
+					},
+				},
+			},
+			conflicts: false,
+		},
 		{
 			name: "no conflict when only one policy sets useClusterIP",
 			polA: &ngfAPI.UpstreamSettingsPolicy{
@@ -432,3 +556,248 @@ func TestValidate_ValidateLoadBalancingMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestValidator_ValidateHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		healthCheck *ngfAPI.UpstreamHealthCheck
+		name        string
+		expErrs     []string
+		plusEnabled bool
+	}{
+		{
+			name: "valid http health check",
+			healthCheck: &ngfAPI.UpstreamHealthCheck{
+				Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				Fails:    helpers.GetPointer[int32](3),
+				Passes:   helpers.GetPointer[int32](2),
+				Port:     helpers.GetPointer[int32](8081),
+				URI:      helpers.GetPointer("/healthz?full=true"),
+				Match: &ngfAPI.UpstreamHealthCheckMatch{
+					Status: []string{"200", "300-399"},
+					Body:   helpers.GetPointer("ok"),
+				},
+			},
+			plusEnabled: true,
+		},
+		{
+			name: "valid grpc health check",
+			healthCheck: &ngfAPI.UpstreamHealthCheck{
+				GRPC: &ngfAPI.UpstreamGRPCHealthCheck{
+					Service: helpers.GetPointer("grpc.health.v1.Health"),
+					Status:  helpers.GetPointer[int32](12),
+				},
+			},
+			plusEnabled: true,
+		},
+		{
+			name:        "health check not supported with NGINX OSS",
+			healthCheck: &ngfAPI.UpstreamHealthCheck{},
+			plusEnabled: false,
+			expErrs: []string{
+				"spec.healthCheck: Forbidden: active health checks are only supported with NGINX Plus",
+			},
+		},
+		{
+			name: "invalid fields",
+			healthCheck: &ngfAPI.UpstreamHealthCheck{
+				Interval: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+				URI:      helpers.GetPointer("/health; return 200"),
+				Match: &ngfAPI.UpstreamHealthCheckMatch{
+					Status: []string{"2000"},
+					Body:   helpers.GetPointer(`"ok`),
+				},
+				GRPC: &ngfAPI.UpstreamGRPCHealthCheck{
+					Service: helpers.GetPointer("svc;"),
+				},
+			},
+			plusEnabled: true,
+			expErrs: []string{
+				"spec.healthCheck.interval",
+				"spec.healthCheck.uri",
+				"spec.healthCheck.match.status[0]",
+				"spec.healthCheck.match.body",
+				"spec.healthCheck.grpc.service",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.LoadBalancingMethod = nil
+				p.Spec.HealthCheck = test.healthCheck
+				return p
+			})
+
+			v := upstreamsettings.NewValidator(validation.GenericValidator{}, test.plusEnabled)
+			conds := v.Validate(policy)
+
+			if test.expErrs == nil {
+				g.Expect(conds).To(BeEmpty())
+				return
+			}
+
+			g.Expect(conds).To(HaveLen(1))
+			for _, expErr := range test.expErrs {
+				g.Expect(conds[0].Message).To(ContainSubstring(expErr))
+			}
+		})
+	}
+}
+
+func TestValidator_ValidatePassiveHealthCheckAndSlowStart(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		passiveHealthCheck *ngfAPI.UpstreamPassiveHealthCheck
+		slowStart          *ngfAPI.Duration
+		lbMethod           *ngfAPI.LoadBalancingType
+		name               string
+		expErrs            []string
+		plusEnabled        bool
+	}{
+		{
+			name: "valid passive health check with NGINX OSS",
+			passiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+				MaxFails:    helpers.GetPointer[int32](3),
+				FailTimeout: helpers.GetPointer[ngfAPI.Duration]("30s"),
+			},
+			plusEnabled: false,
+		},
+		{
+			name: "invalid fail timeout",
+			passiveHealthCheck: &ngfAPI.UpstreamPassiveHealthCheck{
+				FailTimeout: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			},
+			plusEnabled: false,
+			expErrs:     []string{"spec.passiveHealthCheck.failTimeout"},
+		},
+		{
+			name:        "valid slow start",
+			slowStart:   helpers.GetPointer[ngfAPI.Duration]("1m"),
+			lbMethod:    helpers.GetPointer(ngfAPI.LoadBalancingTypeLeastConnection),
+			plusEnabled: true,
+		},
+		{
+			name:        "slow start not supported with NGINX OSS",
+			slowStart:   helpers.GetPointer[ngfAPI.Duration]("1m"),
+			lbMethod:    helpers.GetPointer(ngfAPI.LoadBalancingTypeRoundRobin),
+			plusEnabled: false,
+			expErrs: []string{
+				"spec.slowStart: Forbidden: slow start is only supported with NGINX Plus",
+			},
+		},
+		{
+			name:        "invalid slow start",
+			slowStart:   helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			lbMethod:    helpers.GetPointer(ngfAPI.LoadBalancingTypeRoundRobin),
+			plusEnabled: true,
+			expErrs:     []string{"spec.slowStart"},
+		},
+		{
+			name:        "slow start without load balancing method",
+			slowStart:   helpers.GetPointer[ngfAPI.Duration]("1m"),
+			plusEnabled: true,
+			expErrs: []string{
+				"spec.loadBalancingMethod: Required value: must be set to a method compatible with slow start",
+			},
+		},
+		{
+			name:        "slow start with incompatible load balancing method",
+			slowStart:   helpers.GetPointer[ngfAPI.Duration]("1m"),
+			lbMethod:    helpers.GetPointer(ngfAPI.LoadBalancingTypeRandomTwoLeastConnection),
+			plusEnabled: true,
+			expErrs: []string{
+				"slow start can only be used with the following load balancing methods: least_conn, " +
+					"least_time header, least_time header inflight, least_time last_byte, " +
+					"least_time last_byte inflight, round_robin",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.LoadBalancingMethod = test.lbMethod
+				p.Spec.PassiveHealthCheck = test.passiveHealthCheck
+				p.Spec.SlowStart = test.slowStart
+				return p
+			})
+
+			v := upstreamsettings.NewValidator(validation.GenericValidator{}, test.plusEnabled)
+			conds := v.Validate(policy)
+
+			if test.expErrs == nil {
+				g.Expect(conds).To(BeEmpty())
+				return
+			}
+
+			g.Expect(conds).To(HaveLen(1))
+			for _, expErr := range test.expErrs {
+				g.Expect(conds[0].Message).To(ContainSubstring(expErr))
+			}
+		})
+	}
+}
+
+func TestValidator_ValidateStream(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		stream  *ngfAPI.UpstreamStreamSettings
+		name    string
+		expErrs []string
+	}{
+		{
+			name: "valid stream settings",
+			stream: &ngfAPI.UpstreamStreamSettings{
+				ProxyProtocol:  helpers.GetPointer(true),
+				ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("5s"),
+				Timeout:        helpers.GetPointer[ngfAPI.Duration]("10m"),
+				Responses:      helpers.GetPointer[int32](1),
+				Requests:       helpers.GetPointer[int32](0),
+			},
+		},
+		{
+			name: "invalid timeouts",
+			stream: &ngfAPI.UpstreamStreamSettings{
+				ConnectTimeout: helpers.GetPointer[ngfAPI.Duration]("invalid"),
+				Timeout:        helpers.GetPointer[ngfAPI.Duration]("invalid"),
+			},
+			expErrs: []string{"spec.stream.connectTimeout", "spec.stream.timeout"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			policy := createModifiedPolicy(func(p *ngfAPI.UpstreamSettingsPolicy) *ngfAPI.UpstreamSettingsPolicy {
+				p.Spec.Stream = test.stream
+				return p
+			})
+
+			v := upstreamsettings.NewValidator(validation.GenericValidator{}, plusDisabled)
+			conds := v.Validate(policy)
+
+			if test.expErrs == nil {
+				g.Expect(conds).To(BeEmpty())
+				return
+			}
+
+			g.Expect(conds).To(HaveLen(1))
+			for _, expErr := range test.expErrs {
+				g.Expect(conds[0].Message).To(ContainSubstring(expErr))
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	gotemplate "text/template"
 
 	"github.com/dlclark/regexp2/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
@@ -20,6 +21,8 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
 )
 
 var serversTemplate = gotemplate.Must(
@@ -561,6 +564,9 @@ func createLocations(
 			extLocations[i].Includes = createIncludesFromPolicyGenerateResult(
 				generator.GenerateForLocation(rule.Policies, extLocations[i]),
 			)
+			for _, r := range rule.MatchRules {
+				extLocations[i] = updateLocationRoutes(extLocations[i], r.Source, rule.GRPC)
+			}
 		}
 
 		switch {
@@ -747,6 +753,8 @@ func createInternalLocationsForRule(
 					Match:      r.Match,
 					Filters:    r.Filters,
 					Guardrails: r.Guardrails,
+					Timeouts:   r.Timeouts,
+					Retry:      r.Retry,
 					BackendGroup: dataplane.BackendGroup{
 						Source:      r.BackendGroup.Source,
 						RuleIdx:     r.BackendGroup.RuleIdx,
@@ -1215,6 +1223,8 @@ func updateLocation(
 	grpc := pathRule.GRPC
 	inferenceBackend := pathRule.HasInferenceBackends
 
+	location = updateLocationRoutes(location, matchRule.Source, grpc)
+
 	if filters.InvalidFilter != nil {
 		location.Return = &http.Return{Code: http.StatusInternalServerError}
 		return location
@@ -1245,6 +1255,27 @@ func updateLocation(
 	return location
 }
 
+// updateLocationRoutes adds the route of the match rule to the routes of the location. A location can be generated
+// for the rules of several routes with the same path.
+func updateLocationRoutes(location http.Location, source *metav1.ObjectMeta, grpc bool) http.Location {
+	if source == nil {
+		return location
+	}
+
+	kind := kinds.HTTPRoute
+	if grpc {
+		kind = kinds.GRPCRoute
+	}
+
+	route := fmt.Sprintf("%s %s/%s", kind, source.Namespace, source.Name)
+	if !slices.Contains(location.Routes, route) {
+		// the locations are copied for each match rule, so the routes of the copies must not share an array
+		location.Routes = append(slices.Clip(location.Routes), route)
+	}
+
+	return location
+}
+
 func updateLocationAuthenticationFilter(
 	location http.Location,
 	authenticationFilter *dataplane.AuthenticationFilter,
@@ -1398,6 +1429,16 @@ func updateLocationExternalAuthFilter(
 		ForwardBody:            f.ForwardBody,
 		ProxySSLVerify:         createProxySSLVerify(f.VerifyTLS),
 	}
+	if f.GRPC != nil {
+		location.AuthExternalRequest.GRPC = &http.AuthExternalGRPC{
+			Server: fmt.Sprintf("%s:%d", f.GRPC.Host, f.GRPC.Port),
+		}
+		// the system CA certificates of the nginx container aren't available to the ext_authz shim,
+		// so it uses its own
+		if f.VerifyTLS != nil && f.VerifyTLS.CertBundleID != "" {
+			location.AuthExternalRequest.GRPC.TrustedCertificate = generateCertBundleFileName(f.VerifyTLS.CertBundleID)
+		}
+	}
 	if f.MaxBodySize > 0 {
 		location.ClientMaxBodySize = f.MaxBodySize
 	}
@@ -1420,6 +1461,11 @@ func extractExternalAuthInternalLocations(locations []http.Location) []http.Loca
 		seen[path] = struct{}{}
 
 		ar := loc.AuthExternalRequest
+		if ar.GRPC != nil {
+			result = append(result, buildGRPCExternalAuthInternalLocation(ar))
+			continue
+		}
+
 		proxyPass := generateProtocolString(ar.ProxySSLVerify, false) + "://" + ar.UpstreamName
 		if ar.PathPrefix != "" {
 			proxyPass += ar.PathPrefix
@@ -1453,6 +1499,56 @@ func extractExternalAuthInternalLocations(locations []http.Location) []http.Loca
 	return result
 }
 
+// buildGRPCExternalAuthInternalLocation builds the internal auth_request location for a gRPC auth server.
+// NGINX can't send the auth subrequest as an ext_authz gRPC call, so the location proxies the subrequest to
+// the ext_authz shim in the nginx Pod, which calls the auth server and translates its response.
+// If no request headers are allowed explicitly, all headers of the client request are forwarded. The headers
+// of the shim are always set, so that a client can't set them. Headers with empty values aren't sent.
+func buildGRPCExternalAuthInternalLocation(ar *http.AuthExternalRequest) http.Location {
+	var tls, tlsServerName, tlsTrustedCA string
+	if ar.ProxySSLVerify != nil {
+		tls = "on"
+		tlsServerName = ar.ProxySSLVerify.Name
+		tlsTrustedCA = ar.GRPC.TrustedCertificate
+	}
+
+	headers := []http.Header{
+		{Name: "Host", Value: "$gw_api_compliant_host"},
+		{Name: ngftypes.ExtAuthzServerHeader, Value: ar.GRPC.Server},
+		{Name: ngftypes.ExtAuthzTLSHeader, Value: tls},
+		{Name: ngftypes.ExtAuthzTLSServerNameHeader, Value: tlsServerName},
+		{Name: ngftypes.ExtAuthzTLSTrustedCAHeader, Value: tlsTrustedCA},
+		{Name: ngftypes.ExtAuthzMethodHeader, Value: extAuthMethodValue},
+		{Name: ngftypes.ExtAuthzPathHeader, Value: extAuthPathValue},
+		{Name: ngftypes.ExtAuthzSchemeHeader, Value: "$scheme"},
+		{Name: ngftypes.ExtAuthzProtocolHeader, Value: "$server_protocol"},
+		{Name: ngftypes.ExtAuthzClientAddressHeader, Value: "$remote_addr"},
+	}
+
+	var proxyPassHeaders string
+	if len(ar.AllowedRequestHeaders) > 0 {
+		proxyPassHeaders = proxyPassRequestHeadersOff
+		headers = append(headers, http.Header{Name: extAuthAuthorizationHeader, Value: extAuthAuthorizationValue})
+		for _, h := range ar.AllowedRequestHeaders {
+			headers = append(headers, http.Header{Name: h, Value: httpHeaderVarPrefix + headerToNginxVar(h)})
+		}
+	}
+
+	var proxyPassBody string
+	if !ar.ForwardBody {
+		proxyPassBody = proxyPassRequestBodyOff
+	}
+
+	return http.Location{
+		Path:                    ar.InternalPath,
+		Type:                    http.InternalLocationType,
+		ProxyPass:               fmt.Sprintf("http://127.0.0.1:%d", ngftypes.ExtAuthzShimPort),
+		ProxySetHeaders:         headers,
+		ProxyPassRequestBody:    proxyPassBody,
+		ProxyPassRequestHeaders: proxyPassHeaders,
+	}
+}
+
 // guardrailsScansPath is the guardrails backend endpoint that inspection requests are POSTed to.
 // It is appended to the configured guardrails APIURL to form the internal location's proxy_pass
 // target.
@@ -1765,10 +1861,69 @@ func updateLocationProxySettings(
 	location.ResponseHeaders = responseHeaders
 	location.ProxyPass = proxyPass
 	location.GRPC = grpc
+	location.Timeouts = createLocationTimeouts(matchRule.Timeouts)
+	location.Retry = createLocationRetry(matchRule.Retry)
 
 	return location
 }
 
+// createLocationTimeouts converts the timeouts of a routing rule into proxy timeouts for a location.
+// The backend request timeout bounds every attempt to reach a backend, so it is used for the connect,
+// read, and send timeouts. If it is not set, the request timeout is used instead, since a single attempt
+// cannot outlive the whole request. The request timeout also bounds the overall time spent passing
+// the request to backends.
+// NGINX has no deadline for a whole request: proxy_next_upstream_timeout only stops further retries and
+// proxy_read_timeout only bounds the time between two successive reads. So a single backend that keeps
+// sending data slowly can make a request last longer than the request timeout.
+func createLocationTimeouts(timeouts *dataplane.Timeouts) *http.LocationTimeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	backendTimeout := timeouts.BackendRequest
+	if backendTimeout == "" {
+		backendTimeout = timeouts.Request
+	}
+
+	return &http.LocationTimeouts{
+		Connect:      backendTimeout,
+		Read:         backendTimeout,
+		Send:         backendTimeout,
+		NextUpstream: timeouts.Request,
+	}
+}
+
+// createLocationRetry converts the retry configuration of a routing rule into the conditions and
+// number of tries for passing a request to the next backend. Requests are always retried on connection
+// errors and timeouts, for which NGINX responds with 502 and 504. A 502 is also what NGINX responds with
+// when a backend sends an invalid response header, so that case is retried too when 502 is in the codes.
+// Attempts counts retries, while NGINX counts tries, which include the first one.
+func createLocationRetry(retry *dataplane.Retry) *http.LocationRetry {
+	if retry == nil {
+		return nil
+	}
+
+	conditions := make([]string, 0, len(retry.Codes)+3)
+	conditions = append(conditions, "error", "timeout")
+
+	for _, code := range retry.Codes {
+		if code == 502 {
+			conditions = append(conditions, "invalid_header")
+		}
+		conditions = append(conditions, fmt.Sprintf("http_%d", code))
+	}
+
+	var tries int
+	if retry.Attempts != nil {
+		tries = *retry.Attempts + 1
+	}
+
+	return &http.LocationRetry{
+		Conditions: conditions,
+		Tries:      tries,
+	}
+}
+
 // resolveProxyHTTPVersion decides whether to emit a proxy_http_version directive for a location.
 // The directive is only written when the value differs from NGINX's default (1.1).
 //
@@ -2128,6 +2283,11 @@ func createProxyPass(
 		return protocol + "://$" + convertStringToSafeVariableName(backendName) + requestURI
 	}
 
+	// the upstream is selected by the zone of the node of the nginx replica
+	if backendName != invalidBackendRef && backendGroup.Backends[0].TopologyAware {
+		return protocol + "://" + generateTopologyUpstreamVariableName(backendName) + requestURI
+	}
+
 	return protocol + "://" + backendName + requestURI
 }
 
@@ -172,6 +172,9 @@ server {
 
         {{ range $l := $s.Locations }}
     location {{ $l.Path }} {
+        {{- range $r := $l.Routes }}
+        # {{ $r }}
+        {{- end }}
         {{ if contains $l.Type "internal" -}}
         internal;
         {{ end }}
@@ -319,6 +322,26 @@ server {
         {{ $proxyOrGRPC }}_set_header {{ $h.Name }} "{{ $h.Value }}";
             {{- end }}
         {{ $proxyOrGRPC }}_pass {{ $l.ProxyPass }};
+            {{- if $l.Timeouts }}
+                {{- if $l.Timeouts.Connect }}
+        {{ $proxyOrGRPC }}_connect_timeout {{ $l.Timeouts.Connect }};
+                {{- end }}
+                {{- if $l.Timeouts.Read }}
+        {{ $proxyOrGRPC }}_read_timeout {{ $l.Timeouts.Read }};
+                {{- end }}
+                {{- if $l.Timeouts.Send }}
+        {{ $proxyOrGRPC }}_send_timeout {{ $l.Timeouts.Send }};
+                {{- end }}
+                {{- if $l.Timeouts.NextUpstream }}
+        {{ $proxyOrGRPC }}_next_upstream_timeout {{ $l.Timeouts.NextUpstream }};
+                {{- end }}
+            {{- end }}
+            {{- if $l.Retry }}
+        {{ $proxyOrGRPC }}_next_upstream{{ range $c := $l.Retry.Conditions }} {{ $c }}{{ end }};
+                {{- if $l.Retry.Tries }}
+        {{ $proxyOrGRPC }}_next_upstream_tries {{ $l.Retry.Tries }};
+                {{- end }}
+            {{- end }}
             {{- if $l.ProxyPassRequestBody }}
         proxy_pass_request_body {{ $l.ProxyPassRequestBody }};
                 {{- if eq $l.ProxyPassRequestBody "off" }}
@@ -8,6 +8,7 @@ import (
 
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/format"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
 
@@ -4427,6 +4428,35 @@ func TestCreateProxyPass(t *testing.T) {
 			},
 			locationType: http.InternalLocationType,
 		},
+		{
+			expected: "http://$ngf_topology_test_svc_80$request_uri",
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName:  "test_svc_80",
+						Valid:         true,
+						Weight:        1,
+						TopologyAware: true,
+					},
+				},
+			},
+			locationType: http.InternalLocationType,
+		},
+		{
+			expected: "grpc://$ngf_topology_test_svc_80",
+			grp: dataplane.BackendGroup{
+				Backends: []dataplane.Backend{
+					{
+						UpstreamName:  "test_svc_80",
+						Valid:         true,
+						Weight:        1,
+						TopologyAware: true,
+					},
+				},
+			},
+			GRPC:         true,
+			locationType: http.ExternalLocationType,
+		},
 		// Inference case
 		{
 			expected: "http://$inference_backend_upstream_inference$request_uri",
@@ -8738,6 +8768,51 @@ func TestUpdateLocationExternalAuthFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GRPC external auth filter",
+			filter: &dataplane.HTTPExternalAuthFilter{
+				UpstreamName: "default_ext-auth_9001",
+				InternalPath: "/_ngf-internal-ext-auth-default_route_rule0",
+				GRPC:         &dataplane.ExternalAuthGRPC{Host: "ext-auth.default.svc", Port: 9001},
+			},
+			expected: http.Location{
+				Path: "/coffee",
+				Type: http.ExternalLocationType,
+				AuthExternalRequest: &http.AuthExternalRequest{
+					InternalPath: "/_ngf-internal-ext-auth-default_route_rule0",
+					UpstreamName: "default_ext-auth_9001",
+					GRPC:         &http.AuthExternalGRPC{Server: "ext-auth.default.svc:9001"},
+				},
+			},
+		},
+		{
+			name: "GRPC external auth filter with BackendTLSPolicy CA certificate",
+			filter: &dataplane.HTTPExternalAuthFilter{
+				UpstreamName: "default_ext-auth_9001",
+				InternalPath: "/_ngf-internal-ext-auth-default_route_rule0",
+				GRPC:         &dataplane.ExternalAuthGRPC{Host: "ext-auth.default.svc", Port: 9001},
+				VerifyTLS: &dataplane.VerifyTLS{
+					Hostname:     "auth.example.com",
+					CertBundleID: "cert_bundle_default_ca",
+				},
+			},
+			expected: http.Location{
+				Path: "/coffee",
+				Type: http.ExternalLocationType,
+				AuthExternalRequest: &http.AuthExternalRequest{
+					InternalPath: "/_ngf-internal-ext-auth-default_route_rule0",
+					UpstreamName: "default_ext-auth_9001",
+					GRPC: &http.AuthExternalGRPC{
+						Server:             "ext-auth.default.svc:9001",
+						TrustedCertificate: "/etc/nginx/secrets/cert_bundle_default_ca.crt",
+					},
+					ProxySSLVerify: &http.ProxySSLVerify{
+						Name:               "auth.example.com",
+						TrustedCertificate: "/etc/nginx/secrets/cert_bundle_default_ca.crt",
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -8862,6 +8937,85 @@ func TestExtractExternalAuthInternalLocations(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "generates GRPC internal location that forwards all request headers",
+			locations: []http.Location{
+				{
+					Path: "/coffee",
+					Type: http.ExternalLocationType,
+					AuthExternalRequest: &http.AuthExternalRequest{
+						InternalPath: "/_ngf-internal-ext-auth-default_route_rule0",
+						UpstreamName: "default_ext-auth_9001",
+						GRPC:         &http.AuthExternalGRPC{Server: "ext-auth.default.svc:9001"},
+						ForwardBody:  true,
+					},
+				},
+			},
+			expected: []http.Location{
+				{
+					Path:      "/_ngf-internal-ext-auth-default_route_rule0",
+					Type:      http.InternalLocationType,
+					ProxyPass: "http://127.0.0.1:54801",
+					ProxySetHeaders: []http.Header{
+						{Name: "Host", Value: "$gw_api_compliant_host"},
+						{Name: "X-Ext-Authz-Server", Value: "ext-auth.default.svc:9001"},
+						{Name: "X-Ext-Authz-Tls", Value: ""},
+						{Name: "X-Ext-Authz-Tls-Server-Name", Value: ""},
+						{Name: "X-Ext-Authz-Tls-Trusted-Ca", Value: ""},
+						{Name: "X-Ext-Authz-Method", Value: "$request_method"},
+						{Name: "X-Ext-Authz-Path", Value: "$request_uri"},
+						{Name: "X-Ext-Authz-Scheme", Value: "$scheme"},
+						{Name: "X-Ext-Authz-Protocol", Value: "$server_protocol"},
+						{Name: "X-Ext-Authz-Client-Address", Value: "$remote_addr"},
+					},
+				},
+			},
+		},
+		{
+			name: "generates GRPC internal location with allowed request headers and TLS",
+			locations: []http.Location{
+				{
+					Path: "/coffee",
+					Type: http.ExternalLocationType,
+					AuthExternalRequest: &http.AuthExternalRequest{
+						InternalPath:          "/_ngf-internal-ext-auth-default_route_rule0",
+						UpstreamName:          "default_ext-auth_9001",
+						AllowedRequestHeaders: []string{"X-Custom-Token"},
+						GRPC: &http.AuthExternalGRPC{
+							Server:             "ext-auth.default.svc:9001",
+							TrustedCertificate: "/etc/nginx/secrets/ca.crt",
+						},
+						ProxySSLVerify: &http.ProxySSLVerify{
+							Name:               "auth.example.com",
+							TrustedCertificate: "/etc/nginx/secrets/ca.crt",
+						},
+					},
+				},
+			},
+			expected: []http.Location{
+				{
+					Path:      "/_ngf-internal-ext-auth-default_route_rule0",
+					Type:      http.InternalLocationType,
+					ProxyPass: "http://127.0.0.1:54801",
+					ProxySetHeaders: []http.Header{
+						{Name: "Host", Value: "$gw_api_compliant_host"},
+						{Name: "X-Ext-Authz-Server", Value: "ext-auth.default.svc:9001"},
+						{Name: "X-Ext-Authz-Tls", Value: "on"},
+						{Name: "X-Ext-Authz-Tls-Server-Name", Value: "auth.example.com"},
+						{Name: "X-Ext-Authz-Tls-Trusted-Ca", Value: "/etc/nginx/secrets/ca.crt"},
+						{Name: "X-Ext-Authz-Method", Value: "$request_method"},
+						{Name: "X-Ext-Authz-Path", Value: "$request_uri"},
+						{Name: "X-Ext-Authz-Scheme", Value: "$scheme"},
+						{Name: "X-Ext-Authz-Protocol", Value: "$server_protocol"},
+						{Name: "X-Ext-Authz-Client-Address", Value: "$remote_addr"},
+						{Name: "Authorization", Value: "$http_authorization"},
+						{Name: "X-Custom-Token", Value: "$http_x_custom_token"},
+					},
+					ProxyPassRequestBody:    "off",
+					ProxyPassRequestHeaders: "off",
+				},
+			},
+		},
 		{
 			name: "deduplicates internal locations with same path",
 			locations: []http.Location{
@@ -9555,3 +9709,362 @@ func TestExtractGuardrailsInternalLocations(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateLocationTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *dataplane.Timeouts
+		expected *http.LocationTimeouts
+		name     string
+	}{
+		{
+			name:     "nil timeouts",
+			timeouts: nil,
+			expected: nil,
+		},
+		{
+			name: "request and backendRequest",
+			timeouts: &dataplane.Timeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+			expected: &http.LocationTimeouts{
+				Connect:      "2s",
+				Read:         "2s",
+				Send:         "2s",
+				NextUpstream: "10s",
+			},
+		},
+		{
+			name: "request only",
+			timeouts: &dataplane.Timeouts{
+				Request: "10s",
+			},
+			expected: &http.LocationTimeouts{
+				Connect:      "10s",
+				Read:         "10s",
+				Send:         "10s",
+				NextUpstream: "10s",
+			},
+		},
+		{
+			name: "backendRequest only",
+			timeouts: &dataplane.Timeouts{
+				BackendRequest: "2s",
+			},
+			expected: &http.LocationTimeouts{
+				Connect: "2s",
+				Read:    "2s",
+				Send:    "2s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createLocationTimeouts(test.timeouts)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteServers_Timeouts(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Timeouts: &dataplane.Timeouts{
+									Request:        "10s",
+									BackendRequest: "2s",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_connect_timeout 2s;":        1,
+		"proxy_read_timeout 2s;":           1,
+		"proxy_send_timeout 2s;":           1,
+		"proxy_next_upstream_timeout 10s;": 1,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+
+	serverConf := string(results[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestCreateLocationRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		retry    *dataplane.Retry
+		expected *http.LocationRetry
+		name     string
+	}{
+		{
+			name:     "nil retry",
+			retry:    nil,
+			expected: nil,
+		},
+		{
+			name:  "empty retry",
+			retry: &dataplane.Retry{},
+			expected: &http.LocationRetry{
+				Conditions: []string{"error", "timeout"},
+			},
+		},
+		{
+			name: "codes and attempts",
+			retry: &dataplane.Retry{
+				Attempts: helpers.GetPointer(2),
+				Codes:    []int{500, 502, 429},
+			},
+			expected: &http.LocationRetry{
+				Conditions: []string{"error", "timeout", "http_500", "invalid_header", "http_502", "http_429"},
+				Tries:      3,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createLocationRetry(test.retry)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteServers_Retry(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/",
+						PathType: dataplane.PathTypePrefix,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+									RuleIdx: 0,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Retry: &dataplane.Retry{
+									Attempts: helpers.GetPointer(2),
+									Codes:    []int{502, 503},
+								},
+							},
+						},
+					},
+					{
+						Path:     "/no-attempts",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Match: dataplane.Match{},
+								BackendGroup: dataplane.BackendGroup{
+									Source:  types.NamespacedName{Namespace: "test", Name: "route1"},
+									RuleIdx: 1,
+									Backends: []dataplane.Backend{
+										{
+											UpstreamName: "test_foo_80",
+											Valid:        true,
+											Weight:       1,
+										},
+									},
+								},
+								Retry: &dataplane.Retry{},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_next_upstream error timeout invalid_header http_502 http_503;": 1,
+		"proxy_next_upstream error timeout;":                                  1,
+		"proxy_next_upstream_tries 3;":                                        1,
+		"proxy_next_upstream_tries":                                           1,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+
+	serverConf := string(results[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
+func TestUpdateLocationRoutes(t *testing.T) {
+	t.Parallel()
+
+	source := &metav1.ObjectMeta{Namespace: "test", Name: "route1"}
+
+	tests := []struct {
+		source    *metav1.ObjectMeta
+		name      string
+		expRoutes []string
+		location  http.Location
+		grpc      bool
+	}{
+		{
+			name:     "no source",
+			location: http.Location{Path: "/"},
+		},
+		{
+			name:      "HTTPRoute",
+			source:    source,
+			location:  http.Location{Path: "/"},
+			expRoutes: []string{"HTTPRoute test/route1"},
+		},
+		{
+			name:      "GRPCRoute",
+			source:    source,
+			grpc:      true,
+			location:  http.Location{Path: "/"},
+			expRoutes: []string{"GRPCRoute test/route1"},
+		},
+		{
+			name:      "route of another rule of the location",
+			source:    source,
+			location:  http.Location{Path: "/", Routes: []string{"HTTPRoute test/route0"}},
+			expRoutes: []string{"HTTPRoute test/route0", "HTTPRoute test/route1"},
+		},
+		{
+			name:      "route already added for another rule",
+			source:    source,
+			location:  http.Location{Path: "/", Routes: []string{"HTTPRoute test/route1"}},
+			expRoutes: []string{"HTTPRoute test/route1"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			location := updateLocationRoutes(test.location, test.source, test.grpc)
+			g.Expect(location.Routes).To(Equal(test.expRoutes))
+		})
+	}
+}
+
+func TestExecuteServers_Routes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	backendGroup := dataplane.BackendGroup{
+		Source: types.NamespacedName{Namespace: "test", Name: "route1"},
+		Backends: []dataplane.Backend{
+			{
+				UpstreamName: "test_foo_80",
+				Valid:        true,
+				Weight:       1,
+			},
+		},
+	}
+
+	conf := dataplane.Configuration{
+		HTTPServers: []dataplane.VirtualServer{
+			{
+				Hostname: "example.com",
+				Port:     8080,
+				PathRules: []dataplane.PathRule{
+					{
+						Path:     "/coffee",
+						PathType: dataplane.PathTypeExact,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source:       &metav1.ObjectMeta{Namespace: "test", Name: "route1"},
+								BackendGroup: backendGroup,
+							},
+							{
+								Source:       &metav1.ObjectMeta{Namespace: "test", Name: "route2"},
+								BackendGroup: backendGroup,
+							},
+						},
+					},
+					{
+						Path:     "/tea",
+						PathType: dataplane.PathTypeExact,
+						GRPC:     true,
+						MatchRules: []dataplane.MatchRule{
+							{
+								Source:       &metav1.ObjectMeta{Namespace: "test", Name: "grpc-route"},
+								BackendGroup: backendGroup,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"location = /coffee {\n        # HTTPRoute test/route1\n        # HTTPRoute test/route2\n": 1,
+		"location = /tea {\n        # GRPCRoute test/grpc-route\n":                                 1,
+		"location /_ngf-internal-rule0-route0 {\n        # HTTPRoute test/route1\n":                1,
+		"location /_ngf-internal-rule0-route1 {\n        # HTTPRoute test/route2\n":                1,
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeServers(conf, &policiesfakes.FakeGenerator{}, alwaysFalseKeepAliveChecker)
+	g.Expect(results).To(HaveLen(2))
+
+	serverConf := string(results[0].data)
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(serverConf, expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
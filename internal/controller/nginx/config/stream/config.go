@@ -13,11 +13,22 @@ type Server struct {
 	StatusZone      string
 	ProxyPass       string
 	Target          string
+	Includes        []shared.Include
+	ProxySettings   ProxySettings
 	RewriteClientIP shared.RewriteClientIPSettings
 	SSLPreread      bool
 	IsSocket        bool
 }
 
+// ProxySettings holds the settings of the connections to the upstream servers of a stream server.
+type ProxySettings struct {
+	Responses      *int32
+	Requests       *int32
+	ConnectTimeout string
+	Timeout        string
+	ProxyProtocol  bool
+}
+
 // ProxySSLVerify holds backend TLS verification settings for stream proxying.
 type ProxySSLVerify struct {
 	TrustedCertificate string
@@ -67,9 +78,11 @@ type SplitClientDistribution struct {
 // ServerConfig holds configuration for a stream server and IP family to be used by NGINX.
 type ServerConfig struct {
 	DNSResolver     *dataplane.DNSResolverConfig
+	ZoneSync        *dataplane.ZoneSyncConfig
 	GatewaySecretID dataplane.SSLKeyPairID
 	Servers         []Server
 	SplitClients    []SplitClient
+	Includes        []shared.Include
 	IPFamily        shared.IPFamily
 	Plus            bool
 }
@@ -2,12 +2,15 @@ package config
 
 import (
 	"fmt"
+	"reflect"
 	"strings"
 	gotemplate "text/template"
 
 	"github.com/go-logr/logr"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
@@ -16,16 +19,29 @@ import (
 
 var streamServersTemplate = gotemplate.Must(gotemplate.New("streamServers").Parse(streamServersTemplateText))
 
-func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []executeResult {
-	streamServers := createStreamServers(g.logger, conf)
+func (g GeneratorImpl) newExecuteStreamServersFunc(generator policies.Generator) executeFunc {
+	return func(conf dataplane.Configuration) []executeResult {
+		return g.executeStreamServers(conf, generator)
+	}
+}
+
+func (g GeneratorImpl) executeStreamServers(
+	conf dataplane.Configuration,
+	generator policies.Generator,
+) []executeResult {
+	streamServers := createStreamServers(g.logger, conf, generator)
 	splitClients := createStreamSplitClients(conf)
 
+	includes := createIncludesFromPolicyGenerateResult(generator.GenerateForStream(conf.BaseStreamConfig.Policies))
+
 	streamServerConfig := stream.ServerConfig{
 		Servers:         streamServers,
 		SplitClients:    splitClients,
+		Includes:        includes,
 		IPFamily:        getIPFamily(conf.BaseHTTPConfig),
 		Plus:            g.plus,
 		DNSResolver:     buildDNSResolver(conf.BaseStreamConfig.DNSResolver),
+		ZoneSync:        conf.BaseStreamConfig.ZoneSync,
 		GatewaySecretID: conf.BaseHTTPConfig.GatewaySecretID,
 	}
 
@@ -34,9 +50,35 @@ func (g GeneratorImpl) executeStreamServers(conf dataplane.Configuration) []exec
 		data: helpers.MustExecuteTemplate(streamServersTemplate, streamServerConfig),
 	}
 
-	return []executeResult{
-		streamServerResult,
+	results := make([]executeResult, 0, len(includes)+1)
+	results = append(results, streamServerResult)
+	results = append(results, createIncludeExecuteResults(includes)...)
+	results = append(results, createIncludeExecuteResultsFromStreamServers(streamServers)...)
+
+	return results
+}
+
+// createIncludeExecuteResultsFromStreamServers creates the include files of the stream servers,
+// deduplicated across servers.
+func createIncludeExecuteResultsFromStreamServers(servers []stream.Server) []executeResult {
+	uniqueIncludes := make(map[string][]byte)
+
+	for _, server := range servers {
+		for _, include := range server.Includes {
+			uniqueIncludes[include.Name] = include.Content
+		}
 	}
+
+	results := make([]executeResult, 0, len(uniqueIncludes))
+
+	for filename, contents := range uniqueIncludes {
+		results = append(results, executeResult{
+			dest: filename,
+			data: contents,
+		})
+	}
+
+	return results
 }
 
 // portProtoKey uniquely identifies a port and protocol combination for deduplication.
@@ -45,7 +87,11 @@ type portProtoKey struct {
 	port     int32
 }
 
-func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []stream.Server {
+func createStreamServers(
+	logger logr.Logger,
+	conf dataplane.Configuration,
+	generator policies.Generator,
+) []stream.Server {
 	totalServers := len(conf.TLSServers) + len(conf.TCPServers) + len(conf.UDPServers)
 	if totalServers == 0 {
 		return nil
@@ -62,7 +108,10 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 	for _, server := range conf.TLSServers {
 		if server.SSL != nil {
 			// TLS Terminate mode: create a socket server with SSL termination
-			streamServers = append(streamServers, createTLSTerminateSocketServer(server, upstreams, conf)...)
+			streamServers = append(
+				streamServers,
+				createTLSTerminateSocketServer(server, upstreams, conf, generator)...,
+			)
 		} else if len(server.Upstreams) > 0 {
 			// TLS Passthrough mode: create a socket server that proxies encrypted traffic
 			upstreamName := server.Upstreams[0].Name
@@ -72,6 +121,10 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 					StatusZone: server.Hostname,
 					ProxyPass:  upstreamName,
 					IsSocket:   true,
+					Includes: createIncludesFromPolicyGenerateResult(
+						generator.GenerateForStreamServer(server.Policies),
+					),
+					ProxySettings: buildStreamProxySettings(u.UpstreamSettings.Stream, string(v1.TCPProtocolType)),
 				}
 				// set rewriteClientIP settings as this is a socket stream server
 				streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
@@ -99,8 +152,24 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 	}
 
 	// Process Layer4 servers (TCP and UDP)
-	processLayer4Servers(logger, conf.TCPServers, upstreams, portSet, &streamServers, string(v1.TCPProtocolType))
-	processLayer4Servers(logger, conf.UDPServers, upstreams, portSet, &streamServers, string(v1.UDPProtocolType))
+	processLayer4Servers(
+		logger,
+		generator,
+		conf.TCPServers,
+		upstreams,
+		portSet,
+		&streamServers,
+		string(v1.TCPProtocolType),
+	)
+	processLayer4Servers(
+		logger,
+		generator,
+		conf.UDPServers,
+		upstreams,
+		portSet,
+		&streamServers,
+		string(v1.UDPProtocolType),
+	)
 
 	return streamServers
 }
@@ -108,6 +177,7 @@ func createStreamServers(logger logr.Logger, conf dataplane.Configuration) []str
 // processLayer4Servers processes TCP and UDP servers to create stream servers.
 func processLayer4Servers(
 	logger logr.Logger,
+	generator policies.Generator,
 	servers []dataplane.Layer4VirtualServer,
 	upstreams map[string]dataplane.Upstream,
 	portSet map[portProtoKey]struct{},
@@ -135,6 +205,7 @@ func processLayer4Servers(
 		}
 
 		var proxyPass string
+		var streamSettings upstreamsettings.StreamSettings
 		if len(server.Upstreams) > 1 {
 			proxyPass = fmt.Sprintf("$backend_%d", server.Port)
 			hasValidUpstreams := false
@@ -152,10 +223,22 @@ func processLayer4Servers(
 				)
 				continue
 			}
+
+			var equal bool
+			streamSettings, equal = getCommonStreamSettings(server.Upstreams, upstreams)
+			// the conflict is reported in the status of the Route when the Graph is built
+			if !equal {
+				logger.V(1).Info(
+					fmt.Sprintf("%s Server stream settings skipped - upstreams have different settings", protocol),
+					"serverIndex", i,
+					"port", server.Port,
+				)
+			}
 		} else {
 			upstreamName := server.Upstreams[0].Name
 			if u, ok := upstreams[upstreamName]; ok && len(u.Endpoints) > 0 {
 				proxyPass = upstreamName
+				streamSettings = u.UpstreamSettings.Stream
 			} else {
 				logger.V(1).Info(
 					fmt.Sprintf("%s Server skipped - upstream not found or no endpoints", protocol),
@@ -168,15 +251,66 @@ func processLayer4Servers(
 		}
 
 		streamServer := stream.Server{
-			Listen:     fmt.Sprintf("%d%s", server.Port, protocolSuffix),
-			StatusZone: fmt.Sprintf("%s_%d", protocol, server.Port),
-			ProxyPass:  proxyPass,
+			Listen:        fmt.Sprintf("%d%s", server.Port, protocolSuffix),
+			StatusZone:    fmt.Sprintf("%s_%d", protocol, server.Port),
+			ProxyPass:     proxyPass,
+			Includes:      createIncludesFromPolicyGenerateResult(generator.GenerateForStreamServer(server.Policies)),
+			ProxySettings: buildStreamProxySettings(streamSettings, protocol),
 		}
 		*streamServers = append(*streamServers, streamServer)
 		portSet[key] = struct{}{}
 	}
 }
 
+// getCommonStreamSettings returns the stream settings shared by all the upstreams of a Layer4 server.
+// Since the settings are applied to the server, they can only be used if all of its upstreams agree on them.
+// If the upstreams have different settings, empty settings and false are returned.
+func getCommonStreamSettings(
+	serverUpstreams []dataplane.Layer4Upstream,
+	upstreams map[string]dataplane.Upstream,
+) (upstreamsettings.StreamSettings, bool) {
+	var settings *upstreamsettings.StreamSettings
+
+	for _, serverUpstream := range serverUpstreams {
+		u, ok := upstreams[serverUpstream.Name]
+		if !ok {
+			continue
+		}
+
+		if settings == nil {
+			settings = &u.UpstreamSettings.Stream
+			continue
+		}
+
+		if !reflect.DeepEqual(*settings, u.UpstreamSettings.Stream) {
+			return upstreamsettings.StreamSettings{}, false
+		}
+	}
+
+	if settings == nil {
+		return upstreamsettings.StreamSettings{}, true
+	}
+
+	return *settings, true
+}
+
+// buildStreamProxySettings builds the proxy settings of a stream server from the stream settings of its upstreams.
+// proxy_responses and proxy_requests only apply to UDP, so they are ignored for other protocols.
+func buildStreamProxySettings(settings upstreamsettings.StreamSettings, protocol string) stream.ProxySettings {
+	proxySettings := stream.ProxySettings{
+		ProxyProtocol:  settings.ProxyProtocol,
+		ConnectTimeout: settings.ConnectTimeout,
+		Timeout:        settings.Timeout,
+	}
+
+	if protocol == string(v1.UDPProtocolType) {
+		proxySettings.Responses = settings.Responses
+		proxySettings.Requests = settings.Requests
+	}
+
+	return proxySettings
+}
+
 func getRewriteClientIPSettingsForStream(
 	rewriteConfig dataplane.RewriteClientIPSettings,
 ) shared.RewriteClientIPSettings {
@@ -280,6 +414,7 @@ func createTLSTerminateSocketServer(
 	server dataplane.Layer4VirtualServer,
 	upstreams map[string]dataplane.Upstream,
 	conf dataplane.Configuration,
+	generator policies.Generator,
 ) []stream.Server {
 	if server.IsDefault {
 		// Default server for TLS Terminate: reject TLS handshake for unmatched traffic.
@@ -317,6 +452,8 @@ func createTLSTerminateSocketServer(
 		IsSocket:       true,
 		SSL:            buildStreamSSL(server.SSL),
 		ProxySSLVerify: buildStreamProxySSLVerify(server.VerifyTLS),
+		Includes:       createIncludesFromPolicyGenerateResult(generator.GenerateForStreamServer(server.Policies)),
+		ProxySettings:  buildStreamProxySettings(u.UpstreamSettings.Stream, string(v1.TCPProtocolType)),
 	}
 	streamServer.RewriteClientIP = getRewriteClientIPSettingsForStream(
 		conf.BaseHTTPConfig.RewriteClientIPSettings,
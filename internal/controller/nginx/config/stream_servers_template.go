@@ -15,6 +15,10 @@ proxy_ssl_certificate /etc/nginx/secrets/{{ .GatewaySecretID }}.pem;
 proxy_ssl_certificate_key /etc/nginx/secrets/{{ .GatewaySecretID }}.pem;
 {{- end }}
 
+{{- range $i := .Includes }}
+include {{ $i.Name }};
+{{- end }}
+
 {{- if .SplitClients }}
 # Split clients configuration for weighted load balancing
 {{- range $sc := .SplitClients }}
@@ -40,6 +44,9 @@ server {
     {{- end}}
 	{{- if and $.Plus $s.StatusZone }}
     status_zone {{ $s.StatusZone }};
+    {{- end }}
+    {{- range $i := $s.Includes }}
+    include {{ $i.Name }};
     {{- end }}
 
 	{{- if $s.SSL }}
@@ -86,6 +93,21 @@ server {
 	{{- if $s.ProxySSLVerify.TrustedCertificate }}
     proxy_ssl_trusted_certificate {{ $s.ProxySSLVerify.TrustedCertificate }};
 	{{- end }}
+	{{- end }}
+	{{- if $s.ProxySettings.ProxyProtocol }}
+    proxy_protocol on;
+	{{- end }}
+	{{- if $s.ProxySettings.ConnectTimeout }}
+    proxy_connect_timeout {{ $s.ProxySettings.ConnectTimeout }};
+	{{- end }}
+	{{- if $s.ProxySettings.Timeout }}
+    proxy_timeout {{ $s.ProxySettings.Timeout }};
+	{{- end }}
+	{{- if $s.ProxySettings.Responses }}
+    proxy_responses {{ $s.ProxySettings.Responses }};
+	{{- end }}
+	{{- if $s.ProxySettings.Requests }}
+    proxy_requests {{ $s.ProxySettings.Requests }};
 	{{- end }}
 	{{- end }}
 	{{- if $s.Target }}
@@ -97,6 +119,33 @@ server {
 }
 {{- end }}
 
+{{- if .ZoneSync }}
+# Synchronizes the shared memory zones between the NGINX replicas of the Gateway.
+# Replicas authenticate each other with the certificate that NGINX Agent uses to connect to the control plane.
+server {
+	{{- if $.IPFamily.IPv4 }}
+    listen {{ .ZoneSync.Port }} ssl;
+	{{- end }}
+	{{- if $.IPFamily.IPv6 }}
+    listen [::]:{{ .ZoneSync.Port }} ssl;
+	{{- end }}
+	{{- if .ZoneSync.Resolver }}
+    resolver {{ .ZoneSync.Resolver }} valid=5s;
+	{{- end }}
+
+    ssl_certificate /var/run/secrets/ngf/tls.crt;
+    ssl_certificate_key /var/run/secrets/ngf/tls.key;
+    ssl_client_certificate /var/run/secrets/ngf/ca.crt;
+    ssl_verify_client on;
+
+    zone_sync;
+    zone_sync_server {{ .ZoneSync.Server }} resolve;
+    zone_sync_ssl on;
+    zone_sync_ssl_certificate /var/run/secrets/ngf/tls.crt;
+    zone_sync_ssl_certificate_key /var/run/secrets/ngf/tls.key;
+}
+{{- end }}
+
 server {
     listen ` + SocketBasePath + `connection-closed-server.sock;
     return "";
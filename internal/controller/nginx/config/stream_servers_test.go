@@ -9,9 +9,13 @@ import (
 	. "github.com/onsi/gomega"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
 const testGatewayClientCertID = dataplane.SSLKeyPairID("ssl_keypair_default_gateway-client-cert")
@@ -74,7 +78,7 @@ func TestExecuteStreamServers(t *testing.T) {
 	g := NewWithT(t)
 
 	gen := GeneratorImpl{}
-	results := gen.executeStreamServers(conf)
+	results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 	result := results[0]
 
@@ -119,7 +123,7 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	g := NewWithT(t)
 
 	gen := GeneratorImpl{plus: true}
-	results := gen.executeStreamServers(config)
+	results := gen.executeStreamServers(config, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 
 	serverConf := string(results[0].data)
@@ -129,6 +133,102 @@ func TestExecuteStreamServers_Plus(t *testing.T) {
 	}
 }
 
+func TestExecuteStreamServersWithPolicies(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	streamPolicy := &policiesfakes.FakePolicy{}
+
+	conf := dataplane.Configuration{
+		BaseStreamConfig: dataplane.BaseStreamConfig{
+			Policies: []policies.Policy{streamPolicy},
+		},
+		TLSServers: []dataplane.Layer4VirtualServer{
+			{
+				// Passthrough server
+				Hostname: "passthrough.example.com",
+				Port:     8443,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1", Weight: 0},
+				},
+				Policies: []policies.Policy{streamPolicy},
+			},
+			{
+				// Terminate server
+				Hostname: "terminate.example.com",
+				Port:     9443,
+				SSL: &dataplane.SSL{
+					KeyPairIDs: []dataplane.SSLKeyPairID{"keypair1"},
+				},
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1", Weight: 0},
+				},
+				Policies: []policies.Policy{streamPolicy},
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port: 8080,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1", Weight: 1},
+				},
+				Policies: []policies.Policy{streamPolicy},
+			},
+			{
+				Port: 8081,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1", Weight: 1},
+				},
+				Policies: []policies.Policy{streamPolicy},
+			},
+			{
+				Port: 8082,
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "backend1", Weight: 1},
+				},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name: "backend1",
+				Endpoints: []resolver.Endpoint{
+					{Address: "10.0.0.1", Port: 80},
+				},
+			},
+		},
+	}
+
+	fakeGenerator := &policiesfakes.FakeGenerator{}
+	fakeGenerator.GenerateForStreamReturns(policies.GenerateResultFiles{
+		{Name: "stream.conf", Content: []byte("stream-content")},
+	})
+	fakeGenerator.GenerateForStreamServerStub = func(pols []policies.Policy) policies.GenerateResultFiles {
+		if len(pols) == 0 {
+			return nil
+		}
+
+		return policies.GenerateResultFiles{
+			{Name: "stream_server.conf", Content: []byte("stream-server-content")},
+		}
+	}
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf, fakeGenerator)
+	g.Expect(results).To(HaveLen(3))
+
+	g.Expect(results[0].dest).To(Equal(streamConfigFile))
+	serverConf := string(results[0].data)
+	g.Expect(strings.Count(serverConf, "include /etc/nginx/includes/stream.conf;")).To(Equal(1))
+	g.Expect(strings.Count(serverConf, "include /etc/nginx/includes/stream_server.conf;")).To(Equal(4))
+
+	g.Expect(results[1:]).To(ConsistOf(
+		executeResult{dest: "/etc/nginx/includes/stream.conf", data: []byte("stream-content")},
+		executeResult{dest: "/etc/nginx/includes/stream_server.conf", data: []byte("stream-server-content")},
+	))
+
+	g.Expect(fakeGenerator.GenerateForStreamArgsForCall(0)).To(ConsistOf(streamPolicy))
+}
+
 func TestExecuteStreamServersWithTLSTerminate(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -187,7 +287,7 @@ func TestExecuteStreamServersWithTLSTerminate(t *testing.T) {
 	}
 
 	gen := GeneratorImpl{}
-	results := gen.executeStreamServers(conf)
+	results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
 	g.Expect(results).To(HaveLen(1))
 
 	serverConf := string(results[0].data)
@@ -291,7 +391,7 @@ func TestCreateStreamServers(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -410,7 +510,7 @@ func TestExecuteStreamServersForIPFamily(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeStreamServers(test.config)
+			results := gen.executeStreamServers(test.config, &policiesfakes.FakeGenerator{})
 			g.Expect(results).To(HaveLen(1))
 			serverConf := string(results[0].data)
 
@@ -509,7 +609,7 @@ func TestExecuteStreamServers_RewriteClientIP(t *testing.T) {
 			g := NewWithT(t)
 
 			gen := GeneratorImpl{}
-			results := gen.executeStreamServers(test.config)
+			results := gen.executeStreamServers(test.config, &policiesfakes.FakeGenerator{})
 			g.Expect(results).To(HaveLen(1))
 			serverConf := string(results[0].data)
 
@@ -527,7 +627,7 @@ func TestCreateStreamServersWithNone(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -578,7 +678,7 @@ func TestCreateStreamServersWithTLSTerminate(t *testing.T) {
 	}
 
 	logger := logr.Discard()
-	streamServers := createStreamServers(logger, conf)
+	streamServers := createStreamServers(logger, conf, &policiesfakes.FakeGenerator{})
 
 	g := NewWithT(t)
 
@@ -626,6 +726,17 @@ func TestCreateTLSTerminateSocketServer(t *testing.T) {
 			Name:      "no-endpoints",
 			Endpoints: nil,
 		},
+		"stream-settings": {
+			Name:      "stream-settings",
+			Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}},
+			UpstreamSettings: upstreamsettings.UpstreamSettings{
+				Stream: upstreamsettings.StreamSettings{
+					ProxyProtocol: true,
+					Timeout:       "1m",
+					Responses:     helpers.GetPointer[int32](1),
+				},
+			},
+		},
 	}
 
 	conf := dataplane.Configuration{}
@@ -820,6 +931,35 @@ func TestCreateTLSTerminateSocketServer(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "terminate server with upstream stream settings",
+			server: dataplane.Layer4VirtualServer{
+				Hostname: "proxy.example.com",
+				Port:     8443,
+				SSL: &dataplane.SSL{
+					KeyPairIDs: []dataplane.SSLKeyPairID{"keypair1"},
+				},
+				Upstreams: []dataplane.Layer4Upstream{
+					{Name: "stream-settings", Weight: 0},
+				},
+			},
+			expected: []stream.Server{
+				{
+					Listen:     getSocketNameTLSTerminate(8443, "proxy.example.com"),
+					StatusZone: "proxy.example.com",
+					ProxyPass:  "stream-settings",
+					IsSocket:   true,
+					SSL: &stream.SSL{
+						Certificates:    []string{generatePEMFileName("keypair1")},
+						CertificateKeys: []string{generatePEMFileName("keypair1")},
+					},
+					ProxySettings: stream.ProxySettings{
+						ProxyProtocol: true,
+						Timeout:       "1m",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -827,7 +967,7 @@ func TestCreateTLSTerminateSocketServer(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			result := createTLSTerminateSocketServer(tt.server, upstreams, conf)
+			result := createTLSTerminateSocketServer(tt.server, upstreams, conf, &policiesfakes.FakeGenerator{})
 
 			if tt.expected == nil {
 				g.Expect(result).To(BeNil())
@@ -908,6 +1048,96 @@ func TestBuildStreamSSL(t *testing.T) {
 	}
 }
 
+func TestExecuteStreamServersWithZoneSync(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		name          string
+		expStrings    []string
+		notExpStrings []string
+		conf          dataplane.Configuration
+	}{
+		{
+			name: "zone sync with resolver",
+			conf: dataplane.Configuration{
+				BaseStreamConfig: dataplane.BaseStreamConfig{
+					ZoneSync: &dataplane.ZoneSyncConfig{
+						Server:   "gateway-nginx-zone-sync.test.svc.cluster.local:12345",
+						Resolver: "10.96.0.10",
+						Port:     12345,
+					},
+				},
+			},
+			expStrings: []string{
+				"listen 12345 ssl;",
+				"listen [::]:12345 ssl;",
+				"resolver 10.96.0.10 valid=5s;",
+				"ssl_certificate /var/run/secrets/ngf/tls.crt;",
+				"ssl_certificate_key /var/run/secrets/ngf/tls.key;",
+				"ssl_client_certificate /var/run/secrets/ngf/ca.crt;",
+				"ssl_verify_client on;",
+				"zone_sync;",
+				"zone_sync_server gateway-nginx-zone-sync.test.svc.cluster.local:12345 resolve;",
+				"zone_sync_ssl on;",
+				"zone_sync_ssl_certificate /var/run/secrets/ngf/tls.crt;",
+				"zone_sync_ssl_certificate_key /var/run/secrets/ngf/tls.key;",
+			},
+		},
+		{
+			name: "zone sync with stream resolver and IPv4 only",
+			conf: dataplane.Configuration{
+				BaseHTTPConfig: dataplane.BaseHTTPConfig{
+					IPFamily: dataplane.IPv4,
+				},
+				BaseStreamConfig: dataplane.BaseStreamConfig{
+					DNSResolver: &dataplane.DNSResolverConfig{
+						Addresses: []string{"10.0.0.10"},
+					},
+					ZoneSync: &dataplane.ZoneSyncConfig{
+						Server: "gateway-nginx-zone-sync.test.svc.cluster.local:12345",
+						Port:   12345,
+					},
+				},
+			},
+			expStrings: []string{
+				"resolver 10.0.0.10;",
+				"listen 12345 ssl;",
+				"zone_sync;",
+				"zone_sync_server gateway-nginx-zone-sync.test.svc.cluster.local:12345 resolve;",
+			},
+			notExpStrings: []string{
+				"listen [::]:12345 ssl;",
+				"valid=5s;",
+			},
+		},
+		{
+			name: "no zone sync",
+			conf: dataplane.Configuration{},
+			notExpStrings: []string{
+				"zone_sync",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{plus: true}
+			results := gen.executeStreamServers(test.conf, &policiesfakes.FakeGenerator{})
+			g.Expect(results).To(HaveLen(1))
+
+			serverConf := string(results[0].data)
+			for _, str := range test.expStrings {
+				g.Expect(serverConf).To(ContainSubstring(str))
+			}
+			for _, str := range test.notExpStrings {
+				g.Expect(serverConf).ToNot(ContainSubstring(str))
+			}
+		})
+	}
+}
+
 func TestExecuteStreamServersWithResolver(t *testing.T) {
 	t.Parallel()
 	tests := []struct {
@@ -1022,7 +1252,7 @@ server {
 			t.Parallel()
 			g := NewWithT(t)
 			generator := GeneratorImpl{}
-			results := generator.executeStreamServers(test.conf)
+			results := generator.executeStreamServers(test.conf, &policiesfakes.FakeGenerator{})
 
 			g.Expect(results).To(HaveLen(1))
 			g.Expect(string(results[0].data)).To(Equal(test.expectedConfig))
@@ -1217,6 +1447,83 @@ func TestExecuteStreamServersWithTCPUDPWeights(t *testing.T) {
 	g.Expect(splitClients[0].Distributions[1].Value).To(Equal("tcp_v2"))
 }
 
+func TestExecuteStreamServersWithProxySettings(t *testing.T) {
+	t.Parallel()
+
+	streamSettings := upstreamsettings.UpstreamSettings{
+		Stream: upstreamsettings.StreamSettings{
+			ProxyProtocol:  true,
+			ConnectTimeout: "5s",
+			Timeout:        "1m",
+			Responses:      helpers.GetPointer[int32](1),
+			Requests:       helpers.GetPointer[int32](2),
+		},
+	}
+
+	conf := dataplane.Configuration{
+		TLSServers: []dataplane.Layer4VirtualServer{
+			{
+				Hostname:  "example.com",
+				Port:      8443,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "tls-backend"}},
+			},
+		},
+		TCPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:      8080,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "tcp-backend"}},
+			},
+		},
+		UDPServers: []dataplane.Layer4VirtualServer{
+			{
+				Port:      5353,
+				Upstreams: []dataplane.Layer4Upstream{{Name: "udp-backend"}},
+			},
+		},
+		StreamUpstreams: []dataplane.Upstream{
+			{
+				Name:             "tls-backend",
+				Endpoints:        []resolver.Endpoint{{Address: "10.0.0.1", Port: 443}},
+				UpstreamSettings: streamSettings,
+			},
+			{
+				Name:             "tcp-backend",
+				Endpoints:        []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}},
+				UpstreamSettings: streamSettings,
+			},
+			{
+				Name:             "udp-backend",
+				Endpoints:        []resolver.Endpoint{{Address: "10.0.0.3", Port: 53}},
+				UpstreamSettings: streamSettings,
+			},
+		},
+	}
+
+	expSubStrings := map[string]int{
+		"proxy_protocol on;":            3,
+		"proxy_connect_timeout 5s;":     3,
+		"proxy_timeout 1m;":             3,
+		"proxy_responses 1;":            1,
+		"proxy_requests 2;":             1,
+		"proxy_pass tls-backend;":       1,
+		"proxy_pass tcp-backend;":       1,
+		"proxy_pass udp-backend;":       1,
+		"listen 5353 udp;":              1,
+		"proxy_ssl_trusted_certificate": 0,
+	}
+
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	results := gen.executeStreamServers(conf, &policiesfakes.FakeGenerator{})
+	g.Expect(results).To(HaveLen(1))
+	result := results[0]
+
+	for expSubStr, expCount := range expSubStrings {
+		g.Expect(strings.Count(string(result.data), expSubStr)).To(Equal(expCount), expSubStr)
+	}
+}
+
 func TestProcessLayer4Servers(t *testing.T) {
 	t.Parallel()
 
@@ -1448,6 +1755,142 @@ func TestProcessLayer4Servers(t *testing.T) {
 				ProxyPass:  "backend1",
 			},
 		},
+		{
+			name: "UDP server with upstream stream settings",
+			servers: []dataplane.Layer4VirtualServer{
+				{Port: 5353, Upstreams: []dataplane.Layer4Upstream{{Name: "dns-backend"}}},
+			},
+			upstreams: map[string]dataplane.Upstream{
+				"dns-backend": {
+					Name:      "dns-backend",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.2", Port: 53}},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						Stream: upstreamsettings.StreamSettings{
+							ProxyProtocol:  true,
+							ConnectTimeout: "5s",
+							Timeout:        "1m",
+							Responses:      helpers.GetPointer[int32](1),
+							Requests:       helpers.GetPointer[int32](0),
+						},
+					},
+				},
+			},
+			portSet:       map[portProtoKey]struct{}{},
+			protocol:      string(v1.UDPProtocolType),
+			expectedCount: 1,
+			expectedServer: &stream.Server{
+				Listen:     "5353 udp",
+				StatusZone: "UDP_5353",
+				ProxyPass:  "dns-backend",
+				ProxySettings: stream.ProxySettings{
+					ProxyProtocol:  true,
+					ConnectTimeout: "5s",
+					Timeout:        "1m",
+					Responses:      helpers.GetPointer[int32](1),
+					Requests:       helpers.GetPointer[int32](0),
+				},
+			},
+		},
+		{
+			name: "TCP server ignores UDP only stream settings",
+			servers: []dataplane.Layer4VirtualServer{
+				{Port: 8080, Upstreams: []dataplane.Layer4Upstream{{Name: "backend1"}}},
+			},
+			upstreams: map[string]dataplane.Upstream{
+				"backend1": {
+					Name:      "backend1",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 8080}},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						Stream: upstreamsettings.StreamSettings{
+							Timeout:   "1m",
+							Responses: helpers.GetPointer[int32](1),
+							Requests:  helpers.GetPointer[int32](1),
+						},
+					},
+				},
+			},
+			portSet:       map[portProtoKey]struct{}{},
+			protocol:      string(v1.TCPProtocolType),
+			expectedCount: 1,
+			expectedServer: &stream.Server{
+				Listen:     "8080",
+				StatusZone: "TCP_8080",
+				ProxyPass:  "backend1",
+				ProxySettings: stream.ProxySettings{
+					Timeout: "1m",
+				},
+			},
+		},
+		{
+			name: "server with multiple upstreams with the same stream settings",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Port: 9000,
+					Upstreams: []dataplane.Layer4Upstream{
+						{Name: "backend-v1", Weight: 80},
+						{Name: "backend-v2", Weight: 20},
+					},
+				},
+			},
+			upstreams: map[string]dataplane.Upstream{
+				"backend-v1": {
+					Name:      "backend-v1",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.3", Port: 9000}},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						Stream: upstreamsettings.StreamSettings{ProxyProtocol: true},
+					},
+				},
+				"backend-v2": {
+					Name:      "backend-v2",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.4", Port: 9000}},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						Stream: upstreamsettings.StreamSettings{ProxyProtocol: true},
+					},
+				},
+			},
+			portSet:       map[portProtoKey]struct{}{},
+			protocol:      string(v1.TCPProtocolType),
+			expectedCount: 1,
+			expectedServer: &stream.Server{
+				Listen:        "9000",
+				StatusZone:    "TCP_9000",
+				ProxyPass:     "$backend_9000",
+				ProxySettings: stream.ProxySettings{ProxyProtocol: true},
+			},
+		},
+		{
+			name: "server with multiple upstreams with different stream settings",
+			servers: []dataplane.Layer4VirtualServer{
+				{
+					Port: 9000,
+					Upstreams: []dataplane.Layer4Upstream{
+						{Name: "backend-v1", Weight: 80},
+						{Name: "backend-v2", Weight: 20},
+					},
+				},
+			},
+			upstreams: map[string]dataplane.Upstream{
+				"backend-v1": {
+					Name:      "backend-v1",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.3", Port: 9000}},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						Stream: upstreamsettings.StreamSettings{ProxyProtocol: true},
+					},
+				},
+				"backend-v2": {
+					Name:      "backend-v2",
+					Endpoints: []resolver.Endpoint{{Address: "10.0.0.4", Port: 9000}},
+				},
+			},
+			portSet:       map[portProtoKey]struct{}{},
+			protocol:      string(v1.TCPProtocolType),
+			expectedCount: 1,
+			expectedServer: &stream.Server{
+				Listen:     "9000",
+				StatusZone: "TCP_9000",
+				ProxyPass:  "$backend_9000",
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -1462,7 +1905,15 @@ func TestProcessLayer4Servers(t *testing.T) {
 			}
 
 			logger := logr.Discard()
-			processLayer4Servers(logger, tt.servers, tt.upstreams, portSet, &streamServers, tt.protocol)
+			processLayer4Servers(
+				logger,
+				&policiesfakes.FakeGenerator{},
+				tt.servers,
+				tt.upstreams,
+				portSet,
+				&streamServers,
+				tt.protocol,
+			)
 
 			g.Expect(streamServers).To(HaveLen(tt.expectedCount))
 
@@ -1470,6 +1921,7 @@ func TestProcessLayer4Servers(t *testing.T) {
 				g.Expect(streamServers[0].Listen).To(Equal(tt.expectedServer.Listen))
 				g.Expect(streamServers[0].StatusZone).To(Equal(tt.expectedServer.StatusZone))
 				g.Expect(streamServers[0].ProxyPass).To(Equal(tt.expectedServer.ProxyPass))
+				g.Expect(streamServers[0].ProxySettings).To(Equal(tt.expectedServer.ProxySettings))
 			}
 		})
 	}
@@ -2,6 +2,7 @@ package config
 
 import (
 	"fmt"
+	"slices"
 	gotemplate "text/template"
 
 	ngfAPI "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
@@ -9,6 +10,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/stream"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/types"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
@@ -34,6 +36,8 @@ const (
 	stateDir = "/var/lib/nginx/state"
 	// default load balancing method.
 	defaultLBMethod = "random two least_conn"
+	// healthCheckMatchSuffix is appended to the upstream name to form the name of its health check match block.
+	healthCheckMatchSuffix = "_health_check_match"
 )
 
 // keepAliveChecker takes an upstream name and returns if it has keep alive settings enabled.
@@ -131,12 +135,22 @@ func (g GeneratorImpl) createStreamUpstream(up dataplane.Upstream) stream.Upstre
 
 func (g GeneratorImpl) createUpstreams(
 	upstreams []dataplane.Upstream,
+	backendGroups []dataplane.BackendGroup,
 ) []http.Upstream {
 	// capacity is the number of upstreams + 1 for the invalid backend ref upstream
 	ups := make([]http.Upstream, 0, len(upstreams)+1)
+	upstreamsProxySSLVerify := createUpstreamsProxySSLVerify(backendGroups)
 
 	for _, u := range upstreams {
+		proxySSLVerify := upstreamsProxySSLVerify[u.Name]
+
 		ups = append(ups, g.createUpstream(u))
+		setHealthCheckProxySSLVerify(&ups[len(ups)-1], proxySSLVerify)
+
+		for _, topologyUpstream := range u.TopologyUpstreams {
+			ups = append(ups, g.createUpstream(topologyUpstream))
+			setHealthCheckProxySSLVerify(&ups[len(ups)-1], proxySSLVerify)
+		}
 	}
 
 	ups = append(ups, createInvalidBackendRefUpstream())
@@ -187,8 +201,14 @@ func (g GeneratorImpl) createUpstream(
 		chosenLBMethod = lbMethod
 	}
 
+	endpoints := up.Endpoints
+	if !g.plus {
+		// NGINX OSS doesn't support draining upstream servers, so the draining endpoints don't receive any traffic.
+		endpoints = slices.DeleteFunc(slices.Clone(endpoints), func(ep resolver.Endpoint) bool { return ep.Drain })
+	}
+
 	keepAliveSettings := processKeepAliveSettings(upstreamPolicySettings.KeepAlive)
-	if len(up.Endpoints) == 0 {
+	if len(endpoints) == 0 {
 		return http.Upstream{
 			Name:      up.Name,
 			ZoneSize:  zoneSize,
@@ -203,18 +223,31 @@ func (g GeneratorImpl) createUpstream(
 		}
 	}
 
-	upstreamServers := make([]http.UpstreamServer, len(up.Endpoints))
-	for idx, ep := range up.Endpoints {
+	upstreamServers := make([]http.UpstreamServer, len(endpoints))
+	for idx, ep := range endpoints {
 		format := "%s:%d"
 		if ep.IPv6 {
 			format = "[%s]:%d"
 		}
 		upstreamServers[idx] = http.UpstreamServer{
-			Address: fmt.Sprintf(format, ep.Address, ep.Port),
-			Resolve: ep.Resolve,
+			Address:     fmt.Sprintf(format, ep.Address, ep.Port),
+			Resolve:     ep.Resolve,
+			MaxFails:    upstreamPolicySettings.MaxFails,
+			FailTimeout: upstreamPolicySettings.FailTimeout,
+			Backup:      ep.Backup,
+		}
+
+		if g.plus {
+			upstreamServers[idx].SlowStart = upstreamPolicySettings.SlowStart
+			upstreamServers[idx].Drain = ep.Drain
 		}
 	}
 
+	var healthCheck *http.UpstreamHealthCheck
+	if g.plus {
+		healthCheck = createUpstreamHealthCheck(up.Name, upstreamPolicySettings.HealthCheck)
+	}
+
 	return http.Upstream{
 		Name:                up.Name,
 		ZoneSize:            zoneSize,
@@ -223,9 +256,86 @@ func (g GeneratorImpl) createUpstream(
 		KeepAlive:           keepAliveSettings,
 		LoadBalancingMethod: chosenLBMethod,
 		SessionPersistence:  sp,
+		HealthCheck:         healthCheck,
+	}
+}
+
+// createUpstreamsProxySSLVerify returns the backend TLS verification settings of the upstreams
+// that have a BackendTLSPolicy, keyed by the upstream name.
+func createUpstreamsProxySSLVerify(backendGroups []dataplane.BackendGroup) map[string]*http.ProxySSLVerify {
+	upstreamsProxySSLVerify := make(map[string]*http.ProxySSLVerify)
+
+	for _, group := range backendGroups {
+		for _, backend := range group.Backends {
+			if proxySSLVerify := createProxySSLVerify(backend.VerifyTLS); proxySSLVerify != nil {
+				upstreamsProxySSLVerify[backend.UpstreamName] = proxySSLVerify
+			}
+		}
+	}
+
+	return upstreamsProxySSLVerify
+}
+
+// setHealthCheckProxySSLVerify sets the backend TLS verification settings of the active health check
+// of an upstream, so that the health checks use TLS like the requests proxied to the upstream.
+func setHealthCheckProxySSLVerify(upstream *http.Upstream, proxySSLVerify *http.ProxySSLVerify) {
+	if upstream.HealthCheck != nil {
+		upstream.HealthCheck.ProxySSLVerify = proxySSLVerify
 	}
 }
 
+// createUpstreamHealthCheck creates the active health check configuration for an upstream.
+// Active health checks are supported only by NGINX Plus.
+func createUpstreamHealthCheck(upstreamName string, spec *ngfAPI.UpstreamHealthCheck) *http.UpstreamHealthCheck {
+	if spec == nil {
+		return nil
+	}
+
+	hc := &http.UpstreamHealthCheck{}
+
+	if spec.Interval != nil {
+		hc.Interval = string(*spec.Interval)
+	}
+
+	if spec.Fails != nil {
+		hc.Fails = *spec.Fails
+	}
+
+	if spec.Passes != nil {
+		hc.Passes = *spec.Passes
+	}
+
+	if spec.Port != nil {
+		hc.Port = *spec.Port
+	}
+
+	if spec.URI != nil {
+		hc.URI = *spec.URI
+	}
+
+	if spec.Match != nil {
+		hc.Match = &http.UpstreamHealthCheckMatch{
+			Name:   upstreamName + healthCheckMatchSuffix,
+			Status: spec.Match.Status,
+		}
+
+		if spec.Match.Body != nil {
+			hc.Match.Body = *spec.Match.Body
+		}
+	}
+
+	if spec.GRPC != nil {
+		hc.GRPC = true
+		hc.GRPCStatus = spec.GRPC.Status
+
+		if spec.GRPC.Service != nil {
+			hc.GRPCService = *spec.GRPC.Service
+		}
+	}
+
+	return hc
+}
+
 // processKeepAliveSettings normalizes keepalive configuration from an upstream policy.
 // If Connections is nil, the field is omitted and the NGINX default is used.
 // If Connections is set to 0, keepAlive is disabled.
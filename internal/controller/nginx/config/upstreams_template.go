@@ -7,6 +7,9 @@ package config
 // https://github.com/nginx/nginx-gateway-fabric/issues/483
 //
 // if the keepalive directive is present, it is necessary to activate the load balancing method before the directive.
+//
+// Active health checks (NGINX Plus) are defined in named locations of a dedicated server that never receives
+// client traffic, so that every upstream is checked once, no matter how many locations proxy to it.
 const upstreamsTemplateText = `
 {{ range $u := . }}
 upstream {{ $u.Name }} {
@@ -27,7 +30,13 @@ upstream {{ $u.Name }} {
     state {{ $u.StateFile }};
     {{- else }}
         {{ range $server := $u.Servers }}
-    server {{ $server.Address }}{{ if $server.Resolve }} resolve{{ end }};
+    server {{ $server.Address }}
+            {{- if $server.MaxFails }} max_fails={{ $server.MaxFails }}{{ end }}
+            {{- if $server.FailTimeout }} fail_timeout={{ $server.FailTimeout }}{{ end }}
+            {{- if $server.SlowStart }} slow_start={{ $server.SlowStart }}{{ end }}
+            {{- if $server.Backup }} backup{{ end }}
+            {{- if $server.Drain }} drain{{ end }}
+            {{- if $server.Resolve }} resolve{{ end }};
         {{- end }}
     {{- end }}
     {{ if $u.KeepAlive.Connections -}}
@@ -43,7 +52,62 @@ upstream {{ $u.Name }} {
     keepalive_timeout {{ $u.KeepAlive.Timeout }};
     {{- end }}
 }
+    {{- if and $u.HealthCheck $u.HealthCheck.Match }}
+
+match {{ $u.HealthCheck.Match.Name }} {
+        {{- if $u.HealthCheck.Match.Status }}
+    status{{ range $s := $u.HealthCheck.Match.Status }} {{ $s }}{{ end }};
+        {{- end }}
+        {{- if $u.HealthCheck.Match.Body }}
+    body ~ "{{ $u.HealthCheck.Match.Body }}";
+        {{- end }}
+}
+    {{- end }}
 {{ end -}}
+
+{{- $healthChecks := false }}
+{{- range $u := . }}{{ if $u.HealthCheck }}{{ $healthChecks = true }}{{ end }}{{ end }}
+{{- if $healthChecks }}
+server {
+    listen ` + SocketBasePath + `nginx-health-check-server.sock;
+    access_log off;
+    {{ range $u := . }}
+        {{- if $u.HealthCheck }}
+            {{- $hc := $u.HealthCheck }}
+
+    location @health_check_{{ $u.Name }} {
+            {{- $proxyOrGRPC := "proxy" }}{{ if $hc.GRPC }}{{ $proxyOrGRPC = "grpc" }}{{ end }}
+            {{- if $hc.ProxySSLVerify }}
+        {{ $proxyOrGRPC }}_ssl_server_name on;
+        {{ $proxyOrGRPC }}_ssl_verify on;
+        {{ $proxyOrGRPC }}_ssl_verify_depth 4;
+                {{- if $hc.ProxySSLVerify.Name }}
+        {{ $proxyOrGRPC }}_ssl_name {{ $hc.ProxySSLVerify.Name }};
+                {{- end }}
+                {{- if $hc.ProxySSLVerify.TrustedCertificate }}
+        {{ $proxyOrGRPC }}_ssl_trusted_certificate {{ $hc.ProxySSLVerify.TrustedCertificate }};
+                {{- end }}
+            {{- end }}
+            {{- if $hc.GRPC }}
+        grpc_pass grpc{{ if $hc.ProxySSLVerify }}s{{ end }}://{{ $u.Name }};
+        health_check type=grpc
+                {{- if $hc.GRPCService }} grpc_service={{ $hc.GRPCService }}{{ end }}
+                {{- if $hc.GRPCStatus }} grpc_status={{ $hc.GRPCStatus }}{{ end }}
+            {{- else }}
+        proxy_pass http{{ if $hc.ProxySSLVerify }}s{{ end }}://{{ $u.Name }};
+        health_check
+                {{- if $hc.URI }} uri={{ $hc.URI }}{{ end }}
+                {{- if $hc.Match }} match={{ $hc.Match.Name }}{{ end }}
+            {{- end }}
+            {{- if $hc.Interval }} interval={{ $hc.Interval }}{{ end }}
+            {{- if $hc.Fails }} fails={{ $hc.Fails }}{{ end }}
+            {{- if $hc.Passes }} passes={{ $hc.Passes }}{{ end }}
+            {{- if $hc.Port }} port={{ $hc.Port }}{{ end }};
+    }
+        {{- end }}
+    {{- end }}
+}
+{{- end }}
 `
 
 const streamUpstreamsTemplateText = `
@@ -128,7 +128,7 @@ func TestExecuteUpstreams_NginxOSS(t *testing.T) {
 		defaultLBMethod + ";": 5,
 	}
 
-	upstreams := gen.createUpstreams(stateUpstreams)
+	upstreams := gen.createUpstreams(stateUpstreams, nil)
 
 	upstreamResults := executeUpstreams(upstreams)
 	g := NewWithT(t)
@@ -342,7 +342,7 @@ func TestExecuteUpstreams_NginxPlus(t *testing.T) {
 		fmt.Sprintf("server %snginx-500-server.sock;", SocketBasePath): 1,
 	}
 
-	upstreams := gen.createUpstreams(stateUpstreams)
+	upstreams := gen.createUpstreams(stateUpstreams, nil)
 
 	upstreamResults := executeUpstreams(upstreams)
 	g := NewWithT(t)
@@ -530,7 +530,7 @@ func TestCreateUpstreams(t *testing.T) {
 	}
 
 	g := NewWithT(t)
-	result := gen.createUpstreams(stateUpstreams)
+	result := gen.createUpstreams(stateUpstreams, nil)
 	g.Expect(result).To(Equal(expUpstreams))
 }
 
@@ -932,6 +932,35 @@ func TestCreateUpstream(t *testing.T) {
 			},
 			msg: "zone size override",
 		},
+		{
+			stateUpstream: dataplane.Upstream{
+				Name: "passive-health-check",
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					MaxFails:    helpers.GetPointer[int32](3),
+					FailTimeout: "30s",
+					SlowStart:   "1m",
+				},
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.1",
+						Port:    80,
+					},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:     "passive-health-check",
+				ZoneSize: ossZoneSize,
+				Servers: []http.UpstreamServer{
+					{
+						Address:     "10.0.0.1:80",
+						MaxFails:    helpers.GetPointer[int32](3),
+						FailTimeout: "30s",
+					},
+				},
+				LoadBalancingMethod: defaultLBMethod,
+			},
+			msg: "passive health check settings; slow start is ignored for OSS",
+		},
 	}
 
 	for _, test := range tests {
@@ -1032,6 +1061,93 @@ func TestCreateUpstreamPlus(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg: "health check with endpoints",
+			stateUpstream: dataplane.Upstream{
+				Name:         "hc-with-endpoints",
+				StateFileKey: "hc-with-endpoints",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.3",
+						Port:    80,
+					},
+				},
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{
+						Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+						URI:      helpers.GetPointer("/healthz"),
+					},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "hc-with-endpoints",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/hc-with-endpoints.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: "10.0.0.3:80",
+					},
+				},
+				LoadBalancingMethod: defaultLBMethod,
+				HealthCheck: &http.UpstreamHealthCheck{
+					Interval: "10s",
+					URI:      "/healthz",
+				},
+			},
+		},
+		{
+			msg: "passive health check and slow start with endpoints",
+			stateUpstream: dataplane.Upstream{
+				Name:         "phc-with-endpoints",
+				StateFileKey: "phc-with-endpoints",
+				Endpoints: []resolver.Endpoint{
+					{
+						Address: "10.0.0.4",
+						Port:    80,
+					},
+				},
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastConnection),
+					MaxFails:            helpers.GetPointer[int32](0),
+					SlowStart:           "30s",
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "phc-with-endpoints",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/phc-with-endpoints.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address:   "10.0.0.4:80",
+						MaxFails:  helpers.GetPointer[int32](0),
+						SlowStart: "30s",
+					},
+				},
+				LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastConnection),
+			},
+		},
+		{
+			msg: "health check without endpoints",
+			stateUpstream: dataplane.Upstream{
+				Name:         "hc-no-endpoints",
+				StateFileKey: "hc-no-endpoints",
+				Endpoints:    []resolver.Endpoint{},
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					HealthCheck: &ngfAPI.UpstreamHealthCheck{},
+				},
+			},
+			expectedUpstream: http.Upstream{
+				Name:      "hc-no-endpoints",
+				ZoneSize:  plusZoneSize,
+				StateFile: stateDir + "/hc-no-endpoints.conf",
+				Servers: []http.UpstreamServer{
+					{
+						Address: types.Nginx503Server,
+					},
+				},
+				LoadBalancingMethod: defaultLBMethod,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -1862,7 +1978,7 @@ func TestExecuteUpstreams_LoadBalancingMethod(t *testing.T) {
 				},
 			}
 
-			upstreams := gen.createUpstreams(stateUpstreams)
+			upstreams := gen.createUpstreams(stateUpstreams, nil)
 			upstreamResults := executeUpstreams(upstreams)
 
 			g.Expect(upstreamResults).To(HaveLen(1))
@@ -1878,3 +1994,382 @@ func TestExecuteUpstreams_LoadBalancingMethod(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateUpstreamHealthCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		spec     *ngfAPI.UpstreamHealthCheck
+		expected *http.UpstreamHealthCheck
+		msg      string
+	}{
+		{
+			msg:      "nil health check",
+			spec:     nil,
+			expected: nil,
+		},
+		{
+			msg:      "empty health check",
+			spec:     &ngfAPI.UpstreamHealthCheck{},
+			expected: &http.UpstreamHealthCheck{},
+		},
+		{
+			msg: "http health check",
+			spec: &ngfAPI.UpstreamHealthCheck{
+				Interval: helpers.GetPointer[ngfAPI.Duration]("10s"),
+				Fails:    helpers.GetPointer[int32](3),
+				Passes:   helpers.GetPointer[int32](2),
+				Port:     helpers.GetPointer[int32](8081),
+				URI:      helpers.GetPointer("/healthz"),
+				Match: &ngfAPI.UpstreamHealthCheckMatch{
+					Status: []string{"200", "300-399"},
+					Body:   helpers.GetPointer("ok"),
+				},
+			},
+			expected: &http.UpstreamHealthCheck{
+				Interval: "10s",
+				Fails:    3,
+				Passes:   2,
+				Port:     8081,
+				URI:      "/healthz",
+				Match: &http.UpstreamHealthCheckMatch{
+					Name:   "up" + healthCheckMatchSuffix,
+					Status: []string{"200", "300-399"},
+					Body:   "ok",
+				},
+			},
+		},
+		{
+			msg: "grpc health check",
+			spec: &ngfAPI.UpstreamHealthCheck{
+				GRPC: &ngfAPI.UpstreamGRPCHealthCheck{
+					Service: helpers.GetPointer("helloworld.Greeter"),
+					Status:  helpers.GetPointer[int32](12),
+				},
+			},
+			expected: &http.UpstreamHealthCheck{
+				GRPC:        true,
+				GRPCService: "helloworld.Greeter",
+				GRPCStatus:  helpers.GetPointer[int32](12),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(createUpstreamHealthCheck("up", test.spec)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestExecuteUpstreams_HealthChecks(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []http.Upstream{
+		{
+			Name:     "up1",
+			ZoneSize: plusZoneSize,
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.1:80"}},
+			HealthCheck: &http.UpstreamHealthCheck{
+				Interval: "10s",
+				Fails:    3,
+				Passes:   2,
+				Port:     8081,
+				URI:      "/healthz",
+				Match: &http.UpstreamHealthCheckMatch{
+					Name:   "up1" + healthCheckMatchSuffix,
+					Status: []string{"200", "300-399"},
+					Body:   "ok",
+				},
+			},
+		},
+		{
+			Name:     "up2",
+			ZoneSize: plusZoneSize,
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.2:80"}},
+			HealthCheck: &http.UpstreamHealthCheck{
+				GRPC:        true,
+				GRPCService: "helloworld.Greeter",
+				GRPCStatus:  helpers.GetPointer[int32](0),
+			},
+		},
+		{
+			Name:     "up3",
+			ZoneSize: plusZoneSize,
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.3:80"}},
+		},
+		{
+			Name:     "up4",
+			ZoneSize: plusZoneSize,
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.4:443"}},
+			HealthCheck: &http.UpstreamHealthCheck{
+				URI: "/ready",
+				ProxySSLVerify: &http.ProxySSLVerify{
+					Name:               "up4.example.com",
+					TrustedCertificate: "/etc/nginx/secrets/up4-ca.crt",
+				},
+			},
+		},
+		{
+			Name:     "up5",
+			ZoneSize: plusZoneSize,
+			Servers:  []http.UpstreamServer{{Address: "10.0.0.5:443"}},
+			HealthCheck: &http.UpstreamHealthCheck{
+				GRPC: true,
+				ProxySSLVerify: &http.ProxySSLVerify{
+					Name: "up5.example.com",
+				},
+			},
+		},
+	}
+
+	expectedSubStrings := map[string]int{
+		"match up1_health_check_match {":  1,
+		"status 200 300-399;":             1,
+		`body ~ "ok";`:                    1,
+		"match=up1_health_check_match":    1,
+		"nginx-health-check-server.sock;": 1,
+		"location @health_check_up1 {":    1,
+		"proxy_pass http://up1;":          1,
+		"location @health_check_up2 {":    1,
+		"grpc_pass grpc://up2;":           1,
+		"location @health_check_up3 {":    0,
+		"health_check uri=/healthz match=up1_health_check_match interval=10s fails=3 passes=2 port=8081;": 1,
+		"health_check type=grpc grpc_service=helloworld.Greeter grpc_status=0;":                           1,
+		"proxy_pass https://up4;":                                      1,
+		"proxy_ssl_verify on;":                                         1,
+		"proxy_ssl_name up4.example.com;":                              1,
+		"proxy_ssl_trusted_certificate /etc/nginx/secrets/up4-ca.crt;": 1,
+		"grpc_pass grpcs://up5;":                                       1,
+		"grpc_ssl_verify on;":                                          1,
+		"grpc_ssl_name up5.example.com;":                               1,
+		"grpc_ssl_trusted_certificate":                                 0,
+	}
+
+	g := NewWithT(t)
+
+	results := executeUpstreams(upstreams)
+	g.Expect(results).To(HaveLen(1))
+
+	nginxUpstreams := string(results[0].data)
+	for expSubString, expectedCount := range expectedSubStrings {
+		actualCount := strings.Count(nginxUpstreams, expSubString)
+		g.Expect(actualCount).To(
+			Equal(expectedCount),
+			fmt.Sprintf("substring %q expected %d occurrence(s), got %d", expSubString, expectedCount, actualCount),
+		)
+	}
+
+	results = executeUpstreams(upstreams[2:3])
+	g.Expect(string(results[0].data)).ToNot(ContainSubstring("nginx-health-check-server.sock"))
+}
+
+func TestExecuteUpstreams_ServerParameters(t *testing.T) {
+	t.Parallel()
+
+	upstreams := []http.Upstream{
+		{
+			Name:     "up1",
+			ZoneSize: plusZoneSize,
+			Servers: []http.UpstreamServer{
+				{
+					Address:     "10.0.0.1:80",
+					MaxFails:    helpers.GetPointer[int32](0),
+					FailTimeout: "30s",
+					SlowStart:   "1m",
+				},
+				{
+					Address:  "example.com:80",
+					MaxFails: helpers.GetPointer[int32](5),
+					Resolve:  true,
+				},
+			},
+		},
+		{
+			Name:     "up2",
+			ZoneSize: ossZoneSize,
+			Servers: []http.UpstreamServer{
+				{
+					Address: "10.0.0.2:80",
+				},
+				{
+					Address: "10.0.0.3:80",
+					Backup:  true,
+				},
+				{
+					Address: "10.0.0.4:80",
+					Drain:   true,
+				},
+			},
+		},
+	}
+
+	expectedSubStrings := map[string]int{
+		"server 10.0.0.1:80 max_fails=0 fail_timeout=30s slow_start=1m;": 1,
+		"server example.com:80 max_fails=5 resolve;":                     1,
+		"server 10.0.0.2:80;":        1,
+		"server 10.0.0.3:80 backup;": 1,
+		"server 10.0.0.4:80 drain;":  1,
+		"max_fails=":                 2,
+	}
+
+	g := NewWithT(t)
+
+	results := executeUpstreams(upstreams)
+	g.Expect(results).To(HaveLen(1))
+
+	nginxUpstreams := string(results[0].data)
+	for expSubString, expectedCount := range expectedSubStrings {
+		actualCount := strings.Count(nginxUpstreams, expSubString)
+		g.Expect(actualCount).To(
+			Equal(expectedCount),
+			fmt.Sprintf("substring %q expected %d occurrence(s), got %d", expSubString, expectedCount, actualCount),
+		)
+	}
+}
+
+func TestCreateUpstreams_TopologyUpstreams(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{}
+	upstreams := []dataplane.Upstream{
+		{
+			Name: "test_svc_80",
+			Endpoints: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80, ZoneHints: []string{"zone-a"}},
+			},
+			TopologyUpstreams: []dataplane.Upstream{
+				{
+					Name: "test_svc_80_zone_zone-a",
+					Zone: "zone-a",
+					Endpoints: []resolver.Endpoint{
+						{Address: "10.0.0.1", Port: 80, ZoneHints: []string{"zone-a"}},
+						{Address: "10.0.0.2", Port: 80, ZoneHints: []string{"zone-b"}, Backup: true},
+					},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						LoadBalancingMethod: string(ngfAPI.LoadBalancingTypeLeastConnection),
+					},
+				},
+			},
+		},
+	}
+
+	result := gen.createUpstreams(upstreams, nil)
+	g.Expect(result).To(HaveLen(3))
+	g.Expect(result[0].Name).To(Equal("test_svc_80"))
+	g.Expect(result[1].Name).To(Equal("test_svc_80_zone_zone-a"))
+	g.Expect(result[1].LoadBalancingMethod).To(Equal("least_conn"))
+	g.Expect(result[1].Servers).To(Equal([]http.UpstreamServer{
+		{Address: "10.0.0.1:80"},
+		{Address: "10.0.0.2:80", Backup: true},
+	}))
+	g.Expect(result[2].Name).To(Equal(invalidBackendRef))
+}
+
+func TestCreateUpstreams_HealthCheckProxySSLVerify(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gen := GeneratorImpl{plus: true}
+	healthCheckSettings := upstreamsettings.UpstreamSettings{
+		HealthCheck: &ngfAPI.UpstreamHealthCheck{},
+	}
+	upstreams := []dataplane.Upstream{
+		{
+			Name:             "tls_svc_443",
+			Endpoints:        []resolver.Endpoint{{Address: "10.0.0.1", Port: 443}},
+			UpstreamSettings: healthCheckSettings,
+		},
+		{
+			Name:             "plain_svc_80",
+			Endpoints:        []resolver.Endpoint{{Address: "10.0.0.2", Port: 80}},
+			UpstreamSettings: healthCheckSettings,
+		},
+	}
+	backendGroups := []dataplane.BackendGroup{
+		{
+			Backends: []dataplane.Backend{
+				{
+					UpstreamName: "tls_svc_443",
+					VerifyTLS: &dataplane.VerifyTLS{
+						CertBundleID: "cert_bundle_test_ca",
+						Hostname:     "tls.example.com",
+					},
+				},
+				{UpstreamName: "plain_svc_80"},
+			},
+		},
+	}
+
+	result := gen.createUpstreams(upstreams, backendGroups)
+	g.Expect(result).To(HaveLen(3))
+	g.Expect(result[0].HealthCheck.ProxySSLVerify).To(Equal(&http.ProxySSLVerify{
+		TrustedCertificate: generateCertBundleFileName("cert_bundle_test_ca"),
+		Name:               "tls.example.com",
+	}))
+	g.Expect(result[1].HealthCheck.ProxySSLVerify).To(BeNil())
+}
+
+func TestCreateUpstream_DrainingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	up := dataplane.Upstream{
+		Name: "test_svc_80",
+		Endpoints: []resolver.Endpoint{
+			{Address: "10.0.0.1", Port: 80},
+			{Address: "10.0.0.2", Port: 80, Drain: true},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		upstream   dataplane.Upstream
+		expServers []http.UpstreamServer
+		plus       bool
+	}{
+		{
+			name:     "draining endpoints are not used with NGINX OSS",
+			upstream: up,
+			expServers: []http.UpstreamServer{
+				{Address: "10.0.0.1:80"},
+			},
+		},
+		{
+			name:     "draining endpoints are drained with NGINX Plus",
+			upstream: up,
+			expServers: []http.UpstreamServer{
+				{Address: "10.0.0.1:80"},
+				{Address: "10.0.0.2:80", Drain: true},
+			},
+			plus: true,
+		},
+		{
+			name: "only draining endpoints with NGINX OSS",
+			upstream: dataplane.Upstream{
+				Name: "test_svc_80",
+				Endpoints: []resolver.Endpoint{
+					{Address: "10.0.0.2", Port: 80, Drain: true},
+				},
+			},
+			expServers: []http.UpstreamServer{
+				{Address: types.Nginx503Server},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gen := GeneratorImpl{plus: test.plus}
+
+			result := gen.createUpstream(test.upstream)
+			g.Expect(result.Servers).To(Equal(test.expServers))
+		})
+	}
+}
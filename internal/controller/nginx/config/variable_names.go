@@ -28,3 +28,9 @@ func generateCORSAllowedOriginVariableName(serverID string, pathRuleIndex, match
 func generateCORSAllowCredentialsVariableName(serverID string, pathRuleIndex, matchRuleIndex int) string {
 	return fmt.Sprintf("$cors_allow_credentials_server%s_path%d_match%d", serverID, pathRuleIndex, matchRuleIndex)
 }
+
+// generateTopologyUpstreamVariableName generates the variable name for the upstream that an nginx replica
+// proxies to for an upstream with topology-aware variants.
+func generateTopologyUpstreamVariableName(upstreamName string) string {
+	return "$ngf_topology_" + convertStringToSafeVariableName(upstreamName)
+}
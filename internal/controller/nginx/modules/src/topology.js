@@ -0,0 +1,10 @@
+const NODE_NAME_ENV = 'NODE_NAME';
+
+// nodeName returns the name of the node that the nginx replica runs on, so that the topology-aware
+// upstreams of the zone of the node can be selected. The NODE_NAME environment variable is set by
+// the Kubernetes downward API and must be preserved with the env directive of the main context.
+function nodeName() {
+	return process.env[NODE_NAME_ENV] || '';
+}
+
+export default { nodeName };
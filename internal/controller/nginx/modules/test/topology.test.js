@@ -0,0 +1,26 @@
+import { default as topology } from '../src/topology.js';
+import { expect, describe, it, beforeEach, afterEach } from 'vitest';
+
+describe('nodeName', () => {
+	let originalNodeName;
+	beforeEach(() => {
+		originalNodeName = process.env.NODE_NAME;
+	});
+	afterEach(() => {
+		if (originalNodeName === undefined) {
+			delete process.env.NODE_NAME;
+		} else {
+			process.env.NODE_NAME = originalNodeName;
+		}
+	});
+
+	it('returns the name of the node', () => {
+		process.env.NODE_NAME = 'node-1';
+		expect(topology.nodeName()).toBe('node-1');
+	});
+
+	it('returns an empty string if the node name is not set', () => {
+		delete process.env.NODE_NAME;
+		expect(topology.nodeName()).toBe('');
+	});
+});
@@ -0,0 +1,9 @@
+/*
+Package otlp exports the telemetry of the control plane using the OpenTelemetry Protocol (OTLP).
+
+The control plane creates spans for each event batch: building the graph, generating the nginx configuration of
+each Gateway, pushing the configuration to each nginx agent, and writing the statuses. Spans are only exported if
+an OTLP endpoint is configured. Optionally, the Prometheus metrics of the control plane are exported as well,
+so that a Kubernetes change can be correlated with the nginx reload it caused.
+*/
+package otlp
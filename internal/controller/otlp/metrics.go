@@ -0,0 +1,60 @@
+package otlp
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	promBridge "go.opentelemetry.io/contrib/bridges/prometheus"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// DefaultMetricsExportInterval is the default interval between two exports of the metrics.
+const DefaultMetricsExportInterval = 30 * time.Second
+
+// NewMeterProvider creates a MeterProvider that exports the Prometheus metrics gathered by the gatherer to the
+// OTLP receiver every interval. The connection to the receiver is established lazily.
+// The MeterProvider must be shut down to export the metrics one last time.
+func NewMeterProvider(
+	ctx context.Context,
+	cfg Config,
+	gatherer prometheus.Gatherer,
+	interval time.Duration,
+) (*sdkmetric.MeterProvider, error) {
+	options := []otlpmetricgrpc.Option{
+		otlpmetricgrpc.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		options = append(options, otlpmetricgrpc.WithInsecure())
+	}
+
+	exporter, err := otlpmetricgrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OTLP metric exporter: %w", err)
+	}
+
+	return newMeterProvider(exporter, cfg, gatherer, interval), nil
+}
+
+// newMeterProvider creates a MeterProvider that periodically exports the Prometheus metrics using the exporter.
+// The Prometheus metrics are converted to OTLP by the OpenTelemetry Prometheus bridge.
+func newMeterProvider(
+	exporter sdkmetric.Exporter,
+	cfg Config,
+	gatherer prometheus.Gatherer,
+	interval time.Duration,
+) *sdkmetric.MeterProvider {
+	reader := sdkmetric.NewPeriodicReader(
+		exporter,
+		sdkmetric.WithInterval(interval),
+		sdkmetric.WithProducer(promBridge.NewMetricProducer(promBridge.WithGatherer(gatherer))),
+	)
+
+	return sdkmetric.NewMeterProvider(
+		sdkmetric.WithReader(reader),
+		sdkmetric.WithResource(resource.NewSchemaless(resourceAttributes(cfg)...)),
+	)
+}
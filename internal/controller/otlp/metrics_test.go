@@ -0,0 +1,123 @@
+package otlp
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+type fakeMetricExporter struct {
+	exported []metricdata.ResourceMetrics
+	lock     sync.Mutex
+}
+
+func (f *fakeMetricExporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return sdkmetric.DefaultTemporalitySelector(kind)
+}
+
+func (f *fakeMetricExporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return sdkmetric.DefaultAggregationSelector(kind)
+}
+
+func (f *fakeMetricExporter) Export(_ context.Context, rm *metricdata.ResourceMetrics) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	f.exported = append(f.exported, *rm)
+	return nil
+}
+
+func (f *fakeMetricExporter) ForceFlush(context.Context) error {
+	return nil
+}
+
+func (f *fakeMetricExporter) Shutdown(context.Context) error {
+	return nil
+}
+
+func (f *fakeMetricExporter) getExported() []metricdata.ResourceMetrics {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	return f.exported
+}
+
+func createRegistry() *prometheus.Registry {
+	registry := prometheus.NewRegistry()
+
+	counter := prometheus.NewCounterVec(
+		prometheus.CounterOpts{Name: "test_counter", Help: "A counter."},
+		[]string{"gateway"},
+	)
+	counter.WithLabelValues("test/gw").Add(3)
+
+	gauge := prometheus.NewGauge(prometheus.GaugeOpts{Name: "test_gauge", Help: "A gauge."})
+	gauge.Set(5)
+
+	histogram := prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "test_histogram",
+		Help:    "A histogram.",
+		Buckets: []float64{1, 10},
+	})
+	histogram.Observe(0.5)
+	histogram.Observe(2)
+	histogram.Observe(20)
+
+	registry.MustRegister(counter, gauge, histogram)
+
+	return registry
+}
+
+func TestNewMeterProvider(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	exporter := &fakeMetricExporter{}
+	provider := newMeterProvider(exporter, Config{ServiceVersion: "2.0.0"}, createRegistry(), time.Hour)
+
+	// the metrics are exported once more when the provider is shut down
+	g.Expect(provider.Shutdown(context.Background())).To(Succeed())
+
+	exported := exporter.getExported()
+	g.Expect(exported).To(HaveLen(1))
+
+	version, ok := exported[0].Resource.Set().Value("service.version")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(version.AsString()).To(Equal("2.0.0"))
+
+	g.Expect(exported[0].ScopeMetrics).To(HaveLen(1))
+
+	byName := make(map[string]metricdata.Metrics)
+	for _, m := range exported[0].ScopeMetrics[0].Metrics {
+		byName[m.Name] = m
+	}
+	g.Expect(byName).To(HaveLen(3))
+
+	counter, ok := byName["test_counter"].Data.(metricdata.Sum[float64])
+	g.Expect(ok).To(BeTrue())
+	g.Expect(counter.IsMonotonic).To(BeTrue())
+	g.Expect(counter.Temporality).To(Equal(metricdata.CumulativeTemporality))
+	g.Expect(counter.DataPoints).To(HaveLen(1))
+	g.Expect(counter.DataPoints[0].Value).To(Equal(3.0))
+	gateway, ok := counter.DataPoints[0].Attributes.Value("gateway")
+	g.Expect(ok).To(BeTrue())
+	g.Expect(gateway).To(Equal(attribute.StringValue("test/gw")))
+
+	gauge, ok := byName["test_gauge"].Data.(metricdata.Gauge[float64])
+	g.Expect(ok).To(BeTrue())
+	g.Expect(gauge.DataPoints[0].Value).To(Equal(5.0))
+
+	histogram, ok := byName["test_histogram"].Data.(metricdata.Histogram[float64])
+	g.Expect(ok).To(BeTrue())
+	g.Expect(histogram.DataPoints[0].Count).To(Equal(uint64(3)))
+	g.Expect(histogram.DataPoints[0].Sum).To(Equal(22.5))
+	g.Expect(histogram.DataPoints[0].Bounds).To(Equal([]float64{1, 10}))
+	g.Expect(histogram.DataPoints[0].BucketCounts).To(Equal([]uint64{1, 1, 1}))
+}
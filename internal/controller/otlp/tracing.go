@@ -0,0 +1,76 @@
+package otlp
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer of the control plane.
+	TracerName = "github.com/nginx/nginx-gateway-fabric/v2"
+
+	serviceName = "nginx-gateway-fabric"
+)
+
+// Config is the configuration of the OTLP export.
+type Config struct {
+	// Endpoint is the address of the OTLP gRPC receiver in the format host:port.
+	Endpoint string
+	// ServiceVersion is the version of the control plane.
+	ServiceVersion string
+	// PodName is the name of the control plane Pod.
+	PodName string
+	// PodNamespace is the namespace of the control plane Pod.
+	PodNamespace string
+	// Insecure disables TLS for the connection to the OTLP receiver.
+	Insecure bool
+}
+
+// Tracer returns the tracer of the control plane. Its spans are not recorded until a TracerProvider
+// is registered with RegisterTracerProvider.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// RegisterTracerProvider creates a TracerProvider that exports spans to the OTLP receiver, and registers it
+// as the global TracerProvider. The connection to the receiver is established lazily.
+// The TracerProvider must be shut down to flush the remaining spans.
+func RegisterTracerProvider(ctx context.Context, cfg Config) (*sdktrace.TracerProvider, error) {
+	options := []otlptracegrpc.Option{
+		otlptracegrpc.WithEndpoint(cfg.Endpoint),
+	}
+	if cfg.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating OTLP trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewSchemaless(resourceAttributes(cfg)...)),
+	)
+
+	otel.SetTracerProvider(provider)
+
+	return provider, nil
+}
+
+// resourceAttributes returns the attributes that identify the control plane in the exported telemetry.
+func resourceAttributes(cfg Config) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("service.name", serviceName),
+		attribute.String("service.version", cfg.ServiceVersion),
+		attribute.String("k8s.pod.name", cfg.PodName),
+		attribute.String("k8s.namespace.name", cfg.PodNamespace),
+	}
+}
@@ -0,0 +1,42 @@
+package otlp
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+)
+
+// TestRegisterTracerProvider is not parallel, because it registers the global TracerProvider.
+func TestRegisterTracerProvider(t *testing.T) {
+	g := NewWithT(t)
+
+	provider, err := RegisterTracerProvider(context.Background(), Config{
+		Endpoint: "localhost:4317",
+		Insecure: true,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(otel.GetTracerProvider()).To(BeIdenticalTo(provider))
+
+	g.Expect(provider.Shutdown(context.Background())).To(Succeed())
+}
+
+func TestResourceAttributes(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	attrs := resourceAttributes(Config{
+		ServiceVersion: "2.0.0",
+		PodName:        "ngf-pod",
+		PodNamespace:   "nginx-gateway",
+	})
+
+	g.Expect(attrs).To(ConsistOf(
+		attribute.String("service.name", "nginx-gateway-fabric"),
+		attribute.String("service.version", "2.0.0"),
+		attribute.String("k8s.pod.name", "ngf-pod"),
+		attribute.String("k8s.namespace.name", "nginx-gateway"),
+	))
+}
@@ -131,6 +131,11 @@ func (h *eventHandler) handleServiceUpsert(ctx context.Context, logger logr.Logg
 		return fmt.Errorf("error handling resource update: %w", err)
 	}
 
+	// The zone sync Service does not determine the Gateway's address.
+	if isZoneSyncService(svc) {
+		return nil
+	}
+
 	h.provisioner.cfg.StatusQueue.Enqueue(&status.QueueObject{
 		Deployment: status.Deployment{
 			NamespacedName: client.ObjectKeyFromObject(svc),
@@ -273,6 +278,8 @@ func (h *eventHandler) provisionResource(
 				resources.Gateway.EffectiveNginxProxy,
 				resources.Gateway.Listeners,
 				extractExternalLoadBalancer(resources.Gateway),
+				resources.Gateway.ZoneSync,
+				resources.Gateway.ExtAuthzShim,
 			)
 			if err != nil {
 				logger.Error(err, "error building some nginx resources")
@@ -324,6 +331,8 @@ func (h *eventHandler) reprovisionResources(ctx context.Context, event *events.D
 				gateway.EffectiveNginxProxy,
 				gateway.Listeners,
 				extractExternalLoadBalancer(gateway),
+				gateway.ZoneSync,
+				gateway.ExtAuthzShim,
 			); err != nil {
 				return err
 			}
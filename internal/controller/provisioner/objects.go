@@ -95,12 +95,16 @@ type resourceNames struct {
 // buildNginxResourceObjects builds all the NGINX resource objects for a given Gateway and EffectiveNginxProxy.
 // The allListeners parameter must include all listeners from both the Gateway and any attached ListenerSets;
 // these are used to determine which ports the Service and container should expose.
+// The zoneSync parameter indicates whether the NGINX Plus replicas need to synchronize their shared memory zones.
+// The extAuthzShim parameter indicates whether the nginx Pods need the ext_authz shim sidecar.
 func (p *NginxProvisioner) buildNginxResourceObjects(
 	resourceName string,
 	gateway *gatewayv1.Gateway,
 	nProxyCfg *graph.EffectiveNginxProxy,
 	allListeners []*graph.Listener,
 	elb *ngfAPIv1alpha1.ExternalLoadBalancer,
+	zoneSync bool,
+	extAuthzShim bool,
 ) ([]client.Object, error) {
 	// NOTE: When adding new fields to the generated objects, please ensure to update the corresponding spec
 	// setter function in setter.go to set the new fields when updating the object.
@@ -183,6 +187,19 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		errs = append(errs, fmt.Errorf("failed to set owner reference on Service %s: %w", service.GetName(), err))
 	}
 
+	// build the headless Service used by the NGINX Plus replicas to synchronize their shared memory zones
+	var zoneSyncService *corev1.Service
+	if p.cfg.Plus && zoneSync {
+		zoneSyncService = p.buildZoneSyncService(cloneObjectMeta(objectMeta), nProxyCfg, selectorLabels)
+		if err := p.setOwnerReference(zoneSyncService, gateway); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"failed to set owner reference on Service %s: %w",
+				zoneSyncService.GetName(),
+				err,
+			))
+		}
+	}
+
 	// build deployment/daemonset
 	deployment, err := p.buildNginxDeployment(
 		cloneObjectMeta(objectMeta),
@@ -190,6 +207,7 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		ports,
 		selectorLabels,
 		resourceNames,
+		extAuthzShim,
 	)
 	if err != nil {
 		errs = append(errs, err)
@@ -209,12 +227,13 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 	// serviceaccount
 	// role/binding (if openshift)
 	// service
+	// zone sync service (if plus)
 	// deployment/daemonset
 	// hpa
 	// pdb
 	// external load balancer (last: it selects the service, which must exist first)
 
-	objects := make([]client.Object, 0, len(configmapsList)+len(secretsList)+len(openshiftObjs)+3)
+	objects := make([]client.Object, 0, len(configmapsList)+len(secretsList)+len(openshiftObjs)+4)
 	objects = append(objects, secretsList...)
 	objects = append(objects, configmapsList...)
 	objects = append(objects, serviceAccount)
@@ -222,7 +241,11 @@ func (p *NginxProvisioner) buildNginxResourceObjects(
 		objects = append(objects, openshiftObjs...)
 	}
 
-	objects = append(objects, service, deployment)
+	objects = append(objects, service)
+	if zoneSyncService != nil {
+		objects = append(objects, zoneSyncService)
+	}
+	objects = append(objects, deployment)
 
 	objects, errs = p.buildHPAAndPDB(objectMeta, nProxyCfg, selectorLabels, gateway, objects, errs)
 
@@ -821,6 +844,40 @@ func (p *NginxProvisioner) buildNginxService(
 	return svc, nil
 }
 
+// buildZoneSyncService builds the headless Service that resolves to all NGINX replicas of the Gateway.
+// NGINX Plus uses it to discover the other replicas and synchronize the shared memory zones with them.
+func (p *NginxProvisioner) buildZoneSyncService(
+	objectMeta metav1.ObjectMeta,
+	nProxyCfg *graph.EffectiveNginxProxy,
+	selectorLabels map[string]string,
+) *corev1.Service {
+	objectMeta.Name = controller.CreateZoneSyncServiceName(objectMeta.Name)
+
+	svc := &corev1.Service{
+		ObjectMeta: objectMeta,
+		Spec: corev1.ServiceSpec{
+			Type:      corev1.ServiceTypeClusterIP,
+			ClusterIP: corev1.ClusterIPNone, // headless
+			Ports: []corev1.ServicePort{
+				{
+					Name:       "zone-sync",
+					Port:       dataplane.ZoneSyncPort,
+					TargetPort: intstr.FromInt32(dataplane.ZoneSyncPort),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+			Selector: selectorLabels,
+			// Replicas need to find each other before they are ready to receive traffic.
+			PublishNotReadyAddresses: true,
+			IPFamilyPolicy:           helpers.GetPointer(corev1.IPFamilyPolicyPreferDualStack),
+		},
+	}
+
+	p.setIPFamily(nProxyCfg, svc)
+
+	return svc
+}
+
 // updateLoadBalancerClass sets the Service's LoadBalancerClass to this controller
 // if the Gateway has IP addresses and the Service is a LoadBalancer.
 func (p *NginxProvisioner) updateLoadBalancerClass(
@@ -940,12 +997,14 @@ func (p *NginxProvisioner) buildNginxDeployment(
 	ports []portProtoEntry,
 	selectorLabels map[string]string,
 	names resourceNames,
+	extAuthzShim bool,
 ) (client.Object, error) {
 	podTemplateSpec := p.buildNginxPodTemplateSpec(
 		objectMeta,
 		nProxyCfg,
 		ports,
 		names,
+		extAuthzShim,
 	)
 
 	if nProxyCfg != nil && nProxyCfg.Kubernetes != nil && nProxyCfg.Kubernetes.DaemonSet != nil {
@@ -1115,6 +1174,7 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 	nProxyCfg *graph.EffectiveNginxProxy,
 	ports []portProtoEntry,
 	names resourceNames,
+	extAuthzShim bool,
 ) corev1.PodTemplateSpec {
 	// Build container ports and pod annotations
 	containerPorts, podAnnotations := p.buildContainerPortsAndAnnotations(ports, nProxyCfg, objectMeta.Annotations)
@@ -1157,24 +1217,55 @@ func (p *NginxProvisioner) buildNginxPodTemplateSpec(
 
 	// Configure inference extension if enabled
 	if p.cfg.InferenceExtension {
-		var containerResources corev1.ResourceRequirements
-		if nProxyCfg != nil && nProxyCfg.Kubernetes != nil {
-			var containerSpec *ngfAPIv1alpha2.ContainerSpec
-			if nProxyCfg.Kubernetes.Deployment != nil {
-				containerSpec = &nProxyCfg.Kubernetes.Deployment.Container
-			} else if nProxyCfg.Kubernetes.DaemonSet != nil {
-				containerSpec = &nProxyCfg.Kubernetes.DaemonSet.Container
-			}
-			if containerSpec != nil && containerSpec.Resources != nil {
-				containerResources = *containerSpec.Resources
-			}
-		}
-		p.configureInferenceExtension(&spec, containerResources)
+		p.configureInferenceExtension(&spec, shimContainerResources(nProxyCfg))
+	}
+
+	// Configure the ext_authz shim if a route of the Gateway has an ExternalAuth filter with the GRPC protocol
+	if extAuthzShim {
+		p.configureExtAuthz(&spec, extAuthzContainerResources(nProxyCfg))
 	}
 
 	return spec
 }
 
+// shimContainerResources returns the resources of the nginx container, which the shim sidecars use too.
+func shimContainerResources(nProxyCfg *graph.EffectiveNginxProxy) corev1.ResourceRequirements {
+	var containerResources corev1.ResourceRequirements
+	if nProxyCfg != nil && nProxyCfg.Kubernetes != nil {
+		var containerSpec *ngfAPIv1alpha2.ContainerSpec
+		if nProxyCfg.Kubernetes.Deployment != nil {
+			containerSpec = &nProxyCfg.Kubernetes.Deployment.Container
+		} else if nProxyCfg.Kubernetes.DaemonSet != nil {
+			containerSpec = &nProxyCfg.Kubernetes.DaemonSet.Container
+		}
+		if containerSpec != nil && containerSpec.Resources != nil {
+			containerResources = *containerSpec.Resources
+		}
+	}
+
+	return containerResources
+}
+
+// extAuthzContainerResources returns the resources of the ext_authz shim container. Unlike the endpoint picker
+// shim, the ext_authz shim doesn't share the resources of the nginx container, since it only handles
+// the auth subrequests.
+func extAuthzContainerResources(nProxyCfg *graph.EffectiveNginxProxy) corev1.ResourceRequirements {
+	var containerResources corev1.ResourceRequirements
+	if nProxyCfg != nil && nProxyCfg.Kubernetes != nil {
+		var containerSpec *ngfAPIv1alpha2.SidecarContainerSpec
+		if nProxyCfg.Kubernetes.Deployment != nil {
+			containerSpec = nProxyCfg.Kubernetes.Deployment.ExtAuthzContainer
+		} else if nProxyCfg.Kubernetes.DaemonSet != nil {
+			containerSpec = nProxyCfg.Kubernetes.DaemonSet.ExtAuthzContainer
+		}
+		if containerSpec != nil && containerSpec.Resources != nil {
+			containerResources = *containerSpec.Resources
+		}
+	}
+
+	return containerResources
+}
+
 // buildContainerPortsAndAnnotations builds container ports and pod annotations.
 func (p *NginxProvisioner) buildContainerPortsAndAnnotations(
 	ports []portProtoEntry,
@@ -1244,6 +1335,17 @@ func (p *NginxProvisioner) buildNginxContainer(
 		ImagePullPolicy: pullPolicy,
 		Ports:           containerPorts,
 		ReadinessProbe:  p.buildReadinessProbe(nProxyCfg),
+		Env: []corev1.EnvVar{
+			{
+				// used by nginx to select the topology-aware upstreams of the zone of the node
+				Name: "NODE_NAME",
+				ValueFrom: &corev1.EnvVarSource{
+					FieldRef: &corev1.ObjectFieldSelector{
+						FieldPath: "spec.nodeName",
+					},
+				},
+			},
+		},
 		SecurityContext: &corev1.SecurityContext{
 			Capabilities: &corev1.Capabilities{
 				Drop: []corev1.Capability{"ALL"},
@@ -1269,7 +1371,9 @@ func (p *NginxProvisioner) buildNginxContainer(
 			{MountPath: "/etc/nginx/secrets", Name: "nginx-secrets"},
 			{MountPath: "/var/run/nginx", Name: "nginx-run"},
 			{MountPath: "/var/cache/nginx", Name: "nginx-cache"},
+			{MountPath: "/var/cache/nginx/proxy-cache", Name: "nginx-proxy-cache"},
 			{MountPath: "/etc/nginx/includes", Name: "nginx-includes"},
+			{MountPath: dataplane.AccessLogDirectory, Name: "nginx-access-log"},
 		},
 	}
 }
@@ -1322,7 +1426,9 @@ func (p *NginxProvisioner) buildBaseVolumes(names resourceNames) []corev1.Volume
 		{Name: "nginx-secrets", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-run", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-cache", VolumeSource: emptyDirVolumeSource},
+		{Name: "nginx-proxy-cache", VolumeSource: emptyDirVolumeSource},
 		{Name: "nginx-includes", VolumeSource: emptyDirVolumeSource},
+		{Name: "nginx-access-log", VolumeSource: emptyDirVolumeSource},
 		{
 			Name: "nginx-includes-bootstrap",
 			VolumeSource: corev1.VolumeSource{
@@ -1617,8 +1723,39 @@ func (p *NginxProvisioner) configureInferenceExtension(
 		command = append(command, "--endpoint-picker-tls-skip-verify")
 	}
 
-	spec.Spec.Containers = append(spec.Spec.Containers, corev1.Container{
-		Name:            "endpoint-picker-shim",
+	spec.Spec.Containers = append(
+		spec.Spec.Containers,
+		p.buildShimContainer("endpoint-picker-shim", command, containerResources),
+	)
+}
+
+// configureExtAuthz configures the ext_authz shim sidecar, which sends the auth subrequests of ExternalAuth
+// filters with the GRPC protocol to the ext_authz gRPC servers. The shim reads the CA certificates of
+// BackendTLSPolicies from the secrets volume of the nginx container.
+func (p *NginxProvisioner) configureExtAuthz(
+	spec *corev1.PodTemplateSpec,
+	containerResources corev1.ResourceRequirements,
+) {
+	container := p.buildShimContainer(
+		"ext-authz-shim",
+		[]string{"/usr/bin/gateway", "ext-authz"},
+		containerResources,
+	)
+	container.VolumeMounts = []corev1.VolumeMount{
+		{MountPath: "/etc/nginx/secrets", Name: "nginx-secrets", ReadOnly: true},
+	}
+
+	spec.Spec.Containers = append(spec.Spec.Containers, container)
+}
+
+// buildShimContainer builds a sidecar container that runs a shim server of the gateway binary.
+func (p *NginxProvisioner) buildShimContainer(
+	name string,
+	command []string,
+	containerResources corev1.ResourceRequirements,
+) corev1.Container {
+	return corev1.Container{
+		Name:            name,
 		Image:           p.cfg.GatewayPodConfig.Image,
 		ImagePullPolicy: defaultImagePullPolicy,
 		Command:         command,
@@ -1635,7 +1772,7 @@ func (p *NginxProvisioner) configureInferenceExtension(
 				Type: corev1.SeccompProfileTypeRuntimeDefault,
 			},
 		},
-	})
+	}
 }
 
 func (p *NginxProvisioner) buildImage(nProxyCfg *graph.EffectiveNginxProxy) (string, corev1.PullPolicy) {
@@ -1985,6 +2122,11 @@ func (p *NginxProvisioner) buildResourcesForInvalidGatewayCleanup(
 
 	// 3. Service
 	objects = append(objects, &corev1.Service{ObjectMeta: baseMeta})
+	if p.cfg.Plus {
+		objects = append(objects, &corev1.Service{
+			ObjectMeta: meta(controller.CreateZoneSyncServiceName(deploymentNSName.Name)),
+		})
+	}
 
 	// 4. HorizontalPodAutoscaler
 	objects = append(objects, &autoscalingv2.HorizontalPodAutoscaler{ObjectMeta: baseMeta})
@@ -171,6 +171,8 @@ func TestBuildNginxResourceObjects(t *testing.T) {
 		},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -291,6 +293,21 @@ func TestBuildNginxResourceObjects(t *testing.T) {
 
 	g.Expect(container.Image).To(Equal(fmt.Sprintf("%s:1.0.0", defaultNginxImagePath)))
 	g.Expect(container.ImagePullPolicy).To(Equal(defaultImagePullPolicy))
+	g.Expect(container.Env).To(ContainElement(corev1.EnvVar{
+		Name: "NODE_NAME",
+		ValueFrom: &corev1.EnvVarSource{
+			FieldRef: &corev1.ObjectFieldSelector{FieldPath: "spec.nodeName"},
+		},
+	}))
+
+	g.Expect(container.VolumeMounts).To(ContainElement(corev1.VolumeMount{
+		MountPath: "/var/cache/nginx/proxy-cache",
+		Name:      "nginx-proxy-cache",
+	}))
+	g.Expect(template.Spec.Volumes).To(ContainElement(corev1.Volume{
+		Name:         "nginx-proxy-cache",
+		VolumeSource: emptyDirVolumeSource,
+	}))
 
 	g.Expect(template.Spec.InitContainers).To(HaveLen(1))
 	initContainer := template.Spec.InitContainers[0]
@@ -419,6 +436,8 @@ func TestBuildNginxResourceObjects_ListenerSetPorts(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		allListeners,
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -556,6 +575,8 @@ func TestBuildNginxResourceObjects_NginxProxyConfig(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -734,6 +755,8 @@ func TestBuildNginxResourceObjects_ExposeHealthcheck(t *testing.T) {
 				test.nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -909,6 +932,8 @@ func TestBuildNginxResourceObjects_DeploymentReplicasFromHPA(t *testing.T) {
 				nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -1011,10 +1036,12 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		true,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
-	g.Expect(objects).To(HaveLen(9))
+	g.Expect(objects).To(HaveLen(10))
 
 	expLabels := map[string]string{
 		"label":                                  "value",
@@ -1073,7 +1100,29 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 	g.Expect(cm.Data[configmaps.AgentConfKey]).To(ContainSubstring("- certificates"))
 	g.Expect(cm.Data[configmaps.AgentConfKey]).To(ContainSubstring("- api-action"))
 
-	depObj := objects[8]
+	svcObj := objects[7]
+	svc, ok := svcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+
+	zoneSyncSvcObj := objects[8]
+	zoneSyncSvc, ok := zoneSyncSvcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(zoneSyncSvc.Name).To(Equal(controller.CreateZoneSyncServiceName(svc.Name)))
+	g.Expect(zoneSyncSvc.Labels).To(Equal(svc.Labels))
+	g.Expect(zoneSyncSvc.Spec.ClusterIP).To(Equal(corev1.ClusterIPNone))
+	g.Expect(zoneSyncSvc.Spec.PublishNotReadyAddresses).To(BeTrue())
+	g.Expect(zoneSyncSvc.Spec.Selector).To(Equal(svc.Spec.Selector))
+	g.Expect(zoneSyncSvc.Spec.Ports).To(Equal([]corev1.ServicePort{
+		{
+			Name:       "zone-sync",
+			Port:       dataplane.ZoneSyncPort,
+			TargetPort: intstr.FromInt32(dataplane.ZoneSyncPort),
+			Protocol:   corev1.ProtocolTCP,
+		},
+	}))
+	g.Expect(zoneSyncSvc.OwnerReferences).To(HaveLen(1))
+
+	depObj := objects[9]
 	dep, ok := depObj.(*appsv1.Deployment)
 	g.Expect(ok).To(BeTrue())
 
@@ -1092,6 +1141,23 @@ func TestBuildNginxResourceObjects_Plus(t *testing.T) {
 		MountPath: "/etc/nginx/certs-bootstrap/",
 	}))
 	g.Expect(container.Image).To(Equal(fmt.Sprintf("%s:1.0.0", defaultNginxPlusImagePath)))
+
+	// the zone sync Service is only built when the replicas need to synchronize their zones
+	objects, err = provisioner.buildNginxResourceObjects(
+		resourceName,
+		gateway,
+		&graph.EffectiveNginxProxy{},
+		graphListenersFromGateway(gateway),
+		nil,
+		false,
+		false,
+	)
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(objects).To(HaveLen(9))
+	for _, obj := range objects {
+		g.Expect(obj.GetName()).ToNot(Equal(controller.CreateZoneSyncServiceName(svc.Name)))
+	}
 }
 
 func TestBuildNginxResourceObjects_DockerSecrets(t *testing.T) {
@@ -1167,6 +1233,8 @@ func TestBuildNginxResourceObjects_DockerSecrets(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1302,6 +1370,8 @@ func TestBuildNginxResourceObjects_DaemonSet(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1389,6 +1459,8 @@ func TestBuildNginxResourceObjects_OpenShift(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -1472,6 +1544,8 @@ func TestBuildNginxResourceObjects_DataplaneKeySecret(t *testing.T) {
 		&graph.EffectiveNginxProxy{},
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(7)) // 2 secrets, 2 configmaps, serviceaccount, service, deployment
@@ -1637,7 +1711,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 
 	objects := provisioner.buildResourcesForInvalidGatewayCleanup(deploymentNSName)
 
-	g.Expect(objects).To(HaveLen(13))
+	g.Expect(objects).To(HaveLen(14))
 
 	validateMeta := func(obj client.Object, name string) {
 		g.Expect(obj.GetName()).To(Equal(name))
@@ -1659,32 +1733,37 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svc, deploymentNSName.Name)
 
-	hpaObj := objects[3]
+	zoneSyncSvcObj := objects[3]
+	zoneSyncSvc, ok := zoneSyncSvcObj.(*corev1.Service)
+	g.Expect(ok).To(BeTrue())
+	validateMeta(zoneSyncSvc, controller.CreateZoneSyncServiceName(deploymentNSName.Name))
+
+	hpaObj := objects[4]
 	hpa, ok := hpaObj.(*autoscalingv2.HorizontalPodAutoscaler)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(hpa, deploymentNSName.Name)
 
-	pdbObj := objects[4]
+	pdbObj := objects[5]
 	pdb, ok := pdbObj.(*policyv1.PodDisruptionBudget)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(pdb, deploymentNSName.Name)
 
-	svcAcctObj := objects[5]
+	svcAcctObj := objects[6]
 	svcAcct, ok := svcAcctObj.(*corev1.ServiceAccount)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(svcAcct, deploymentNSName.Name)
 
-	cmObj := objects[6]
+	cmObj := objects[7]
 	cm, ok := cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxIncludesConfigMapNameSuffix))
 
-	cmObj = objects[7]
+	cmObj = objects[8]
 	cm, ok = cmObj.(*corev1.ConfigMap)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(cm, controller.CreateNginxResourceName(deploymentNSName.Name, nginxAgentConfigMapNameSuffix))
 
-	secretObj := objects[8]
+	secretObj := objects[9]
 	secret, ok := secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1692,7 +1771,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.AgentTLSSecretName,
 	))
 
-	secretObj = objects[9]
+	secretObj = objects[10]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1700,7 +1779,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.NginxDockerSecretNames[0],
 	))
 
-	secretObj = objects[10]
+	secretObj = objects[11]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -1708,7 +1787,7 @@ func TestBuildResourcesForInvalidGatewayCleanup_Plus(t *testing.T) {
 		provisioner.cfg.PlusUsageConfig.CASecretName,
 	))
 
-	secretObj = objects[11]
+	secretObj = objects[12]
 	secret, ok = secretObj.(*corev1.Secret)
 	g.Expect(ok).To(BeTrue())
 	validateMeta(secret, controller.CreateNginxResourceName(
@@ -2476,6 +2555,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2527,6 +2608,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2564,6 +2647,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2611,6 +2696,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("failed to apply service patches"))
@@ -2639,6 +2726,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).To(HaveOccurred())
 	g.Expect(err.Error()).To(ContainSubstring("unsupported patch type"))
@@ -2670,6 +2759,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2716,6 +2807,8 @@ func TestBuildNginxResourceObjects_Patches(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).To(HaveLen(6))
@@ -2802,6 +2895,8 @@ func TestBuildNginxResourceObjects_InferenceExtension(t *testing.T) {
 		npCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -2823,6 +2918,135 @@ func TestBuildNginxResourceObjects_InferenceExtension(t *testing.T) {
 	g.Expect(containers[1].Resources.Limits).To(HaveKeyWithValue(corev1.ResourceCPU, resource.MustParse("500m")))
 }
 
+func TestBuildNginxResourceObjects_ExtAuthz(t *testing.T) {
+	t.Parallel()
+
+	nginxResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+	}
+	extAuthzResources := &corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("50m")},
+	}
+
+	tests := []struct {
+		nProxyCfg         *graph.EffectiveNginxProxy
+		name              string
+		expResources      corev1.ResourceRequirements
+		extAuthzShim      bool
+		expExtAuthzShimOn bool
+	}{
+		{
+			name:      "no ExternalAuth filter with the GRPC protocol",
+			nProxyCfg: &graph.EffectiveNginxProxy{},
+		},
+		{
+			name:              "ExternalAuth filter with the GRPC protocol",
+			nProxyCfg:         &graph.EffectiveNginxProxy{},
+			extAuthzShim:      true,
+			expExtAuthzShimOn: true,
+		},
+		{
+			name: "ext-authz container resources are not copied from the nginx container",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Container: ngfAPIv1alpha2.ContainerSpec{Resources: nginxResources},
+					},
+				},
+			},
+			extAuthzShim:      true,
+			expExtAuthzShimOn: true,
+		},
+		{
+			name: "ext-authz container resources",
+			nProxyCfg: &graph.EffectiveNginxProxy{
+				Kubernetes: &ngfAPIv1alpha2.KubernetesSpec{
+					Deployment: &ngfAPIv1alpha2.DeploymentSpec{
+						Container: ngfAPIv1alpha2.ContainerSpec{Resources: nginxResources},
+						ExtAuthzContainer: &ngfAPIv1alpha2.SidecarContainerSpec{
+							Resources: extAuthzResources,
+						},
+					},
+				},
+			},
+			extAuthzShim:      true,
+			expExtAuthzShimOn: true,
+			expResources:      *extAuthzResources,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			agentTLSSecret := &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      agentTLSTestSecretName,
+					Namespace: ngfNamespace,
+				},
+				Data: map[string][]byte{secrets.TLSCertKey: []byte("tls")},
+			}
+			fakeClient := createFakeClientWithScheme(agentTLSSecret)
+
+			provisioner := &NginxProvisioner{
+				cfg: Config{
+					GatewayPodConfig: &config.GatewayPodConfig{
+						Namespace: ngfNamespace,
+					},
+					AgentTLSSecretName:   agentTLSTestSecretName,
+					ExperimentalFeatures: true,
+					AgentLabels:          make(map[string]string),
+				},
+				k8sClient: fakeClient,
+				baseLabelSelector: metav1.LabelSelector{
+					MatchLabels: map[string]string{"app": "nginx"},
+				},
+			}
+
+			gateway := &gatewayv1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "gw",
+					Namespace: "default",
+				},
+				Spec: gatewayv1.GatewaySpec{
+					Listeners: []gatewayv1.Listener{{Port: 80}},
+				},
+			}
+
+			objects, err := provisioner.buildNginxResourceObjects(
+				"gw-nginx",
+				gateway,
+				test.nProxyCfg,
+				graphListenersFromGateway(gateway),
+				nil,
+				false,
+				test.extAuthzShim,
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+
+			deployment := findDeployment(objects)
+			g.Expect(deployment).ToNot(BeNil())
+
+			containers := deployment.Spec.Template.Spec.Containers
+			if !test.expExtAuthzShimOn {
+				g.Expect(containers).To(HaveLen(1))
+				return
+			}
+
+			g.Expect(containers).To(HaveLen(2))
+			g.Expect(containers[1].Name).To(Equal("ext-authz-shim"))
+			g.Expect(containers[1].Command).To(Equal([]string{"/usr/bin/gateway", "ext-authz"}))
+			g.Expect(containers[1].Resources).To(Equal(test.expResources))
+			g.Expect(containers[1].VolumeMounts).To(ConsistOf(corev1.VolumeMount{
+				MountPath: "/etc/nginx/secrets",
+				Name:      "nginx-secrets",
+				ReadOnly:  true,
+			}))
+		})
+	}
+}
+
 func TestOwnerReferencesAreSet(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -2937,6 +3161,8 @@ func TestOwnerReferencesAreSet(t *testing.T) {
 		elbWithGatewayLink(&ngfAPIv1alpha1.GatewayLinkConfig{
 			VirtualServerAddress: helpers.GetPointer("10.0.0.1"),
 		}),
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 	g.Expect(objects).ToNot(BeEmpty())
@@ -3062,6 +3288,8 @@ func TestBuildNginxResourceObjects_LoadBalancerClass(t *testing.T) {
 				test.nProxyCfg,
 				graphListenersFromGateway(gateway),
 				nil,
+				false,
+				false,
 			)
 			g.Expect(err).ToNot(HaveOccurred())
 
@@ -3179,6 +3407,8 @@ func TestBuildNginxResourceObjects_WAF(t *testing.T) {
 		nProxyCfg,
 		graphListenersFromGateway(gateway),
 		nil,
+		false,
+		false,
 	)
 	g.Expect(err).ToNot(HaveOccurred())
 
@@ -71,6 +71,7 @@ type Config struct {
 	NginxOneConsoleTelemetryConfig config.NginxOneConsoleTelemetryConfig
 	Plus                           bool
 	InferenceExtension             bool
+	ExperimentalFeatures           bool
 	EndpointPickerDisableTLS       bool
 	EndpointPickerTLSSkipVerify    bool
 	ExternalLoadBalancer           bool
@@ -673,6 +674,8 @@ func (p *NginxProvisioner) reprovisionNginx(
 	nProxyCfg *graph.EffectiveNginxProxy,
 	allListeners []*graph.Listener,
 	elb *ngfAPIv1alpha1.ExternalLoadBalancer,
+	zoneSync bool,
+	extAuthzShim bool,
 ) error {
 	if !p.isLeader() {
 		return nil
@@ -680,7 +683,15 @@ func (p *NginxProvisioner) reprovisionNginx(
 	if len(allListeners) == 0 {
 		return nil
 	}
-	objects, err := p.buildNginxResourceObjects(resourceName, gateway, nProxyCfg, allListeners, elb)
+	objects, err := p.buildNginxResourceObjects(
+		resourceName,
+		gateway,
+		nProxyCfg,
+		allListeners,
+		elb,
+		zoneSync,
+		extAuthzShim,
+	)
 	if err != nil {
 		p.cfg.Logger.Error(err, "error provisioning some nginx resources")
 	}
@@ -833,6 +844,8 @@ func (p *NginxProvisioner) RegisterGateway(
 			gateway.EffectiveNginxProxy,
 			gateway.Listeners,
 			extractExternalLoadBalancer(gateway),
+			gateway.ZoneSync,
+			gateway.ExtAuthzShim,
 		)
 		if err != nil {
 			p.cfg.Logger.Error(err, "error building some nginx resources")
@@ -840,6 +853,7 @@ func (p *NginxProvisioner) RegisterGateway(
 
 		// If NGINX deployment type switched between Deployment and DaemonSet, clean up the old one.
 		// If HPA was disabled, remove it.
+		// If zone sync is no longer needed, remove its Service.
 		nginxResources := p.store.getNginxResourcesForGateway(gatewayNSName)
 		if nginxResources != nil {
 			p.handleObjectDeletion(ctx, nginxResources)
@@ -880,6 +894,12 @@ func (p *NginxProvisioner) handleObjectDeletion(ctx context.Context, nginxResour
 		}
 	}
 
+	if needToDeleteZoneSyncService(nginxResources) {
+		if err := p.deleteObject(ctx, &corev1.Service{ObjectMeta: nginxResources.ZoneSyncService}); err != nil {
+			p.cfg.Logger.Error(err, "error deleting nginx resource")
+		}
+	}
+
 	if p.needToDeleteIngressLink(nginxResources) {
 		il := &unstructured.Unstructured{}
 		il.SetGroupVersionKind(kinds.IngressLinkGVK)
@@ -1082,6 +1102,12 @@ func needToDeleteHPA(cfg *NginxResources) bool {
 	return false
 }
 
+// needToDeleteZoneSyncService returns true if a zone sync Service was previously created for this Gateway
+// but the NGINX replicas no longer need to synchronize their shared memory zones.
+func needToDeleteZoneSyncService(cfg *NginxResources) bool {
+	return cfg.ZoneSyncService.Name != "" && cfg.Gateway != nil && !cfg.Gateway.ZoneSync
+}
+
 // needToDeleteIngressLink returns true if an IngressLink was previously provisioned for this Gateway
 // but its ExternalLoadBalancer is no longer attached, and therefore the IngressLink should be deleted.
 // The IngressLink is owned by the Gateway, so it is not garbage collected when only the
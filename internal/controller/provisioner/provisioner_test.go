@@ -699,6 +699,46 @@ func TestRegisterGateway_CleansUpOldPDB(t *testing.T) {
 	g.Expect(pdbErr).To(HaveOccurred())
 }
 
+func TestRegisterGateway_CleansUpOldZoneSyncService(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	oldZoneSyncService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      controller.CreateZoneSyncServiceName("gw-nginx"),
+			Namespace: "default",
+		},
+	}
+	gateway := &graph.Gateway{
+		Source: &gatewayv1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "gw",
+				Namespace: "default",
+			},
+		},
+		Listeners: []*graph.Listener{
+			{},
+		},
+		Valid: true,
+		// ZoneSync is false: the global rate limits were removed while the Gateway remains.
+	}
+
+	provisioner, fakeClient, _ := defaultNginxProvisioner(gateway.Source, oldZoneSyncService)
+	provisioner.cfg.Plus = true
+	provisioner.store.nginxResources[types.NamespacedName{Name: "gw", Namespace: "default"}] = &NginxResources{
+		ZoneSyncService: oldZoneSyncService.ObjectMeta,
+	}
+
+	g.Expect(provisioner.RegisterGateway(t.Context(), gateway, "gw-nginx")).To(Succeed())
+
+	svcErr := fakeClient.Get(
+		t.Context(),
+		client.ObjectKeyFromObject(oldZoneSyncService),
+		&corev1.Service{},
+	)
+	g.Expect(svcErr).To(HaveOccurred())
+}
+
 func TestRegisterGateway_CleansUpLingeringIngressLink(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -825,7 +865,7 @@ func TestNonLeaderProvisioner(t *testing.T) {
 	g.Expect(provisioner.provisionNginx(t.Context(), "gw-nginx", nil, nil)).To(Succeed())
 	expectResourcesToNotExist(t, g, fakeClient, nsName)
 
-	g.Expect(provisioner.reprovisionNginx(t.Context(), "gw-nginx", nil, nil, nil, nil)).To(Succeed())
+	g.Expect(provisioner.reprovisionNginx(t.Context(), "gw-nginx", nil, nil, nil, nil, false, false)).To(Succeed())
 	expectResourcesToNotExist(t, g, fakeClient, nsName)
 
 	g.Expect(provisioner.deprovisionNginxForInvalidGateway(t.Context(), nsName)).To(Succeed())
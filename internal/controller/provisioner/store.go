@@ -29,6 +29,7 @@ type NginxResources struct {
 	DaemonSet            metav1.ObjectMeta
 	Service              metav1.ObjectMeta
 	ServiceLBClass       *string
+	ZoneSyncService      metav1.ObjectMeta
 	ServiceAccount       metav1.ObjectMeta
 	Role                 metav1.ObjectMeta
 	RoleBinding          metav1.ObjectMeta
@@ -151,6 +152,10 @@ func (s *store) registerResourceInGatewayConfig(gatewayNSName types.NamespacedNa
 		s.getOrCreateNginxResources(gatewayNSName).DaemonSet = obj.ObjectMeta
 	case *corev1.Service:
 		res := s.getOrCreateNginxResources(gatewayNSName)
+		if isZoneSyncService(obj) {
+			res.ZoneSyncService = obj.ObjectMeta
+			break
+		}
 		res.Service = obj.ObjectMeta
 		res.ServiceLBClass = obj.Spec.LoadBalancerClass
 	case *corev1.ServiceAccount:
@@ -288,6 +293,16 @@ func gatewayChanged(original, updated *graph.Gateway) bool {
 		return true
 	}
 
+	// The zone sync Service is only provisioned when the NGINX replicas need to synchronize their zones.
+	if original.ZoneSync != updated.ZoneSync {
+		return true
+	}
+
+	// The ext_authz shim sidecar is only added when a route of the Gateway needs it.
+	if original.ExtAuthzShim != updated.ExtAuthzShim {
+		return true
+	}
+
 	// The IngressLink is built from an ExternalLoadBalancer resource attached to the Gateway,
 	// so a change to the attached gatewayLink config must trigger a rebuild.
 	if !reflect.DeepEqual(extractExternalLoadBalancer(original), extractExternalLoadBalancer(updated)) {
@@ -363,6 +378,12 @@ func (s *store) clearServiceForGateway(gatewayNSName types.NamespacedName) {
 	}
 }
 
+// isZoneSyncService returns whether the Service is the headless Service used by the NGINX Plus replicas
+// to synchronize their shared memory zones. The Gateway Service is never headless.
+func isZoneSyncService(svc *corev1.Service) bool {
+	return svc.Spec.ClusterIP == corev1.ClusterIPNone
+}
+
 func (s *store) gatewayExistsForResource(object client.Object, nsName types.NamespacedName) *graph.Gateway {
 	s.lock.RLock()
 	defer s.lock.RUnlock()
@@ -389,7 +410,7 @@ func (r *NginxResources) matchesObject(object client.Object, nsName types.Namesp
 	case *appsv1.DaemonSet:
 		return resourceMatches(r.DaemonSet, nsName)
 	case *corev1.Service:
-		return resourceMatches(r.Service, nsName)
+		return resourceMatches(r.Service, nsName) || resourceMatches(r.ZoneSyncService, nsName)
 	case *corev1.ServiceAccount:
 		return resourceMatches(r.ServiceAccount, nsName)
 	case *rbacv1.Role:
@@ -456,6 +477,9 @@ func (s *store) getResourceVersionForObject(gatewayNSName types.NamespacedName,
 	case *appsv1.DaemonSet:
 		return resourceVersionIfNameMatches(resources.DaemonSet, obj.GetName())
 	case *corev1.Service:
+		if isZoneSyncService(obj) {
+			return resourceVersionIfNameMatches(resources.ZoneSyncService, obj.GetName())
+		}
 		return resourceVersionIfNameMatches(resources.Service, obj.GetName())
 	case *corev1.ServiceAccount:
 		return resourceVersionIfNameMatches(resources.ServiceAccount, obj.GetName())
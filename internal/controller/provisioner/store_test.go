@@ -184,6 +184,15 @@ func TestRegisterResourceInGatewayConfig(t *testing.T) {
 	resources = registerAndGetResources(svc)
 	g.Expect(resources.Service).To(Equal(defaultMeta))
 
+	// zone sync Service
+	zoneSyncSvc := &corev1.Service{
+		ObjectMeta: defaultMeta,
+		Spec:       corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone},
+	}
+	resources = registerAndGetResources(zoneSyncSvc)
+	g.Expect(resources.ZoneSyncService).To(Equal(defaultMeta))
+	g.Expect(resources.Service).To(Equal(defaultMeta))
+
 	// clear out resources before next test
 	store.deleteResourcesForGateway(nsName)
 
@@ -421,6 +430,18 @@ func TestGatewayChanged(t *testing.T) {
 			updated:  &graph.Gateway{Valid: false},
 			changed:  true,
 		},
+		{
+			name:     "zone sync changes",
+			original: &graph.Gateway{ZoneSync: false},
+			updated:  &graph.Gateway{ZoneSync: true},
+			changed:  true,
+		},
+		{
+			name:     "ext authz shim changes",
+			original: &graph.Gateway{ExtAuthzShim: false},
+			updated:  &graph.Gateway{ExtAuthzShim: true},
+			changed:  true,
+		},
 		{
 			name: "source changes",
 			original: &graph.Gateway{Source: &gatewayv1.Gateway{
@@ -885,6 +906,11 @@ func TestGetResourceVersionForObject(t *testing.T) {
 			Namespace:       "default",
 			ResourceVersion: "3",
 		},
+		ZoneSyncService: metav1.ObjectMeta{
+			Name:            "test-service-zone-sync",
+			Namespace:       "default",
+			ResourceVersion: "3a",
+		},
 		ServiceAccount: metav1.ObjectMeta{
 			Name:            "test-serviceaccount",
 			Namespace:       "default",
@@ -989,6 +1015,17 @@ func TestGetResourceVersionForObject(t *testing.T) {
 			},
 			expectedResult: "3",
 		},
+		{
+			name: "zone sync Service resource version",
+			object: &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "test-service-zone-sync",
+					Namespace: "default",
+				},
+				Spec: corev1.ServiceSpec{ClusterIP: corev1.ClusterIPNone},
+			},
+			expectedResult: "3a",
+		},
 		{
 			name: "ServiceAccount resource version",
 			object: &corev1.ServiceAccount{
@@ -0,0 +1,511 @@
+package controller
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/types"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	inference "sigs.k8s.io/gateway-api-inference-extension/api/v1"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/agent"
+	ngxcfg "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	ngxvalidation "github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/status"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// RenderConfig holds the configuration for rendering nginx configuration from Kubernetes manifests,
+// without a running cluster.
+type RenderConfig struct {
+	// Logger is the logger for rendering.
+	Logger logr.Logger
+	// GatewayCtlrName is the name of the Gateway controller.
+	GatewayCtlrName string
+	// GatewayClassName is the name of the GatewayClass of the rendered Gateways.
+	GatewayClassName string
+	// ClusterDomain is the DNS domain of the cluster.
+	ClusterDomain string
+	// ZoneSyncResolver is the nameserver that NGINX Plus uses to find the other replicas of a Gateway.
+	ZoneSyncResolver string
+	// ClusterIPFamily is the IP family of the cluster.
+	ClusterIPFamily ngfAPIv1alpha2.IPFamilyType
+	// Objects are the Kubernetes resources to render the configuration for.
+	Objects []client.Object
+	// Plus indicates if NGINX Plus is being used.
+	Plus bool
+	// ExperimentalFeatures indicates if experimental features are enabled.
+	ExperimentalFeatures bool
+	// Snippets indicates if SnippetsFilters and SnippetsPolicies are enabled.
+	Snippets bool
+	// PayloadProcessor indicates if PayloadProcessors are enabled.
+	PayloadProcessor bool
+}
+
+// RenderedGateway is the nginx configuration rendered for a Gateway.
+type RenderedGateway struct {
+	// NsName is the namespace and name of the Gateway.
+	NsName types.NamespacedName
+	// Files are the generated nginx configuration files.
+	Files []agent.File
+}
+
+// RenderedStatus is the status that would be written to a resource.
+type RenderedStatus struct {
+	// Status is the status of the resource.
+	Status any `json:"status"`
+	// Kind is the kind of the resource.
+	Kind string `json:"kind"`
+	// Namespace is the namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+	// Name is the name of the resource.
+	Name string `json:"name"`
+}
+
+// RenderResult is the result of rendering nginx configuration.
+type RenderResult struct {
+	// Gateways are the rendered Gateways, sorted by namespace and name.
+	Gateways []RenderedGateway
+	// Statuses are the statuses that would be written to the resources, sorted by kind, namespace, and name.
+	Statuses []RenderedStatus
+}
+
+// DecodeManifests decodes the Kubernetes resources in the YAML or JSON manifests read from r.
+// Multiple resources can be separated with "---". Kinds that are not registered with NGINX Gateway Fabric
+// return an error.
+func DecodeManifests(r io.Reader) ([]client.Object, error) {
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+	reader := utilyaml.NewYAMLReader(bufio.NewReader(r))
+
+	var objects []client.Object
+	for {
+		doc, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return objects, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("error reading manifest: %w", err)
+		}
+
+		if isEmptyManifest(doc) {
+			continue
+		}
+
+		obj, _, err := decoder.Decode(doc, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding manifest: %w", err)
+		}
+
+		if list, ok := obj.(*apiv1.List); ok {
+			for _, item := range list.Items {
+				itemObj, _, err := decoder.Decode(item.Raw, nil, nil)
+				if err != nil {
+					return nil, fmt.Errorf("error decoding list item: %w", err)
+				}
+				clientObj, ok := itemObj.(client.Object)
+				if !ok {
+					return nil, fmt.Errorf("unexpected object type %T", itemObj)
+				}
+				objects = append(objects, clientObj)
+			}
+			continue
+		}
+
+		clientObj, ok := obj.(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected object type %T", obj)
+		}
+		objects = append(objects, clientObj)
+	}
+}
+
+// Render builds the graph from the provided Kubernetes resources, and generates the nginx configuration for
+// every Gateway, along with the statuses that would be written to the resources.
+// If no GatewayClass is provided, one with the configured name and controller name is assumed to exist.
+func Render(ctx context.Context, cfg RenderConfig) (RenderResult, error) {
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+
+	state, endpointSlices := buildRenderClusterState(cfg, mustExtractGVK)
+
+	genericValidator := ngxvalidation.GenericValidator{}
+	policyManager := createPolicyManager(mustExtractGVK, genericValidator, config.Config{
+		Plus:             cfg.Plus,
+		Snippets:         cfg.Snippets,
+		PayloadProcessor: cfg.PayloadProcessor,
+	})
+
+	gr := graph.BuildGraph(
+		ctx,
+		state,
+		cfg.GatewayCtlrName,
+		cfg.GatewayClassName,
+		cfg.ClusterDomain,
+		nil, // plusSecrets
+		nil, // wafFetcher
+		nil, // plmFetcher
+		nil, // plmSecretNames
+		nil, // previousWAFBundles
+		validation.Validators{
+			HTTPFieldsValidator: ngxvalidation.HTTPValidator{},
+			GenericValidator:    genericValidator,
+			AuthFieldsValidator: ngxvalidation.AuthFieldValidator{},
+			PolicyValidator:     policyManager,
+		},
+		cfg.Logger.WithName("graph"),
+		graph.FeatureFlags{
+			Plus:         cfg.Plus,
+			Experimental: cfg.ExperimentalFeatures,
+		},
+	)
+
+	serviceResolver := resolver.NewServiceResolverImpl(endpointSliceReader{endpointSlices: endpointSlices})
+	generator := ngxcfg.NewGeneratorImpl(cfg.Plus, nil, cfg.Logger.WithName("generator"))
+
+	var result RenderResult
+	for _, nsName := range sortedNsNames(gr.Gateways) {
+		gw := gr.Gateways[nsName]
+
+		conf := dataplane.BuildConfiguration(
+			ctx,
+			cfg.Logger.WithName("dataplane"),
+			gr,
+			gw,
+			serviceResolver,
+			cfg.Plus,
+			cfg.ClusterIPFamily,
+			cfg.ClusterDomain,
+			cfg.ZoneSyncResolver,
+		)
+
+		result.Gateways = append(result.Gateways, RenderedGateway{
+			NsName: nsName,
+			Files:  generator.Generate(conf),
+		})
+	}
+
+	statuses, err := renderStatuses(gr, state, cfg.GatewayCtlrName, cfg.ExperimentalFeatures, mustExtractGVK)
+	if err != nil {
+		return RenderResult{}, err
+	}
+	result.Statuses = statuses
+
+	return result, nil
+}
+
+// buildRenderClusterState sorts the provided resources into a ClusterState. EndpointSlices are returned
+// separately, since they are only used to resolve the endpoints of Services.
+func buildRenderClusterState(
+	cfg RenderConfig,
+	mustExtractGVK kinds.MustExtractGVK,
+) (graph.ClusterState, []discoveryV1.EndpointSlice) {
+	state := graph.ClusterState{
+		GatewayClasses:        make(map[types.NamespacedName]*gatewayv1.GatewayClass),
+		Gateways:              make(map[types.NamespacedName]*gatewayv1.Gateway),
+		HTTPRoutes:            make(map[types.NamespacedName]*gatewayv1.HTTPRoute),
+		TLSRoutes:             make(map[types.NamespacedName]*gatewayv1.TLSRoute),
+		TCPRoutes:             make(map[types.NamespacedName]*gatewayv1.TCPRoute),
+		UDPRoutes:             make(map[types.NamespacedName]*gatewayv1.UDPRoute),
+		Services:              make(map[types.NamespacedName]*apiv1.Service),
+		Namespaces:            make(map[types.NamespacedName]*apiv1.Namespace),
+		ReferenceGrants:       make(map[types.NamespacedName]*gatewayv1.ReferenceGrant),
+		Secrets:               make(map[types.NamespacedName]*apiv1.Secret),
+		BackendTLSPolicies:    make(map[types.NamespacedName]*gatewayv1.BackendTLSPolicy),
+		ConfigMaps:            make(map[types.NamespacedName]*apiv1.ConfigMap),
+		NginxProxies:          make(map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy),
+		GRPCRoutes:            make(map[types.NamespacedName]*gatewayv1.GRPCRoute),
+		NGFPolicies:           make(map[graph.PolicyKey]policies.Policy),
+		SnippetsFilters:       make(map[types.NamespacedName]*ngfAPIv1alpha1.SnippetsFilter),
+		AuthenticationFilters: make(map[types.NamespacedName]*ngfAPIv1alpha1.AuthenticationFilter),
+		InferencePools:        make(map[types.NamespacedName]*inference.InferencePool),
+		ListenerSets:          make(map[types.NamespacedName]*gatewayv1.ListenerSet),
+		ExternalLoadBalancer:  make(map[types.NamespacedName]*ngfAPIv1alpha1.ExternalLoadBalancer),
+		CRDMetadata:           make(map[types.NamespacedName]*metav1.PartialObjectMetadata),
+		ServiceImports:        make(map[types.NamespacedName]*unstructured.Unstructured),
+	}
+
+	var endpointSlices []discoveryV1.EndpointSlice
+
+	ignore := func(obj client.Object, nsName types.NamespacedName) {
+		cfg.Logger.Info(
+			"Ignoring resource of unsupported kind",
+			"kind", fmt.Sprintf("%T", obj),
+			"namespace", nsName.Namespace,
+			"name", nsName.Name,
+		)
+	}
+
+	for _, obj := range cfg.Objects {
+		nsName := client.ObjectKeyFromObject(obj)
+
+		switch o := obj.(type) {
+		case *gatewayv1.GatewayClass:
+			state.GatewayClasses[nsName] = o
+		case *gatewayv1.Gateway:
+			state.Gateways[nsName] = o
+		case *gatewayv1.HTTPRoute:
+			state.HTTPRoutes[nsName] = o
+		case *gatewayv1.GRPCRoute:
+			state.GRPCRoutes[nsName] = o
+		case *gatewayv1.TLSRoute:
+			state.TLSRoutes[nsName] = o
+		case *gatewayv1.TCPRoute:
+			state.TCPRoutes[nsName] = o
+		case *gatewayv1.UDPRoute:
+			state.UDPRoutes[nsName] = o
+		case *gatewayv1.ReferenceGrant:
+			state.ReferenceGrants[nsName] = o
+		case *gatewayv1.BackendTLSPolicy:
+			state.BackendTLSPolicies[nsName] = o
+		case *gatewayv1.ListenerSet:
+			state.ListenerSets[nsName] = o
+		case *apiv1.Service:
+			state.Services[nsName] = o
+		case *apiv1.Namespace:
+			state.Namespaces[nsName] = o
+		case *apiv1.Secret:
+			state.Secrets[nsName] = o
+		case *apiv1.ConfigMap:
+			state.ConfigMaps[nsName] = o
+		case *discoveryV1.EndpointSlice:
+			endpointSlices = append(endpointSlices, *o)
+		case *ngfAPIv1alpha2.NginxProxy:
+			state.NginxProxies[nsName] = o
+		case *ngfAPIv1alpha1.SnippetsFilter:
+			state.SnippetsFilters[nsName] = o
+		case *ngfAPIv1alpha1.AuthenticationFilter:
+			state.AuthenticationFilters[nsName] = o
+		case *ngfAPIv1alpha1.ExternalLoadBalancer:
+			state.ExternalLoadBalancer[nsName] = o
+		case *inference.InferencePool:
+			state.InferencePools[nsName] = o
+		case policies.Policy:
+			state.NGFPolicies[graph.PolicyKey{NsName: nsName, GVK: mustExtractGVK(o)}] = o
+		case *apiext.CustomResourceDefinition:
+			// the controller only watches the metadata of CRDs
+			state.CRDMetadata[nsName] = &metav1.PartialObjectMetadata{
+				TypeMeta:   o.TypeMeta,
+				ObjectMeta: o.ObjectMeta,
+			}
+		case *unstructured.Unstructured:
+			if o.GroupVersionKind() != kinds.ServiceImportGVK {
+				ignore(obj, nsName)
+				continue
+			}
+			state.ServiceImports[nsName] = o
+		default:
+			ignore(obj, nsName)
+		}
+	}
+
+	gcNsName := types.NamespacedName{Name: cfg.GatewayClassName}
+	if _, exists := state.GatewayClasses[gcNsName]; !exists {
+		state.GatewayClasses[gcNsName] = &gatewayv1.GatewayClass{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: cfg.GatewayClassName,
+			},
+			Spec: gatewayv1.GatewayClassSpec{
+				ControllerName: gatewayv1.GatewayController(cfg.GatewayCtlrName),
+			},
+		}
+	}
+
+	return state, endpointSlices
+}
+
+// renderStatuses prepares the status of every resource in the graph, and returns the statuses
+// that would be written.
+func renderStatuses(
+	gr *graph.Graph,
+	state graph.ClusterState,
+	gatewayCtlrName string,
+	experimental bool,
+	mustExtractGVK kinds.MustExtractGVK,
+) ([]RenderedStatus, error) {
+	// use a fixed transition time, so that rendered statuses can be compared
+	var transitionTime metav1.Time
+
+	var reqs []status.UpdateRequest
+	reqs = append(reqs, status.PrepareGatewayClassRequests(
+		gr.GatewayClass,
+		gr.IgnoredGatewayClasses,
+		transitionTime,
+		experimental,
+	)...)
+	for _, nsName := range sortedNsNames(gr.Gateways) {
+		reqs = append(reqs, status.PrepareGatewayRequests(
+			gr.Gateways[nsName],
+			transitionTime,
+			nil, // addresses are assigned by the cluster
+			graph.NginxReloadResult{},
+			graph.DataPlaneHealth{},
+		)...)
+	}
+	reqs = append(reqs, status.PrepareRouteRequests(gr.L4Routes, gr.Routes, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareBackendTLSPolicyRequests(gr.BackendTLSPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareNGFPolicyRequests(gr.NGFPolicies, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareSnippetsFilterRequests(gr.SnippetsFilters, transitionTime, gatewayCtlrName)...)
+	reqs = append(reqs, status.PrepareAuthenticationFilterRequests(
+		gr.AuthenticationFilters,
+		transitionTime,
+		gatewayCtlrName,
+	)...)
+	reqs = append(reqs, status.PrepareListenerSetRequests(gr.ListenerSets, transitionTime)...)
+	reqs = append(reqs, status.PrepareExternalLoadBalancerRequests(
+		gr.ExternalLoadBalancers,
+		transitionTime,
+		gatewayCtlrName,
+	)...)
+
+	inferencePools := &inference.InferencePoolList{}
+	for _, nsName := range sortedNsNames(state.InferencePools) {
+		inferencePools.Items = append(inferencePools.Items, *state.InferencePools[nsName])
+	}
+	reqs = append(reqs, status.PrepareInferencePoolRequests(
+		gr.ReferencedInferencePools,
+		inferencePools,
+		gr.Gateways,
+		transitionTime,
+	)...)
+
+	statuses := make([]RenderedStatus, 0, len(reqs))
+	for _, req := range reqs {
+		obj, ok := req.ResourceType.DeepCopyObject().(client.Object)
+		if !ok {
+			return nil, fmt.Errorf("unexpected resource type %T", req.ResourceType)
+		}
+		obj.SetNamespace(req.NsName.Namespace)
+		obj.SetName(req.NsName.Name)
+
+		if !req.Setter(obj) {
+			continue
+		}
+
+		content, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return nil, fmt.Errorf("error converting status of %s: %w", req.NsName, err)
+		}
+
+		statuses = append(statuses, RenderedStatus{
+			Kind:      mustExtractGVK(obj).Kind,
+			Namespace: req.NsName.Namespace,
+			Name:      req.NsName.Name,
+			Status:    content["status"],
+		})
+	}
+
+	slices.SortFunc(statuses, func(a, b RenderedStatus) int {
+		if c := strings.Compare(a.Kind, b.Kind); c != 0 {
+			return c
+		}
+		if c := strings.Compare(a.Namespace, b.Namespace); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return statuses, nil
+}
+
+// endpointSliceReader is a client.Reader that lists EndpointSlices from memory by Service or ServiceImport name,
+// in the same way the controller lists them from its cache.
+type endpointSliceReader struct {
+	endpointSlices []discoveryV1.EndpointSlice
+}
+
+func (r endpointSliceReader) Get(
+	_ context.Context,
+	key client.ObjectKey,
+	_ client.Object,
+	_ ...client.GetOption,
+) error {
+	return apierrors.NewNotFound(discoveryV1.Resource("endpointslices"), key.String())
+}
+
+func (r endpointSliceReader) List(_ context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	sliceList, ok := list.(*discoveryV1.EndpointSliceList)
+	if !ok {
+		return fmt.Errorf("listing %T is not supported", list)
+	}
+
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	indices := index.CreateEndpointSliceFieldIndices()
+
+	var (
+		indexFunc client.IndexerFunc
+		name      string
+	)
+	if listOpts.FieldSelector != nil {
+		for field, fn := range indices {
+			if value, found := listOpts.FieldSelector.RequiresExactMatch(field); found {
+				indexFunc, name = fn, value
+			}
+		}
+	}
+
+	for _, slice := range r.endpointSlices {
+		if listOpts.Namespace != "" && slice.Namespace != listOpts.Namespace {
+			continue
+		}
+
+		if indexFunc != nil && !slices.Contains(indexFunc(&slice), name) {
+			continue
+		}
+
+		sliceList.Items = append(sliceList.Items, slice)
+	}
+
+	return nil
+}
+
+// isEmptyManifest returns true if the manifest contains only whitespace and comments.
+func isEmptyManifest(doc []byte) bool {
+	for _, line := range bytes.Split(doc, []byte("\n")) {
+		line = bytes.TrimSpace(line)
+		if len(line) > 0 && !bytes.HasPrefix(line, []byte("#")) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedNsNames[T any](m map[types.NamespacedName]T) []types.NamespacedName {
+	nsNames := make([]types.NamespacedName, 0, len(m))
+	for nsName := range m {
+		nsNames = append(nsNames, nsName)
+	}
+
+	slices.SortFunc(nsNames, func(a, b types.NamespacedName) int {
+		return strings.Compare(a.String(), b.String())
+	})
+
+	return nsNames
+}
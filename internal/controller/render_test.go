@@ -0,0 +1,409 @@
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+)
+
+const renderTestManifests = `# Gateway and route for the coffee application
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: default
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+  hostnames:
+  - cafe.example.com
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - name: coffee
+      port: 80
+---
+# only a comment
+---
+apiVersion: v1
+kind: List
+items:
+- apiVersion: v1
+  kind: Service
+  metadata:
+    name: coffee
+    namespace: default
+  spec:
+    ports:
+    - name: http
+      port: 80
+      targetPort: 8080
+- apiVersion: discovery.k8s.io/v1
+  kind: EndpointSlice
+  metadata:
+    name: coffee-abc
+    namespace: default
+    labels:
+      kubernetes.io/service-name: coffee
+  addressType: IPv4
+  ports:
+  - name: http
+    port: 8080
+  endpoints:
+  - addresses:
+    - 10.0.0.1
+    conditions:
+      ready: true
+---
+apiVersion: v1
+kind: Pod
+metadata:
+  name: coffee
+  namespace: default
+`
+
+func TestDecodeManifests(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		manifests string
+		expErr    string
+		expKinds  []string
+	}{
+		{
+			name:      "multiple documents, comments, and lists",
+			manifests: renderTestManifests,
+			expKinds:  []string{"*v1.Gateway", "*v1.HTTPRoute", "*v1.Service", "*v1.EndpointSlice", "*v1.Pod"},
+		},
+		{
+			name:      "empty",
+			manifests: "",
+		},
+		{
+			name:      "unregistered kind",
+			manifests: "apiVersion: example.com/v1\nkind: Unknown\nmetadata:\n  name: unknown\n",
+			expErr:    "error decoding manifest",
+		},
+		{
+			name:      "invalid manifest",
+			manifests: "apiVersion: v1\nkind: Service\nspec: [\n",
+			expErr:    "error decoding manifest",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			objects, err := DecodeManifests(strings.NewReader(test.manifests))
+			if test.expErr != "" {
+				g.Expect(err).To(MatchError(ContainSubstring(test.expErr)))
+				return
+			}
+			g.Expect(err).ToNot(HaveOccurred())
+
+			kinds := make([]string, 0, len(objects))
+			for _, obj := range objects {
+				kinds = append(kinds, fmt.Sprintf("%T", obj))
+			}
+			g.Expect(kinds).To(ConsistOf(test.expKinds))
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	objects, err := DecodeManifests(strings.NewReader(renderTestManifests))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	result, err := Render(context.Background(), RenderConfig{
+		Logger:           logr.Discard(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		ClusterDomain:    "cluster.local",
+		ClusterIPFamily:  "dual",
+		Objects:          objects,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(result.Gateways).To(HaveLen(1))
+	gw := result.Gateways[0]
+	g.Expect(gw.NsName).To(Equal(types.NamespacedName{Namespace: "default", Name: "gateway"}))
+
+	var httpConf string
+	for _, f := range gw.Files {
+		if f.Meta.GetName() == "/etc/nginx/conf.d/http.conf" {
+			httpConf = string(f.Contents)
+		}
+	}
+	g.Expect(httpConf).To(ContainSubstring("server_name cafe.example.com;"))
+	g.Expect(httpConf).To(ContainSubstring("location /coffee/"))
+	g.Expect(httpConf).To(ContainSubstring("server 10.0.0.1:8080;"))
+
+	statusKinds := make([]string, 0, len(result.Statuses))
+	for _, s := range result.Statuses {
+		statusKinds = append(statusKinds, s.Kind+"/"+s.Namespace+"/"+s.Name)
+		g.Expect(s.Status).ToNot(BeNil())
+	}
+	g.Expect(statusKinds).To(Equal([]string{
+		"Gateway/default/gateway",
+		"GatewayClass//nginx",
+		"HTTPRoute/default/coffee",
+	}))
+}
+
+func TestRender_NoGateways(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	gc := &gatewayv1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-class"},
+		Spec: gatewayv1.GatewayClassSpec{
+			ControllerName: "gateway.nginx.org/nginx-gateway-controller",
+			Description:    helpers.GetPointer("provided GatewayClass"),
+		},
+	}
+
+	result, err := Render(context.Background(), RenderConfig{
+		Logger:           logr.Discard(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "my-class",
+		ClusterDomain:    "cluster.local",
+		Objects:          []client.Object{gc},
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+	g.Expect(result.Gateways).To(BeEmpty())
+	g.Expect(result.Statuses).To(HaveLen(1))
+	g.Expect(result.Statuses[0].Kind).To(Equal("GatewayClass"))
+	g.Expect(result.Statuses[0].Name).To(Equal("my-class"))
+}
+
+const renderServiceImportManifests = `apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: gatewayclasses.gateway.networking.k8s.io
+  annotations:
+    gateway.networking.k8s.io/bundle-version: v1.0.0
+spec:
+  group: gateway.networking.k8s.io
+  names:
+    kind: GatewayClass
+    plural: gatewayclasses
+  scope: Cluster
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: Gateway
+metadata:
+  name: gateway
+  namespace: default
+spec:
+  gatewayClassName: nginx
+  listeners:
+  - name: http
+    port: 80
+    protocol: HTTP
+---
+apiVersion: gateway.networking.k8s.io/v1
+kind: HTTPRoute
+metadata:
+  name: coffee
+  namespace: default
+spec:
+  parentRefs:
+  - name: gateway
+  rules:
+  - matches:
+    - path:
+        type: PathPrefix
+        value: /coffee
+    backendRefs:
+    - group: multicluster.x-k8s.io
+      kind: ServiceImport
+      name: coffee
+      port: 80
+---
+apiVersion: multicluster.x-k8s.io/v1alpha1
+kind: ServiceImport
+metadata:
+  name: coffee
+  namespace: default
+spec:
+  type: ClusterSetIP
+  ports:
+  - name: http
+    port: 80
+    protocol: TCP
+---
+apiVersion: discovery.k8s.io/v1
+kind: EndpointSlice
+metadata:
+  name: coffee-imported
+  namespace: default
+  labels:
+    kubernetes.io/service-name: derived-coffee
+    multicluster.kubernetes.io/service-name: coffee
+addressType: IPv4
+ports:
+- name: http
+  port: 8080
+endpoints:
+- addresses:
+  - 10.0.0.5
+  conditions:
+    ready: true
+`
+
+func TestRender_ServiceImport(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	objects, err := DecodeManifests(strings.NewReader(renderServiceImportManifests))
+	g.Expect(err).ToNot(HaveOccurred())
+
+	result, err := Render(context.Background(), RenderConfig{
+		Logger:           logr.Discard(),
+		GatewayCtlrName:  "gateway.nginx.org/nginx-gateway-controller",
+		GatewayClassName: "nginx",
+		ClusterDomain:    "cluster.local",
+		ClusterIPFamily:  "dual",
+		Objects:          objects,
+	})
+	g.Expect(err).ToNot(HaveOccurred())
+
+	g.Expect(result.Gateways).To(HaveLen(1))
+
+	var httpConf string
+	for _, f := range result.Gateways[0].Files {
+		if f.Meta.GetName() == "/etc/nginx/conf.d/http.conf" {
+			httpConf = string(f.Contents)
+		}
+	}
+	g.Expect(httpConf).To(ContainSubstring("location /coffee/"))
+	g.Expect(httpConf).To(ContainSubstring("server 10.0.0.5:8080;"))
+
+	statuses := make(map[string]string, len(result.Statuses))
+	for _, s := range result.Statuses {
+		statuses[s.Kind] = fmt.Sprintf("%v", s.Status)
+	}
+	// the CRD versions are checked like in the controller
+	g.Expect(statuses["GatewayClass"]).To(ContainSubstring("The Gateway API CRD versions are not recommended"))
+	g.Expect(statuses["HTTPRoute"]).ToNot(ContainSubstring("BackendNotFound"))
+}
+
+func TestEndpointSliceReader(t *testing.T) {
+	t.Parallel()
+
+	newSlice := func(namespace, name, svcName string) discoveryV1.EndpointSlice {
+		return discoveryV1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+				Labels:    map[string]string{index.KubernetesServiceNameLabel: svcName},
+			},
+		}
+	}
+
+	importedSlice := newSlice("test", "imported-coffee-1", "derived-coffee")
+	importedSlice.Labels[index.MultiClusterServiceNameLabel] = "coffee"
+
+	reader := endpointSliceReader{
+		endpointSlices: []discoveryV1.EndpointSlice{
+			newSlice("test", "coffee-1", "coffee"),
+			newSlice("test", "coffee-2", "coffee"),
+			newSlice("test", "tea-1", "tea"),
+			newSlice("other", "coffee-1", "coffee"),
+			importedSlice,
+		},
+	}
+
+	tests := []struct {
+		name     string
+		opts     []client.ListOption
+		expNames []string
+	}{
+		{
+			name: "by namespace and service",
+			opts: []client.ListOption{
+				client.InNamespace("test"),
+				client.MatchingFields{index.KubernetesServiceNameIndexField: "coffee"},
+			},
+			expNames: []string{"test/coffee-1", "test/coffee-2"},
+		},
+		{
+			name:     "by namespace",
+			opts:     []client.ListOption{client.InNamespace("test")},
+			expNames: []string{"test/coffee-1", "test/coffee-2", "test/tea-1", "test/imported-coffee-1"},
+		},
+		{
+			name: "by namespace and service import",
+			opts: []client.ListOption{
+				client.InNamespace("test"),
+				client.MatchingFields{index.MultiClusterServiceNameIndexField: "coffee"},
+			},
+			expNames: []string{"test/imported-coffee-1"},
+		},
+		{
+			name: "no matches",
+			opts: []client.ListOption{
+				client.InNamespace("test"),
+				client.MatchingFields{index.KubernetesServiceNameIndexField: "milk"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			var list discoveryV1.EndpointSliceList
+			g.Expect(reader.List(context.Background(), &list, test.opts...)).To(Succeed())
+
+			names := make([]string, 0, len(list.Items))
+			for _, slice := range list.Items {
+				names = append(names, slice.Namespace+"/"+slice.Name)
+			}
+			g.Expect(names).To(ConsistOf(test.expNames))
+		})
+	}
+
+	g := NewWithT(t)
+	g.Expect(reader.List(context.Background(), &apiv1.ServiceList{})).ToNot(Succeed())
+
+	key := types.NamespacedName{Namespace: "test", Name: "coffee-1"}
+	err := reader.Get(context.Background(), key, &discoveryV1.EndpointSlice{})
+	g.Expect(apierrors.IsNotFound(err)).To(BeTrue())
+}
@@ -109,6 +109,8 @@ type ChangeProcessorImpl struct {
 	getAndResetClusterStateChanged func() bool
 	// forceClusterStateRebuild forces the changed flag to true without modifying cluster state.
 	forceClusterStateRebuild func()
+	// getAndResetClusterStateChanges returns the objects that changed the cluster state, or nil if unknown.
+	getAndResetClusterStateChanges func() []graph.ChangedObject
 
 	cfg  ChangeProcessorConfig
 	lock sync.RWMutex
@@ -139,6 +141,7 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 		ListenerSets:          make(map[types.NamespacedName]*v1.ListenerSet),
 		APPolicies:            make(map[types.NamespacedName]*unstructured.Unstructured),
 		APLogConfs:            make(map[types.NamespacedName]*unstructured.Unstructured),
+		ServiceImports:        make(map[types.NamespacedName]*unstructured.Unstructured),
 		ExternalLoadBalancer:  make(map[types.NamespacedName]*ngfAPIv1alpha1.ExternalLoadBalancer),
 	}
 
@@ -202,6 +205,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     newObjectStoreMapAdapter(clusterStore.Services),
 			predicate: funcPredicate{stateChanged: isReferenced},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(kinds.NewServiceImportObject()),
+			store:     newObjectStoreMapAdapter(clusterStore.ServiceImports),
+			predicate: funcPredicate{stateChanged: isReferenced},
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&inference.InferencePool{}),
 			store:     newObjectStoreMapAdapter(clusterStore.InferencePools),
@@ -212,6 +220,16 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     nil,
 			predicate: funcPredicate{stateChanged: isReferenced},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.Node{}),
+			store:     nil,
+			predicate: nil,
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&apiv1.Pod{}),
+			store:     nil,
+			predicate: nil,
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&apiv1.Secret{}),
 			store:     newObjectStoreMapAdapter(clusterStore.Secrets),
@@ -242,6 +260,11 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     commonPolicyObjectStore,
 			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.AccessLogPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.UpstreamSettingsPolicy{}),
 			store:     commonPolicyObjectStore,
@@ -302,6 +325,16 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 			store:     commonPolicyObjectStore,
 			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
 		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.CachePolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
+		{
+			gvk:       cfg.MustExtractGVK(&ngfAPIv1alpha1.AccessControlPolicy{}),
+			store:     commonPolicyObjectStore,
+			predicate: funcPredicate{stateChanged: isNGFPolicyRelevant},
+		},
 		{
 			gvk:       cfg.MustExtractGVK(&v1.ListenerSet{}),
 			store:     newObjectStoreMapAdapter(clusterStore.ListenerSets),
@@ -332,6 +365,7 @@ func NewChangeProcessorImpl(cfg ChangeProcessorConfig) *ChangeProcessorImpl {
 
 	processor.getAndResetClusterStateChanged = trackingUpdater.getAndResetChangedStatus
 	processor.forceClusterStateRebuild = trackingUpdater.forceRebuild
+	processor.getAndResetClusterStateChanges = trackingUpdater.getAndResetChanges
 	processor.updater = trackingUpdater
 
 	return processor
@@ -382,8 +416,23 @@ func (c *ChangeProcessorImpl) Process(ctx context.Context) *graph.Graph {
 		return nil
 	}
 
+	changes := c.getAndResetClusterStateChanges()
+	previousGraph := c.latestGraph
+
+	// EndpointSlices, Nodes and Pods are not part of the Graph, so there is nothing to rebuild when only they changed.
+	// The configuration of the affected Gateways still needs to be rebuilt with the new endpoints and zones.
+	if previousGraph != nil && onlyObjectsOutsideGraphChanged(changes) {
+		latestGraph := *previousGraph
+		latestGraph.AffectedGateways = graph.AffectedGateways(previousGraph, &latestGraph, changes)
+		c.latestGraph = &latestGraph
+
+		return c.latestGraph
+	}
+
 	previousWAFBundles := c.mergedWAFBundles()
 
+	// The Graph is rebuilt for all Gateways. Only the nginx configuration of the affected Gateways is
+	// regenerated and pushed by the event handler.
 	c.latestGraph = graph.BuildGraph(
 		ctx,
 		c.clusterState,
@@ -400,9 +449,35 @@ func (c *ChangeProcessorImpl) Process(ctx context.Context) *graph.Graph {
 		c.cfg.FeatureFlags,
 	)
 
+	c.latestGraph.AffectedGateways = graph.AffectedGateways(previousGraph, c.latestGraph, changes)
+
 	return c.latestGraph
 }
 
+var nodeGVK = apiv1.SchemeGroupVersion.WithKind("Node")
+
+// onlyObjectsOutsideGraphChanged returns true if all the changed objects are EndpointSlices, Nodes or Pods.
+func onlyObjectsOutsideGraphChanged(changes []graph.ChangedObject) bool {
+	if len(changes) == 0 {
+		return false
+	}
+
+	for _, change := range changes {
+		// the Nodes are watched in metadata-only form, so their type is only known from their GVK
+		if change.GVK == nodeGVK {
+			continue
+		}
+
+		switch change.Object.(type) {
+		case *discoveryV1.EndpointSlice, *apiv1.Pod:
+		default:
+			return false
+		}
+	}
+
+	return true
+}
+
 // mergedWAFBundles combines graph-cached bundles with any fresher bundles from WAF pollers.
 // Polled bundles take precedence because they may be newer than what the graph last stored.
 // This prevents a graph rebuild from overwriting polled data with stale cached data
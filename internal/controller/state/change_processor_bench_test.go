@@ -0,0 +1,191 @@
+package state
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/go-logr/logr"
+	apiv1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/dataplane"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver/resolverfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+var benchClusters = []struct {
+	gateways int
+	routes   int
+}{
+	{gateways: 1, routes: 100},
+	{gateways: 10, routes: 100},
+	{gateways: 50, routes: 100},
+	{gateways: 10, routes: 1000},
+}
+
+// BenchmarkProcessEndpointSliceChange measures processing a single EndpointSlice change in a synthetic cluster
+// and building the configuration of the Gateways. The Graph is reused when only EndpointSlices changed, so the
+// sub-benchmarks only differ in building the configuration of all Gateways or of the affected Gateways.
+func BenchmarkProcessEndpointSliceChange(b *testing.B) {
+	slice := createBenchEndpointSlice("svc-0-0")
+
+	changeSlice := func(i int) client.Object {
+		slice.Endpoints[0].Addresses[0] = fmt.Sprintf("10.0.%d.%d", (i/250)%250, i%250+1)
+		return slice.DeepCopy()
+	}
+
+	for _, cluster := range benchClusters {
+		name := fmt.Sprintf("%d gateways with %d routes each", cluster.gateways, cluster.routes)
+
+		b.Run(name+" config for all gateways", func(b *testing.B) {
+			benchProcessChange(b, cluster.gateways, cluster.routes, false, changeSlice)
+		})
+		b.Run(name+" config for affected gateways", func(b *testing.B) {
+			benchProcessChange(b, cluster.gateways, cluster.routes, true, changeSlice)
+		})
+	}
+}
+
+// BenchmarkProcessHTTPRouteChange measures processing a single HTTPRoute change in a synthetic cluster
+// and building the configuration of the Gateways. The Graph is rebuilt for all Gateways in both sub-benchmarks,
+// they only differ in building the configuration of all Gateways or of the affected Gateways.
+func BenchmarkProcessHTTPRouteChange(b *testing.B) {
+	changeRoute := func(i int) client.Object {
+		hr := createBenchHTTPRoute(0, 0)
+		hr.Generation = int64(i + 2)
+		hr.Spec.Hostnames[0] = v1.Hostname(fmt.Sprintf("route-%d.example.com", i))
+		return hr
+	}
+
+	for _, cluster := range benchClusters {
+		name := fmt.Sprintf("%d gateways with %d routes each", cluster.gateways, cluster.routes)
+
+		b.Run(name+" config for all gateways", func(b *testing.B) {
+			benchProcessChange(b, cluster.gateways, cluster.routes, false, changeRoute)
+		})
+		b.Run(name+" config for affected gateways", func(b *testing.B) {
+			benchProcessChange(b, cluster.gateways, cluster.routes, true, changeRoute)
+		})
+	}
+}
+
+func benchProcessChange(
+	b *testing.B,
+	gateways, routes int,
+	onlyAffected bool,
+	change func(i int) client.Object,
+) {
+	b.Helper()
+
+	ctx := context.Background()
+	processor := newSyntheticClusterProcessor(gateways, routes)
+
+	gr := processor.Process(ctx)
+	if gr == nil || len(gr.Gateways) != gateways {
+		b.Fatalf("expected a graph with %d gateways", gateways)
+	}
+
+	serviceResolver := &resolverfakes.FakeServiceResolver{}
+
+	var built int
+	for i := 0; b.Loop(); i++ {
+		processor.CaptureUpsertChange(change(i))
+		gr = processor.Process(ctx)
+
+		built = 0
+		for nsname, gw := range gr.Gateways {
+			if onlyAffected && gr.AffectedGateways != nil {
+				if _, affected := gr.AffectedGateways[nsname]; !affected {
+					continue
+				}
+			}
+
+			dataplane.BuildConfiguration(ctx, logr.Discard(), gr, gw, serviceResolver, false, "", "cluster.local", "")
+			built++
+		}
+	}
+
+	b.ReportMetric(float64(built), "gateways/op")
+}
+
+// newSyntheticClusterProcessor returns a ChangeProcessor with a cluster of Gateways, where each Gateway has
+// the given number of HTTPRoutes, and each HTTPRoute references its own Service.
+func newSyntheticClusterProcessor(gateways, routes int) ChangeProcessor {
+	processor := NewChangeProcessorImpl(ChangeProcessorConfig{
+		GatewayCtlrName:  controllerName,
+		GatewayClassName: gcName,
+		Logger:           logr.Discard(),
+		Validators:       createAlwaysValidValidators(),
+		MustExtractGVK:   kinds.NewMustExtractGKV(createScheme()),
+	})
+
+	processor.CaptureUpsertChange(&v1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Name: gcName},
+		Spec:       v1.GatewayClassSpec{ControllerName: controllerName},
+	})
+
+	for i := range gateways {
+		gwName := fmt.Sprintf("gw-%d", i)
+		processor.CaptureUpsertChange(createGateway(gwName, v1.AllowedListeners{}, createHTTPListener()))
+
+		for j := range routes {
+			svcName := fmt.Sprintf("svc-%d-%d", i, j)
+
+			processor.CaptureUpsertChange(createBenchHTTPRoute(i, j))
+			processor.CaptureUpsertChange(&apiv1.Service{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: svcName},
+				Spec: apiv1.ServiceSpec{
+					Ports: []apiv1.ServicePort{{Port: 80}},
+				},
+			})
+			processor.CaptureUpsertChange(createBenchEndpointSlice(svcName))
+		}
+	}
+
+	return processor
+}
+
+// createBenchHTTPRoute returns the HTTPRoute j of the Gateway i of the synthetic cluster.
+func createBenchHTTPRoute(i, j int) *v1.HTTPRoute {
+	hr := createHTTPRoute(
+		fmt.Sprintf("route-%d-%d", i, j),
+		fmt.Sprintf("gw-%d", i),
+		fmt.Sprintf("route-%d.gw-%d.example.com", j, i),
+		v1.HTTPBackendRef{
+			BackendRef: v1.BackendRef{
+				BackendObjectReference: createBackendRefObj(
+					(*v1.Kind)(helpers.GetPointer(kinds.Service)),
+					v1.ObjectName(fmt.Sprintf("svc-%d-%d", i, j)),
+					nil,
+				),
+			},
+		},
+	)
+	// only attach to the HTTP listener of the Gateway
+	hr.Spec.ParentRefs = hr.Spec.ParentRefs[:1]
+
+	return hr
+}
+
+func createBenchEndpointSlice(svcName string) *discoveryV1.EndpointSlice {
+	return &discoveryV1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      svcName + "-slice",
+			Labels:    map[string]string{index.KubernetesServiceNameLabel: svcName},
+		},
+		AddressType: discoveryV1.AddressTypeIPv4,
+		Endpoints: []discoveryV1.Endpoint{
+			{Addresses: []string{"10.0.0.1"}},
+		},
+		Ports: []discoveryV1.EndpointPort{
+			{Port: helpers.GetPointer[int32](8080)},
+		},
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation/validationfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
@@ -503,6 +504,9 @@ var _ = Describe("ChangeProcessor", func() {
 					actualGW.ListenerFactory = nil
 				}
 
+				// AffectedGateways depends on the previously processed graph, so it is verified separately
+				expGraph.AffectedGateways = graphCfg.AffectedGateways
+
 				Expect(helpers.Diff(expGraph, graphCfg)).To(BeEmpty())
 				Expect(helpers.Diff(expGraph, processor.GetLatestGraph())).To(BeEmpty())
 			}
@@ -1489,7 +1493,8 @@ var _ = Describe("ChangeProcessor", func() {
 
 							graphCfg := processor.Process(context.Background())
 							Expect(graphCfg).To(BeNil())
-							Expect(helpers.Diff(&graph.Graph{}, processor.GetLatestGraph())).To(BeEmpty())
+							expGraph := &graph.Graph{AffectedGateways: map[types.NamespacedName]struct{}{}}
+							Expect(helpers.Diff(expGraph, processor.GetLatestGraph())).To(BeEmpty())
 						})
 					})
 					When("the first Gateway is upserted", func() {
@@ -1972,6 +1977,9 @@ var _ = Describe("ChangeProcessor", func() {
 					processor.CaptureUpsertChange(gw2)
 
 					processAndValidateGraph(expGraph2)
+					Expect(processor.GetLatestGraph().AffectedGateways).To(Equal(
+						map[types.NamespacedName]struct{}{client.ObjectKeyFromObject(gw2): {}},
+					))
 				})
 			})
 			When("the second HTTPRoute is upserted", func() {
@@ -2005,6 +2013,9 @@ var _ = Describe("ChangeProcessor", func() {
 					expGraph2.ReferencedServices[refSvc].GatewayNsNames[gw2NSName] = struct{}{}
 
 					processAndValidateGraph(expGraph2)
+					Expect(processor.GetLatestGraph().AffectedGateways).To(Equal(
+						map[types.NamespacedName]struct{}{gw2NSName: {}},
+					))
 				})
 			})
 			When("the second GRPCRoute is upserted", func() {
@@ -3114,6 +3125,68 @@ var _ = Describe("ChangeProcessor", func() {
 					})
 				})
 			})
+			Context("processing a route with a ServiceImport backend", func() {
+				svcImportNsName := types.NamespacedName{Namespace: "test", Name: "imported-svc"}
+
+				kindServiceImport := v1.Kind(kinds.ServiceImport)
+				svcImportRef := createHTTPBackendRef(&kindServiceImport, "imported-svc", nil)
+				svcImportRef.Group = helpers.GetPointer(v1.Group(kinds.ServiceImportGVK.Group))
+				hrServiceImport := createHTTPRoute("hr-svc-import", "gw", "imported.example.com", svcImportRef)
+
+				svcImport := kinds.NewServiceImportObject()
+				svcImport.SetNamespace(svcImportNsName.Namespace)
+				svcImport.SetName(svcImportNsName.Name)
+				svcImport.Object["spec"] = map[string]any{
+					"type":  "ClusterSetIP",
+					"ports": []any{map[string]any{"protocol": "TCP", "port": int64(80)}},
+				}
+
+				sameNameSvc := createSvc("imported-svc")
+				mcsSlice := &discoveryV1.EndpointSlice{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test",
+						Name:      "imported-svc-1",
+						Labels:    map[string]string{index.MultiClusterServiceNameLabel: "imported-svc"},
+					},
+				}
+
+				When("the route is added", func() {
+					It("should trigger a change and track the ServiceImport", func() {
+						processor.CaptureUpsertChange(hrServiceImport)
+						gr := processor.Process(context.Background())
+						Expect(gr).ToNot(BeNil())
+						Expect(gr.ReferencedServiceImports).To(HaveKey(svcImportNsName))
+					})
+				})
+				When("the referenced ServiceImport is added", func() {
+					It("should trigger a change", func() {
+						testUpsertTriggersChange(svcImport)
+					})
+				})
+				When("an EndpointSlice derived from the ServiceImport is added", func() {
+					It("should trigger a change", func() {
+						testUpsertTriggersChange(mcsSlice)
+					})
+				})
+				When("a Service with the same name as the ServiceImport is added", func() {
+					It("should not trigger a change", func() {
+						testUpsertDoesNotTriggerChange(sameNameSvc)
+					})
+				})
+				When("the route is deleted", func() {
+					It("should trigger a change", func() {
+						testDeleteTriggersChange(
+							hrServiceImport,
+							types.NamespacedName{Namespace: hrServiceImport.Namespace, Name: hrServiceImport.Name},
+						)
+					})
+				})
+				When("the ServiceImport is deleted", func() {
+					It("should not trigger a change", func() {
+						testDeleteDoesNotTriggerChange(kinds.NewServiceImportObject(), svcImportNsName)
+					})
+				})
+			})
 		})
 
 		Describe("namespace changes", Ordered, func() {
@@ -4459,7 +4532,7 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&apiv1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "pod"}},
+				&apiv1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "sa"}},
 			),
 			Entry(
 				"nil resource",
@@ -4477,8 +4550,8 @@ var _ = Describe("ChangeProcessor", func() {
 			},
 			Entry(
 				"an unsupported resource",
-				&apiv1.Pod{},
-				types.NamespacedName{Namespace: "test", Name: "pod"},
+				&apiv1.ServiceAccount{},
+				types.NamespacedName{Namespace: "test", Name: "sa"},
 			),
 			Entry(
 				"nil resource type",
@@ -4633,3 +4706,92 @@ func TestGetLatestGraphReturnsSnapshot(t *testing.T) {
 		To(Equal(string(v1.ListenerConditionAccepted)))
 	g.Expect(latest.NGFPolicies[policyKey].Conditions[0].Type).To(Equal(string(v1.RouteConditionAccepted)))
 }
+
+func TestProcessReusesGraphForEndpointSliceChanges(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	processor := newSyntheticClusterProcessor(2, 1)
+
+	previous := processor.Process(context.Background())
+	g.Expect(previous).ToNot(BeNil())
+	g.Expect(previous.AffectedGateways).To(BeNil())
+
+	slice := createBenchEndpointSlice("svc-1-0")
+	slice.Endpoints[0].Addresses[0] = "10.0.0.2"
+	processor.CaptureUpsertChange(slice)
+
+	latest := processor.Process(context.Background())
+	g.Expect(latest).ToNot(BeNil())
+	g.Expect(latest).ToNot(BeIdenticalTo(previous))
+	routeKey := graph.RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "route-1-0"},
+		RouteType:      graph.RouteTypeHTTP,
+	}
+	g.Expect(latest.Routes[routeKey]).To(BeIdenticalTo(previous.Routes[routeKey]))
+	g.Expect(latest.AffectedGateways).To(Equal(map[types.NamespacedName]struct{}{
+		{Namespace: "test", Name: "gw-1"}: {},
+	}))
+
+	processor.CaptureUpsertChange(createBenchHTTPRoute(0, 0))
+	processor.CaptureUpsertChange(slice)
+	processor.ForceRebuild()
+
+	latest = processor.Process(context.Background())
+	g.Expect(latest).ToNot(BeNil())
+	g.Expect(latest.AffectedGateways).To(BeNil())
+}
+
+func TestProcessReusesGraphForNodeAndPodChanges(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	processor := newSyntheticClusterProcessor(2, 1)
+
+	previous := processor.Process(context.Background())
+	g.Expect(previous).ToNot(BeNil())
+
+	node := &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "node",
+			Labels: map[string]string{apiv1.LabelTopologyZone: "zone-a"},
+		},
+	}
+	node.SetGroupVersionKind(apiv1.SchemeGroupVersion.WithKind("Node"))
+	processor.CaptureUpsertChange(node)
+
+	latest := processor.Process(context.Background())
+	g.Expect(latest).ToNot(BeNil())
+	g.Expect(latest).ToNot(BeIdenticalTo(previous))
+	routeKey := graph.RouteKey{
+		NamespacedName: types.NamespacedName{Namespace: "test", Name: "route-1-0"},
+		RouteType:      graph.RouteTypeHTTP,
+	}
+	g.Expect(latest.Routes[routeKey]).To(BeIdenticalTo(previous.Routes[routeKey]))
+	g.Expect(latest.AffectedGateways).To(BeNil())
+
+	gatewayNsName := types.NamespacedName{Namespace: "test", Name: "gw-1"}
+	deploymentName := previous.Gateways[gatewayNsName].DeploymentName
+	pod := &apiv1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: deploymentName.Namespace,
+			Name:      "nginx-pod",
+			Labels:    map[string]string{controller.AppNameLabel: deploymentName.Name},
+		},
+		Spec: apiv1.PodSpec{NodeName: "node"},
+	}
+	processor.CaptureUpsertChange(pod)
+
+	latest = processor.Process(context.Background())
+	g.Expect(latest).ToNot(BeNil())
+	g.Expect(latest.Routes[routeKey]).To(BeIdenticalTo(previous.Routes[routeKey]))
+	g.Expect(latest.AffectedGateways).To(Equal(map[types.NamespacedName]struct{}{gatewayNsName: {}}))
+
+	node.Labels[apiv1.LabelTopologyZone] = "zone-b"
+	processor.CaptureUpsertChange(node)
+	processor.CaptureUpsertChange(createBenchHTTPRoute(0, 0))
+
+	latest = processor.Process(context.Background())
+	g.Expect(latest).ToNot(BeNil())
+	g.Expect(latest.Routes[routeKey]).ToNot(BeIdenticalTo(previous.Routes[routeKey]))
+}
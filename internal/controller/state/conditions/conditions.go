@@ -87,6 +87,24 @@ const (
 	GatewayMessageFailedNginxReload = "The Gateway is not programmed due to a failure to " +
 		"reload nginx with the configuration"
 
+	// GatewayReasonRolloutAborted is used with GatewayConditionProgrammed (false) when a staged rollout
+	// of the configuration was aborted.
+	GatewayReasonRolloutAborted v1.GatewayConditionReason = "RolloutAborted"
+
+	// GatewayMessageRolloutAborted is a message used with GatewayConditionProgrammed (false)
+	// when a staged rollout of the configuration was aborted.
+	GatewayMessageRolloutAborted = "The Gateway is not programmed because the staged rollout of the " +
+		"configuration was aborted and the previous configuration was restored"
+
+	// GatewayReasonPinnedConfigVersionNotFound is used with GatewayConditionProgrammed (false) when the
+	// configuration version that the Gateway is pinned to is not in the configuration version history.
+	GatewayReasonPinnedConfigVersionNotFound v1.GatewayConditionReason = "PinnedConfigVersionNotFound"
+
+	// GatewayMessagePinnedConfigVersionNotFound is a message used with GatewayConditionProgrammed (false)
+	// when the configuration version that the Gateway is pinned to is not in the configuration version history.
+	GatewayMessagePinnedConfigVersionNotFound = "The Gateway is not programmed because the configuration " +
+		"version it is pinned to is not in the configuration version history, the current configuration is kept"
+
 	// GatewayClassResolvedRefs condition indicates whether the controller was able to resolve the
 	// parametersRef on the GatewayClass.
 	GatewayClassResolvedRefs v1.GatewayClassConditionType = "ResolvedRefs"
@@ -198,13 +216,25 @@ const (
 	// PolicyAffectedReason is used with the "PolicyAffected" condition when a
 	// ObservabilityPolicy, ClientSettingsPolicy, or ProxySettingsPolicy is applied to Gateways or Routes.
 	// RateLimitPolicyAffected is used with the "PolicyAffected" condition when a
-	// RateLimitPolicy is applied to a Gateway, HTTPRoute, or GRPCRoute.
+	// RateLimitPolicy is applied to a Gateway, HTTPRoute, GRPCRoute, or TCPRoute.
 	RateLimitPolicyAffected v1.PolicyConditionType = "RateLimitPolicyAffected"
 
 	// PayloadProcessorPolicyAffected is used with the "PolicyAffected" condition when a
 	// PayloadProcessor is applied to a Gateway or HTTPRoute.
 	PayloadProcessorPolicyAffected v1.PolicyConditionType = "PayloadProcessorPolicyAffected"
 
+	// CachePolicyAffected is used with the "PolicyAffected" condition when a
+	// CachePolicy is applied to a Gateway or HTTPRoute.
+	CachePolicyAffected v1.PolicyConditionType = "CachePolicyAffected"
+
+	// AccessControlPolicyAffected is used with the "PolicyAffected" condition when an
+	// AccessControlPolicy is applied to a Gateway, HTTPRoute, GRPCRoute, TCPRoute, or TLSRoute.
+	AccessControlPolicyAffected v1.PolicyConditionType = "AccessControlPolicyAffected"
+
+	// AccessLogPolicyAffected is used with the "PolicyAffected" condition when an
+	// AccessLogPolicy is applied to an HTTPRoute or GRPCRoute.
+	AccessLogPolicyAffected v1.PolicyConditionType = "AccessLogPolicyAffected"
+
 	// PolicyAffectedReason is used with the "PolicyAffected" condition when a
 	// custom policy is applied to Gateways or Routes.
 	PolicyAffectedReason v1.PolicyConditionReason = "PolicyAffected"
@@ -224,6 +254,17 @@ const (
 	// parametersRef resource is invalid.
 	GatewayReasonParamsRefInvalid v1.GatewayConditionReason = "ParametersRefInvalid"
 
+	// GatewayConditionDataPlaneHealthy condition indicates whether the nginx instances of the Gateway
+	// report a healthy data plane.
+	GatewayConditionDataPlaneHealthy v1.GatewayConditionType = "DataPlaneHealthy"
+
+	// GatewayReasonDataPlaneHealthy is used with the "DataPlaneHealthy" condition when the condition is true.
+	GatewayReasonDataPlaneHealthy v1.GatewayConditionReason = "Healthy"
+
+	// GatewayReasonDataPlaneUnhealthy is used with the "DataPlaneHealthy" condition when at least one
+	// nginx instance of the Gateway reports an unhealthy data plane.
+	GatewayReasonDataPlaneUnhealthy v1.GatewayConditionReason = "Unhealthy"
+
 	// PolicyReasonAncestorLimitReached is used with the "PolicyAccepted" condition when a policy
 	// cannot be applied because the ancestor status list has reached the maximum size of 16.
 	PolicyReasonAncestorLimitReached v1.PolicyConditionReason = "AncestorLimitReached"
@@ -1116,6 +1157,28 @@ func NewGatewayNotProgrammedInvalid(msg string) Condition {
 	}
 }
 
+// NewGatewayNotProgrammedRolloutAborted returns a Condition that indicates the Gateway is not programmed
+// because the staged rollout of the configuration was aborted.
+func NewGatewayNotProgrammedRolloutAborted(msg string) Condition {
+	return Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonRolloutAborted),
+		Message: msg,
+	}
+}
+
+// NewGatewayNotProgrammedPinnedConfigVersionNotFound returns a Condition that indicates the Gateway is not
+// programmed because the configuration version it is pinned to is not in the configuration version history.
+func NewGatewayNotProgrammedPinnedConfigVersionNotFound(msg string) Condition {
+	return Condition{
+		Type:    string(v1.GatewayConditionProgrammed),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonPinnedConfigVersionNotFound),
+		Message: msg,
+	}
+}
+
 // NewGatewayInsecureFrontendValidationMode returns a Condition that indicates
 // the Gateway is accepted, but is using an insecure frontend validation mode.
 func NewGatewayInsecureFrontendValidationMode(msg string) Condition {
@@ -1229,6 +1292,28 @@ func NewGatewayAcceptedUnsupportedField(msg string) Condition {
 	}
 }
 
+// NewGatewayDataPlaneHealthy returns a Condition that indicates that all nginx instances of the Gateway
+// report a healthy data plane.
+func NewGatewayDataPlaneHealthy() Condition {
+	return Condition{
+		Type:    string(GatewayConditionDataPlaneHealthy),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(GatewayReasonDataPlaneHealthy),
+		Message: "All nginx instances report a healthy data plane",
+	}
+}
+
+// NewGatewayDataPlaneUnhealthy returns a Condition that indicates that at least one nginx instance of
+// the Gateway reports an unhealthy data plane. The provided message lists the unhealthy instances.
+func NewGatewayDataPlaneUnhealthy(msg string) Condition {
+	return Condition{
+		Type:    string(GatewayConditionDataPlaneHealthy),
+		Status:  metav1.ConditionFalse,
+		Reason:  string(GatewayReasonDataPlaneUnhealthy),
+		Message: msg,
+	}
+}
+
 // NewPolicyAccepted returns a Condition that indicates that the Policy is accepted.
 func NewPolicyAccepted() Condition {
 	return Condition{
@@ -1493,6 +1578,39 @@ func NewRateLimitPolicyAffected() Condition {
 	}
 }
 
+// NewCachePolicyAffected returns a Condition that indicates that a CachePolicy
+// is applied to the resource.
+func NewCachePolicyAffected() Condition {
+	return Condition{
+		Type:    string(CachePolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The CachePolicy is applied to the resource",
+	}
+}
+
+// NewAccessControlPolicyAffected returns a Condition that indicates that an AccessControlPolicy
+// is applied to the resource.
+func NewAccessControlPolicyAffected() Condition {
+	return Condition{
+		Type:    string(AccessControlPolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The AccessControlPolicy is applied to the resource",
+	}
+}
+
+// NewAccessLogPolicyAffected returns a Condition that indicates that an AccessLogPolicy
+// is applied to the resource.
+func NewAccessLogPolicyAffected() Condition {
+	return Condition{
+		Type:    string(AccessLogPolicyAffected),
+		Status:  metav1.ConditionTrue,
+		Reason:  string(PolicyAffectedReason),
+		Message: "The AccessLogPolicy is applied to the resource",
+	}
+}
+
 // NewPayloadProcessorPolicyAffected returns a Condition that indicates that a PayloadProcessor
 // is applied to the resource.
 func NewPayloadProcessorPolicyAffected() Condition {
@@ -28,6 +28,7 @@ import (
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/configmaps"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph/shared/secrets"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/resolver"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 )
 
@@ -39,10 +40,16 @@ const (
 	DefaultWorkerProcesses         = "auto"
 	DefaultNginxReadinessProbePort = int32(8081)
 	DefaultNginxReadinessProbePath = "/readyz"
+	// ZoneSyncPort is the port on which NGINX Plus replicas synchronize their shared memory zones.
+	ZoneSyncPort = int32(12345)
+	// defaultClusterDomain is the Kubernetes cluster DNS domain used when none is configured.
+	defaultClusterDomain = "cluster.local"
 	// DefaultLogFormatName is used when user provides custom access_log format.
 	DefaultLogFormatName = "ngf_user_defined_log_format"
 	// DefaultAccessLogPath is the default path for the access log.
 	DefaultAccessLogPath = "/dev/stdout"
+	// AccessLogDirectory is the directory of file access logs. It is backed by a volume in the NGINX container.
+	AccessLogDirectory = "/var/log/nginx/access"
 	// JSONAccessLogFormat is the JSON access log template emitted when JSON logging
 	// is enabled and the user has not supplied their own access log format. Fields mirror
 	// nginx's implicit 'combined' format.
@@ -56,17 +63,19 @@ const (
 		`"http_referer":"$http_referer",` +
 		`"http_user_agent":"$http_user_agent"` +
 		`}`
-	// InternalRLPAnnotationKey is the annotation key used to mark internally generated
-	// RateLimitPolicies. These policies are created when a RateLimitPolicy targets a route and not
-	// the Gateway itself; in this situation we need an additional policy to generate the http context
-	// configuration.
-	InternalRLPAnnotationKey = "nginx.org/internal-annotation-http-context-only"
-	// InternalRLPAnnotationValue is the annotation value used to mark internally generated RateLimitPolicies.
-	InternalRLPAnnotationValue = "true"
-	crlBundleIDPrefix          = "crl_bundle"
+	// InternalHTTPContextAnnotationKey is the annotation key used to mark internally generated
+	// policies, such as RateLimitPolicies and CachePolicies. These policies are created when a policy targets
+	// a route and not the Gateway itself; in this situation we need an additional policy to generate the
+	// http context configuration.
+	InternalHTTPContextAnnotationKey = "nginx.org/internal-annotation-http-context-only"
+	// InternalHTTPContextAnnotationValue is the annotation value used to mark internally generated policies.
+	InternalHTTPContextAnnotationValue = "true"
+	crlBundleIDPrefix                  = "crl_bundle"
 )
 
 // BuildConfiguration builds the Configuration from the Graph.
+// The zoneSyncResolver is the nameserver that NGINX Plus uses to find the other replicas of the Gateway
+// if the NginxProxy doesn't configure a DNS resolver.
 func BuildConfiguration(
 	ctx context.Context,
 	logger logr.Logger,
@@ -75,6 +84,8 @@ func BuildConfiguration(
 	serviceResolver resolver.ServiceResolver,
 	plus bool,
 	clusterIPFamily ngfAPIv1alpha2.IPFamilyType,
+	clusterDomain string,
+	zoneSyncResolver string,
 ) Configuration {
 	if g.GatewayClass == nil || !g.GatewayClass.Valid || gateway == nil {
 		config := GetDefaultConfiguration(g, gateway)
@@ -93,9 +104,28 @@ func BuildConfiguration(
 	gatewayRateLimitPolicies := gateway.GetReferencedRateLimitPolicies(g.Routes, g.NGFPolicies)
 
 	baseHTTPConfig := buildBaseHTTPConfig(gateway, gatewaySnippetsFilters, gatewayRateLimitPolicies, clusterIPFamily)
+
+	// Route-targeting CachePolicies need to create the proxy_cache_path directive at the http context,
+	// so we attach HTTP context-only copies of them to the base HTTP config.
+	gatewayCachePolicies := gateway.GetReferencedCachePolicies(g.Routes, g.NGFPolicies)
+	baseHTTPConfig.Policies = append(
+		baseHTTPConfig.Policies,
+		buildHTTPContextPolicies[*ngfAPIv1alpha1.CachePolicy](gatewayCachePolicies)...,
+	)
+
+	// Route-targeting AccessLogPolicies need to create their log_format and condition variables
+	// at the http context, so we attach HTTP context-only copies of them to the base HTTP config.
+	gatewayAccessLogPolicies := gateway.GetReferencedAccessLogPolicies(g.Routes, g.NGFPolicies)
+	baseHTTPConfig.Policies = append(
+		baseHTTPConfig.Policies,
+		buildHTTPContextPolicies[*ngfAPIv1alpha1.AccessLogPolicy](gatewayAccessLogPolicies)...,
+	)
 	baseHTTPConfig.AuthZConfigs = buildAuthZConfigs(g.AuthenticationFilters)
 	baseStreamConfig := buildBaseStreamConfig(gateway)
 
+	tcpServers := buildL4Servers(logger, gateway, v1.TCPProtocolType)
+	baseStreamConfig.Policies = buildStreamContextPolicies(tcpServers)
+
 	httpServers, sslServers, sslListenerHostnames, extAuthCertBundleIDs := buildServers(
 		gateway,
 		g.ReferencedServices,
@@ -121,6 +151,8 @@ func BuildConfiguration(
 		serviceResolver,
 		g.ReferencedServices,
 	)
+	setTopologyAwareBackends(httpServers, upstreams)
+	setTopologyAwareBackends(sslServers, upstreams)
 
 	var nginxPlus NginxPlus
 	if plus {
@@ -155,7 +187,7 @@ func BuildConfiguration(
 		SSLServers:    sslServers,
 		OIDCProviders: oidcProvider,
 		TLSServers:    tlsServers,
-		TCPServers:    buildL4Servers(logger, gateway, v1.TCPProtocolType),
+		TCPServers:    tcpServers,
 		UDPServers:    buildL4Servers(logger, gateway, v1.UDPProtocolType),
 		Upstreams:     upstreams,
 		StreamUpstreams: buildStreamUpstreams(
@@ -187,6 +219,15 @@ func BuildConfiguration(
 
 	maps.Copy(config.AuthSecrets, buildGuardrailsAuthSecrets(gateway))
 
+	if plus {
+		config.BaseStreamConfig.ZoneSync = buildZoneSync(
+			gateway,
+			clusterDomain,
+			zoneSyncResolver,
+			baseStreamConfig.DNSResolver,
+		)
+	}
+
 	return config
 }
 
@@ -218,7 +259,7 @@ func buildTLSServers(gateway *graph.Gateway) []Layer4VirtualServer {
 			ssl = buildSSL(l)
 		}
 
-		count, matched := buildTLSServersForListener(l, ssl, gatewayNsName, tlsServersMap)
+		count, matched := buildTLSServersForListener(gateway, l, ssl, gatewayNsName, tlsServersMap)
 		tlsServerCount += count
 
 		if !matched {
@@ -264,6 +305,7 @@ func buildTLSServers(gateway *graph.Gateway) []Layer4VirtualServer {
 // buildTLSServersForListener processes routes on a TLS listener, adding servers to tlsServersMap.
 // Returns the number of servers added and whether any route hostname matched the listener hostname.
 func buildTLSServersForListener(
+	gateway *graph.Gateway,
 	l *graph.Listener,
 	ssl *SSL,
 	gatewayNsName types.NamespacedName,
@@ -292,6 +334,8 @@ func buildTLSServersForListener(
 
 		count += len(hostnames)
 
+		serverPolicies := buildL4ServerPolicies(gateway, r.Policies)
+
 		for _, h := range hostnames {
 			if l.Source.Hostname != nil && h == string(*l.Source.Hostname) {
 				foundRouteMatchingListenerHostname = true
@@ -307,6 +351,7 @@ func buildTLSServersForListener(
 				Port:      l.Source.Port,
 				SSL:       ssl,
 				VerifyTLS: convertBackendTLS(r.Spec.BackendRef.BackendTLSPolicy, gatewayNsName),
+				Policies:  serverPolicies,
 			})
 		}
 	}
@@ -349,7 +394,10 @@ func buildL4Servers(logger logr.Logger, gateway *graph.Gateway, protocol v1.Prot
 			continue
 		}
 
-		servers = append(servers, *oldest.withPort(l.Source.Port))
+		server := oldest.withPort(l.Source.Port)
+		server.Policies = buildL4ServerPolicies(gateway, oldest.policies)
+
+		servers = append(servers, *server)
 	}
 
 	if len(servers) == 0 {
@@ -369,6 +417,7 @@ func buildL4Servers(logger logr.Logger, gateway *graph.Gateway, protocol v1.Prot
 type l4RouteUpstreams struct {
 	source    client.Object
 	upstreams []Layer4Upstream
+	policies  []*graph.Policy
 }
 
 func (u *l4RouteUpstreams) withPort(port v1.PortNumber) *Layer4VirtualServer {
@@ -431,6 +480,7 @@ func oldestValidL4Route(
 		candidate := &l4RouteUpstreams{
 			source:    r.Source,
 			upstreams: upstreams,
+			policies:  r.Policies,
 		}
 
 		if oldest == nil || ngfsort.LessClientObject(candidate.source, oldest.source) {
@@ -509,9 +559,10 @@ func buildStreamUpstreams(
 				}
 
 				uniqueUpstreams[upstreamName] = Upstream{
-					Name:      upstreamName,
-					Endpoints: eps,
-					ErrorMsg:  errMsg,
+					Name:             upstreamName,
+					Endpoints:        withoutDrainingEndpoints(eps),
+					ErrorMsg:         errMsg,
+					UpstreamSettings: buildStreamUpstreamSettings(gateway, br, referencedServices),
 				}
 			}
 		}
@@ -535,6 +586,33 @@ func buildStreamUpstreams(
 	return upstreams
 }
 
+// buildStreamUpstreamSettings builds the settings of a stream upstream from the UpstreamSettingsPolicies of its
+// Service. Only the stream settings of the policies apply to stream upstreams.
+func buildStreamUpstreamSettings(
+	gateway *graph.Gateway,
+	br graph.BackendRef,
+	referencedServices map[types.NamespacedName]*graph.ReferencedService,
+) upstreamsettings.UpstreamSettings {
+	graphSvc, exists := referencedServices[br.SvcNsName]
+	if !exists || br.IsServiceImport {
+		return upstreamsettings.UpstreamSettings{}
+	}
+
+	uspSettings := upstreamsettings.Processor{}.Process(buildPolicies(gateway, graphSvc.Policies))
+
+	return upstreamsettings.UpstreamSettings{Stream: uspSettings.Stream}
+}
+
+// withoutDrainingEndpoints removes the endpoints that need to be drained, because stream upstream servers
+// don't support draining.
+func withoutDrainingEndpoints(endpoints []resolver.Endpoint) []resolver.Endpoint {
+	if !slices.ContainsFunc(endpoints, func(ep resolver.Endpoint) bool { return ep.Drain }) {
+		return endpoints
+	}
+
+	return slices.DeleteFunc(slices.Clone(endpoints), func(ep resolver.Endpoint) bool { return ep.Drain })
+}
+
 // buildSSLKeyPairs builds the SSLKeyPairs from the Secrets. It will only include Secrets that are referenced by
 // valid gateway and its listeners, so that we don't include unused Secrets in the configuration of the data plane.
 func buildSSLKeyPairs(
@@ -1637,6 +1715,8 @@ func (hpr *hostPathRules) upsertRoute(
 					Filters:      filters,
 					Match:        convertMatch(m),
 					Guardrails:   guardrails,
+					Timeouts:     convertTimeouts(rule.Timeouts),
+					Retry:        convertRetry(rule.Retry),
 				})
 
 				hpr.rulesPerHost[h][key] = hostRule
@@ -1899,7 +1979,8 @@ func buildUpstream(
 
 	var upstreamPolicies []policies.Policy
 	var uspSettings upstreamsettings.UpstreamSettings
-	if graphSvc, exists := referencedServices[br.SvcNsName]; exists {
+	// the policies of a Service don't apply to a ServiceImport with the same name
+	if graphSvc, exists := referencedServices[br.SvcNsName]; exists && !br.IsServiceImport {
 		upstreamPolicies = buildPolicies(gateway, graphSvc.Policies)
 		uspSettings = upstreamsettings.Processor{}.Process(upstreamPolicies)
 	}
@@ -1941,7 +2022,7 @@ func buildUpstream(
 		}
 	}
 
-	return &Upstream{
+	upstream := &Upstream{
 		Name:               upstreamName,
 		Endpoints:          eps,
 		ErrorMsg:           errMsg,
@@ -1950,6 +2031,116 @@ func buildUpstream(
 		SessionPersistence: sp,
 		StateFileKey:       br.BaseServicePortKey(),
 	}
+	upstream.TopologyUpstreams = buildTopologyUpstreams(*upstream)
+
+	return upstream
+}
+
+// buildTopologyUpstreams builds the topology-aware variants of an Upstream, one for each zone of the hints of
+// its endpoints. In each variant, the endpoints with hints for its zone are primary and the others are backups,
+// so that the traffic stays in the zone unless none of the endpoints of the zone are available.
+func buildTopologyUpstreams(up Upstream) []Upstream {
+	lbMethod, ok := topologyLoadBalancingMethod(up.UpstreamSettings.LoadBalancingMethod)
+	if !ok {
+		return nil
+	}
+
+	var zones []string
+	for _, ep := range up.Endpoints {
+		// the resolver only sets hints if all endpoints that are not drained have them
+		if ep.Drain && !ep.Resolve {
+			continue
+		}
+		if len(ep.ZoneHints) == 0 || ep.Resolve {
+			return nil
+		}
+		zones = append(zones, ep.ZoneHints...)
+	}
+
+	if len(zones) == 0 {
+		return nil
+	}
+
+	slices.Sort(zones)
+	zones = slices.Compact(zones)
+
+	topologyUpstreams := make([]Upstream, 0, len(zones))
+	for _, zone := range zones {
+		endpoints := make([]resolver.Endpoint, 0, len(up.Endpoints))
+		for _, ep := range up.Endpoints {
+			ep.Backup = !slices.Contains(ep.ZoneHints, zone)
+			endpoints = append(endpoints, ep)
+		}
+
+		settings := up.UpstreamSettings
+		settings.LoadBalancingMethod = lbMethod
+
+		topologyUpstreams = append(topologyUpstreams, Upstream{
+			Name:               topologyUpstreamName(up.Name, zone),
+			Zone:               zone,
+			Endpoints:          endpoints,
+			Policies:           up.Policies,
+			UpstreamSettings:   settings,
+			SessionPersistence: up.SessionPersistence,
+			StateFileKey:       topologyUpstreamName(up.StateFileKey, zone),
+		})
+	}
+
+	return topologyUpstreams
+}
+
+// topologyLoadBalancingMethod returns the load balancing method of the topology-aware variants of an Upstream
+// with the provided method. NGINX doesn't support backup servers with the random methods, so they are replaced
+// with the closest method that supports them. Returns false if the method can't be used with backup servers,
+// because the hash methods select a server by a key, which the topology-aware routing would break.
+func topologyLoadBalancingMethod(method string) (string, bool) {
+	switch ngfAPIv1alpha1.LoadBalancingType(method) {
+	case ngfAPIv1alpha1.LoadBalancingTypeIPHash,
+		ngfAPIv1alpha1.LoadBalancingTypeHash,
+		ngfAPIv1alpha1.LoadBalancingTypeHashConsistent:
+		return "", false
+	case "",
+		ngfAPIv1alpha1.LoadBalancingTypeRandom,
+		ngfAPIv1alpha1.LoadBalancingTypeRandomTwo,
+		ngfAPIv1alpha1.LoadBalancingTypeRandomTwoLeastConnection:
+		return string(ngfAPIv1alpha1.LoadBalancingTypeLeastConnection), true
+	case ngfAPIv1alpha1.LoadBalancingTypeRandomTwoLeastTimeHeader:
+		return string(ngfAPIv1alpha1.LoadBalancingTypeLeastTimeHeader), true
+	case ngfAPIv1alpha1.LoadBalancingTypeRandomTwoLeastTimeLastByte:
+		return string(ngfAPIv1alpha1.LoadBalancingTypeLeastTimeLastByte), true
+	default:
+		return method, true
+	}
+}
+
+// topologyUpstreamName returns the name of the topology-aware variant of an Upstream for a zone.
+func topologyUpstreamName(upstreamName, zone string) string {
+	return fmt.Sprintf("%s_zone_%s", upstreamName, zone)
+}
+
+// setTopologyAwareBackends marks the backends whose upstreams have topology-aware variants.
+func setTopologyAwareBackends(servers []VirtualServer, upstreams []Upstream) {
+	topologyAware := make(map[string]struct{})
+	for _, up := range upstreams {
+		if len(up.TopologyUpstreams) > 0 {
+			topologyAware[up.Name] = struct{}{}
+		}
+	}
+
+	if len(topologyAware) == 0 {
+		return
+	}
+
+	for _, server := range servers {
+		for _, pathRule := range server.PathRules {
+			for _, matchRule := range pathRule.MatchRules {
+				for i, backend := range matchRule.BackendGroup.Backends {
+					_, exists := topologyAware[backend.UpstreamName]
+					matchRule.BackendGroup.Backends[i].TopologyAware = exists
+				}
+			}
+		}
+	}
 }
 
 func getListenerHostname(h *v1.Hostname) string {
@@ -2359,7 +2550,7 @@ func buildBaseHTTPConfig(
 	// these policies need to create the limit_req_zone directive at the http context.
 	// To achieve this, we create a modified copy of the RateLimitPolicy with an annotation
 	// indicating it's for HTTP context use only and attach it to the base HTTP config.
-	httpContextRateLimitPolicies := buildHTTPContextRateLimitPolicies(gatewayRateLimitPolicies)
+	httpContextRateLimitPolicies := buildHTTPContextPolicies[*ngfAPIv1alpha1.RateLimitPolicy](gatewayRateLimitPolicies)
 	baseConfig.Policies = append(baseConfig.Policies, httpContextRateLimitPolicies...)
 
 	if gateway.Valid && gateway.SecretRef != nil {
@@ -2423,56 +2614,50 @@ func buildDisableBaseProxySetHeaders(np *graph.EffectiveNginxProxy) []string {
 	return disabledHeaders
 }
 
-// buildHTTPContextRateLimitPolicies creates HTTP context versions of RateLimitPolicies that target routes.
+// buildHTTPContextPolicies creates HTTP context versions of the valid policies of type T that target routes.
 // These policies are modified copies with an annotation to indicate they're for HTTP context use only.
-func buildHTTPContextRateLimitPolicies(gatewayRateLimitPolicies map[graph.PolicyKey]*graph.Policy) []policies.Policy {
-	if len(gatewayRateLimitPolicies) == 0 {
+// The policies are sorted by namespace and name for deterministic ordering.
+func buildHTTPContextPolicies[T policies.Policy](graphPolicies map[graph.PolicyKey]*graph.Policy) []policies.Policy {
+	if len(graphPolicies) == 0 {
 		return nil
 	}
 
-	httpContextRateLimitPolicies := make([]policies.Policy, 0, len(gatewayRateLimitPolicies))
+	httpContextPolicies := make([]policies.Policy, 0, len(graphPolicies))
 
-	for _, graphPolicy := range gatewayRateLimitPolicies {
+	for _, graphPolicy := range graphPolicies {
 		if graphPolicy == nil || !graphPolicy.Valid {
 			continue
 		}
 
-		// Extract the actual RateLimitPolicy from the graph.Policy wrapper
-		rateLimitPolicy, ok := graphPolicy.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
+		policy, ok := graphPolicy.Source.(T)
 		if !ok {
 			continue
 		}
 
-		// Create a deep copy of the RateLimitPolicy
-		httpContextPolicy := rateLimitPolicy.DeepCopy()
+		httpContextPolicy, ok := policy.DeepCopyObject().(policies.Policy)
+		if !ok {
+			continue
+		}
 
 		// Add a marker annotation to identify this as a fake HTTP context policy
-		if httpContextPolicy.Annotations == nil {
-			httpContextPolicy.Annotations = make(map[string]string)
+		annotations := httpContextPolicy.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
 		}
-		httpContextPolicy.Annotations[InternalRLPAnnotationKey] = InternalRLPAnnotationValue
+		annotations[InternalHTTPContextAnnotationKey] = InternalHTTPContextAnnotationValue
+		httpContextPolicy.SetAnnotations(annotations)
 
-		// Convert to policies.Policy interface and add to the list
-		httpContextRateLimitPolicies = append(httpContextRateLimitPolicies, httpContextPolicy)
+		httpContextPolicies = append(httpContextPolicies, httpContextPolicy)
 	}
 
-	// Sort for deterministic ordering
-	sort.Slice(httpContextRateLimitPolicies, func(i, j int) bool {
-		policyI, okI := httpContextRateLimitPolicies[i].(*ngfAPIv1alpha1.RateLimitPolicy)
-		policyJ, okJ := httpContextRateLimitPolicies[j].(*ngfAPIv1alpha1.RateLimitPolicy)
-
-		if !okI || !okJ {
-			// If type cast fails, fall back to comparing by string representation
-			return fmt.Sprintf("%v", httpContextRateLimitPolicies[i]) < fmt.Sprintf("%v", httpContextRateLimitPolicies[j])
+	sort.Slice(httpContextPolicies, func(i, j int) bool {
+		if httpContextPolicies[i].GetNamespace() != httpContextPolicies[j].GetNamespace() {
+			return httpContextPolicies[i].GetNamespace() < httpContextPolicies[j].GetNamespace()
 		}
-
-		if policyI.Namespace != policyJ.Namespace {
-			return policyI.Namespace < policyJ.Namespace
-		}
-		return policyI.Name < policyJ.Name
+		return httpContextPolicies[i].GetName() < httpContextPolicies[j].GetName()
 	})
 
-	return httpContextRateLimitPolicies
+	return httpContextPolicies
 }
 
 func GetNginxReadinessProbePort(np *graph.EffectiveNginxProxy) int32 {
@@ -2525,6 +2710,93 @@ func buildBaseStreamConfig(gateway *graph.Gateway) BaseStreamConfig {
 	return baseConfig
 }
 
+// buildZoneSync builds the zone synchronization configuration if the Gateway needs to synchronize the shared
+// memory zones of its replicas, which is the case when its RateLimitPolicies define global rate limits.
+// NGINX resolves the replicas with the DNS resolver of the stream context, or with the zoneSyncResolver
+// if the stream context doesn't have one.
+func buildZoneSync(
+	gateway *graph.Gateway,
+	clusterDomain string,
+	zoneSyncResolver string,
+	streamResolver *DNSResolverConfig,
+) *ZoneSyncConfig {
+	if !gateway.ZoneSync {
+		return nil
+	}
+
+	domain := clusterDomain
+	if domain == "" {
+		domain = defaultClusterDomain
+	}
+
+	zoneSync := &ZoneSyncConfig{
+		Server: fmt.Sprintf(
+			"%s.%s.svc.%s:%d",
+			controller.CreateZoneSyncServiceName(gateway.DeploymentName.Name),
+			gateway.DeploymentName.Namespace,
+			domain,
+			ZoneSyncPort,
+		),
+		Port: ZoneSyncPort,
+	}
+
+	if streamResolver == nil {
+		zoneSync.Resolver = zoneSyncResolver
+	}
+
+	return zoneSync
+}
+
+// buildStreamContextPolicies returns the unique policies attached to the given stream servers.
+// Such policies need to define their shared memory zones at the stream context.
+func buildStreamContextPolicies(servers []Layer4VirtualServer) []policies.Policy {
+	// The same policy can be attached to multiple servers if its Route is attached to multiple listeners.
+	uniquePolicies := make(map[policies.Policy]struct{})
+
+	for _, server := range servers {
+		for _, pol := range server.Policies {
+			uniquePolicies[pol] = struct{}{}
+		}
+	}
+
+	if len(uniquePolicies) == 0 {
+		return nil
+	}
+
+	streamPolicies := make([]policies.Policy, 0, len(uniquePolicies))
+	for pol := range uniquePolicies {
+		streamPolicies = append(streamPolicies, pol)
+	}
+
+	// Preserve order so that this doesn't trigger an unnecessary reload.
+	sort.Slice(streamPolicies, func(i, j int) bool {
+		return ngfsort.LessClientObject(streamPolicies[i], streamPolicies[j])
+	})
+
+	return streamPolicies
+}
+
+// buildL4ServerPolicies returns the policies that apply to a Layer4 server of the given Route.
+// The stream context has no Gateway-level block, so AccessControlPolicies that target the Gateway are
+// added to the server, unless the Route is targeted by its own AccessControlPolicy which overrides them.
+func buildL4ServerPolicies(gateway *graph.Gateway, routePolicies []*graph.Policy) []policies.Policy {
+	serverPolicies := buildPolicies(gateway, routePolicies)
+
+	for _, pol := range serverPolicies {
+		if _, ok := pol.(*ngfAPIv1alpha1.AccessControlPolicy); ok {
+			return serverPolicies
+		}
+	}
+
+	for _, pol := range buildPolicies(gateway, gateway.Policies) {
+		if _, ok := pol.(*ngfAPIv1alpha1.AccessControlPolicy); ok {
+			serverPolicies = append(serverPolicies, pol)
+		}
+	}
+
+	return serverPolicies
+}
+
 func buildRewriteClientIPConfig(rewriteClientIPConfig *ngfAPIv1alpha2.RewriteClientIP) RewriteClientIPSettings {
 	var rewriteClientIPSettings RewriteClientIPSettings
 	if rewriteClientIPConfig != nil {
@@ -2643,7 +2915,11 @@ func buildLogging(gateway *graph.Gateway) Logging {
 
 		srcLogSettings := ngfProxy.Logging
 
-		if accessLog := buildAccessLog(srcLogSettings); accessLog != nil {
+		// accessLogs replaces the single access log, including the JSON access log that is
+		// otherwise used with the JSON error log format.
+		if len(srcLogSettings.AccessLogs) > 0 {
+			logSettings.AccessLogs = BuildAccessLogs(srcLogSettings.AccessLogs)
+		} else if accessLog := buildAccessLog(srcLogSettings); accessLog != nil {
 			logSettings.AccessLog = accessLog
 		}
 	}
@@ -2651,6 +2927,74 @@ func buildLogging(gateway *graph.Gateway) Logging {
 	return logSettings
 }
 
+// BuildAccessLogs builds the configuration for a list of access logs.
+func BuildAccessLogs(entries []ngfAPIv1alpha1.NginxAccessLogEntry) []AccessLog {
+	accessLogs := make([]AccessLog, 0, len(entries))
+
+	for _, entry := range entries {
+		accessLog := AccessLog{
+			Path: buildAccessLogPath(entry.Destination),
+		}
+
+		if entry.Format != nil && *entry.Format != "" {
+			accessLog.Format = *entry.Format
+			if entry.Escape != nil {
+				accessLog.Escape = string(*entry.Escape)
+			}
+		}
+
+		if entry.Condition != nil {
+			accessLog.StatusCodes = entry.Condition.StatusCodes
+			// sampling 100% of requests is the same as not sampling at all
+			if entry.Condition.SamplePercent != nil && *entry.Condition.SamplePercent < 100 {
+				accessLog.SamplePercent = *entry.Condition.SamplePercent
+			}
+		}
+
+		accessLogs = append(accessLogs, accessLog)
+	}
+
+	return accessLogs
+}
+
+func buildAccessLogPath(destination ngfAPIv1alpha1.NginxAccessLogDestination) string {
+	switch destination.Type {
+	case ngfAPIv1alpha1.NginxAccessLogDestinationFile:
+		if destination.File != nil {
+			return AccessLogDirectory + "/" + destination.File.Name
+		}
+	case ngfAPIv1alpha1.NginxAccessLogDestinationSyslog:
+		if destination.Syslog != nil {
+			return buildSyslogPath(*destination.Syslog)
+		}
+	}
+
+	return DefaultAccessLogPath
+}
+
+// buildSyslogPath builds the syslog address of an access log.
+// See https://nginx.org/en/docs/syslog.html
+func buildSyslogPath(syslog ngfAPIv1alpha1.NginxAccessLogSyslog) string {
+	var sb strings.Builder
+	sb.WriteString("syslog:server=")
+	sb.WriteString(syslog.Server)
+
+	if syslog.Facility != nil {
+		sb.WriteString(",facility=")
+		sb.WriteString(string(*syslog.Facility))
+	}
+	if syslog.Severity != nil {
+		sb.WriteString(",severity=")
+		sb.WriteString(string(*syslog.Severity))
+	}
+	if syslog.Tag != nil {
+		sb.WriteString(",tag=")
+		sb.WriteString(*syslog.Tag)
+	}
+
+	return sb.String()
+}
+
 func buildAccessLog(srcLogSettings *ngfAPIv1alpha2.NginxLogging) *AccessLog {
 	if srcLogSettings.AccessLog != nil {
 		if srcLogSettings.AccessLog.Disable != nil && *srcLogSettings.AccessLog.Disable {
@@ -2826,6 +3170,16 @@ func resolveUpstreamEndpoints(
 	referencedServices map[types.NamespacedName]*graph.ReferencedService,
 	useClusterIP bool,
 ) ([]resolver.Endpoint, error) {
+	// Resolve endpoints for both IPv4 and IPv6. NginxProxy ipFamily controls only the
+	// NGINX listen directives, not which upstream endpoints are selected.
+	addressTypes := []discoveryV1.AddressType{discoveryV1.AddressTypeIPv4, discoveryV1.AddressTypeIPv6}
+
+	// ServiceImports are resolved through the EndpointSlices derived from them, which are exported
+	// from the other clusters of the ClusterSet.
+	if br.IsServiceImport {
+		return svcResolver.ResolveServiceImport(ctx, logger, br.SvcNsName, br.ServicePort, addressTypes)
+	}
+
 	// Check if this is an ExternalName service
 	if externalName := getExternalHostname(br.SvcNsName, referencedServices); externalName != "" {
 		// For ExternalName services, create an endpoint directly with the external name
@@ -2858,15 +3212,7 @@ func resolveUpstreamEndpoints(
 		}
 	}
 
-	// Resolve endpoints for both IPv4 and IPv6. NginxProxy ipFamily controls only the
-	// NGINX listen directives, not which upstream endpoints are selected.
-	return svcResolver.Resolve(
-		ctx,
-		logger,
-		br.SvcNsName,
-		br.ServicePort,
-		[]discoveryV1.AddressType{discoveryV1.AddressTypeIPv4, discoveryV1.AddressTypeIPv6},
-	)
+	return svcResolver.Resolve(ctx, logger, br.SvcNsName, br.ServicePort, addressTypes)
 }
 
 func buildServerTokens(gateway *graph.Gateway) string {
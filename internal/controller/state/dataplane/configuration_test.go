@@ -5,6 +5,7 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
+	"slices"
 	"sort"
 	"testing"
 	"time"
@@ -24,6 +25,7 @@ import (
 	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/shared"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
@@ -2755,7 +2757,7 @@ func TestBuildConfiguration(t *testing.T) {
 						Name:      "route-rate-limit",
 						Namespace: "test",
 						Annotations: map[string]string{
-							InternalRLPAnnotationKey: InternalRLPAnnotationValue,
+							InternalHTTPContextAnnotationKey: InternalHTTPContextAnnotationValue,
 						},
 					},
 					Spec: ngfAPIv1alpha1.RateLimitPolicySpec{
@@ -3024,6 +3026,8 @@ func TestBuildConfiguration(t *testing.T) {
 				fakeResolver,
 				false,
 				ngfAPIv1alpha2.Dual,
+				"",
+				"",
 			)
 
 			assertBuildConfiguration(g, result, test.expConf)
@@ -3112,6 +3116,8 @@ func TestBuildConfiguration_Guardrails(t *testing.T) {
 			fakeResolver,
 			false,
 			ngfAPIv1alpha2.Dual,
+			"",
+			"",
 		)
 
 		g.Expect(result.GuardrailsEnabled).To(BeTrue())
@@ -3140,6 +3146,8 @@ func TestBuildConfiguration_Guardrails(t *testing.T) {
 			fakeResolver,
 			false,
 			ngfAPIv1alpha2.Dual,
+			"",
+			"",
 		)
 
 		g.Expect(result.GuardrailsEnabled).To(BeFalse())
@@ -3251,6 +3259,8 @@ func TestBuildConfiguration_Plus(t *testing.T) {
 				fakeResolver,
 				true,
 				ngfAPIv1alpha2.Dual,
+				"",
+				"",
 			)
 
 			g.Expect(result.BackendGroups).To(ConsistOf(test.expConf.BackendGroups))
@@ -4312,6 +4322,85 @@ func TestBuildUpstreamsAlwaysResolvesAllAddressTypes(t *testing.T) {
 	}
 }
 
+func TestBuildUpstreamsWithServiceImport(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	nsName := types.NamespacedName{Namespace: "test", Name: "svc"}
+	svcRef := graph.BackendRef{
+		SvcNsName:   nsName,
+		ServicePort: apiv1.ServicePort{Port: 80},
+		Valid:       true,
+	}
+	svcImportRef := graph.BackendRef{
+		SvcNsName:       nsName,
+		ServicePort:     apiv1.ServicePort{Port: 80},
+		Valid:           true,
+		IsServiceImport: true,
+	}
+
+	gateway := &graph.Gateway{
+		Source: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gateway"}},
+		Listeners: []*graph.Listener{
+			{
+				Valid: true,
+				Routes: map[graph.RouteKey]*graph.L7Route{
+					{NamespacedName: types.NamespacedName{Name: "hr", Namespace: "test"}}: {
+						Valid: true,
+						Spec:  graph.L7RouteSpec{Rules: refsToValidRules([]graph.BackendRef{svcRef, svcImportRef})},
+					},
+				},
+			},
+		},
+	}
+
+	// The Service with the same name as the ServiceImport is an ExternalName Service with a policy, which must not
+	// be used for the ServiceImport.
+	usp := &ngfAPIv1alpha1.UpstreamSettingsPolicy{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "usp"},
+		Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+			LoadBalancingMethod: helpers.GetPointer(ngfAPIv1alpha1.LoadBalancingTypeIPHash),
+		},
+	}
+	referencedServices := map[types.NamespacedName]*graph.ReferencedService{
+		nsName: {
+			ExternalName:   "example.com",
+			IsExternalName: true,
+			Policies:       []*graph.Policy{{Source: usp, Valid: true}},
+		},
+	}
+
+	svcImportEndpoints := []resolver.Endpoint{{Address: "10.1.0.1", Port: 8080}}
+
+	fakeResolver := &resolverfakes.FakeServiceResolver{}
+	fakeResolver.ResolveServiceImportReturns(svcImportEndpoints, nil)
+
+	upstreams := buildUpstreams(t.Context(), logr.Discard(), gateway, fakeResolver, referencedServices)
+
+	g.Expect(fakeResolver.ResolveCallCount()).To(BeZero())
+	g.Expect(fakeResolver.ResolveServiceImportCallCount()).To(Equal(1))
+	_, _, svcImportNsName, svcPort, addressTypes := fakeResolver.ResolveServiceImportArgsForCall(0)
+	g.Expect(svcImportNsName).To(Equal(nsName))
+	g.Expect(svcPort).To(Equal(apiv1.ServicePort{Port: 80}))
+	g.Expect(addressTypes).To(ConsistOf(discoveryV1.AddressTypeIPv4, discoveryV1.AddressTypeIPv6))
+
+	g.Expect(upstreams).To(ConsistOf(
+		HaveField("Name", svcRef.ServicePortReference()),
+		HaveField("Name", svcImportRef.ServicePortReference()),
+	))
+	for _, u := range upstreams {
+		if u.Name == svcImportRef.ServicePortReference() {
+			g.Expect(u.Endpoints).To(Equal(svcImportEndpoints))
+			g.Expect(u.Policies).To(BeEmpty())
+			g.Expect(u.UpstreamSettings.LoadBalancingMethod).To(BeEmpty())
+		} else {
+			g.Expect(u.Endpoints).To(Equal([]resolver.Endpoint{{Address: "example.com", Port: 80, Resolve: true}}))
+			g.Expect(u.Policies).To(ConsistOf(usp))
+			g.Expect(u.UpstreamSettings.LoadBalancingMethod).To(Equal(string(ngfAPIv1alpha1.LoadBalancingTypeIPHash)))
+		}
+	}
+}
+
 func createBackendGroup(name string, ruleIdx int, backendNames ...string) BackendGroup {
 	backends := make([]Backend, len(backendNames))
 	for i, name := range backendNames {
@@ -4977,6 +5066,210 @@ func TestBuildPolicies(t *testing.T) {
 	}
 }
 
+func TestBuildHTTPContextPolicies(t *testing.T) {
+	t.Parallel()
+
+	createCachePolicy := func(ns, name string) *ngfAPIv1alpha1.CachePolicy {
+		return &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+			Spec: ngfAPIv1alpha1.CachePolicySpec{
+				Key: helpers.GetPointer("$host$request_uri"),
+			},
+		}
+	}
+
+	createExpPolicy := func(ns, name string) *ngfAPIv1alpha1.CachePolicy {
+		cp := createCachePolicy(ns, name)
+		cp.Annotations = map[string]string{
+			InternalHTTPContextAnnotationKey: InternalHTTPContextAnnotationValue,
+		}
+		return cp
+	}
+
+	sourceB := createCachePolicy("test", "b")
+
+	tests := []struct {
+		policies map[graph.PolicyKey]*graph.Policy
+		name     string
+		expected []policies.Policy
+	}{
+		{
+			name:     "no policies",
+			policies: nil,
+			expected: nil,
+		},
+		{
+			name: "policies are copied, annotated, and sorted; invalid and other kinds of policies are skipped",
+			policies: map[graph.PolicyKey]*graph.Policy{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "b"}}: {
+					Source: sourceB,
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "a"}}: {
+					Source: createCachePolicy("test", "a"),
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "other", Name: "z"}}: {
+					Source: createCachePolicy("other", "z"),
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "invalid"}}: {
+					Source: createCachePolicy("test", "invalid"),
+					Valid:  false,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "nil"}}: nil,
+				{NsName: types.NamespacedName{Namespace: "test", Name: "other-kind"}}: {
+					Source: &ngfAPIv1alpha1.RateLimitPolicy{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "other-kind"},
+					},
+					Valid: true,
+				},
+			},
+			expected: []policies.Policy{
+				createExpPolicy("other", "z"),
+				createExpPolicy("test", "a"),
+				createExpPolicy("test", "b"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildHTTPContextPolicies[*ngfAPIv1alpha1.CachePolicy](test.policies)).To(Equal(test.expected))
+			g.Expect(sourceB.Annotations).To(BeNil())
+		})
+	}
+}
+
+func TestBuildHTTPContextPolicies_AccessLogPolicies(t *testing.T) {
+	t.Parallel()
+
+	createAccessLogPolicy := func(ns, name string) *ngfAPIv1alpha1.AccessLogPolicy {
+		return &ngfAPIv1alpha1.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+			Spec: ngfAPIv1alpha1.AccessLogPolicySpec{
+				Disable: helpers.GetPointer(true),
+			},
+		}
+	}
+
+	createExpPolicy := func(ns, name string) *ngfAPIv1alpha1.AccessLogPolicy {
+		p := createAccessLogPolicy(ns, name)
+		p.Annotations = map[string]string{
+			InternalHTTPContextAnnotationKey: InternalHTTPContextAnnotationValue,
+		}
+		return p
+	}
+
+	tests := []struct {
+		policies map[graph.PolicyKey]*graph.Policy
+		name     string
+		expected []policies.Policy
+	}{
+		{
+			name:     "no policies",
+			policies: nil,
+			expected: nil,
+		},
+		{
+			name: "policies are copied, annotated, and sorted; invalid policies are skipped",
+			policies: map[graph.PolicyKey]*graph.Policy{
+				{NsName: types.NamespacedName{Namespace: "test", Name: "b"}}: {
+					Source: createAccessLogPolicy("test", "b"),
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "a"}}: {
+					Source: createAccessLogPolicy("test", "a"),
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "other", Name: "z"}}: {
+					Source: createAccessLogPolicy("other", "z"),
+					Valid:  true,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "invalid"}}: {
+					Source: createAccessLogPolicy("test", "invalid"),
+					Valid:  false,
+				},
+				{NsName: types.NamespacedName{Namespace: "test", Name: "nil"}}: nil,
+			},
+			expected: []policies.Policy{
+				createExpPolicy("other", "z"),
+				createExpPolicy("test", "a"),
+				createExpPolicy("test", "b"),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildHTTPContextPolicies[*ngfAPIv1alpha1.AccessLogPolicy](test.policies)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildStreamContextPolicies(t *testing.T) {
+	t.Parallel()
+
+	createPolicy := func(name string) *ngfAPIv1alpha1.RateLimitPolicy {
+		return &ngfAPIv1alpha1.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      name,
+			},
+		}
+	}
+
+	polA := createPolicy("a")
+	polB := createPolicy("b")
+
+	tests := []struct {
+		name     string
+		servers  []Layer4VirtualServer
+		expected []policies.Policy
+	}{
+		{
+			name:     "no servers",
+			expected: nil,
+		},
+		{
+			name: "servers without policies",
+			servers: []Layer4VirtualServer{
+				{Port: 8080},
+			},
+			expected: nil,
+		},
+		{
+			name: "policies are deduplicated and sorted",
+			servers: []Layer4VirtualServer{
+				{Port: 8080, Policies: []policies.Policy{polB}},
+				{Port: 8081, Policies: []policies.Policy{polA, polB}},
+			},
+			expected: []policies.Policy{polA, polB},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildStreamContextPolicies(test.servers)).To(Equal(test.expected))
+		})
+	}
+}
+
 func TestCreateRatioVarName(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -5775,7 +6068,13 @@ func TestBuildStreamUpstreams(t *testing.T) {
 							Namespace: "default",
 							Name:      "usp-use-cluster-ip",
 						},
-						Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{UseClusterIP: helpers.GetPointer(true)},
+						Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+							UseClusterIP: helpers.GetPointer(true),
+							Stream: &ngfAPIv1alpha1.UpstreamStreamSettings{
+								ProxyProtocol: helpers.GetPointer(true),
+								Timeout:       helpers.GetPointer[ngfAPIv1alpha1.Duration]("1m"),
+							},
+						},
 					},
 					Valid: true,
 				},
@@ -5798,6 +6097,13 @@ func TestBuildStreamUpstreams(t *testing.T) {
 		{
 			Name:      "default_cluster-app_8443",
 			Endpoints: fakeEndpoints,
+			// only the stream settings of the policy apply to stream upstreams
+			UpstreamSettings: upstreamsettings.UpstreamSettings{
+				Stream: upstreamsettings.StreamSettings{
+					ProxyProtocol: true,
+					Timeout:       "1m",
+				},
+			},
 		},
 		{
 			Name: "default_external-app_443",
@@ -5824,6 +6130,13 @@ func TestBuildL4Servers(t *testing.T) {
 
 	baseTime := metav1.NewTime(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
 
+	l4RoutePolicy := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "rlp",
+		},
+	}
+
 	createL4Route := func(name string, valid bool, backendRefs []graph.BackendRef) *graph.L4Route {
 		return &graph.L4Route{
 			Valid: valid,
@@ -6462,6 +6775,53 @@ func TestBuildL4Servers(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid policies of the programmed route are included",
+			gateway: &graph.Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "test",
+						Name:      "gateway",
+					},
+				},
+				Listeners: []*graph.Listener{
+					{
+						Name:  "tcp-listener",
+						Valid: true,
+						Source: v1.Listener{
+							Protocol: v1.TCPProtocolType,
+							Port:     8080,
+						},
+						L4Routes: map[graph.L4RouteKey]*graph.L4Route{
+							{NamespacedName: types.NamespacedName{Namespace: "default", Name: "route"}}: func() *graph.L4Route {
+								route := createL4Route("route", true, []graph.BackendRef{{
+									Valid:       true,
+									SvcNsName:   types.NamespacedName{Namespace: "default", Name: "svc"},
+									ServicePort: apiv1.ServicePort{Name: "tcp", Port: 8080},
+									Weight:      1,
+								}})
+								route.Policies = []*graph.Policy{
+									{Source: l4RoutePolicy, Valid: true},
+									{Source: &ngfAPIv1alpha1.RateLimitPolicy{}, Valid: false},
+								}
+								return route
+							}(),
+						},
+					},
+				},
+			},
+			protocol: v1.TCPProtocolType,
+			expectedServers: []Layer4VirtualServer{
+				{
+					Hostname: "",
+					Port:     8080,
+					Upstreams: []Layer4Upstream{
+						{Name: "default_svc_8080", Weight: 1},
+					},
+					Policies: []policies.Policy{l4RoutePolicy},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -8453,6 +8813,106 @@ func TestBuildLogging(t *testing.T) {
 				AccessLog:  nil,
 			},
 		},
+		{
+			msg: "AccessLogs configured",
+			gw: &graph.Gateway{
+				EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						ErrorLevel: helpers.GetPointer(ngfAPIv1alpha2.NginxLogLevelInfo),
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationStdout,
+								},
+							},
+							{
+								Format: helpers.GetPointer(logFormat),
+								Escape: helpers.GetPointer(ngfAPIv1alpha1.NginxAccessLogEscapeJSON),
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationFile,
+									File: &ngfAPIv1alpha1.NginxAccessLogFile{Name: "access.log"},
+								},
+								Condition: &ngfAPIv1alpha1.NginxAccessLogCondition{
+									StatusCodes: []string{"404", "5xx"},
+								},
+							},
+							{
+								Escape: helpers.GetPointer(ngfAPIv1alpha1.NginxAccessLogEscapeJSON),
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationSyslog,
+									Syslog: &ngfAPIv1alpha1.NginxAccessLogSyslog{
+										Server:   "syslog.example.com:514",
+										Facility: helpers.GetPointer[ngfAPIv1alpha1.SyslogFacility]("local7"),
+										Severity: helpers.GetPointer[ngfAPIv1alpha1.SyslogSeverity]("info"),
+										Tag:      helpers.GetPointer("ngf"),
+									},
+								},
+								Condition: &ngfAPIv1alpha1.NginxAccessLogCondition{
+									SamplePercent: helpers.GetPointer[int32](10),
+								},
+							},
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type:   ngfAPIv1alpha1.NginxAccessLogDestinationSyslog,
+									Syslog: &ngfAPIv1alpha1.NginxAccessLogSyslog{Server: "10.0.0.1"},
+								},
+								Condition: &ngfAPIv1alpha1.NginxAccessLogCondition{
+									SamplePercent: helpers.GetPointer[int32](100),
+								},
+							},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{
+				ErrorLevel: "info",
+				AccessLogs: []AccessLog{
+					{
+						Path: "/dev/stdout",
+					},
+					{
+						Format:      logFormat,
+						Escape:      "json",
+						Path:        "/var/log/nginx/access/access.log",
+						StatusCodes: []string{"404", "5xx"},
+					},
+					{
+						Path:          "syslog:server=syslog.example.com:514,facility=local7,severity=info,tag=ngf",
+						SamplePercent: 10,
+					},
+					{
+						Path: "syslog:server=10.0.0.1",
+					},
+				},
+			},
+		},
+		{
+			msg: "AccessLogs replace the JSON access log of the JSON error log format",
+			gw: &graph.Gateway{
+				EffectiveNginxProxy: &graph.EffectiveNginxProxy{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						ErrorLevel:     helpers.GetPointer(ngfAPIv1alpha2.NginxLogLevelInfo),
+						ErrorLogFormat: helpers.GetPointer(ngfAPIv1alpha2.NginxErrorLogFormatJSON),
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationStdout,
+								},
+							},
+						},
+					},
+				},
+			},
+			expLoggingSettings: Logging{
+				ErrorLevel:     "info",
+				ErrorLogFormat: "json",
+				AccessLogs: []AccessLog{
+					{
+						Path: "/dev/stdout",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -9614,6 +10074,8 @@ func TestBuildConfiguration_GatewaysAndListeners(t *testing.T) {
 				fakeResolver,
 				false,
 				ngfAPIv1alpha2.Dual,
+				"",
+				"",
 			)
 
 			assertBuildConfiguration(g, result, test.expConf)
@@ -9878,6 +10340,8 @@ func TestBuildConfiguration_NginxProxy(t *testing.T) {
 				fakeResolver,
 				false,
 				ngfAPIv1alpha2.Dual,
+				"",
+				"",
 			)
 
 			assertBuildConfiguration(g, result, test.expConf)
@@ -10072,6 +10536,8 @@ func TestBuildConfiguration_ClusterIPFamily(t *testing.T) {
 				fakeResolver,
 				false,
 				test.clusterIPFamily,
+				"",
+				"",
 			)
 
 			g.Expect(result.BaseHTTPConfig.IPFamily).To(Equal(test.expectedFamily))
@@ -12610,3 +13076,383 @@ func TestGuardrailsEnabled(t *testing.T) {
 	g.Expect(guardrailsEnabled(withoutGuardrails, withGuardrails)).To(BeTrue())
 	g.Expect(guardrailsEnabled()).To(BeFalse())
 }
+
+func TestBuildL4ServerPolicies(t *testing.T) {
+	t.Parallel()
+
+	gwSource := &v1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gateway",
+		},
+	}
+
+	createACP := func(name string) *ngfAPIv1alpha1.AccessControlPolicy {
+		return &ngfAPIv1alpha1.AccessControlPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+				Name:      name,
+			},
+		}
+	}
+
+	gwACP := createACP("gw-acp")
+	routeACP := createACP("route-acp")
+	invalidGwACP := createACP("invalid-gw-acp")
+	rlp := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "rlp",
+		},
+	}
+	gwRLP := &ngfAPIv1alpha1.RateLimitPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "test",
+			Name:      "gw-rlp",
+		},
+	}
+
+	gateway := &graph.Gateway{
+		Source: gwSource,
+		Policies: []*graph.Policy{
+			{Source: gwACP, Valid: true},
+			{Source: invalidGwACP, Valid: false},
+			{Source: gwRLP, Valid: true},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		routePolicies []*graph.Policy
+		expected      []policies.Policy
+	}{
+		{
+			name:     "no route policies; gateway access control policy is added",
+			expected: []policies.Policy{gwACP},
+		},
+		{
+			name: "route policies without access control policy; gateway access control policy is added",
+			routePolicies: []*graph.Policy{
+				{Source: rlp, Valid: true},
+			},
+			expected: []policies.Policy{rlp, gwACP},
+		},
+		{
+			name: "route access control policy overrides gateway access control policy",
+			routePolicies: []*graph.Policy{
+				{Source: rlp, Valid: true},
+				{Source: routeACP, Valid: true},
+			},
+			expected: []policies.Policy{rlp, routeACP},
+		},
+		{
+			name: "invalid route access control policy does not override gateway access control policy",
+			routePolicies: []*graph.Policy{
+				{Source: routeACP, Valid: false},
+			},
+			expected: []policies.Policy{gwACP},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildL4ServerPolicies(gateway, test.routePolicies)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildZoneSync(t *testing.T) {
+	t.Parallel()
+
+	deploymentName := types.NamespacedName{
+		Namespace: "test",
+		Name:      "gateway-nginx",
+	}
+
+	tests := []struct {
+		gateway          *graph.Gateway
+		streamResolver   *DNSResolverConfig
+		expected         *ZoneSyncConfig
+		name             string
+		clusterDomain    string
+		zoneSyncResolver string
+	}{
+		{
+			name: "zone sync not needed",
+			gateway: &graph.Gateway{
+				DeploymentName: deploymentName,
+			},
+			zoneSyncResolver: "10.96.0.10",
+			expected:         nil,
+		},
+		{
+			name: "zone sync with zone sync resolver",
+			gateway: &graph.Gateway{
+				DeploymentName: deploymentName,
+				ZoneSync:       true,
+			},
+			zoneSyncResolver: "10.96.0.10",
+			expected: &ZoneSyncConfig{
+				Server:   "gateway-nginx-zone-sync.test.svc.cluster.local:12345",
+				Resolver: "10.96.0.10",
+				Port:     ZoneSyncPort,
+			},
+		},
+		{
+			name: "zone sync with custom cluster domain and stream resolver",
+			gateway: &graph.Gateway{
+				DeploymentName: deploymentName,
+				ZoneSync:       true,
+			},
+			clusterDomain:    "example.internal",
+			zoneSyncResolver: "10.96.0.10",
+			streamResolver: &DNSResolverConfig{
+				Addresses: []string{"10.0.0.10"},
+			},
+			expected: &ZoneSyncConfig{
+				Server: "gateway-nginx-zone-sync.test.svc.example.internal:12345",
+				Port:   ZoneSyncPort,
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			result := buildZoneSync(
+				test.gateway,
+				test.clusterDomain,
+				test.zoneSyncResolver,
+				test.streamResolver,
+			)
+			g.Expect(result).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildTopologyUpstreams(t *testing.T) {
+	t.Parallel()
+
+	endpointA := resolver.Endpoint{Address: "10.0.0.1", Port: 80, ZoneHints: []string{"zone-a"}}
+	endpointB := resolver.Endpoint{Address: "10.0.0.2", Port: 80, ZoneHints: []string{"zone-b"}}
+	drainingEndpoint := resolver.Endpoint{Address: "10.0.0.3", Port: 80, Drain: true}
+
+	backup := func(ep resolver.Endpoint) resolver.Endpoint {
+		ep.Backup = true
+		return ep
+	}
+
+	tests := []struct {
+		name     string
+		upstream Upstream
+		expected []Upstream
+	}{
+		{
+			name: "endpoints without hints",
+			upstream: Upstream{
+				Name:      "test_svc_80",
+				Endpoints: []resolver.Endpoint{{Address: "10.0.0.1", Port: 80}},
+			},
+		},
+		{
+			name: "hash load balancing method",
+			upstream: Upstream{
+				Name:      "test_svc_80",
+				Endpoints: []resolver.Endpoint{endpointA, endpointB},
+				UpstreamSettings: upstreamsettings.UpstreamSettings{
+					LoadBalancingMethod: string(ngfAPIv1alpha1.LoadBalancingTypeIPHash),
+				},
+			},
+		},
+		{
+			name: "endpoints with hints",
+			upstream: Upstream{
+				Name:         "test_svc_80",
+				StateFileKey: "test_svc_80",
+				Endpoints:    []resolver.Endpoint{endpointB, endpointA},
+			},
+			expected: []Upstream{
+				{
+					Name:         "test_svc_80_zone_zone-a",
+					StateFileKey: "test_svc_80_zone_zone-a",
+					Zone:         "zone-a",
+					Endpoints:    []resolver.Endpoint{backup(endpointB), endpointA},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						LoadBalancingMethod: string(ngfAPIv1alpha1.LoadBalancingTypeLeastConnection),
+					},
+				},
+				{
+					Name:         "test_svc_80_zone_zone-b",
+					StateFileKey: "test_svc_80_zone_zone-b",
+					Zone:         "zone-b",
+					Endpoints:    []resolver.Endpoint{endpointB, backup(endpointA)},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						LoadBalancingMethod: string(ngfAPIv1alpha1.LoadBalancingTypeLeastConnection),
+					},
+				},
+			},
+		},
+		{
+			name: "draining endpoint without hints",
+			upstream: Upstream{
+				Name:         "test_svc_80",
+				StateFileKey: "test_svc_80",
+				Endpoints:    []resolver.Endpoint{endpointA, drainingEndpoint},
+			},
+			expected: []Upstream{
+				{
+					Name:         "test_svc_80_zone_zone-a",
+					StateFileKey: "test_svc_80_zone_zone-a",
+					Zone:         "zone-a",
+					Endpoints:    []resolver.Endpoint{endpointA, backup(drainingEndpoint)},
+					UpstreamSettings: upstreamsettings.UpstreamSettings{
+						LoadBalancingMethod: string(ngfAPIv1alpha1.LoadBalancingTypeLeastConnection),
+					},
+				},
+			},
+		},
+		{
+			name: "resolved endpoint",
+			upstream: Upstream{
+				Name:      "test_svc_80",
+				Endpoints: []resolver.Endpoint{endpointA, {Address: "example.com", Port: 80, Resolve: true}},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildTopologyUpstreams(test.upstream)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestTopologyLoadBalancingMethod(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		method    ngfAPIv1alpha1.LoadBalancingType
+		expMethod ngfAPIv1alpha1.LoadBalancingType
+		expOk     bool
+	}{
+		{method: "", expMethod: ngfAPIv1alpha1.LoadBalancingTypeLeastConnection, expOk: true},
+		{
+			method:    ngfAPIv1alpha1.LoadBalancingTypeRandomTwoLeastConnection,
+			expMethod: ngfAPIv1alpha1.LoadBalancingTypeLeastConnection,
+			expOk:     true,
+		},
+		{
+			method:    ngfAPIv1alpha1.LoadBalancingTypeRandomTwoLeastTimeHeader,
+			expMethod: ngfAPIv1alpha1.LoadBalancingTypeLeastTimeHeader,
+			expOk:     true,
+		},
+		{
+			method:    ngfAPIv1alpha1.LoadBalancingTypeRoundRobin,
+			expMethod: ngfAPIv1alpha1.LoadBalancingTypeRoundRobin,
+			expOk:     true,
+		},
+		{method: ngfAPIv1alpha1.LoadBalancingTypeHashConsistent},
+	}
+
+	for _, test := range tests {
+		t.Run(string(test.method), func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			method, ok := topologyLoadBalancingMethod(string(test.method))
+			g.Expect(method).To(Equal(string(test.expMethod)))
+			g.Expect(ok).To(Equal(test.expOk))
+		})
+	}
+}
+
+func TestSetTopologyAwareBackends(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	servers := []VirtualServer{
+		{
+			PathRules: []PathRule{
+				{
+					MatchRules: []MatchRule{
+						{
+							BackendGroup: BackendGroup{
+								Backends: []Backend{
+									{UpstreamName: "topology_svc_80", Valid: true},
+									{UpstreamName: "test_svc_80", Valid: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	upstreams := []Upstream{
+		{Name: "test_svc_80"},
+		{
+			Name:              "topology_svc_80",
+			TopologyUpstreams: []Upstream{{Name: "topology_svc_80_zone_zone-a", Zone: "zone-a"}},
+		},
+	}
+
+	setTopologyAwareBackends(servers, upstreams)
+
+	backends := servers[0].PathRules[0].MatchRules[0].BackendGroup.Backends
+	g.Expect(backends[0].TopologyAware).To(BeTrue())
+	g.Expect(backends[1].TopologyAware).To(BeFalse())
+}
+
+func TestWithoutDrainingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		endpoints    []resolver.Endpoint
+		expEndpoints []resolver.Endpoint
+	}{
+		{
+			name: "no draining endpoints",
+			endpoints: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+			expEndpoints: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+		},
+		{
+			name: "draining endpoints are removed",
+			endpoints: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80, Drain: true},
+			},
+			expEndpoints: []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+		},
+		{
+			name: "nil endpoints",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			original := slices.Clone(test.endpoints)
+
+			g.Expect(withoutDrainingEndpoints(test.endpoints)).To(Equal(test.expEndpoints))
+			g.Expect(test.endpoints).To(Equal(original))
+		})
+	}
+}
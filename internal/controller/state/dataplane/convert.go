@@ -51,6 +51,28 @@ func convertMatch(m v1.HTTPRouteMatch) Match {
 	return match
 }
 
+func convertTimeouts(timeouts *graph.RouteTimeouts) *Timeouts {
+	if timeouts == nil {
+		return nil
+	}
+
+	return &Timeouts{
+		Request:        timeouts.Request,
+		BackendRequest: timeouts.BackendRequest,
+	}
+}
+
+func convertRetry(retry *graph.RouteRetry) *Retry {
+	if retry == nil {
+		return nil
+	}
+
+	return &Retry{
+		Attempts: retry.Attempts,
+		Codes:    retry.Codes,
+	}
+}
+
 func convertHTTPRequestRedirectFilter(filter *v1.HTTPRequestRedirectFilter) *HTTPRequestRedirectFilter {
 	return &HTTPRequestRedirectFilter{
 		Scheme:     filter.Scheme,
@@ -518,7 +540,15 @@ func convertHTTPExternalAuthFilter(
 		VerifyTLS:    convertBackendTLS(resolvedBackendRef.BackendTLSPolicy, gwNsName),
 	}
 
-	if filter.HTTPAuthConfig != nil {
+	if filter.ExternalAuthProtocol == v1.HTTPRouteExternalAuthGRPCProtocol {
+		result.GRPC = &ExternalAuthGRPC{
+			Host: fmt.Sprintf("%s.%s.svc", resolvedBackendRef.SvcNsName.Name, resolvedBackendRef.SvcNsName.Namespace),
+			Port: resolvedBackendRef.ServicePort.Port,
+		}
+		if filter.GRPCAuthConfig != nil {
+			result.AllowedRequestHeaders = filter.GRPCAuthConfig.AllowedRequestHeaders
+		}
+	} else if filter.HTTPAuthConfig != nil {
 		result.PathPrefix = filter.HTTPAuthConfig.Path
 		result.AllowedRequestHeaders = filter.HTTPAuthConfig.AllowedRequestHeaders
 		result.AllowedResponseHeaders = filter.HTTPAuthConfig.AllowedResponseHeaders
@@ -2041,6 +2041,61 @@ func TestConvertHTTPExternalAuthFilter(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "GRPC external auth filter",
+			filter: &v1.HTTPExternalAuthFilter{
+				ExternalAuthProtocol: v1.HTTPRouteExternalAuthGRPCProtocol,
+				BackendRef: v1.BackendObjectReference{
+					Name: "ext-auth-server",
+					Port: &port,
+				},
+				ForwardBody: &v1.ForwardBodyConfig{
+					MaxSize: 1024,
+				},
+			},
+			backendRef: validBackendRef,
+			expected: &HTTPExternalAuthFilter{
+				UpstreamName: "default_ext-auth-server_80",
+				InternalPath: "/_ngf-internal-ext-auth-default_coffee-route_rule0",
+				GRPC: &ExternalAuthGRPC{
+					Host: "ext-auth-server.default.svc",
+					Port: 80,
+				},
+				ForwardBody: true,
+				MaxBodySize: 1024,
+			},
+		},
+		{
+			name: "GRPC external auth filter with grpcAuthConfig and BackendTLSPolicy",
+			filter: &v1.HTTPExternalAuthFilter{
+				ExternalAuthProtocol: v1.HTTPRouteExternalAuthGRPCProtocol,
+				BackendRef: v1.BackendObjectReference{
+					Name: "ext-auth-server",
+					Port: &port,
+				},
+				GRPCAuthConfig: &v1.GRPCAuthConfig{
+					AllowedRequestHeaders: []string{"X-Api-Key"},
+				},
+				// ignored for the GRPC protocol
+				HTTPAuthConfig: &v1.HTTPAuthConfig{
+					Path: "/auth/check",
+				},
+			},
+			backendRef: backendRefWithBTP,
+			expected: &HTTPExternalAuthFilter{
+				UpstreamName: "default_ext-auth-server_80",
+				InternalPath: "/_ngf-internal-ext-auth-default_coffee-route_rule0",
+				GRPC: &ExternalAuthGRPC{
+					Host: "ext-auth-server.default.svc",
+					Port: 80,
+				},
+				AllowedRequestHeaders: []string{"X-Api-Key"},
+				VerifyTLS: &VerifyTLS{
+					Hostname:   "ext-auth-server.default.svc.cluster.local",
+					RootCAPath: AlpineSSLRootCAPath,
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -2346,3 +2401,75 @@ func TestConvertGraphGuardrails(t *testing.T) {
 		})
 	}
 }
+
+func TestConvertTimeouts(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		timeouts *graph.RouteTimeouts
+		expected *Timeouts
+		name     string
+	}{
+		{
+			name:     "nil timeouts",
+			timeouts: nil,
+			expected: nil,
+		},
+		{
+			name: "timeouts",
+			timeouts: &graph.RouteTimeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+			expected: &Timeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertTimeouts(test.timeouts)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestConvertRetry(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		retry    *graph.RouteRetry
+		expected *Retry
+		name     string
+	}{
+		{
+			name:     "nil retry",
+			retry:    nil,
+			expected: nil,
+		},
+		{
+			name: "retry",
+			retry: &graph.RouteRetry{
+				Attempts: helpers.GetPointer(3),
+				Codes:    []int{502, 503},
+			},
+			expected: &Retry{
+				Attempts: helpers.GetPointer(3),
+				Codes:    []int{502, 503},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(convertRetry(test.retry)).To(Equal(test.expected))
+		})
+	}
+}
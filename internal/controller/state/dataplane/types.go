@@ -45,6 +45,9 @@ type Configuration struct {
 	// An empty string represents a listener with no hostname (catch-all).
 	// Used to build NGINX maps for misdirected request detection.
 	SSLListenerHostnames map[int32][]string
+	// NodeZones maps the names of the cluster nodes to their topology zones. Set only if an Upstream has
+	// topology-aware variants, so that each nginx replica can select the variants of the zone of its node.
+	NodeZones map[string]string
 	// DeploymentContext contains metadata about NGF and the cluster.
 	DeploymentContext DeploymentContext
 	// Logging defines logging related settings for NGINX.
@@ -115,6 +118,7 @@ func cloneUpstreams(src []Upstream) []Upstream {
 	for i := range cloned {
 		cloned[i].Endpoints = slices.Clone(src[i].Endpoints)
 		cloned[i].Policies = slices.Clone(src[i].Policies)
+		cloned[i].TopologyUpstreams = cloneUpstreams(src[i].TopologyUpstreams)
 	}
 
 	return cloned
@@ -189,6 +193,8 @@ type Layer4VirtualServer struct {
 	Hostname string
 	// Upstreams holds upstreams with weights. For single backend cases, the list contains one entry.
 	Upstreams []Layer4Upstream
+	// Policies holds the policies attached to the Route that is programmed for this server.
+	Policies []policies.Policy
 	// Port is the port of the server.
 	Port int32
 	// IsDefault refers to whether this server is created for the default listener hostname.
@@ -212,10 +218,16 @@ type Upstream struct {
 	ErrorMsg string
 	// StateFileKey is the key for naming the state file for NGINX Plus upstreams.
 	StateFileKey string
+	// Zone is the topology zone of a topology-aware Upstream. Empty for other Upstreams.
+	Zone string
 	// Endpoints are the endpoints of the Upstream.
 	Endpoints []resolver.Endpoint
 	// Policies holds all the valid policies that apply to the Upstream.
 	Policies []policies.Policy
+	// TopologyUpstreams are the topology-aware variants of the Upstream, one for each zone of the hints of its
+	// endpoints. In each variant, the endpoints with hints for its zone are primary and the others are backups.
+	// Each nginx replica proxies to the variant of the zone of its node.
+	TopologyUpstreams []Upstream
 }
 
 // SessionPersistenceConfig holds the session persistence configuration for an upstream.
@@ -356,6 +368,8 @@ type HTTPCORSFilter struct {
 type HTTPExternalAuthFilter struct {
 	// VerifyTLS holds TLS verification config when the auth backend has a BackendTLSPolicy.
 	VerifyTLS *VerifyTLS
+	// GRPC holds the gRPC auth server configuration. If nil, the auth server speaks HTTP.
+	GRPC *ExternalAuthGRPC
 	// UpstreamName is the NGINX upstream name for the auth backend service.
 	UpstreamName string
 	// InternalPath is the NGINX internal location path for the auth subrequest.
@@ -364,6 +378,7 @@ type HTTPExternalAuthFilter struct {
 	PathPrefix string
 	// AllowedRequestHeaders are extra headers to forward from the client request to the auth server,
 	// beyond the mandatory set (Host, Method, Path, Content-Length, Authorization).
+	// For a gRPC auth server, all headers are forwarded if empty.
 	AllowedRequestHeaders []string
 	// AllowedResponseHeaders are headers from the auth response to copy into the proxied backend request.
 	AllowedResponseHeaders []string
@@ -375,6 +390,14 @@ type HTTPExternalAuthFilter struct {
 	MaxBodySize uint16
 }
 
+// ExternalAuthGRPC holds the configuration of an auth server that implements the Envoy ext_authz gRPC API.
+type ExternalAuthGRPC struct {
+	// Host is the DNS name of the auth server Service.
+	Host string
+	// Port is the port of the auth server Service.
+	Port int32
+}
+
 // AuthenticationFilter holds the top level spec for each kind of authentication (e.g. Basic, JWT, etc...).
 type AuthenticationFilter struct {
 	// Basic contains fields related to basic authentication.
@@ -638,12 +661,34 @@ type MatchRule struct {
 	Source *metav1.ObjectMeta
 	// Guardrails holds the ai-guardrails (PayloadProcessor ExtProcess) configuration for the rule, if any.
 	Guardrails *GuardrailsConfig
+	// Timeouts holds the timeouts for the rule, if any.
+	Timeouts *Timeouts
+	// Retry holds the retry configuration for the rule, if any.
+	Retry *Retry
 	// Match holds the match for the rule.
 	Match Match
 	// BackendGroup is the group of Backends that the rule routes to.
 	BackendGroup BackendGroup
 }
 
+// Timeouts holds the timeouts for a routing rule in the NGINX duration format.
+// An empty value means that the corresponding timeout is not set.
+type Timeouts struct {
+	// Request is the timeout for the Gateway to respond to a request.
+	Request string
+	// BackendRequest is the timeout for a single request from the Gateway to a backend.
+	BackendRequest string
+}
+
+// Retry holds the retry configuration for a routing rule.
+type Retry struct {
+	// Attempts is the maximum number of times a request to a backend is retried.
+	// If nil, the number of retries is not limited by the rule.
+	Attempts *int
+	// Codes are the HTTP response status codes on which a request is retried.
+	Codes []int
+}
+
 // GuardrailsConfig contains the ai-guardrails / ExtProcess configuration that must be emitted into the
 // generated NGINX location for a match.
 type GuardrailsConfig struct {
@@ -723,6 +768,8 @@ type Backend struct {
 	// The possible values of weight are 0-1,000,000.
 	// If weight is 0, no traffic should be forwarded for this entry.
 	Weight int32
+	// TopologyAware is true if the upstream of this backend has topology-aware variants.
+	TopologyAware bool
 	// Valid indicates whether the Backend is valid.
 	Valid bool
 }
@@ -804,6 +851,22 @@ type BaseHTTPConfig struct {
 type BaseStreamConfig struct {
 	// DNSResolver specifies the DNS resolver configuration for ExternalName services.
 	DNSResolver *DNSResolverConfig
+	// ZoneSync holds the configuration for synchronizing shared memory zones between NGINX Plus replicas.
+	// It is nil if no zones need to be synchronized.
+	ZoneSync *ZoneSyncConfig
+	// Policies holds the policies of the stream servers that need to be configured at the stream context.
+	Policies []policies.Policy
+}
+
+// ZoneSyncConfig defines the configuration for synchronizing shared memory zones between NGINX Plus replicas.
+type ZoneSyncConfig struct {
+	// Server is the address of the headless Service that resolves to all NGINX replicas of the Gateway.
+	Server string
+	// Resolver is the address of the DNS server used to resolve the Server address.
+	// It is empty if the DNS resolver is already configured at the stream context.
+	Resolver string
+	// Port is the port on which NGINX listens for connections from the other replicas.
+	Port int32
 }
 
 // RewriteClientIPSettings defines configuration for rewriting the client IP to the original client's IP.
@@ -890,6 +953,8 @@ type Logging struct {
 	// ErrorLogFormat defines the error log format.
 	// If not specified, the default NGINX error log format is used.
 	ErrorLogFormat string
+	// AccessLogs defines the configuration for multiple NGINX access logs.
+	AccessLogs []AccessLog
 }
 
 // NginxPlus specifies NGINX Plus additional settings.
@@ -917,6 +982,15 @@ type AccessLog struct {
 	Format string
 	// Escape specifies how to escape characters in variables (default, json, none).
 	Escape string
+	// Path is where the access log is written to. It is a file path or a syslog address.
+	// Only set for access logs that are configured in a list of access logs.
+	Path string
+	// StatusCodes are the response status codes that are logged, either exact codes or classes like 5xx.
+	// If empty, requests are logged regardless of their status code.
+	StatusCodes []string
+	// SamplePercent is the percentage of requests that are logged.
+	// If zero, all requests are logged.
+	SamplePercent int32
 	// Disable specifies whether the access log is disabled.
 	Disable bool
 }
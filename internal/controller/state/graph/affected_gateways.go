@@ -0,0 +1,273 @@
+package graph
+
+import (
+	"slices"
+
+	v1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+	ngftypes "github.com/nginx/nginx-gateway-fabric/v2/internal/framework/types"
+)
+
+// ChangedObject is a resource whose upsert or delete changed the cluster state since the Graph was last built.
+type ChangedObject struct {
+	// Object is the changed resource. For a deleted resource, it only identifies the resource type.
+	Object ngftypes.ObjectType
+	// GVK is the GroupVersionKind of the resource.
+	GVK schema.GroupVersionKind
+	// NsName is the NamespacedName of the resource.
+	NsName types.NamespacedName
+}
+
+// AffectedGateways returns the Gateways whose nginx configuration might have changed because of the changed
+// objects. The Gateways of a changed object are determined from the references in both the previous and the
+// current Graph, so that a Gateway an object was detached from is affected as well.
+//
+// Returns nil if all Gateways might be affected, which is the case when there is no previous Graph, or when the
+// Gateways of a changed object can't be determined (for example, a GatewayClass, a Secret or a Node).
+func AffectedGateways(previous, current *Graph, changes []ChangedObject) map[types.NamespacedName]struct{} {
+	if previous == nil || current == nil || changes == nil {
+		return nil
+	}
+
+	affected := make(map[types.NamespacedName]struct{})
+
+	for _, change := range changes {
+		for _, g := range []*Graph{previous, current} {
+			gateways, ok := g.gatewaysReferencing(change)
+			if !ok {
+				return nil
+			}
+
+			for _, gw := range gateways {
+				affected[gw] = struct{}{}
+			}
+		}
+	}
+
+	return affected
+}
+
+// gatewaysReferencing returns the Gateways that reference the changed object in the Graph.
+// Returns false if the Gateways can't be determined.
+func (g *Graph) gatewaysReferencing(change ChangedObject) ([]types.NamespacedName, bool) {
+	switch obj := change.Object.(type) {
+	case *gatewayv1.Gateway:
+		return []types.NamespacedName{change.NsName}, true
+	case *gatewayv1.HTTPRoute:
+		return g.l7RouteGateways(RouteKey{NamespacedName: change.NsName, RouteType: RouteTypeHTTP}), true
+	case *gatewayv1.GRPCRoute:
+		return g.l7RouteGateways(RouteKey{NamespacedName: change.NsName, RouteType: RouteTypeGRPC}), true
+	case *gatewayv1.TLSRoute:
+		return g.l4RouteGateways(L4RouteKey{NamespacedName: change.NsName, RouteType: RouteTypeTLS}), true
+	case *gatewayv1.TCPRoute:
+		return g.l4RouteGateways(L4RouteKey{NamespacedName: change.NsName, RouteType: RouteTypeTCP}), true
+	case *gatewayv1.UDPRoute:
+		return g.l4RouteGateways(L4RouteKey{NamespacedName: change.NsName, RouteType: RouteTypeUDP}), true
+	case *gatewayv1.ListenerSet:
+		ls, exists := g.ListenerSets[change.NsName]
+		if !exists || ls.Gateway == nil {
+			return nil, true
+		}
+		return []types.NamespacedName{client.ObjectKeyFromObject(ls.Gateway)}, true
+	case *v1.Service:
+		return g.serviceGateways(change.NsName)
+	case *discoveryV1.EndpointSlice:
+		return g.endpointSliceGateways(change.NsName.Namespace, obj)
+	case *v1.Pod:
+		return g.podGateways(obj), true
+	case *ngfAPIv1alpha2.NginxProxy:
+		return g.nginxProxyGateways(change.NsName)
+	case policies.Policy:
+		return g.policyGateways(PolicyKey{NsName: change.NsName, GVK: change.GVK})
+	default:
+		return nil, false
+	}
+}
+
+func (g *Graph) l7RouteGateways(key RouteKey) []types.NamespacedName {
+	route, exists := g.Routes[key]
+	if !exists {
+		return nil
+	}
+
+	return parentRefGateways(route.ParentRefs)
+}
+
+func (g *Graph) l4RouteGateways(key L4RouteKey) []types.NamespacedName {
+	route, exists := g.L4Routes[key]
+	if !exists {
+		return nil
+	}
+
+	return parentRefGateways(route.ParentRefs)
+}
+
+func parentRefGateways(refs []ParentRef) []types.NamespacedName {
+	gateways := make([]types.NamespacedName, 0, len(refs))
+	for _, ref := range refs {
+		gateways = append(gateways, ref.GatewayNsName)
+	}
+
+	return gateways
+}
+
+func (g *Graph) serviceGateways(nsname types.NamespacedName) ([]types.NamespacedName, bool) {
+	// the Gateways of a PayloadProcessor backend are not tracked
+	if _, exists := g.ReferencedPayloadProcessorServices[nsname]; exists {
+		return nil, false
+	}
+
+	svc, exists := g.ReferencedServices[nsname]
+	if !exists {
+		return nil, true
+	}
+
+	gateways := make([]types.NamespacedName, 0, len(svc.GatewayNsNames))
+	for gw := range svc.GatewayNsNames {
+		gateways = append(gateways, gw)
+	}
+
+	return gateways, true
+}
+
+// endpointSliceGateways returns the Gateways that reference the Service or the ServiceImport of an EndpointSlice.
+// An EndpointSlice of a ServiceImport usually also has the name of a Service derived from the ServiceImport,
+// which isn't referenced by the Routes, so both are checked.
+func (g *Graph) endpointSliceGateways(
+	namespace string,
+	slice *discoveryV1.EndpointSlice,
+) ([]types.NamespacedName, bool) {
+	svcName := index.GetServiceNameFromEndpointSlice(slice)
+	svcImportName := index.GetServiceImportNameFromEndpointSlice(slice)
+
+	// the Service of a deleted EndpointSlice is unknown, since only its type and name are captured
+	if svcName == "" && svcImportName == "" {
+		return nil, false
+	}
+
+	var gateways []types.NamespacedName
+	if svcName != "" {
+		svcGateways, ok := g.serviceGateways(types.NamespacedName{Namespace: namespace, Name: svcName})
+		if !ok {
+			return nil, false
+		}
+		gateways = append(gateways, svcGateways...)
+	}
+
+	if svcImportName != "" {
+		svcImport := types.NamespacedName{Namespace: namespace, Name: svcImportName}
+		gateways = append(gateways, g.serviceImportGateways(svcImport)...)
+	}
+
+	return gateways, true
+}
+
+// serviceImportGateways returns the Gateways of the Routes that have the ServiceImport as a backend.
+func (g *Graph) serviceImportGateways(nsname types.NamespacedName) []types.NamespacedName {
+	if _, exists := g.ReferencedServiceImports[nsname]; !exists {
+		return nil
+	}
+
+	hasServiceImport := func(refs []BackendRef) bool {
+		return slices.ContainsFunc(refs, func(ref BackendRef) bool {
+			return ref.IsServiceImport && ref.SvcNsName == nsname
+		})
+	}
+
+	var gateways []types.NamespacedName
+	for _, route := range g.Routes {
+		for _, rule := range route.Spec.Rules {
+			if hasServiceImport(rule.BackendRefs) {
+				gateways = append(gateways, parentRefGateways(route.ParentRefs)...)
+				break
+			}
+		}
+	}
+
+	for _, route := range g.L4Routes {
+		if hasServiceImport(route.Spec.GetBackendRefs()) {
+			gateways = append(gateways, parentRefGateways(route.ParentRefs)...)
+		}
+	}
+
+	return gateways
+}
+
+// podGateways returns the Gateway of an nginx Pod. The nginx Pods are labeled with the name of the
+// Deployment or DaemonSet of their Gateway.
+func (g *Graph) podGateways(pod *v1.Pod) []types.NamespacedName {
+	// the labels of a deleted Pod are unknown, since only its type and name are captured
+	name, ok := pod.Labels[controller.AppNameLabel]
+	if !ok {
+		return nil
+	}
+
+	deploymentName := types.NamespacedName{Namespace: pod.Namespace, Name: name}
+
+	for gwNsName, gw := range g.Gateways {
+		if gw.DeploymentName == deploymentName {
+			return []types.NamespacedName{gwNsName}
+		}
+	}
+
+	return nil
+}
+
+func (g *Graph) nginxProxyGateways(nsname types.NamespacedName) ([]types.NamespacedName, bool) {
+	// an NginxProxy referenced by the GatewayClass applies to all Gateways
+	if g.GatewayClass != nil && nginxProxyHasName(g.GatewayClass.NginxProxy, nsname) {
+		return nil, false
+	}
+
+	var gateways []types.NamespacedName
+	for gwNsName, gw := range g.Gateways {
+		if nginxProxyHasName(gw.NginxProxy, nsname) {
+			gateways = append(gateways, gwNsName)
+		}
+	}
+
+	return gateways, true
+}
+
+func nginxProxyHasName(np *NginxProxy, nsname types.NamespacedName) bool {
+	return np != nil && np.Source != nil && client.ObjectKeyFromObject(np.Source) == nsname
+}
+
+func (g *Graph) policyGateways(key PolicyKey) ([]types.NamespacedName, bool) {
+	policy, exists := g.NGFPolicies[key]
+	if !exists {
+		return nil, true
+	}
+
+	var gateways []types.NamespacedName
+	for _, ref := range policy.TargetRefs {
+		switch ref.Kind {
+		case kinds.Gateway:
+			gateways = append(gateways, ref.Nsname)
+		case kinds.HTTPRoute:
+			gateways = append(gateways, g.l7RouteGateways(RouteKey{NamespacedName: ref.Nsname, RouteType: RouteTypeHTTP})...)
+		case kinds.GRPCRoute:
+			gateways = append(gateways, g.l7RouteGateways(RouteKey{NamespacedName: ref.Nsname, RouteType: RouteTypeGRPC})...)
+		case kinds.Service:
+			svcGateways, ok := g.serviceGateways(ref.Nsname)
+			if !ok {
+				return nil, false
+			}
+			gateways = append(gateways, svcGateways...)
+		default:
+			return nil, false
+		}
+	}
+
+	return gateways, true
+}
@@ -0,0 +1,321 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	discoveryV1 "k8s.io/api/discovery/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	v1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	ngfAPIv1alpha2 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha2"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/policiesfakes"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/controller/index"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+func TestAffectedGateways(t *testing.T) {
+	t.Parallel()
+
+	gw1 := types.NamespacedName{Namespace: "test", Name: "gw1"}
+	gw2 := types.NamespacedName{Namespace: "test", Name: "gw2"}
+	gw3 := types.NamespacedName{Namespace: "test", Name: "gw3"}
+
+	route1 := types.NamespacedName{Namespace: "test", Name: "route1"}
+	route2 := types.NamespacedName{Namespace: "test", Name: "route2"}
+	tcpRoute := types.NamespacedName{Namespace: "test", Name: "tcp-route"}
+	svc1 := types.NamespacedName{Namespace: "test", Name: "svc1"}
+	svcImport := types.NamespacedName{Namespace: "test", Name: "svc-import"}
+	ppSvc := types.NamespacedName{Namespace: "test", Name: "pp-svc"}
+	ls := types.NamespacedName{Namespace: "test", Name: "ls"}
+	gcNp := types.NamespacedName{Namespace: "test", Name: "gc-np"}
+	gwNp := types.NamespacedName{Namespace: "test", Name: "gw-np"}
+	gw1Deployment := types.NamespacedName{Namespace: "test", Name: "gw1-nginx"}
+
+	policyGVK := schema.GroupVersionKind{Group: "gateway.nginx.org", Version: "v1alpha1", Kind: "TestPolicy"}
+	gwPolicy := types.NamespacedName{Namespace: "test", Name: "gw-policy"}
+	routePolicy := types.NamespacedName{Namespace: "test", Name: "route-policy"}
+	svcPolicy := types.NamespacedName{Namespace: "test", Name: "svc-policy"}
+	otherPolicy := types.NamespacedName{Namespace: "test", Name: "other-policy"}
+
+	nginxProxy := func(nsname types.NamespacedName) *NginxProxy {
+		return &NginxProxy{
+			Source: &ngfAPIv1alpha2.NginxProxy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: nsname.Namespace, Name: nsname.Name},
+			},
+		}
+	}
+
+	createGraph := func(route1Parents ...types.NamespacedName) *Graph {
+		parentRefs := make([]ParentRef, 0, len(route1Parents))
+		for _, gw := range route1Parents {
+			parentRefs = append(parentRefs, ParentRef{GatewayNsName: gw})
+		}
+
+		return &Graph{
+			GatewayClass: &GatewayClass{NginxProxy: nginxProxy(gcNp)},
+			Gateways: map[types.NamespacedName]*Gateway{
+				gw1: {DeploymentName: gw1Deployment},
+				gw2: {NginxProxy: nginxProxy(gwNp)},
+				gw3: {},
+			},
+			Routes: map[RouteKey]*L7Route{
+				{NamespacedName: route1, RouteType: RouteTypeHTTP}: {ParentRefs: parentRefs},
+				{NamespacedName: route2, RouteType: RouteTypeGRPC}: {
+					ParentRefs: []ParentRef{{GatewayNsName: gw3}},
+					Spec: L7RouteSpec{
+						Rules: []RouteRule{
+							{BackendRefs: []BackendRef{{SvcNsName: svcImport, IsServiceImport: true}}},
+						},
+					},
+				},
+			},
+			L4Routes: map[L4RouteKey]*L4Route{
+				{NamespacedName: tcpRoute, RouteType: RouteTypeTCP}: {
+					ParentRefs: []ParentRef{{GatewayNsName: gw2}},
+				},
+			},
+			ListenerSets: map[types.NamespacedName]*ListenerSet{
+				ls: {
+					Gateway: &v1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: gw3.Namespace, Name: gw3.Name}},
+				},
+			},
+			ReferencedServices: map[types.NamespacedName]*ReferencedService{
+				svc1: {
+					GatewayNsNames: map[types.NamespacedName]struct{}{gw1: {}, gw2: {}},
+				},
+			},
+			ReferencedPayloadProcessorServices: map[types.NamespacedName]struct{}{ppSvc: {}},
+			ReferencedServiceImports:           map[types.NamespacedName]struct{}{svcImport: {}},
+			NGFPolicies: map[PolicyKey]*Policy{
+				{NsName: gwPolicy, GVK: policyGVK}: {
+					TargetRefs: []PolicyTargetRef{{Kind: kinds.Gateway, Nsname: gw2}},
+				},
+				{NsName: routePolicy, GVK: policyGVK}: {
+					TargetRefs: []PolicyTargetRef{{Kind: kinds.GRPCRoute, Nsname: route2}},
+				},
+				{NsName: svcPolicy, GVK: policyGVK}: {
+					TargetRefs: []PolicyTargetRef{{Kind: kinds.Service, Nsname: svc1}},
+				},
+				{NsName: otherPolicy, GVK: policyGVK}: {
+					TargetRefs: []PolicyTargetRef{{Kind: kinds.GatewayClass, Nsname: gw1}},
+				},
+			},
+		}
+	}
+
+	previous := createGraph(gw1)
+	current := createGraph(gw2)
+
+	endpointSlice := func(svcName string) *discoveryV1.EndpointSlice {
+		es := &discoveryV1.EndpointSlice{}
+		if svcName != "" {
+			es.Labels = map[string]string{"kubernetes.io/service-name": svcName}
+		}
+		return es
+	}
+
+	serviceImportEndpointSlice := func(svcImportName string) *discoveryV1.EndpointSlice {
+		// the EndpointSlice of a ServiceImport also has the name of the Service derived from the ServiceImport
+		es := endpointSlice("derived-" + svcImportName)
+		es.Labels[index.MultiClusterServiceNameLabel] = svcImportName
+		return es
+	}
+
+	tests := []struct {
+		previous *Graph
+		expected map[types.NamespacedName]struct{}
+		name     string
+		changes  []ChangedObject
+	}{
+		{
+			name:     "no previous graph",
+			changes:  []ChangedObject{{Object: &v1.Gateway{}, NsName: gw1}},
+			expected: nil,
+		},
+		{
+			name:     "unknown changes",
+			previous: previous,
+			expected: nil,
+		},
+		{
+			name:     "gateway",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &v1.Gateway{}, NsName: gw3}},
+			expected: map[types.NamespacedName]struct{}{gw3: {}},
+		},
+		{
+			name:     "route moved between gateways",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &v1.HTTPRoute{}, NsName: route1}},
+			expected: map[types.NamespacedName]struct{}{gw1: {}, gw2: {}},
+		},
+		{
+			name:     "route not in graph",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &v1.HTTPRoute{}, NsName: route2}},
+			expected: map[types.NamespacedName]struct{}{},
+		},
+		{
+			name:     "L4 route",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &v1.TCPRoute{}, NsName: tcpRoute}},
+			expected: map[types.NamespacedName]struct{}{gw2: {}},
+		},
+		{
+			name:     "listener set",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &v1.ListenerSet{}, NsName: ls}},
+			expected: map[types.NamespacedName]struct{}{gw3: {}},
+		},
+		{
+			name:     "service",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &corev1.Service{}, NsName: svc1}},
+			expected: map[types.NamespacedName]struct{}{gw1: {}, gw2: {}},
+		},
+		{
+			name:     "service referenced by payload processor",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &corev1.Service{}, NsName: ppSvc}},
+			expected: nil,
+		},
+		{
+			name:     "endpoint slice",
+			previous: previous,
+			changes: []ChangedObject{
+				{Object: endpointSlice(svc1.Name), NsName: types.NamespacedName{Namespace: "test", Name: "es"}},
+			},
+			expected: map[types.NamespacedName]struct{}{gw1: {}, gw2: {}},
+		},
+		{
+			name:     "endpoint slice of service import",
+			previous: previous,
+			changes: []ChangedObject{
+				{
+					Object: serviceImportEndpointSlice(svcImport.Name),
+					NsName: types.NamespacedName{Namespace: "test", Name: "es"},
+				},
+			},
+			expected: map[types.NamespacedName]struct{}{gw3: {}},
+		},
+		{
+			name:     "endpoint slice of service import not in graph",
+			previous: previous,
+			changes: []ChangedObject{
+				{
+					Object: serviceImportEndpointSlice("other-import"),
+					NsName: types.NamespacedName{Namespace: "test", Name: "es"},
+				},
+			},
+			expected: map[types.NamespacedName]struct{}{},
+		},
+		{
+			name:     "deleted endpoint slice",
+			previous: previous,
+			changes: []ChangedObject{
+				{Object: endpointSlice(""), NsName: types.NamespacedName{Namespace: "test", Name: "es"}},
+			},
+			expected: nil,
+		},
+		{
+			name:     "nginx pod",
+			previous: previous,
+			changes: []ChangedObject{
+				{
+					Object: &corev1.Pod{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: gw1Deployment.Namespace,
+							Name:      "gw1-nginx-pod",
+							Labels:    map[string]string{controller.AppNameLabel: gw1Deployment.Name},
+						},
+					},
+					NsName: types.NamespacedName{Namespace: gw1Deployment.Namespace, Name: "gw1-nginx-pod"},
+				},
+			},
+			expected: map[types.NamespacedName]struct{}{gw1: {}},
+		},
+		{
+			name:     "pod of another deployment",
+			previous: previous,
+			changes: []ChangedObject{
+				{Object: &corev1.Pod{}, NsName: types.NamespacedName{Namespace: "test", Name: "pod"}},
+			},
+			expected: map[types.NamespacedName]struct{}{},
+		},
+		{
+			name:     "node",
+			previous: previous,
+			changes: []ChangedObject{
+				{
+					Object: &metav1.PartialObjectMetadata{},
+					GVK:    corev1.SchemeGroupVersion.WithKind("Node"),
+					NsName: types.NamespacedName{Name: "node"},
+				},
+			},
+			expected: nil,
+		},
+		{
+			name:     "gateway class nginx proxy",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &ngfAPIv1alpha2.NginxProxy{}, NsName: gcNp}},
+			expected: nil,
+		},
+		{
+			name:     "gateway nginx proxy",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &ngfAPIv1alpha2.NginxProxy{}, NsName: gwNp}},
+			expected: map[types.NamespacedName]struct{}{gw2: {}},
+		},
+		{
+			name:     "policies targeting gateway, route, and service",
+			previous: previous,
+			changes: []ChangedObject{
+				{Object: &policiesfakes.FakePolicy{}, GVK: policyGVK, NsName: gwPolicy},
+				{Object: &policiesfakes.FakePolicy{}, GVK: policyGVK, NsName: routePolicy},
+				{Object: &policiesfakes.FakePolicy{}, GVK: policyGVK, NsName: svcPolicy},
+			},
+			expected: map[types.NamespacedName]struct{}{gw1: {}, gw2: {}, gw3: {}},
+		},
+		{
+			name:     "policy targeting another kind",
+			previous: previous,
+			changes:  []ChangedObject{{Object: &policiesfakes.FakePolicy{}, GVK: policyGVK, NsName: otherPolicy}},
+			expected: nil,
+		},
+		{
+			name:     "policy not in graph",
+			previous: previous,
+			changes: []ChangedObject{
+				{
+					Object: &policiesfakes.FakePolicy{},
+					GVK:    policyGVK,
+					NsName: types.NamespacedName{Namespace: "test", Name: "unknown"},
+				},
+			},
+			expected: map[types.NamespacedName]struct{}{},
+		},
+		{
+			name:     "unsupported resource",
+			previous: previous,
+			changes: []ChangedObject{
+				{Object: &v1.Gateway{}, NsName: gw3},
+				{Object: &corev1.Secret{}, NsName: types.NamespacedName{Namespace: "test", Name: "secret"}},
+			},
+			expected: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(AffectedGateways(test.previous, current, test.changes)).To(Equal(test.expected))
+		})
+	}
+}
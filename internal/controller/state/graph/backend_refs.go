@@ -49,11 +49,20 @@ type BackendRef struct {
 	IsExternalAuthBackend bool
 	// IsInferencePool indicates whether the BackendRef is for an InferencePool.
 	IsInferencePool bool
+	// IsServiceImport indicates whether the BackendRef is for a Multi-Cluster Services ServiceImport.
+	// If true, SvcNsName is the NamespacedName of the ServiceImport.
+	IsServiceImport bool
 }
 
 // BaseServicePortKey returns a base unique string key for the Service port of the BackendRef.
 func (b BackendRef) BaseServicePortKey() string {
-	return fmt.Sprintf("%s_%s_%d", b.SvcNsName.Namespace, b.SvcNsName.Name, b.ServicePort.Port)
+	key := fmt.Sprintf("%s_%s_%d", b.SvcNsName.Namespace, b.SvcNsName.Name, b.ServicePort.Port)
+	if b.IsServiceImport {
+		// a ServiceImport usually has the same name as the exported Service in the same Namespace
+		key += "_serviceimport"
+	}
+
+	return key
 }
 
 // ServicePortReference returns a unique string reference for the Service port of the BackendRef including
@@ -75,11 +84,19 @@ func addBackendRefsToRouteRules(
 	routes map[RouteKey]*L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
+	serviceImports map[types.NamespacedName]*v1.Service,
 	referencedInferencePools map[types.NamespacedName]*ReferencedInferencePool,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) {
 	for _, r := range routes {
-		addBackendRefsToRules(r, refGrantResolver, services, referencedInferencePools, backendTLSPolicies)
+		addBackendRefsToRules(
+			r,
+			refGrantResolver,
+			services,
+			serviceImports,
+			referencedInferencePools,
+			backendTLSPolicies,
+		)
 	}
 }
 
@@ -89,6 +106,7 @@ func addBackendRefsToRules(
 	route *L7Route,
 	refGrantResolver *referenceGrantResolver,
 	services map[types.NamespacedName]*v1.Service,
+	serviceImports map[types.NamespacedName]*v1.Service,
 	referencedInferencePools map[types.NamespacedName]*ReferencedInferencePool,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) {
@@ -129,6 +147,7 @@ func addBackendRefsToRules(
 				route,
 				refGrantResolver.refAllowedFrom(getRefGrantFromResourceForRoute(route.RouteType, routeNs)),
 				services,
+				serviceImports,
 				refPath,
 				backendTLSPolicies,
 			)
@@ -254,6 +273,7 @@ func createBackendRef(
 	route *L7Route,
 	refGrantResolver func(resource toResource) bool,
 	services map[types.NamespacedName]*v1.Service,
+	serviceImports map[types.NamespacedName]*v1.Service,
 	refPath *field.Path,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 ) (BackendRef, []conditions.Condition) {
@@ -297,7 +317,14 @@ func createBackendRef(
 		ns = string(*ref.Namespace)
 	}
 	svcNsName := types.NamespacedName{Name: string(ref.Name), Namespace: ns}
-	svcPort, err := getPortFromRef(ref.BackendRef, svcNsName, services, refPath)
+
+	isServiceImport := isServiceImportBackendRef(ref.BackendRef)
+	backendServices := services
+	if isServiceImport {
+		backendServices = serviceImports
+	}
+
+	svcPort, err := getPortFromRef(ref.BackendRef, svcNsName, backendServices, refPath)
 	if err != nil {
 		backendRef := BackendRef{
 			Weight:                weight,
@@ -307,6 +334,7 @@ func createBackendRef(
 			IsMirrorBackend:       ref.MirrorBackendIdx != nil,
 			IsExternalAuthBackend: ref.ExternalAuthBackendIdx != nil,
 			IsInferencePool:       ref.IsInferencePool,
+			IsServiceImport:       isServiceImport,
 			InvalidForGateways:    make(map[types.NamespacedName]conditions.Condition),
 			EndpointPickerConfig:  ref.EndpointPickerConfig,
 		}
@@ -318,7 +346,7 @@ func createBackendRef(
 	invalidForGateways := make(map[types.NamespacedName]conditions.Condition)
 
 	// Check if this is an ExternalName service and validate DNS resolver configuration
-	svc, svcExists := services[svcNsName]
+	svc, svcExists := backendServices[svcNsName]
 	if svcExists && svc.Spec.Type == v1.ServiceTypeExternalName {
 		invalidForGateways = checkExternalNameValidForGateways(route.ParentRefs, invalidForGateways)
 
@@ -332,6 +360,7 @@ func createBackendRef(
 				IsMirrorBackend:       ref.MirrorBackendIdx != nil,
 				IsExternalAuthBackend: ref.ExternalAuthBackendIdx != nil,
 				IsInferencePool:       ref.IsInferencePool,
+				IsServiceImport:       isServiceImport,
 				InvalidForGateways:    invalidForGateways,
 				EndpointPickerConfig:  ref.EndpointPickerConfig,
 			}
@@ -342,13 +371,17 @@ func createBackendRef(
 		}
 	}
 
-	backendTLSPolicy, err := findBackendTLSPolicyForService(
-		backendTLSPolicies,
-		ref.Namespace,
-		string(ref.Name),
-		route.Source.GetNamespace(),
-		svcPort,
-	)
+	// BackendTLSPolicies target Services, so they don't apply to ServiceImports.
+	var backendTLSPolicy *BackendTLSPolicy
+	if !isServiceImport {
+		backendTLSPolicy, err = findBackendTLSPolicyForService(
+			backendTLSPolicies,
+			ref.Namespace,
+			string(ref.Name),
+			route.Source.GetNamespace(),
+			svcPort,
+		)
+	}
 	if err != nil {
 		backendRef := BackendRef{
 			SvcNsName:             svcNsName,
@@ -358,6 +391,7 @@ func createBackendRef(
 			IsMirrorBackend:       ref.MirrorBackendIdx != nil,
 			IsExternalAuthBackend: ref.ExternalAuthBackendIdx != nil,
 			IsInferencePool:       ref.IsInferencePool,
+			IsServiceImport:       isServiceImport,
 			InvalidForGateways:    invalidForGateways,
 			EndpointPickerConfig:  ref.EndpointPickerConfig,
 		}
@@ -377,6 +411,7 @@ func createBackendRef(
 				IsMirrorBackend:       ref.MirrorBackendIdx != nil,
 				IsExternalAuthBackend: ref.ExternalAuthBackendIdx != nil,
 				IsInferencePool:       ref.IsInferencePool,
+				IsServiceImport:       isServiceImport,
 				InvalidForGateways:    invalidForGateways,
 				EndpointPickerConfig:  ref.EndpointPickerConfig,
 			}
@@ -394,6 +429,7 @@ func createBackendRef(
 		IsMirrorBackend:       ref.MirrorBackendIdx != nil,
 		IsExternalAuthBackend: ref.ExternalAuthBackendIdx != nil,
 		IsInferencePool:       ref.IsInferencePool,
+		IsServiceImport:       isServiceImport,
 		InvalidForGateways:    invalidForGateways,
 		EndpointPickerConfig:  ref.EndpointPickerConfig,
 		SessionPersistence:    ref.SessionPersistence,
@@ -625,7 +661,7 @@ func validateRouteBackendRef(
 		return validateBackendRefHTTPRoute(ref, routeNs, refGrantResolver, path)
 	}
 
-	return validateBackendRef(ref.BackendRef, routeNs, refGrantResolver, path)
+	return validateBackendRef(ref.BackendRef, routeNs, refGrantResolver, path, true /* serviceImportAllowed */)
 }
 
 func validateBackendRef(
@@ -633,29 +669,19 @@ func validateBackendRef(
 	routeNs string,
 	refGrantResolver func(toResource toResource) bool,
 	path *field.Path,
+	serviceImportAllowed bool,
 ) (valid bool, cond conditions.Condition) {
 	// Because all errors cause same condition but different reasons, we return as soon as we find an error
 
-	if ref.Group != nil && (*ref.Group != "core" && *ref.Group != "") {
-		valErr := field.NotSupported(path.Child("group"), *ref.Group, []string{"core", ""})
-		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
-	}
-
-	if ref.Kind != nil && *ref.Kind != "Service" {
-		valErr := field.NotSupported(path.Child("kind"), *ref.Kind, []string{"Service"})
-		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
+	if valid, cond := validateBackendRefGroupKind(ref, path, serviceImportAllowed); !valid {
+		return false, cond
 	}
 
 	// no need to validate ref.Name
 
 	if ref.Namespace != nil && string(*ref.Namespace) != routeNs {
-		refNsName := types.NamespacedName{Namespace: string(*ref.Namespace), Name: string(ref.Name)}
-
-		if !refGrantResolver(toService(refNsName)) {
-			msg := fmt.Sprintf("Backend ref to Service %s not permitted by any ReferenceGrant", refNsName)
-			valErr := field.Forbidden(path.Child("namespace"), msg)
-
-			return false, conditions.NewRouteBackendRefRefNotPermitted(valErr.Error())
+		if valid, cond := validateBackendRefNamespace(ref, refGrantResolver, path); !valid {
+			return false, cond
 		}
 	}
 
@@ -709,13 +735,8 @@ func validateBackendRefHTTPRoute(
 				Name:      ref.InferencePoolName,
 			}
 		default:
-			refNsName := types.NamespacedName{Namespace: string(*ref.Namespace), Name: string(ref.Name)}
-
-			if !refGrantResolver(toService(refNsName)) {
-				msg := fmt.Sprintf("Backend ref to Service %s not permitted by any ReferenceGrant", refNsName)
-				valErr := field.Forbidden(path.Child("namespace"), msg)
-
-				return false, conditions.NewRouteBackendRefRefNotPermitted(valErr.Error())
+			if valid, cond := validateBackendRefNamespace(ref.BackendRef, refGrantResolver, path); !valid {
+				return false, cond
 			}
 		}
 
@@ -731,7 +752,7 @@ func validateBackendRefHTTPRoute(
 		}
 	}
 
-	if ref.Port == nil && (ref.Kind == nil || *ref.Kind == kinds.Service) {
+	if ref.Port == nil && (ref.Kind == nil || *ref.Kind == kinds.Service || *ref.Kind == kinds.ServiceImport) {
 		valErr := field.Required(path.Child("port"), "port cannot be nil")
 		return false, conditions.NewRouteBackendRefUnsupportedValue(valErr.Error())
 	}
@@ -748,14 +769,97 @@ func validateBackendRefHTTPRoute(
 	return true, conditions.Condition{}
 }
 
+// validateBackendRefGroupKind validates the group and kind of a backendRef to a Service, or to a ServiceImport
+// if serviceImportAllowed is true.
+func validateBackendRefGroupKind(
+	ref gatewayv1.BackendRef,
+	path *field.Path,
+	serviceImportAllowed bool,
+) (bool, conditions.Condition) {
+	supportedGroups := []string{"core", ""}
+	supportedKinds := []string{kinds.Service}
+	if serviceImportAllowed {
+		supportedGroups = append(supportedGroups, kinds.ServiceImportGVK.Group)
+		supportedKinds = append(supportedKinds, kinds.ServiceImport)
+	}
+
+	if ref.Group != nil && !slices.Contains(supportedGroups, string(*ref.Group)) {
+		valErr := field.NotSupported(path.Child("group"), *ref.Group, supportedGroups)
+		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
+	}
+
+	if ref.Kind != nil && !slices.Contains(supportedKinds, string(*ref.Kind)) {
+		valErr := field.NotSupported(path.Child("kind"), *ref.Kind, supportedKinds)
+		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
+	}
+
+	return validateServiceImportBackendRefGroupKind(ref, path)
+}
+
+// validateServiceImportBackendRefGroupKind validates that the group of a backendRef is the Multi-Cluster Services
+// API group if and only if the kind is ServiceImport.
+func validateServiceImportBackendRefGroupKind(
+	ref gatewayv1.BackendRef,
+	path *field.Path,
+) (bool, conditions.Condition) {
+	mcsGroup := ref.Group != nil && string(*ref.Group) == kinds.ServiceImportGVK.Group
+
+	if mcsGroup && !isServiceImportBackendRef(ref) {
+		valErr := field.Invalid(
+			path.Child("kind"),
+			ref.Kind,
+			fmt.Sprintf("kind must be %s when group is %s", kinds.ServiceImport, kinds.ServiceImportGVK.Group),
+		)
+		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
+	}
+
+	if !mcsGroup && isServiceImportBackendRef(ref) {
+		valErr := field.Invalid(
+			path.Child("group"),
+			ref.Group,
+			fmt.Sprintf("group must be %s when kind is %s", kinds.ServiceImportGVK.Group, kinds.ServiceImport),
+		)
+		return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
+	}
+
+	return true, conditions.Condition{}
+}
+
+// validateBackendRefNamespace validates that a backendRef to a Service or ServiceImport in another Namespace
+// is permitted by a ReferenceGrant.
+func validateBackendRefNamespace(
+	ref gatewayv1.BackendRef,
+	refGrantResolver func(toResource toResource) bool,
+	path *field.Path,
+) (bool, conditions.Condition) {
+	refNsName := types.NamespacedName{Namespace: string(*ref.Namespace), Name: string(ref.Name)}
+
+	kind := kinds.Service
+	resource := toService(refNsName)
+	if isServiceImportBackendRef(ref) {
+		kind = kinds.ServiceImport
+		resource = toServiceImport(refNsName)
+	}
+
+	if !refGrantResolver(resource) {
+		msg := fmt.Sprintf("Backend ref to %s %s not permitted by any ReferenceGrant", kind, refNsName)
+		valErr := field.Forbidden(path.Child("namespace"), msg)
+
+		return false, conditions.NewRouteBackendRefRefNotPermitted(valErr.Error())
+	}
+
+	return true, conditions.Condition{}
+}
+
 func validateBackendRefHTTPRouteGroupKind(
 	ref gatewayv1.BackendRef,
 	path *field.Path,
 ) (bool, conditions.Condition) {
 	if ref.Group != nil {
 		group := *ref.Group
-		if group != "core" && group != "" && group != inferenceAPIGroup {
-			valErr := field.NotSupported(path.Child("group"), group, []string{"core", "", inferenceAPIGroup})
+		supportedGroups := []string{"core", "", inferenceAPIGroup, kinds.ServiceImportGVK.Group}
+		if !slices.Contains(supportedGroups, string(group)) {
+			valErr := field.NotSupported(path.Child("group"), group, supportedGroups)
 			return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
 		}
 		if group == inferenceAPIGroup {
@@ -772,8 +876,9 @@ func validateBackendRefHTTPRouteGroupKind(
 
 	if ref.Kind != nil {
 		kind := *ref.Kind
-		if kind != kinds.Service && kind != kinds.InferencePool {
-			valErr := field.NotSupported(path.Child("kind"), kind, []string{kinds.Service, kinds.InferencePool})
+		supportedKinds := []string{kinds.Service, kinds.InferencePool, kinds.ServiceImport}
+		if !slices.Contains(supportedKinds, string(kind)) {
+			valErr := field.NotSupported(path.Child("kind"), kind, supportedKinds)
 			return false, conditions.NewRouteBackendRefInvalidKind(valErr.Error())
 		}
 		if kind == kinds.InferencePool {
@@ -787,7 +892,8 @@ func validateBackendRefHTTPRouteGroupKind(
 			}
 		}
 	}
-	return true, conditions.Condition{}
+
+	return validateServiceImportBackendRefGroupKind(ref, path)
 }
 
 // validateRouteBackendRefAppProtocol checks if a given RouteType supports sending traffic to a service AppProtocol.
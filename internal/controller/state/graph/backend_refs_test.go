@@ -139,7 +139,7 @@ func TestValidateRouteBackendRef(t *testing.T) {
 			},
 			expectedValid: false,
 			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
-				`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool"`,
+				`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool", "ServiceImport"`,
 			),
 		},
 	}
@@ -169,12 +169,19 @@ func TestValidateBackendRef(t *testing.T) {
 	alwaysFalseRefGrantResolver := func(_ toResource) bool { return false }
 	alwaysTrueRefGrantResolver := func(_ toResource) bool { return true }
 
+	serviceImportRef := func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+		backend.Group = helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group))
+		backend.Kind = helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport)
+		return backend
+	}
+
 	tests := []struct {
-		ref               gatewayv1.BackendRef
-		refGrantResolver  func(resource toResource) bool
-		expectedCondition conditions.Condition
-		name              string
-		expectedValid     bool
+		ref                  gatewayv1.BackendRef
+		refGrantResolver     func(resource toResource) bool
+		expectedCondition    conditions.Condition
+		name                 string
+		expectedValid        bool
+		serviceImportAllowed bool
 	}{
 		{
 			name:             "normal case",
@@ -182,6 +189,64 @@ func TestValidateBackendRef(t *testing.T) {
 			refGrantResolver: alwaysTrueRefGrantResolver,
 			expectedValid:    true,
 		},
+		{
+			name:                 "ServiceImport",
+			ref:                  getModifiedRef(serviceImportRef),
+			refGrantResolver:     alwaysTrueRefGrantResolver,
+			expectedValid:        true,
+			serviceImportAllowed: true,
+		},
+		{
+			name:             "ServiceImport not allowed",
+			ref:              getModifiedRef(serviceImportRef),
+			refGrantResolver: alwaysTrueRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
+				`test.group: Unsupported value: "multicluster.x-k8s.io": supported values: "core", ""`,
+			),
+		},
+		{
+			name: "ServiceImport with core group",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group]("core")
+				backend.Kind = helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport)
+				return backend
+			}),
+			refGrantResolver: alwaysTrueRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
+				`test.group: Invalid value: "core": group must be multicluster.x-k8s.io when kind is ServiceImport`,
+			),
+			serviceImportAllowed: true,
+		},
+		{
+			name: "Service with multicluster group",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group))
+				return backend
+			}),
+			refGrantResolver: alwaysTrueRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
+				`test.kind: Invalid value: "Service": kind must be ServiceImport when group is multicluster.x-k8s.io`,
+			),
+			serviceImportAllowed: true,
+		},
+		{
+			name: "ServiceImport in another namespace not permitted by reference grant",
+			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
+				backend = serviceImportRef(backend)
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("invalid")
+				return backend
+			}),
+			refGrantResolver: alwaysFalseRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefRefNotPermitted(
+				"test.namespace: Forbidden: Backend ref to ServiceImport invalid/service1 not permitted by any " +
+					"ReferenceGrant",
+			),
+			serviceImportAllowed: true,
+		},
 		{
 			name: "normal case with implicit namespace",
 			ref: getModifiedRef(func(backend gatewayv1.BackendRef) gatewayv1.BackendRef {
@@ -276,7 +341,13 @@ func TestValidateBackendRef(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			valid, cond := validateBackendRef(test.ref, "test", test.refGrantResolver, field.NewPath("test"))
+			valid, cond := validateBackendRef(
+				test.ref,
+				"test",
+				test.refGrantResolver,
+				field.NewPath("test"),
+				test.serviceImportAllowed,
+			)
 
 			g.Expect(valid).To(Equal(test.expectedValid))
 			g.Expect(cond).To(Equal(test.expectedCondition))
@@ -321,6 +392,45 @@ func TestValidateBackendRefHTTPRoute(t *testing.T) {
 			refGrantResolver: alwaysTrueRefGrantResolver,
 			expectedValid:    true,
 		},
+		{
+			name: "normal case with ServiceImport",
+			ref: getModifiedRouteBackendRef(func(backend RouteBackendRef) RouteBackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group))
+				backend.Kind = helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport)
+				return backend
+			}),
+			refGrantResolver: alwaysTrueRefGrantResolver,
+			expectedValid:    true,
+		},
+		{
+			name: "ServiceImport with nil port",
+			ref: getModifiedRouteBackendRef(func(backend RouteBackendRef) RouteBackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group))
+				backend.Kind = helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport)
+				backend.Port = nil
+				return backend
+			}),
+			refGrantResolver: alwaysTrueRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefUnsupportedValue(
+				"test.port: Required value: port cannot be nil",
+			),
+		},
+		{
+			name: "ServiceImport in another namespace not permitted by reference grant",
+			ref: getModifiedRouteBackendRef(func(backend RouteBackendRef) RouteBackendRef {
+				backend.Group = helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group))
+				backend.Kind = helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport)
+				backend.Namespace = helpers.GetPointer[gatewayv1.Namespace]("invalid")
+				return backend
+			}),
+			refGrantResolver: alwaysFalseRefGrantResolver,
+			expectedValid:    false,
+			expectedCondition: conditions.NewRouteBackendRefRefNotPermitted(
+				"test.namespace: Forbidden: Backend ref to ServiceImport invalid/service1 not permitted by any " +
+					"ReferenceGrant",
+			),
+		},
 		{
 			name: "normal case with InferencePool",
 			ref: getModifiedRouteBackendRef(func(backend RouteBackendRef) RouteBackendRef {
@@ -411,7 +521,8 @@ func TestValidateBackendRefHTTPRoute(t *testing.T) {
 			refGrantResolver: alwaysTrueRefGrantResolver,
 			expectedValid:    false,
 			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
-				`test.group: Unsupported value: "invalid": supported values: "core", "", "inference.networking.k8s.io"`,
+				`test.group: Unsupported value: "invalid": supported values: "core", "", "inference.networking.k8s.io", ` +
+					`"multicluster.x-k8s.io"`,
 			),
 		},
 		{
@@ -423,7 +534,7 @@ func TestValidateBackendRefHTTPRoute(t *testing.T) {
 			refGrantResolver: alwaysTrueRefGrantResolver,
 			expectedValid:    false,
 			expectedCondition: conditions.NewRouteBackendRefInvalidKind(
-				`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool"`,
+				`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool", "ServiceImport"`,
 			),
 		},
 		{
@@ -1104,7 +1215,8 @@ func TestAddBackendRefsToRules(t *testing.T) {
 			},
 			expectedConditions: []conditions.Condition{
 				conditions.NewRouteBackendRefInvalidKind(
-					`spec.rules[0].backendRefs[0].kind: Unsupported value: "NotService": supported values: "Service", "InferencePool"`,
+					`spec.rules[0].backendRefs[0].kind: Unsupported value: "NotService": supported values: ` +
+						`"Service", "InferencePool", "ServiceImport"`,
 				),
 			},
 			policies: emptyPolicies,
@@ -1203,7 +1315,7 @@ func TestAddBackendRefsToRules(t *testing.T) {
 				},
 			}
 
-			addBackendRefsToRules(test.route, resolver, services, referencedInferencePools, test.policies)
+			addBackendRefsToRules(test.route, resolver, services, nil, referencedInferencePools, test.policies)
 
 			var actual []BackendRef
 			if test.route.Spec.Rules != nil {
@@ -1437,7 +1549,7 @@ func TestCreateBackend(t *testing.T) {
 			expectedServicePortReference: "",
 			expectedConditions: []conditions.Condition{
 				conditions.NewRouteBackendRefInvalidKind(
-					`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool"`,
+					`test.kind: Unsupported value: "NotService": supported values: "Service", "InferencePool", "ServiceImport"`,
 				),
 			},
 			name: "invalid kind",
@@ -1742,6 +1854,7 @@ func TestCreateBackend(t *testing.T) {
 				route,
 				alwaysTrueRefGrantResolver,
 				services,
+				nil,
 				refPath,
 				policies,
 			)
@@ -1784,6 +1897,7 @@ func TestCreateBackend(t *testing.T) {
 		route,
 		alwaysTrueRefGrantResolver,
 		services,
+		nil,
 		refPath,
 		policies,
 	)
@@ -1792,6 +1906,109 @@ func TestCreateBackend(t *testing.T) {
 	g.Expect(backend.IsMirrorBackend).To(BeTrue())
 }
 
+func TestCreateBackendRef_ServiceImport(t *testing.T) {
+	t.Parallel()
+
+	svcImportRef := RouteBackendRef{
+		BackendRef: gatewayv1.BackendRef{
+			BackendObjectReference: gatewayv1.BackendObjectReference{
+				Group:     helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group)),
+				Kind:      helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport),
+				Name:      "service1",
+				Namespace: helpers.GetPointer[gatewayv1.Namespace]("test"),
+				Port:      helpers.GetPointer[gatewayv1.PortNumber](80),
+			},
+		},
+	}
+
+	route := &L7Route{
+		RouteType: RouteTypeHTTP,
+		Source: &gatewayv1.HTTPRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "test",
+			},
+		},
+	}
+
+	// the Service has the same name as the ServiceImport, but a different port, so it must not be used.
+	services := map[types.NamespacedName]*v1.Service{
+		{Namespace: "test", Name: "service1"}: {
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{{Port: 81}},
+			},
+		},
+	}
+
+	svcImportPort := v1.ServicePort{Name: "http", Port: 80, Protocol: v1.ProtocolTCP}
+
+	tests := []struct {
+		serviceImports      map[types.NamespacedName]*v1.Service
+		expectedConditions  []conditions.Condition
+		name                string
+		expectedServicePort v1.ServicePort
+		expectedValid       bool
+	}{
+		{
+			name: "ServiceImport exists",
+			serviceImports: map[types.NamespacedName]*v1.Service{
+				{Namespace: "test", Name: "service1"}: {
+					Spec: v1.ServiceSpec{
+						Ports: []v1.ServicePort{svcImportPort},
+					},
+				},
+			},
+			expectedServicePort: svcImportPort,
+			expectedValid:       true,
+		},
+		{
+			name:           "ServiceImport does not exist",
+			serviceImports: map[types.NamespacedName]*v1.Service{},
+			expectedConditions: []conditions.Condition{
+				conditions.NewRouteBackendRefRefBackendNotFound(`test.name: Not found: "service1"`),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			backend, conds := createBackendRef(
+				svcImportRef,
+				route,
+				func(_ toResource) bool { return true },
+				services,
+				test.serviceImports,
+				field.NewPath("test"),
+				nil,
+			)
+
+			g.Expect(conds).To(Equal(test.expectedConditions))
+			g.Expect(backend.Valid).To(Equal(test.expectedValid))
+			g.Expect(backend.IsServiceImport).To(BeTrue())
+			g.Expect(backend.SvcNsName).To(Equal(types.NamespacedName{Namespace: "test", Name: "service1"}))
+			g.Expect(backend.ServicePort).To(Equal(test.expectedServicePort))
+		})
+	}
+}
+
+func TestBaseServicePortKey(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	ref := BackendRef{
+		SvcNsName:   types.NamespacedName{Namespace: "test", Name: "service1"},
+		ServicePort: v1.ServicePort{Port: 80},
+		Valid:       true,
+	}
+	g.Expect(ref.BaseServicePortKey()).To(Equal("test_service1_80"))
+
+	ref.IsServiceImport = true
+	g.Expect(ref.BaseServicePortKey()).To(Equal("test_service1_80_serviceimport"))
+	g.Expect(ref.ServicePortReference()).To(Equal("test_service1_80_serviceimport"))
+}
+
 func TestGetServicePort(t *testing.T) {
 	t.Parallel()
 	svc := &v1.Service{
@@ -281,19 +281,29 @@ func validateFilterExternalAuth(
 	if filter == nil {
 		return field.ErrorList{field.Required(filterPath.Child("externalAuth"), "cannot be nil")}
 	}
-	if filter.ExternalAuthProtocol != v1.HTTPRouteExternalAuthHTTPProtocol {
+	switch filter.ExternalAuthProtocol {
+	case v1.HTTPRouteExternalAuthHTTPProtocol, v1.HTTPRouteExternalAuthGRPCProtocol:
+	default:
 		return field.ErrorList{
 			field.NotSupported(
 				filterPath.Child("externalAuth", "protocol"),
 				filter.ExternalAuthProtocol,
-				[]string{string(v1.HTTPRouteExternalAuthHTTPProtocol)},
+				[]string{string(v1.HTTPRouteExternalAuthHTTPProtocol), string(v1.HTTPRouteExternalAuthGRPCProtocol)},
 			),
 		}
 	}
 
 	var allErrs field.ErrorList
 
-	if filter.HTTPAuthConfig != nil {
+	if filter.ExternalAuthProtocol == v1.HTTPRouteExternalAuthGRPCProtocol && filter.GRPCAuthConfig != nil {
+		allErrs = append(allErrs, verifyExternalAuthHeaders(
+			validator,
+			filter.GRPCAuthConfig.AllowedRequestHeaders,
+			filterPath.Child("externalAuth", "grpc", "allowedHeaders"),
+		)...)
+	}
+
+	if filter.ExternalAuthProtocol == v1.HTTPRouteExternalAuthHTTPProtocol && filter.HTTPAuthConfig != nil {
 		reqErrs := verifyExternalAuthHeaders(
 			validator,
 			filter.HTTPAuthConfig.AllowedRequestHeaders,
@@ -706,7 +706,7 @@ func TestValidateFilterExternalAuth(t *testing.T) {
 			expectErrCount: 1,
 		},
 		{
-			name:      "GRPC protocol is not supported",
+			name:      "valid GRPC protocol",
 			validator: &validationfakes.FakeHTTPFieldsValidator{},
 			filter: &gatewayv1.HTTPExternalAuthFilter{
 				ExternalAuthProtocol: gatewayv1.HTTPRouteExternalAuthGRPCProtocol,
@@ -714,6 +714,36 @@ func TestValidateFilterExternalAuth(t *testing.T) {
 					Name: "auth-svc",
 					Port: &port,
 				},
+				GRPCAuthConfig: &gatewayv1.GRPCAuthConfig{
+					AllowedRequestHeaders: []string{"X-Request-Id"},
+				},
+			},
+			expectErrCount: 0,
+		},
+		{
+			name:      "invalid header name in GRPC allowedHeaders",
+			validator: invalidHeaderValidator,
+			filter: &gatewayv1.HTTPExternalAuthFilter{
+				ExternalAuthProtocol: gatewayv1.HTTPRouteExternalAuthGRPCProtocol,
+				BackendRef: gatewayv1.BackendObjectReference{
+					Name: "auth-svc",
+					Port: &port,
+				},
+				GRPCAuthConfig: &gatewayv1.GRPCAuthConfig{
+					AllowedRequestHeaders: []string{"invalid header"},
+				},
+			},
+			expectErrCount: 1,
+		},
+		{
+			name:      "unsupported protocol",
+			validator: &validationfakes.FakeHTTPFieldsValidator{},
+			filter: &gatewayv1.HTTPExternalAuthFilter{
+				ExternalAuthProtocol: "TCP",
+				BackendRef: gatewayv1.BackendObjectReference{
+					Name: "auth-svc",
+					Port: &port,
+				},
 			},
 			expectErrCount: 1,
 		},
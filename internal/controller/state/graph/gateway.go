@@ -50,6 +50,13 @@ type Gateway struct {
 	Policies []*Policy
 	// Valid indicates whether the Gateway Spec is valid.
 	Valid bool
+	// ZoneSync indicates whether the NGINX Plus replicas of the Gateway need to synchronize their shared memory
+	// zones. This is the case when a RateLimitPolicy attached to the Gateway or to one of its Routes defines
+	// global rate limits.
+	ZoneSync bool
+	// ExtAuthzShim indicates whether the nginx Pods of the Gateway need the ext_authz shim sidecar. This is the case
+	// when a Route attached to the Gateway has an ExternalAuth filter with the GRPC protocol.
+	ExtAuthzShim bool
 }
 
 // processGateways determines which Gateway resources belong to NGF (determined by the Gateway GatewayClassName field).
@@ -350,13 +357,100 @@ func (g *Gateway) GetReferencedSnippetsFilters(
 func (g *Gateway) GetReferencedRateLimitPolicies(
 	routes map[RouteKey]*L7Route,
 	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.RateLimitPolicy)
+}
+
+// needsZoneSync returns whether any valid RateLimitPolicy attached to this Gateway, or to the routes attached to
+// this Gateway, defines global rate limits.
+func (g *Gateway) needsZoneSync(routes map[RouteKey]*L7Route, allPolicies map[PolicyKey]*Policy) bool {
+	gatewayNsName := client.ObjectKeyFromObject(g.Source)
+
+	for _, policy := range g.Policies {
+		if _, ok := policy.InvalidForGateways[gatewayNsName]; ok || !policy.Valid {
+			continue
+		}
+
+		if hasGlobalRateLimit(policy) {
+			return true
+		}
+	}
+
+	for _, policy := range g.GetReferencedRateLimitPolicies(routes, allPolicies) {
+		if hasGlobalRateLimit(policy) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// needsExtAuthzShim returns whether a valid rule of a route attached to this Gateway has an ExternalAuth filter
+// with the GRPC protocol. The auth subrequests of these filters are sent to the ext_authz shim sidecar.
+func (g *Gateway) needsExtAuthzShim(routes map[RouteKey]*L7Route) bool {
+	gatewayNsName := client.ObjectKeyFromObject(g.Source)
+
+	for _, route := range routes {
+		if !route.Valid || !g.isRouteAttachedToGateway(route, gatewayNsName) {
+			continue
+		}
+
+		for _, rule := range route.Spec.Rules {
+			if !rule.Filters.Valid {
+				continue
+			}
+
+			for _, filter := range rule.Filters.Filters {
+				if filter.FilterType == FilterExternalAuth && filter.ExternalAuth != nil &&
+					filter.ExternalAuth.ExternalAuthProtocol == v1.HTTPRouteExternalAuthGRPCProtocol {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+func hasGlobalRateLimit(policy *Policy) bool {
+	rlp, ok := policy.Source.(*ngfAPIv1alpha1.RateLimitPolicy)
+	if !ok {
+		return false
+	}
+
+	return rlp.Spec.RateLimit != nil && rlp.Spec.RateLimit.Global != nil && len(rlp.Spec.RateLimit.Global.Rules) > 0
+}
+
+// GetReferencedCachePolicies returns all CachePolicies that target routes attached to this Gateway.
+// CachePolicies that target the Gateway directly are excluded.
+func (g *Gateway) GetReferencedCachePolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.CachePolicy)
+}
+
+// GetReferencedAccessLogPolicies returns all AccessLogPolicies that target routes attached to this Gateway.
+func (g *Gateway) GetReferencedAccessLogPolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+) map[PolicyKey]*Policy {
+	return g.getReferencedRoutePolicies(routes, allPolicies, kinds.AccessLogPolicy)
+}
+
+// getReferencedRoutePolicies returns all valid policies of the given kind that target routes attached
+// to this Gateway, excluding policies that target the Gateway directly.
+func (g *Gateway) getReferencedRoutePolicies(
+	routes map[RouteKey]*L7Route,
+	allPolicies map[PolicyKey]*Policy,
+	kind string,
 ) map[PolicyKey]*Policy {
 	if len(allPolicies) == 0 {
 		return nil
 	}
 
 	gatewayNsName := client.ObjectKeyFromObject(g.Source)
-	referencedRateLimitPolicies := make(map[PolicyKey]*Policy)
+	referencedPolicies := make(map[PolicyKey]*Policy)
 
 	// Create a lookup map of routes attached to this gateway for efficient checking
 	attachedRoutes := g.buildAttachedRoutes(routes, gatewayNsName)
@@ -368,7 +462,7 @@ func (g *Gateway) GetReferencedRateLimitPolicies(
 			continue
 		}
 
-		if !policy.Valid || policyKey.GVK.Kind != kinds.RateLimitPolicy {
+		if !policy.Valid || policyKey.GVK.Kind != kind {
 			continue
 		}
 
@@ -377,15 +471,15 @@ func (g *Gateway) GetReferencedRateLimitPolicies(
 
 		// Only include policies that target attached routes but NOT the gateway
 		if targetsAttachedRoute && !targetsGateway {
-			referencedRateLimitPolicies[policyKey] = policy
+			referencedPolicies[policyKey] = policy
 		}
 	}
 
-	if len(referencedRateLimitPolicies) == 0 {
+	if len(referencedPolicies) == 0 {
 		return nil
 	}
 
-	return referencedRateLimitPolicies
+	return referencedPolicies
 }
 
 func (g *Gateway) buildAttachedRoutes(
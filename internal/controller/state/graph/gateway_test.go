@@ -2702,6 +2702,407 @@ func TestGetReferencedRateLimitPolicies(t *testing.T) {
 	g.Expect(noAttachedResult).To(BeEmpty())
 }
 
+func TestGetReferencedCachePolicies(t *testing.T) {
+	t.Parallel()
+
+	gw := &Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "gateway-ns",
+				Name:      "test-gateway",
+			},
+		},
+	}
+
+	routeTargetRef := PolicyTargetRef{
+		Kind:   kinds.HTTPRoute,
+		Nsname: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+	}
+
+	cpRoute := &Policy{
+		Source: &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "route-cache"},
+		},
+		Valid:      true,
+		TargetRefs: []PolicyTargetRef{routeTargetRef},
+	}
+
+	cpGateway := &Policy{
+		Source: &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "gateway-ns", Name: "gateway-cache"},
+		},
+		Valid: true,
+		TargetRefs: []PolicyTargetRef{
+			{
+				Kind:   kinds.Gateway,
+				Nsname: types.NamespacedName{Namespace: "gateway-ns", Name: "test-gateway"},
+			},
+		},
+	}
+
+	cpInvalid := &Policy{
+		Source: &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "invalid-cache"},
+		},
+		Valid:      false,
+		TargetRefs: []PolicyTargetRef{routeTargetRef},
+	}
+
+	rlpRoute := &Policy{
+		Source: &ngfAPIv1alpha1.RateLimitPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "route-rate-limit"},
+		},
+		Valid:      true,
+		TargetRefs: []PolicyTargetRef{routeTargetRef},
+	}
+
+	routes := map[RouteKey]*L7Route{
+		{
+			NamespacedName: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+			RouteType:      RouteTypeHTTP,
+		}: {
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "attached-route"},
+			},
+			Valid: true,
+			ParentRefs: []ParentRef{
+				{
+					Kind:           kinds.Gateway,
+					NamespacedName: types.NamespacedName{Namespace: "gateway-ns", Name: "test-gateway"},
+				},
+			},
+		},
+	}
+
+	cpRouteKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: "app1", Name: "route-cache"},
+		GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+	}
+
+	allPolicies := map[PolicyKey]*Policy{
+		cpRouteKey: cpRoute,
+		{
+			NsName: types.NamespacedName{Namespace: "gateway-ns", Name: "gateway-cache"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}: cpGateway,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "invalid-cache"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}: cpInvalid,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "route-rate-limit"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+		}: rlpRoute,
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(gw.GetReferencedCachePolicies(routes, allPolicies)).To(Equal(map[PolicyKey]*Policy{
+		cpRouteKey: cpRoute,
+	}))
+
+	g.Expect(gw.GetReferencedCachePolicies(map[RouteKey]*L7Route{}, allPolicies)).To(BeEmpty())
+	g.Expect(gw.GetReferencedCachePolicies(routes, nil)).To(BeEmpty())
+}
+
+func TestNeedsZoneSync(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "gateway-ns", Name: "test-gateway"}
+	routeNsName := types.NamespacedName{Namespace: "app1", Name: "attached-route"}
+
+	rateLimitPolicy := func(name string, global bool, targetKind v1.Kind, target types.NamespacedName) *Policy {
+		rl := &ngfAPIv1alpha1.RateLimit{
+			Local: &ngfAPIv1alpha1.LocalRateLimit{
+				Rules: []ngfAPIv1alpha1.RateLimitRule{{Rate: "10r/s"}},
+			},
+		}
+		if global {
+			rl = &ngfAPIv1alpha1.RateLimit{
+				Global: &ngfAPIv1alpha1.GlobalRateLimit{
+					Rules: []ngfAPIv1alpha1.RateLimitRule{{Rate: "10r/s"}},
+				},
+			}
+		}
+
+		return &Policy{
+			Source: &ngfAPIv1alpha1.RateLimitPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: target.Namespace, Name: name},
+				Spec:       ngfAPIv1alpha1.RateLimitPolicySpec{RateLimit: rl},
+			},
+			Valid:      true,
+			TargetRefs: []PolicyTargetRef{{Kind: targetKind, Nsname: target}},
+		}
+	}
+
+	policyKey := func(pol *Policy) PolicyKey {
+		return PolicyKey{
+			NsName: client.ObjectKeyFromObject(pol.Source),
+			GVK:    schema.GroupVersionKind{Kind: kinds.RateLimitPolicy},
+		}
+	}
+
+	routes := map[RouteKey]*L7Route{
+		{NamespacedName: routeNsName, RouteType: RouteTypeHTTP}: {
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: routeNsName.Namespace, Name: routeNsName.Name},
+			},
+			Valid: true,
+			ParentRefs: []ParentRef{
+				{
+					Kind:           kinds.Gateway,
+					NamespacedName: gwNsName,
+				},
+			},
+		},
+	}
+
+	localGatewayPolicy := rateLimitPolicy("local-gateway", false, kinds.Gateway, gwNsName)
+	globalGatewayPolicy := rateLimitPolicy("global-gateway", true, kinds.Gateway, gwNsName)
+	localRoutePolicy := rateLimitPolicy("local-route", false, kinds.HTTPRoute, routeNsName)
+	globalRoutePolicy := rateLimitPolicy("global-route", true, kinds.HTTPRoute, routeNsName)
+
+	invalidGlobalGatewayPolicy := rateLimitPolicy("invalid-global-gateway", true, kinds.Gateway, gwNsName)
+	invalidGlobalGatewayPolicy.Valid = false
+
+	tests := []struct {
+		name            string
+		gatewayPolicies []*Policy
+		routePolicies   []*Policy
+		expected        bool
+	}{
+		{
+			name:     "no policies",
+			expected: false,
+		},
+		{
+			name:            "only local rate limits",
+			gatewayPolicies: []*Policy{localGatewayPolicy},
+			routePolicies:   []*Policy{localRoutePolicy},
+			expected:        false,
+		},
+		{
+			name:            "invalid global rate limit attached to the gateway",
+			gatewayPolicies: []*Policy{invalidGlobalGatewayPolicy},
+			expected:        false,
+		},
+		{
+			name:            "global rate limit attached to the gateway",
+			gatewayPolicies: []*Policy{localGatewayPolicy, globalGatewayPolicy},
+			expected:        true,
+		},
+		{
+			name:          "global rate limit attached to a route",
+			routePolicies: []*Policy{localRoutePolicy, globalRoutePolicy},
+			expected:      true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gw := &Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				},
+				Policies: test.gatewayPolicies,
+			}
+
+			allPolicies := make(map[PolicyKey]*Policy)
+			for _, pol := range append(test.gatewayPolicies, test.routePolicies...) {
+				allPolicies[policyKey(pol)] = pol
+			}
+
+			g.Expect(gw.needsZoneSync(routes, allPolicies)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestNeedsExtAuthzShim(t *testing.T) {
+	t.Parallel()
+
+	gwNsName := types.NamespacedName{Namespace: "gateway-ns", Name: "test-gateway"}
+
+	createRoute := func(protocol v1.HTTPRouteExternalAuthProtocol, routeValid, filtersValid bool, parent string) *L7Route {
+		return &L7Route{
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "route"},
+			},
+			Valid: routeValid,
+			ParentRefs: []ParentRef{
+				{
+					Kind:           kinds.Gateway,
+					NamespacedName: types.NamespacedName{Namespace: gwNsName.Namespace, Name: parent},
+				},
+			},
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{
+						Filters: RouteRuleFilters{
+							Valid: filtersValid,
+							Filters: []Filter{
+								{
+									FilterType:   FilterExternalAuth,
+									ExternalAuth: &v1.HTTPExternalAuthFilter{ExternalAuthProtocol: protocol},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		route    *L7Route
+		name     string
+		expected bool
+	}{
+		{
+			name:     "no routes",
+			expected: false,
+		},
+		{
+			name:     "HTTP ExternalAuth filter",
+			route:    createRoute(v1.HTTPRouteExternalAuthHTTPProtocol, true, true, gwNsName.Name),
+			expected: false,
+		},
+		{
+			name:     "GRPC ExternalAuth filter",
+			route:    createRoute(v1.HTTPRouteExternalAuthGRPCProtocol, true, true, gwNsName.Name),
+			expected: true,
+		},
+		{
+			name:     "GRPC ExternalAuth filter on an invalid route",
+			route:    createRoute(v1.HTTPRouteExternalAuthGRPCProtocol, false, true, gwNsName.Name),
+			expected: false,
+		},
+		{
+			name:     "invalid GRPC ExternalAuth filter",
+			route:    createRoute(v1.HTTPRouteExternalAuthGRPCProtocol, true, false, gwNsName.Name),
+			expected: false,
+		},
+		{
+			name:     "GRPC ExternalAuth filter on a route attached to another gateway",
+			route:    createRoute(v1.HTTPRouteExternalAuthGRPCProtocol, true, true, "other-gateway"),
+			expected: false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			gw := &Gateway{
+				Source: &v1.Gateway{
+					ObjectMeta: metav1.ObjectMeta{Namespace: gwNsName.Namespace, Name: gwNsName.Name},
+				},
+			}
+
+			routes := make(map[RouteKey]*L7Route)
+			if test.route != nil {
+				routes[CreateRouteKey(test.route.Source)] = test.route
+			}
+
+			g.Expect(gw.needsExtAuthzShim(routes)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestGetReferencedAccessLogPolicies(t *testing.T) {
+	t.Parallel()
+
+	gw := &Gateway{
+		Source: &v1.Gateway{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "gateway-ns",
+				Name:      "test-gateway",
+			},
+		},
+	}
+
+	routeTargetRef := PolicyTargetRef{
+		Kind:   kinds.HTTPRoute,
+		Nsname: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+	}
+
+	alpRoute := &Policy{
+		Source: &ngfAPIv1alpha1.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "route-access-log"},
+		},
+		Valid:      true,
+		TargetRefs: []PolicyTargetRef{routeTargetRef},
+	}
+
+	alpUnattached := &Policy{
+		Source: &ngfAPIv1alpha1.AccessLogPolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "unattached-access-log"},
+		},
+		Valid: true,
+		TargetRefs: []PolicyTargetRef{
+			{
+				Kind:   kinds.HTTPRoute,
+				Nsname: types.NamespacedName{Namespace: "app1", Name: "other-route"},
+			},
+		},
+	}
+
+	cpRoute := &Policy{
+		Source: &ngfAPIv1alpha1.CachePolicy{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "route-cache"},
+		},
+		Valid:      true,
+		TargetRefs: []PolicyTargetRef{routeTargetRef},
+	}
+
+	routes := map[RouteKey]*L7Route{
+		{
+			NamespacedName: types.NamespacedName{Namespace: "app1", Name: "attached-route"},
+			RouteType:      RouteTypeHTTP,
+		}: {
+			Source: &v1.HTTPRoute{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "app1", Name: "attached-route"},
+			},
+			Valid: true,
+			ParentRefs: []ParentRef{
+				{
+					Kind:           kinds.Gateway,
+					NamespacedName: types.NamespacedName{Namespace: "gateway-ns", Name: "test-gateway"},
+				},
+			},
+		},
+	}
+
+	alpRouteKey := PolicyKey{
+		NsName: types.NamespacedName{Namespace: "app1", Name: "route-access-log"},
+		GVK:    schema.GroupVersionKind{Kind: kinds.AccessLogPolicy},
+	}
+
+	allPolicies := map[PolicyKey]*Policy{
+		alpRouteKey: alpRoute,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "unattached-access-log"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.AccessLogPolicy},
+		}: alpUnattached,
+		{
+			NsName: types.NamespacedName{Namespace: "app1", Name: "route-cache"},
+			GVK:    schema.GroupVersionKind{Kind: kinds.CachePolicy},
+		}: cpRoute,
+	}
+
+	g := NewWithT(t)
+
+	g.Expect(gw.GetReferencedAccessLogPolicies(routes, allPolicies)).To(Equal(map[PolicyKey]*Policy{
+		alpRouteKey: alpRoute,
+	}))
+
+	g.Expect(gw.GetReferencedAccessLogPolicies(map[RouteKey]*L7Route{}, allPolicies)).To(BeEmpty())
+	g.Expect(gw.GetReferencedAccessLogPolicies(routes, nil)).To(BeEmpty())
+}
+
 func TestValidateUnsupportedGatewayFields(t *testing.T) {
 	t.Parallel()
 
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"maps"
 	"slices"
@@ -56,6 +57,7 @@ type ClusterState struct {
 	APPolicies            map[types.NamespacedName]*unstructured.Unstructured
 	APLogConfs            map[types.NamespacedName]*unstructured.Unstructured
 	ExternalLoadBalancer  map[types.NamespacedName]*ngfAPIv1alpha1.ExternalLoadBalancer
+	ServiceImports        map[types.NamespacedName]*unstructured.Unstructured
 }
 
 // Graph is a Graph-like representation of Gateway API resources.
@@ -84,6 +86,9 @@ type Graph struct {
 	// ReferencedInferencePools includes the NamespacedNames of all the InferencePools
 	// that are referenced by at least one Route.
 	ReferencedInferencePools map[types.NamespacedName]*ReferencedInferencePool
+	// ReferencedServiceImports includes the NamespacedNames of all the Multi-Cluster Services ServiceImports
+	// that are referenced by at least one Route, including ServiceImports that do not exist.
+	ReferencedServiceImports map[types.NamespacedName]struct{}
 	// ReferencedCaCertConfigMaps includes ConfigMaps that have been referenced by any BackendTLSPolicies.
 	ReferencedCaCertConfigMaps map[types.NamespacedName]*configmaps.CaCertConfigMap
 	// ReferencedNginxProxies includes NginxProxies that have been referenced by a GatewayClass or a Gateway.
@@ -124,6 +129,10 @@ type Graph struct {
 	// PLMSecrets holds the PLM S3 storage secrets, keyed by NamespacedName with the configured roles as value.
 	// Used by IsReferenced to ensure PLM secrets trigger graph rebuilds when updated.
 	PLMSecrets map[types.NamespacedName][]PLMRole
+	// AffectedGateways holds the Gateways whose configuration might have changed since the previous Graph.
+	// If nil, all Gateways might be affected. Note that the Graph itself is still built for all Gateways;
+	// AffectedGateways only scopes the generation and pushing of the nginx configuration.
+	AffectedGateways map[types.NamespacedName]struct{}
 }
 
 // Snapshot returns a defensive copy of the graph for read-only consumers.
@@ -209,12 +218,31 @@ func clonePolicies(src map[PolicyKey]*Policy) map[PolicyKey]*Policy {
 	return cloned
 }
 
+// ErrConfigRolloutAborted is the NginxReloadResult error when a staged rollout of the configuration
+// was aborted, because the configuration failed to apply or the data plane was unhealthy in a canary Pod.
+// The previous configuration is restored in the canary Pods.
+var ErrConfigRolloutAborted = errors.New("staged configuration rollout aborted")
+
+// ErrPinnedConfigVersionNotFound is the NginxReloadResult error when the configuration version that a Gateway
+// is pinned to is not in the configuration version history, for example after the control plane restarted.
+// The current configuration is kept.
+var ErrPinnedConfigVersionNotFound = errors.New("pinned configuration version not found")
+
 // NginxReloadResult describes the result of an NGINX reload.
 type NginxReloadResult struct {
 	// Error is the error that occurred during the reload.
 	Error error
 }
 
+// DataPlaneHealth describes the data plane health of the nginx instances of a Gateway,
+// as reported by the nginx agents.
+type DataPlaneHealth struct {
+	// UnhealthyPods maps the names of the Pods with an unhealthy data plane to a description of the problem.
+	UnhealthyPods map[string]string
+	// Reported is true if at least one nginx agent has reported data plane health.
+	Reported bool
+}
+
 // ProtectedPorts are the ports that may not be configured by a listener with a descriptive name of each port.
 type ProtectedPorts map[int32]string
 
@@ -242,7 +270,8 @@ func (g *Graph) IsReferenced(resourceType ngftypes.ObjectType, nsname types.Name
 	// InferencePool reference exists if at least one Route references it.
 	case *inference.InferencePool:
 		return g.inferencePoolIsReferenced(nsname)
-	// EndpointSlice reference exists if its Service owner is referenced by at least one Route.
+	// EndpointSlice reference exists if its Service owner, or the ServiceImport it is derived from,
+	// is referenced by at least one Route.
 	case *discoveryV1.EndpointSlice:
 		return g.endpointSliceIsReferenced(nsname, obj)
 	// NginxProxy reference exists if the GatewayClass or Gateway references it.
@@ -305,6 +334,16 @@ func (g *Graph) endpointSliceIsReferenced(nsname types.NamespacedName, obj *disc
 
 	// Service Namespace should be the same Namespace as the EndpointSlice
 	_, exists := g.ReferencedServices[types.NamespacedName{Namespace: nsname.Namespace, Name: svcName}]
+	if exists {
+		return true
+	}
+
+	svcImportName := index.GetServiceImportNameFromEndpointSlice(obj)
+	if svcImportName == "" {
+		return false
+	}
+
+	_, exists = g.ReferencedServiceImports[types.NamespacedName{Namespace: nsname.Namespace, Name: svcImportName}]
 	return exists
 }
 
@@ -321,6 +360,9 @@ func (g *Graph) unstructuredIsReferenced(nsname types.NamespacedName, obj *unstr
 	case kinds.APLogConfGVK:
 		_, exists := g.ReferencedAPLogConfs[nsname]
 		return exists
+	case kinds.ServiceImportGVK:
+		_, exists := g.ReferencedServiceImports[nsname]
+		return exists
 	default:
 		return false
 	}
@@ -383,7 +425,8 @@ func (g *Graph) gatewayAPIResourceExist(ref gatewayv1.LocalPolicyTargetReference
 	}
 }
 
-// BuildGraph builds a Graph from a state.
+// BuildGraph builds a Graph from a state. The Graph is always built from the whole state, for all Gateways,
+// regardless of which resources changed.
 func BuildGraph(
 	ctx context.Context,
 	state ClusterState,
@@ -472,11 +515,14 @@ func BuildGraph(
 		listenerSets,
 	)
 
+	processedServiceImports := processServiceImports(state.ServiceImports)
+
 	l4routes := buildL4RoutesForGateways(
 		state.TLSRoutes,
 		state.TCPRoutes,
 		state.UDPRoutes,
 		state.Services,
+		processedServiceImports,
 		processedBackendTLSPolicies,
 		gws,
 		refGrantResolver,
@@ -487,6 +533,7 @@ func BuildGraph(
 		routes,
 		refGrantResolver,
 		state.Services,
+		processedServiceImports,
 		referencedInferencePools,
 		processedBackendTLSPolicies,
 	)
@@ -519,6 +566,7 @@ func BuildGraph(
 		state.NGFPolicies,
 		validators.PolicyValidator,
 		routes,
+		l4routes,
 		referencedServices,
 		gws,
 		wafInput,
@@ -550,7 +598,7 @@ func BuildGraph(
 	addGatewaysForBackendTLSPolicies(processedBackendTLSPolicies, referencedServices, controllerName, gws, logger)
 
 	// add status conditions to each targetRef based on the policies that affect them.
-	addPolicyAffectedStatusToTargetRefs(processedPolicies, routes, gws)
+	addPolicyAffectedStatusToTargetRefs(processedPolicies, routes, l4routes, gws)
 
 	setPlusSecretContent(state.Secrets, plusSecrets)
 
@@ -575,6 +623,7 @@ func BuildGraph(
 		ReferencedNamespaces:               referencedNamespaces,
 		ReferencedServices:                 referencedServices,
 		ReferencedInferencePools:           referencedInferencePools,
+		ReferencedServiceImports:           buildReferencedServiceImports(routes, l4routes),
 		ReferencedCaCertConfigMaps:         resourceResolver.GetConfigMaps(),
 		ReferencedNginxProxies:             processedNginxProxies,
 		BackendTLSPolicies:                 processedBackendTLSPolicies,
@@ -594,8 +643,14 @@ func BuildGraph(
 	}
 
 	g.attachPolicies(validators.PolicyValidator, controllerName, logger)
+	for _, gw := range g.Gateways {
+		gw.ZoneSync = gw.needsZoneSync(g.Routes, g.NGFPolicies)
+		gw.ExtAuthzShim = gw.needsExtAuthzShim(g.Routes)
+	}
 	resolveEffectivePayloadProcessors(g.Gateways, g.Routes)
 	validateExternalAuthConflicts(routes)
+	validateRouteTimeoutsConflicts(routes, g.Gateways)
+	validateStreamSettingsConflicts(l4routes, referencedServices)
 
 	return g
 }
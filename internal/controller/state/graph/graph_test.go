@@ -2659,6 +2659,20 @@ func TestIsReferenced(t *testing.T) {
 	endpointSliceInGraph := createEndpointSlice("endpointSliceInGraph", "serviceInGraph")
 	endpointSliceNotInGraph := createEndpointSlice("endpointSliceNotInGraph", "serviceNotInGraph")
 	emptyEndpointSlice := &discoveryV1.EndpointSlice{}
+	mcsEndpointSliceInGraph := &discoveryV1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "mcsEndpointSliceInGraph",
+			Labels:    map[string]string{index.MultiClusterServiceNameLabel: "serviceImportInGraph"},
+		},
+	}
+	mcsEndpointSliceNotInGraph := &discoveryV1.EndpointSlice{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "mcsEndpointSliceNotInGraph",
+			Labels:    map[string]string{index.MultiClusterServiceNameLabel: "serviceInGraph"},
+		},
+	}
 
 	gw := map[types.NamespacedName]*Gateway{
 		{}: {
@@ -2733,6 +2747,14 @@ func TestIsReferenced(t *testing.T) {
 	apLogConfNotReferenced.SetNamespace(testNs)
 	apLogConfNotReferenced.SetName("ap-logconf-other")
 
+	serviceImportReferenced := kinds.NewServiceImportObject()
+	serviceImportReferenced.SetNamespace("default")
+	serviceImportReferenced.SetName("serviceImportInGraph")
+
+	serviceImportNotReferenced := kinds.NewServiceImportObject()
+	serviceImportNotReferenced.SetNamespace("default")
+	serviceImportNotReferenced.SetName("serviceInGraph")
+
 	graph := &Graph{
 		Gateways: gw,
 		ReferencedSecrets: map[types.NamespacedName]*secrets.Secret{
@@ -2749,6 +2771,9 @@ func TestIsReferenced(t *testing.T) {
 		ReferencedPayloadProcessorServices: map[types.NamespacedName]struct{}{
 			client.ObjectKeyFromObject(payloadProcessorServiceInGraph): {},
 		},
+		ReferencedServiceImports: map[types.NamespacedName]struct{}{
+			client.ObjectKeyFromObject(serviceImportReferenced): {},
+		},
 		ReferencedInferencePools: map[types.NamespacedName]*ReferencedInferencePool{
 			client.ObjectKeyFromObject(inferenceInGraph): {},
 		},
@@ -2921,6 +2946,18 @@ func TestIsReferenced(t *testing.T) {
 			graph:    graph,
 			expected: false,
 		},
+		{
+			name:     "EndpointSlice derived from a ServiceImport in graph's ReferencedServiceImports is referenced",
+			resource: mcsEndpointSliceInGraph,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "EndpointSlice derived from a ServiceImport not in graph's ReferencedServiceImports is not referenced",
+			resource: mcsEndpointSliceNotInGraph,
+			graph:    graph,
+			expected: false,
+		},
 		{
 			name:     "Empty EndpointSlice",
 			resource: emptyEndpointSlice,
@@ -2986,6 +3023,20 @@ func TestIsReferenced(t *testing.T) {
 			expected: false,
 		},
 
+		// ServiceImport tests
+		{
+			name:     "ServiceImport is referenced",
+			resource: serviceImportReferenced,
+			graph:    graph,
+			expected: true,
+		},
+		{
+			name:     "ServiceImport with the same name as a referenced Service is not referenced",
+			resource: serviceImportNotReferenced,
+			graph:    graph,
+			expected: false,
+		},
+
 		// Edge cases
 		{
 			name:     "Resource is not supported by IsReferenced",
@@ -2,7 +2,10 @@ package graph
 
 import (
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -247,6 +250,34 @@ func processHTTPRouteRule(
 		}
 	}
 
+	var timeouts *RouteTimeouts
+	if specRule.Timeouts != nil {
+		var timeoutsErrors routeRuleErrors
+		timeouts, timeoutsErrors = processRouteTimeouts(specRule.Timeouts, rulePath.Child("timeouts"), validator)
+		errors = errors.append(timeoutsErrors)
+
+		// a rule with conflicting timeouts is not accepted, the same as a rule with invalid matches
+		if len(timeoutsErrors.invalid) > 0 {
+			validMatches = false
+		}
+	}
+
+	var retry *RouteRetry
+	if specRule.Retry != nil {
+		if featureFlags.Experimental {
+			var retryErrors routeRuleErrors
+			retry, retryErrors = processRouteRetry(specRule.Retry, rulePath.Child("retry"))
+			errors = errors.append(retryErrors)
+		} else {
+			// the rule is not accepted, because ignoring the retry would change how its requests are handled
+			validMatches = false
+			errors.invalid = append(errors.invalid, field.Forbidden(
+				rulePath.Child("retry"),
+				"Retry requires experimental features to be enabled",
+			))
+		}
+	}
+
 	backendRefs, backendRefErrors := getBackendRefs(specRule, routeNsName.Namespace, inferencePools, rulePath, sp)
 	errors = errors.append(backendRefErrors)
 
@@ -279,9 +310,120 @@ func processHTTPRouteRule(
 		Matches:          specRule.Matches,
 		Filters:          routeFilters,
 		RouteBackendRefs: backendRefs,
+		Timeouts:         timeouts,
+		Retry:            retry,
 	}, errors
 }
 
+// processRouteTimeouts validates the timeouts of an HTTPRoute rule and converts them to the NGINX duration format.
+// Invalid timeouts are ignored and reported as warnings, so they don't make the rule invalid.
+// A backendRequest timeout that is longer than the request timeout makes the rule invalid,
+// as required by the Gateway API spec.
+func processRouteTimeouts(
+	timeouts *v1.HTTPRouteTimeouts,
+	timeoutsPath *field.Path,
+	validator validation.HTTPFieldsValidator,
+) (*RouteTimeouts, routeRuleErrors) {
+	var (
+		errors routeRuleErrors
+		rt     RouteTimeouts
+	)
+
+	if timeouts.Request != nil {
+		request, err := convertRouteTimeout(*timeouts.Request, validator)
+		if err != nil {
+			errors.warn = append(errors.warn, field.Invalid(timeoutsPath.Child("request"), *timeouts.Request, err.Error()))
+		} else {
+			rt.Request = request
+		}
+	}
+
+	if timeouts.BackendRequest != nil {
+		backendRequest, err := convertRouteTimeout(*timeouts.BackendRequest, validator)
+		if err != nil {
+			errors.warn = append(errors.warn, field.Invalid(
+				timeoutsPath.Child("backendRequest"),
+				*timeouts.BackendRequest,
+				err.Error(),
+			))
+		} else {
+			rt.BackendRequest = backendRequest
+		}
+	}
+
+	if rt.Request != "" && rt.BackendRequest != "" {
+		request, requestErr := time.ParseDuration(string(*timeouts.Request))
+		backendRequest, backendErr := time.ParseDuration(string(*timeouts.BackendRequest))
+
+		if requestErr == nil && backendErr == nil && backendRequest > request {
+			errors.invalid = append(errors.invalid, field.Invalid(
+				timeoutsPath.Child("backendRequest"),
+				*timeouts.BackendRequest,
+				fmt.Sprintf("must be less than or equal to the request timeout %s", *timeouts.Request),
+			))
+
+			return nil, errors
+		}
+	}
+
+	if rt.Request == "" && rt.BackendRequest == "" {
+		return nil, errors
+	}
+
+	return &rt, errors
+}
+
+// convertRouteTimeout converts a Gateway API timeout to the NGINX duration format.
+// A zero duration disables the timeout according to the Gateway API spec, so it is converted to an empty string.
+func convertRouteTimeout(timeout v1.Duration, validator validation.HTTPFieldsValidator) (string, error) {
+	if d, err := time.ParseDuration(string(timeout)); err == nil && d == 0 {
+		return "", nil
+	}
+
+	return validator.ValidateDuration(string(timeout))
+}
+
+// supportedRetryCodes are the HTTP response status codes that NGINX can retry a request on.
+// See https://nginx.org/en/docs/http/ngx_http_proxy_module.html#proxy_next_upstream
+var supportedRetryCodes = []v1.HTTPRouteRetryStatusCode{403, 404, 429, 500, 502, 503, 504}
+
+// processRouteRetry validates the retry configuration of an HTTPRoute rule.
+// NGINX can only retry on a fixed set of status codes and does not support a backoff between retries,
+// so any other codes and the backoff are ignored and reported as warnings, which end up in the Accepted
+// condition of the route. Retries on connection errors and timeouts, which NGINX responds to with 502
+// and 504, are always enabled when the retry configuration is set.
+func processRouteRetry(retry *v1.HTTPRouteRetry, retryPath *field.Path) (*RouteRetry, routeRuleErrors) {
+	var errors routeRuleErrors
+
+	rr := &RouteRetry{
+		Attempts: retry.Attempts,
+	}
+
+	for i, code := range retry.Codes {
+		if !slices.Contains(supportedRetryCodes, code) {
+			valid := make([]string, 0, len(supportedRetryCodes))
+			for _, c := range supportedRetryCodes {
+				valid = append(valid, strconv.Itoa(int(c)))
+			}
+
+			errors.warn = append(errors.warn, field.NotSupported(retryPath.Child("codes").Index(i), code, valid))
+
+			continue
+		}
+
+		rr.Codes = append(rr.Codes, int(code))
+	}
+
+	if retry.Backoff != nil {
+		errors.warn = append(errors.warn, field.Forbidden(
+			retryPath.Child("backoff"),
+			"Backoff is not supported by NGINX, requests are retried without a delay",
+		))
+	}
+
+	return rr, errors
+}
+
 func getBackendRefs(
 	routeRule v1.HTTPRouteRule,
 	routeNamespace string,
@@ -678,19 +820,6 @@ func checkForUnsupportedHTTPFields(
 			"Name",
 		))
 	}
-	if rule.Timeouts != nil {
-		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("timeouts"),
-			"Timeouts",
-		))
-	}
-	if rule.Retry != nil {
-		ruleErrors = append(ruleErrors, field.Forbidden(
-			rulePath.Child("retry"),
-			"Retry",
-		))
-	}
-
 	if !featureFlags.Plus && rule.SessionPersistence != nil {
 		ruleErrors = append(ruleErrors, field.Forbidden(
 			rulePath.Child("sessionPersistence"),
@@ -792,3 +921,54 @@ func validateExternalAuthConflicts(routes map[RouteKey]*L7Route) {
 		}
 	}
 }
+
+// validateRouteTimeoutsConflicts checks all route rules for conflicts between rule timeouts and a
+// ProxySettingsPolicy that sets timeouts on the same route or on one of its parent Gateways. A policy on
+// the route would emit the proxy timeout directives in the same location, and a policy on the Gateway is
+// inherited by the location, so the rule timeouts are ignored in favor of the policy.
+func validateRouteTimeoutsConflicts(routes map[RouteKey]*L7Route, gateways map[types.NamespacedName]*Gateway) {
+	for _, route := range routes {
+		if route.RouteType != RouteTypeHTTP {
+			continue
+		}
+
+		psp := findProxySettingsPolicyWithTimeout(route.Policies)
+		for _, ref := range route.ParentRefs {
+			if psp != nil {
+				break
+			}
+
+			if gw, ok := gateways[ref.GatewayNsName]; ok {
+				psp = findProxySettingsPolicyWithTimeout(gw.Policies)
+			}
+		}
+		if psp == nil {
+			continue
+		}
+
+		for ruleIdx, rule := range route.Spec.Rules {
+			if rule.Timeouts == nil {
+				continue
+			}
+
+			route.Spec.Rules[ruleIdx].Timeouts = nil
+			timeoutsPath := field.NewPath("spec").Child("rules").Index(ruleIdx).Child("timeouts")
+			msg := fmt.Sprintf(
+				"%s: conflicts with ProxySettingsPolicy %s/%s timeout",
+				timeoutsPath, psp.Namespace, psp.Name,
+			)
+			mergeOrAppendRouteCondition(route, conditions.NewRouteAcceptedUnsupportedField(msg))
+		}
+	}
+}
+
+func findProxySettingsPolicyWithTimeout(policies []*Policy) *ngfAPI.ProxySettingsPolicy {
+	for _, pol := range policies {
+		p, ok := pol.Source.(*ngfAPI.ProxySettingsPolicy)
+		if pol.Valid && ok && p.Spec.Timeout != nil {
+			return p
+		}
+	}
+
+	return nil
+}
@@ -3103,16 +3103,13 @@ func TestUnsupportedFieldsErrors(t *testing.T) {
 		{
 			name: "Multiple unsupported fields",
 			specRule: gatewayv1.HTTPRouteRule{
-				Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
-				Timeouts: helpers.GetPointer(gatewayv1.HTTPRouteTimeouts{
-					Request: (*gatewayv1.Duration)(helpers.GetPointer("unsupported-timeouts")),
-				}),
+				Name:  helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
 				Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
 				SessionPersistence: helpers.GetPointer(gatewayv1.SessionPersistence{
 					Type: helpers.GetPointer(gatewayv1.SessionPersistenceType("unsupported-session-persistence")),
 				}),
 			},
-			expectedErrors: 5,
+			expectedErrors: 3,
 		},
 	}
 
@@ -3181,10 +3178,7 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			name: "Multiple unsupported fields",
 			specRules: []gatewayv1.HTTPRouteRule{
 				{
-					Name: helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
-					Timeouts: helpers.GetPointer(gatewayv1.HTTPRouteTimeouts{
-						Request: (*gatewayv1.Duration)(helpers.GetPointer("unsupported-timeouts")),
-					}),
+					Name:  helpers.GetPointer[gatewayv1.SectionName]("unsupported-name"),
 					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
 					SessionPersistence: helpers.GetPointer(gatewayv1.SessionPersistence{
 						Type:        helpers.GetPointer(gatewayv1.CookieBasedSessionPersistence),
@@ -3195,8 +3189,7 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			expectedValid: true,
 			expectedConds: []conditions.Condition{
 				conditions.NewRouteAcceptedUnsupportedField(
-					fmt.Sprintf("[spec.rules[0].name: Forbidden: Name, spec.rules[0].timeouts: "+
-						"Forbidden: Timeouts, spec.rules[0].retry: Forbidden: Retry, "+
+					fmt.Sprintf("[spec.rules[0].name: Forbidden: Name, "+
 						"spec.rules[0].sessionPersistence: Forbidden: "+
 						"%s OSS users can use `ip_hash` load balancing method via the UpstreamSettingsPolicy for session affinity.]",
 						spErrMsg,
@@ -3204,7 +3197,60 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 			},
 			experimental:  true,
 			plusEnabled:   false,
-			expectedWarns: 4,
+			expectedWarns: 2,
+		},
+		{
+			name: "Retry unsupported with experimental disabled",
+			specRules: []gatewayv1.HTTPRouteRule{
+				{
+					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
+				},
+			},
+			expectedValid: false,
+			expectedConds: []conditions.Condition{
+				conditions.NewRouteUnsupportedValue(
+					"All rules are invalid: spec.rules[0].retry: Forbidden: " +
+						"Retry requires experimental features to be enabled",
+				),
+			},
+		},
+		{
+			name: "Retry rejects only its rule with experimental disabled",
+			specRules: []gatewayv1.HTTPRouteRule{
+				{
+					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{Attempts: helpers.GetPointer(3)}),
+				},
+				{},
+			},
+			expectedValid: true,
+			expectedConds: []conditions.Condition{
+				conditions.NewRoutePartiallyInvalid(
+					"spec.rules[0].retry: Forbidden: Retry requires experimental features to be enabled",
+				),
+			},
+		},
+		{
+			name: "Retry partially supported with experimental enabled",
+			specRules: []gatewayv1.HTTPRouteRule{
+				{
+					Retry: helpers.GetPointer(gatewayv1.HTTPRouteRetry{
+						Codes:    []gatewayv1.HTTPRouteRetryStatusCode{502, 505},
+						Attempts: helpers.GetPointer(3),
+						Backoff:  helpers.GetPointer[gatewayv1.Duration]("100ms"),
+					}),
+				},
+			},
+			expectedValid: true,
+			expectedConds: []conditions.Condition{
+				conditions.NewRouteAcceptedUnsupportedField(
+					"[spec.rules[0].retry.codes[1]: Unsupported value: 505: supported values: " +
+						"\"403\", \"404\", \"429\", \"500\", \"502\", \"503\", \"504\", " +
+						"spec.rules[0].retry.backoff: Forbidden: Backoff is not supported by NGINX, " +
+						"requests are retried without a delay]",
+				),
+			},
+			expectedWarns: 2,
+			experimental:  true,
 		},
 		{
 			name: "Session persistence unsupported with experimental disabled",
@@ -3272,3 +3318,363 @@ func TestProcessHTTPRouteRules_UnsupportedFields(t *testing.T) {
 		})
 	}
 }
+
+func TestProcessRouteTimeouts(t *testing.T) {
+	t.Parallel()
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{}
+	validator.ValidateDurationCalls(func(d string) (string, error) {
+		if d == "invalid" {
+			return "", errors.New("invalid duration")
+		}
+		return d, nil
+	})
+
+	timeoutsPath := field.NewPath("spec").Child("rules").Index(0).Child("timeouts")
+
+	tests := []struct {
+		timeouts         *gatewayv1.HTTPRouteTimeouts
+		expTimeouts      *RouteTimeouts
+		name             string
+		expectedWarnings int
+		expectedInvalid  int
+	}{
+		{
+			name: "request and backendRequest",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("10s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("2s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request:        "10s",
+				BackendRequest: "2s",
+			},
+		},
+		{
+			name: "request only",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request: helpers.GetPointer[gatewayv1.Duration]("500ms"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request: "500ms",
+			},
+		},
+		{
+			name: "zero request timeout disables the timeout",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				BackendRequest: "1s",
+			},
+		},
+		{
+			name: "all timeouts disabled",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("0s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("0ms"),
+			},
+			expTimeouts: nil,
+		},
+		{
+			name: "invalid timeouts are ignored",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("invalid"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("invalid"),
+			},
+			expTimeouts:      nil,
+			expectedWarnings: 2,
+		},
+		{
+			name: "invalid request timeout with valid backendRequest",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("invalid"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("3s"),
+			},
+			expTimeouts: &RouteTimeouts{
+				BackendRequest: "3s",
+			},
+			expectedWarnings: 1,
+		},
+		{
+			name: "backendRequest equal to request",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("1s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1000ms"),
+			},
+			expTimeouts: &RouteTimeouts{
+				Request:        "1s",
+				BackendRequest: "1000ms",
+			},
+		},
+		{
+			name: "backendRequest longer than request",
+			timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("2s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("1m"),
+			},
+			expTimeouts:     nil,
+			expectedInvalid: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			timeouts, errs := processRouteTimeouts(test.timeouts, timeoutsPath, validator)
+			g.Expect(timeouts).To(Equal(test.expTimeouts))
+			g.Expect(errs.warn).To(HaveLen(test.expectedWarnings))
+			g.Expect(errs.invalid).To(HaveLen(test.expectedInvalid))
+		})
+	}
+}
+
+func TestProcessHTTPRouteRules_BackendRequestLongerThanRequest(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	specRules := []gatewayv1.HTTPRouteRule{
+		{
+			Timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("10s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("5s"),
+			},
+		},
+		{
+			Timeouts: &gatewayv1.HTTPRouteTimeouts{
+				Request:        helpers.GetPointer[gatewayv1.Duration]("5s"),
+				BackendRequest: helpers.GetPointer[gatewayv1.Duration]("10s"),
+			},
+		},
+	}
+
+	validator := &validationfakes.FakeHTTPFieldsValidator{}
+	validator.ValidateDurationCalls(func(d string) (string, error) {
+		return d, nil
+	})
+
+	rules, valid, conds := processHTTPRouteRules(
+		specRules,
+		validator,
+		nil,
+		nil,
+		types.NamespacedName{Namespace: "test", Name: "route"},
+		FeatureFlags{},
+	)
+
+	g.Expect(valid).To(BeTrue())
+	g.Expect(rules[0].ValidMatches).To(BeTrue())
+	g.Expect(rules[0].Timeouts).To(Equal(&RouteTimeouts{Request: "10s", BackendRequest: "5s"}))
+	g.Expect(rules[1].ValidMatches).To(BeFalse())
+	g.Expect(rules[1].Timeouts).To(BeNil())
+	g.Expect(conds).To(Equal([]conditions.Condition{
+		conditions.NewRoutePartiallyInvalid(
+			`spec.rules[1].timeouts.backendRequest: Invalid value: "10s": ` +
+				"must be less than or equal to the request timeout 5s",
+		),
+	}))
+}
+
+func TestValidateRouteTimeoutsConflicts(t *testing.T) {
+	t.Parallel()
+
+	timeout := ngfAPI.Duration("10s")
+
+	gwNsName := types.NamespacedName{Namespace: "default", Name: "gateway"}
+
+	createRoute := func(routeType RouteType, policies []*Policy) *L7Route {
+		return &L7Route{
+			RouteType: routeType,
+			ParentRefs: []ParentRef{
+				{
+					NamespacedName: gwNsName,
+					GatewayNsName:  gwNsName,
+				},
+			},
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{
+					{
+						Timeouts: &RouteTimeouts{Request: "5s"},
+					},
+					{},
+				},
+			},
+			Policies: policies,
+		}
+	}
+
+	pspWithTimeout := &Policy{
+		Source: &ngfAPI.ProxySettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "psp1",
+			},
+			Spec: ngfAPI.ProxySettingsPolicySpec{
+				Timeout: &ngfAPI.ProxyTimeout{
+					Read: &timeout,
+				},
+			},
+		},
+		Valid: true,
+	}
+
+	pspWithoutTimeout := &Policy{
+		Source: &ngfAPI.ProxySettingsPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "psp2",
+			},
+			Spec: ngfAPI.ProxySettingsPolicySpec{},
+		},
+		Valid: true,
+	}
+
+	invalidPSPWithTimeout := &Policy{
+		Source: pspWithTimeout.Source,
+		Valid:  false,
+	}
+
+	tests := []struct {
+		route                *L7Route
+		name                 string
+		gatewayPolicies      []*Policy
+		expectConditionCount int
+		expectTimeouts       bool
+	}{
+		{
+			name:           "no policies",
+			route:          createRoute(RouteTypeHTTP, nil),
+			expectTimeouts: true,
+		},
+		{
+			name:           "ProxySettingsPolicy without timeout",
+			route:          createRoute(RouteTypeHTTP, []*Policy{pspWithoutTimeout}),
+			expectTimeouts: true,
+		},
+		{
+			name:           "invalid ProxySettingsPolicy with timeout",
+			route:          createRoute(RouteTypeHTTP, []*Policy{invalidPSPWithTimeout}),
+			expectTimeouts: true,
+		},
+		{
+			name:           "GRPCRoute is ignored",
+			route:          createRoute(RouteTypeGRPC, []*Policy{pspWithTimeout}),
+			expectTimeouts: true,
+		},
+		{
+			name:                 "conflict with ProxySettingsPolicy timeout",
+			route:                createRoute(RouteTypeHTTP, []*Policy{pspWithoutTimeout, pspWithTimeout}),
+			expectTimeouts:       false,
+			expectConditionCount: 1,
+		},
+		{
+			name:                 "conflict with ProxySettingsPolicy timeout inherited from the Gateway",
+			route:                createRoute(RouteTypeHTTP, nil),
+			gatewayPolicies:      []*Policy{pspWithoutTimeout, pspWithTimeout},
+			expectTimeouts:       false,
+			expectConditionCount: 1,
+		},
+		{
+			name:            "Gateway ProxySettingsPolicy without timeout",
+			route:           createRoute(RouteTypeHTTP, nil),
+			gatewayPolicies: []*Policy{pspWithoutTimeout, invalidPSPWithTimeout},
+			expectTimeouts:  true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			routes := map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "default", Name: "route1"}}: test.route,
+			}
+
+			gateways := map[types.NamespacedName]*Gateway{
+				gwNsName: {Policies: test.gatewayPolicies},
+			}
+
+			validateRouteTimeoutsConflicts(routes, gateways)
+
+			if test.expectTimeouts {
+				g.Expect(test.route.Spec.Rules[0].Timeouts).ToNot(BeNil())
+			} else {
+				g.Expect(test.route.Spec.Rules[0].Timeouts).To(BeNil())
+			}
+			g.Expect(test.route.Spec.Rules[1].Timeouts).To(BeNil())
+			g.Expect(test.route.Conditions).To(HaveLen(test.expectConditionCount))
+			if test.expectConditionCount > 0 {
+				g.Expect(test.route.Conditions[0].Message).To(ContainSubstring(
+					"spec.rules[0].timeouts: conflicts with ProxySettingsPolicy default/psp1 timeout",
+				))
+			}
+		})
+	}
+}
+
+func TestProcessRouteRetry(t *testing.T) {
+	t.Parallel()
+
+	retryPath := field.NewPath("spec").Child("rules").Index(0).Child("retry")
+
+	tests := []struct {
+		retry            *gatewayv1.HTTPRouteRetry
+		expRetry         *RouteRetry
+		name             string
+		expectedWarnings int
+	}{
+		{
+			name:     "empty retry",
+			retry:    &gatewayv1.HTTPRouteRetry{},
+			expRetry: &RouteRetry{},
+		},
+		{
+			name: "supported codes and attempts",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Codes:    []gatewayv1.HTTPRouteRetryStatusCode{500, 502, 503, 504, 429},
+				Attempts: helpers.GetPointer(2),
+			},
+			expRetry: &RouteRetry{
+				Codes:    []int{500, 502, 503, 504, 429},
+				Attempts: helpers.GetPointer(2),
+			},
+		},
+		{
+			name: "unsupported codes are ignored",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Codes: []gatewayv1.HTTPRouteRetryStatusCode{501, 503, 599},
+			},
+			expRetry: &RouteRetry{
+				Codes: []int{503},
+			},
+			expectedWarnings: 2,
+		},
+		{
+			name: "backoff is ignored",
+			retry: &gatewayv1.HTTPRouteRetry{
+				Attempts: helpers.GetPointer(1),
+				Backoff:  helpers.GetPointer[gatewayv1.Duration]("100ms"),
+			},
+			expRetry: &RouteRetry{
+				Attempts: helpers.GetPointer(1),
+			},
+			expectedWarnings: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			retry, errs := processRouteRetry(test.retry, retryPath)
+			g.Expect(retry).To(Equal(test.expRetry))
+			g.Expect(errs.warn).To(HaveLen(test.expectedWarnings))
+			g.Expect(errs.invalid).To(BeEmpty())
+		})
+	}
+}
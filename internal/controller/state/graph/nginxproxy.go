@@ -5,8 +5,12 @@ import (
 	"fmt"
 	"regexp"
 	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -25,6 +29,15 @@ var (
 	mimeTypePattern  = regexp.MustCompile(`^[A-Za-z0-9!#$%&'+.^_` + "`" + `|~-]+/[A-Za-z0-9!#$%&'+.^_` + "`" + `|~-]+$`)
 )
 
+var (
+	accessLogFileNamePattern   = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9._-]*$`)
+	accessLogSyslogTagPattern  = regexp.MustCompile(`^[A-Za-z0-9_]{1,32}$`)
+	accessLogStatusCodePattern = regexp.MustCompile(`^[1-5]([0-9]{2}|xx)$`)
+)
+
+// maxRolloutSoakPeriod is the maximum soak period of a staged configuration rollout.
+const maxRolloutSoakPeriod = 5 * time.Minute
+
 // NginxProxy represents the NginxProxy resource.
 type NginxProxy struct {
 	// Source is the source resource.
@@ -221,6 +234,28 @@ func WAFBundleFailOpenForNginxProxy(np *EffectiveNginxProxy) bool {
 	return np != nil && np.WAF != nil && np.WAF.BundleFailOpen != nil && *np.WAF.BundleFailOpen
 }
 
+// RolloutSoakPeriodForNginxProxy returns the soak period of the staged configuration rollout.
+// The soak period must already be validated.
+func RolloutSoakPeriodForNginxProxy(np *EffectiveNginxProxy) time.Duration {
+	if np == nil || np.Rollout == nil || np.Rollout.SoakPeriod == nil {
+		return 0
+	}
+
+	d, _ := parseNginxDuration(*np.Rollout.SoakPeriod)
+
+	return d
+}
+
+// parseNginxDuration parses a duration in the nginx format. A duration without a unit is in seconds.
+func parseNginxDuration(duration ngfAPIv1alpha1.Duration) (time.Duration, error) {
+	d := string(duration)
+	if d != "" && d[len(d)-1] >= '0' && d[len(d)-1] <= '9' {
+		d += "s"
+	}
+
+	return time.ParseDuration(d)
+}
+
 func processNginxProxies(
 	nps map[types.NamespacedName]*ngfAPIv1alpha2.NginxProxy,
 	validator validation.GenericValidator,
@@ -383,6 +418,8 @@ func validateNginxProxy(
 
 	allErrs = append(allErrs, validateCompression(validator, npCfg)...)
 
+	allErrs = append(allErrs, validateRollout(validator, npCfg)...)
+
 	return allErrs
 }
 
@@ -449,6 +486,124 @@ func validateLogging(
 				)
 			}
 		}
+
+		if logging.AccessLog != nil && len(logging.AccessLogs) > 0 {
+			allErrs = append(
+				allErrs,
+				field.Forbidden(loggingPath.Child("accessLogs"), "cannot be set together with accessLog"),
+			)
+		}
+
+		allErrs = append(
+			allErrs,
+			ValidateAccessLogEntries(validator, logging.AccessLogs, loggingPath.Child("accessLogs"))...,
+		)
+	}
+
+	return allErrs
+}
+
+// ValidateAccessLogEntries validates a list of access logs.
+func ValidateAccessLogEntries(
+	validator validation.GenericValidator,
+	entries []ngfAPIv1alpha1.NginxAccessLogEntry,
+	fieldPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+
+	for i, entry := range entries {
+		entryPath := fieldPath.Index(i)
+
+		if entry.Format != nil {
+			if err := validator.ValidateAccessLogFormatString(*entry.Format); err != nil {
+				allErrs = append(allErrs, field.Invalid(entryPath.Child("format"), *entry.Format, err.Error()))
+			}
+		}
+
+		allErrs = append(allErrs, validateAccessLogDestination(validator, entry.Destination, entryPath)...)
+
+		if entry.Condition != nil {
+			for j, code := range entry.Condition.StatusCodes {
+				if !accessLogStatusCodePattern.MatchString(code) {
+					allErrs = append(
+						allErrs,
+						field.Invalid(
+							entryPath.Child("condition", "statusCodes").Index(j),
+							code,
+							"must be a status code, such as 404, or a class of status codes, such as 5xx",
+						),
+					)
+				}
+			}
+		}
+	}
+
+	return allErrs
+}
+
+func validateAccessLogDestination(
+	validator validation.GenericValidator,
+	destination ngfAPIv1alpha1.NginxAccessLogDestination,
+	entryPath *field.Path,
+) field.ErrorList {
+	var allErrs field.ErrorList
+	destPath := entryPath.Child("destination")
+
+	switch destination.Type {
+	case ngfAPIv1alpha1.NginxAccessLogDestinationStdout:
+	case ngfAPIv1alpha1.NginxAccessLogDestinationFile:
+		if destination.File == nil {
+			return append(allErrs, field.Required(destPath.Child("file"), "required when type is File"))
+		}
+
+		if !accessLogFileNamePattern.MatchString(destination.File.Name) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					destPath.Child("file", "name"),
+					destination.File.Name,
+					"must start with an alphanumeric character and only contain alphanumeric characters, "+
+						"'.', '_', or '-'",
+				),
+			)
+		}
+	case ngfAPIv1alpha1.NginxAccessLogDestinationSyslog:
+		if destination.Syslog == nil {
+			return append(allErrs, field.Required(destPath.Child("syslog"), "required when type is Syslog"))
+		}
+
+		syslogPath := destPath.Child("syslog")
+		server := destination.Syslog.Server
+
+		if strings.Contains(server, "://") {
+			allErrs = append(allErrs, field.Invalid(syslogPath.Child("server"), server, "must not contain a scheme"))
+		} else if err := validator.ValidateEndpoint(server); err != nil {
+			allErrs = append(allErrs, field.Invalid(syslogPath.Child("server"), server, err.Error()))
+		}
+
+		if tag := destination.Syslog.Tag; tag != nil && !accessLogSyslogTagPattern.MatchString(*tag) {
+			allErrs = append(
+				allErrs,
+				field.Invalid(
+					syslogPath.Child("tag"),
+					*tag,
+					"must be 1 to 32 alphanumeric or underscore characters",
+				),
+			)
+		}
+	default:
+		allErrs = append(
+			allErrs,
+			field.NotSupported(
+				destPath.Child("type"),
+				destination.Type,
+				[]string{
+					string(ngfAPIv1alpha1.NginxAccessLogDestinationStdout),
+					string(ngfAPIv1alpha1.NginxAccessLogDestinationFile),
+					string(ngfAPIv1alpha1.NginxAccessLogDestinationSyslog),
+				},
+			),
+		)
 	}
 
 	return allErrs
@@ -738,3 +893,42 @@ func validateCompression(
 
 	return allErrs
 }
+
+func validateRollout(
+	validator validation.GenericValidator,
+	npCfg *ngfAPIv1alpha2.NginxProxy,
+) field.ErrorList {
+	rollout := npCfg.Spec.Rollout
+	if rollout == nil {
+		return nil
+	}
+
+	var allErrs field.ErrorList
+	rolloutPath := field.NewPath("spec").Child("rollout")
+
+	canaryPath := rolloutPath.Child("canary")
+	if rollout.Canary.Type == intstr.String {
+		percent, err := strconv.Atoi(strings.TrimSuffix(rollout.Canary.StrVal, "%"))
+		if err != nil || !strings.HasSuffix(rollout.Canary.StrVal, "%") || percent < 1 || percent > 100 {
+			allErrs = append(
+				allErrs,
+				field.Invalid(canaryPath, rollout.Canary.StrVal, "must be a percentage between 1% and 100%"),
+			)
+		}
+	} else if rollout.Canary.IntVal < 1 {
+		allErrs = append(allErrs, field.Invalid(canaryPath, rollout.Canary.IntVal, "must be at least 1"))
+	}
+
+	if rollout.SoakPeriod != nil {
+		soakPeriodPath := rolloutPath.Child("soakPeriod")
+		soakPeriod := *rollout.SoakPeriod
+
+		if err := validator.ValidateNginxDuration(string(soakPeriod)); err != nil {
+			allErrs = append(allErrs, field.Invalid(soakPeriodPath, soakPeriod, err.Error()))
+		} else if d, err := parseNginxDuration(soakPeriod); err != nil || d > maxRolloutSoakPeriod {
+			allErrs = append(allErrs, field.Invalid(soakPeriodPath, soakPeriod, "must be at most 5m"))
+		}
+	}
+
+	return allErrs
+}
@@ -3,10 +3,12 @@ package graph
 import (
 	"errors"
 	"testing"
+	"time"
 
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
@@ -543,6 +545,59 @@ func TestMetricsEnabledForNginxProxy(t *testing.T) {
 	}
 }
 
+func TestRolloutSoakPeriodForNginxProxy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		ep   *EffectiveNginxProxy
+		name string
+		exp  time.Duration
+	}{
+		{
+			name: "NginxProxy is nil",
+		},
+		{
+			name: "rollout is nil",
+			ep:   &EffectiveNginxProxy{},
+		},
+		{
+			name: "soak period is nil",
+			ep: &EffectiveNginxProxy{
+				Rollout: &ngfAPIv1alpha2.ConfigRollout{Canary: intstr.FromInt32(1)},
+			},
+		},
+		{
+			name: "soak period with unit",
+			ep: &EffectiveNginxProxy{
+				Rollout: &ngfAPIv1alpha2.ConfigRollout{
+					Canary:     intstr.FromInt32(1),
+					SoakPeriod: helpers.GetPointer[ngfAPIv1alpha1.Duration]("2m"),
+				},
+			},
+			exp: 2 * time.Minute,
+		},
+		{
+			name: "soak period without unit is in seconds",
+			ep: &EffectiveNginxProxy{
+				Rollout: &ngfAPIv1alpha2.ConfigRollout{
+					Canary:     intstr.FromInt32(1),
+					SoakPeriod: helpers.GetPointer[ngfAPIv1alpha1.Duration]("30"),
+				},
+			},
+			exp: 30 * time.Second,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(RolloutSoakPeriodForNginxProxy(test.ep)).To(Equal(test.exp))
+		})
+	}
+}
+
 // Add test cases for WAF merging in TestBuildEffectiveNginxProxy.
 func TestBuildEffectiveNginxProxy_WAF(t *testing.T) {
 	t.Parallel()
@@ -1875,6 +1930,143 @@ func TestValidateLogging(t *testing.T) {
 			errorString:    "",
 			expectErrCount: 0,
 		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Format: helpers.GetPointer("$remote_addr $status"),
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationStdout,
+								},
+							},
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationFile,
+									File: &ngfAPIv1alpha1.NginxAccessLogFile{Name: "access.log"},
+								},
+								Condition: &ngfAPIv1alpha1.NginxAccessLogCondition{
+									StatusCodes: []string{"404", "5xx"},
+								},
+							},
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationSyslog,
+									Syslog: &ngfAPIv1alpha1.NginxAccessLogSyslog{
+										Server: "syslog.example.com:514",
+										Tag:    helpers.GetPointer("ngf"),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator:      createValidValidator(),
+			name:           "valid access logs",
+			errorString:    "",
+			expectErrCount: 0,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						AccessLog: &ngfAPIv1alpha2.NginxAccessLog{},
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationStdout,
+								},
+							},
+						},
+					},
+				},
+			},
+			validator:      createValidValidator(),
+			name:           "access log and access logs are both set",
+			errorString:    "spec.logging.accessLogs: Forbidden: cannot be set together with accessLog",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Format: helpers.GetPointer("bad format"),
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationSyslog,
+									Syslog: &ngfAPIv1alpha1.NginxAccessLogSyslog{
+										Server: "syslog.example.com:514",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator:      createInvalidValidator(),
+			name:           "invalid access logs format and syslog server",
+			expectErrCount: 2,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationFile,
+									File: &ngfAPIv1alpha1.NginxAccessLogFile{Name: "../access.log"},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator: createValidValidator(),
+			name:      "invalid access logs file name",
+			errorString: "spec.logging.accessLogs[0].destination.file.name: Invalid value: \"../access.log\": " +
+				"must start with an alphanumeric character and only contain alphanumeric characters, " +
+				"'.', '_', or '-'",
+			expectErrCount: 1,
+		},
+		{
+			np: &ngfAPIv1alpha2.NginxProxy{
+				Spec: ngfAPIv1alpha2.NginxProxySpec{
+					Logging: &ngfAPIv1alpha2.NginxLogging{
+						AccessLogs: []ngfAPIv1alpha1.NginxAccessLogEntry{
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationFile,
+								},
+							},
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: ngfAPIv1alpha1.NginxAccessLogDestinationSyslog,
+									Syslog: &ngfAPIv1alpha1.NginxAccessLogSyslog{
+										Server: "syslog.example.com",
+										Tag:    helpers.GetPointer("bad-tag"),
+									},
+								},
+							},
+							{
+								Destination: ngfAPIv1alpha1.NginxAccessLogDestination{
+									Type: "Kafka",
+								},
+								Condition: &ngfAPIv1alpha1.NginxAccessLogCondition{
+									StatusCodes: []string{"4x"},
+								},
+							},
+						},
+					},
+				},
+			},
+			validator:      createValidValidator(),
+			name:           "invalid access logs destinations and status codes",
+			expectErrCount: 4,
+		},
 	}
 
 	for _, test := range tests {
@@ -2219,3 +2411,105 @@ func TestValidateCompression(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRollout(t *testing.T) {
+	t.Parallel()
+
+	npWithRollout := func(rollout *ngfAPIv1alpha2.ConfigRollout) *ngfAPIv1alpha2.NginxProxy {
+		return &ngfAPIv1alpha2.NginxProxy{
+			Spec: ngfAPIv1alpha2.NginxProxySpec{
+				Rollout: rollout,
+			},
+		}
+	}
+
+	tests := []struct {
+		np              *ngfAPIv1alpha2.NginxProxy
+		validator       *validationfakes.FakeGenericValidator
+		name            string
+		expErrSubstring string
+		expectErrCount  int
+	}{
+		{
+			name:      "nil rollout is valid",
+			validator: createValidValidator(),
+			np:        &ngfAPIv1alpha2.NginxProxy{},
+		},
+		{
+			name:      "valid rollout with number of canaries",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary:         intstr.FromInt32(1),
+				SoakPeriod:     helpers.GetPointer[ngfAPIv1alpha1.Duration]("5m"),
+				RequireHealthy: helpers.GetPointer(true),
+			}),
+		},
+		{
+			name:      "valid rollout with percentage of canaries",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary: intstr.FromString("25%"),
+			}),
+		},
+		{
+			name:      "zero canaries",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary: intstr.FromInt32(0),
+			}),
+			expErrSubstring: "rollout.canary",
+			expectErrCount:  1,
+		},
+		{
+			name:      "canary is not a percentage",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary: intstr.FromString("25"),
+			}),
+			expErrSubstring: "rollout.canary",
+			expectErrCount:  1,
+		},
+		{
+			name:      "canary percentage out of range",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary: intstr.FromString("101%"),
+			}),
+			expErrSubstring: "rollout.canary",
+			expectErrCount:  1,
+		},
+		{
+			name:      "invalid soak period",
+			validator: createInvalidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary:     intstr.FromInt32(1),
+				SoakPeriod: helpers.GetPointer[ngfAPIv1alpha1.Duration]("1d"),
+			}),
+			expErrSubstring: "rollout.soakPeriod",
+			expectErrCount:  1,
+		},
+		{
+			name:      "soak period too long",
+			validator: createValidValidator(),
+			np: npWithRollout(&ngfAPIv1alpha2.ConfigRollout{
+				Canary:     intstr.FromInt32(1),
+				SoakPeriod: helpers.GetPointer[ngfAPIv1alpha1.Duration]("301"),
+			}),
+			expErrSubstring: "rollout.soakPeriod",
+			expectErrCount:  1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			allErrs := validateRollout(test.validator, test.np)
+			g.Expect(allErrs).To(HaveLen(test.expectErrCount))
+			if len(allErrs) > 0 {
+				g.Expect(allErrs.ToAggregate().Error()).To(ContainSubstring(test.expErrSubstring))
+			}
+		})
+	}
+}
@@ -111,6 +111,9 @@ var policyDeterminingCondition = map[string]func() conditions.Condition{
 	kinds.RateLimitPolicy:      conditions.NewRateLimitPolicyAffected,
 	kinds.WAFPolicy:            conditions.NewWAFPolicyAffected,
 	kinds.PayloadProcessor:     conditions.NewPayloadProcessorPolicyAffected,
+	kinds.CachePolicy:          conditions.NewCachePolicyAffected,
+	kinds.AccessControlPolicy:  conditions.NewAccessControlPolicyAffected,
+	kinds.AccessLogPolicy:      conditions.NewAccessLogPolicyAffected,
 }
 
 // PolicyBundleKey returns the WAFBundleKey for a WAFPolicy's main policy bundle.
@@ -213,6 +216,8 @@ const (
 	gatewayGroupKind = v1.GroupName + "/" + kinds.Gateway
 	hrGroupKind      = v1.GroupName + "/" + kinds.HTTPRoute
 	grpcGroupKind    = v1.GroupName + "/" + kinds.GRPCRoute
+	tcpGroupKind     = v1.GroupName + "/" + kinds.TCPRoute
+	tlsGroupKind     = v1.GroupName + "/" + kinds.TLSRoute
 	serviceGroupKind = "core" + "/" + kinds.Service
 	// plmDefaultAccessKeyID is the fixed S3 access key ID configured by the SeaweedFS operator.
 	plmDefaultAccessKeyID = "adminKey"
@@ -281,6 +286,13 @@ func (g *Graph) attachPolicies(validator validation.PolicyValidator, ctlrName st
 				}
 
 				attachPolicyToRoute(policy, route, validator, ctlrName, logger)
+			case kinds.TCPRoute, kinds.TLSRoute:
+				route, exists := g.L4Routes[l4RouteKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				attachPolicyToL4Route(policy, route, validator, ctlrName, logger)
 			case kinds.Service:
 				svc, exists := g.ReferencedServices[ref.Nsname]
 				if !exists {
@@ -500,6 +512,67 @@ func attachPolicyToRoute(
 	}
 }
 
+// attachPolicyToL4Route attaches a policy to a TCPRoute or TLSRoute. Only policies that support the stream context
+// (such as the connection limiting settings of RateLimitPolicy) are allowed to target L4 Routes by their CRDs.
+func attachPolicyToL4Route(
+	policy *Policy,
+	route *L4Route,
+	validator validation.PolicyValidator,
+	ctlrName string,
+	logger logr.Logger,
+) {
+	routeNsName := client.ObjectKeyFromObject(route.Source)
+	routeKind := v1.Kind(kinds.TCPRoute)
+	if route.RouteType == RouteTypeTLS {
+		routeKind = kinds.TLSRoute
+	}
+
+	ancestorRef := createParentReference(v1.GroupName, routeKind, routeNsName)
+
+	if ngfPolicyAncestorsFull(policy, ctlrName) {
+		policyName := getPolicyName(policy.Source)
+		policyKind := getPolicyKind(policy.Source)
+		routeName := getAncestorName(ancestorRef)
+
+		route.Conditions = addPolicyAncestorLimitCondition(route.Conditions, policyName, policyKind)
+		logAncestorLimitReached(logger, policyName, policyKind, routeName)
+
+		return
+	}
+
+	ancestor := PolicyAncestor{
+		Ancestor: ancestorRef,
+	}
+
+	if !route.Valid || !route.Attachable || len(route.ParentRefs) == 0 {
+		ancestor.Conditions = []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")}
+		policy.Ancestors = append(policy.Ancestors, ancestor)
+		return
+	}
+
+	for _, parentRef := range route.ParentRefs {
+		if parentRef.EffectiveNginxProxy == nil {
+			continue
+		}
+
+		globalSettings := &policies.GlobalSettings{
+			TelemetryEnabled: telemetryEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+			WAFEnabled:       WAFEnabledForNginxProxy(parentRef.EffectiveNginxProxy),
+		}
+
+		if conds := validator.ValidateGlobalSettings(policy.Source, globalSettings); len(conds) > 0 {
+			policy.InvalidForGateways[parentRef.GatewayNsName] = struct{}{}
+			ancestor.Conditions = append(ancestor.Conditions, conds...)
+		}
+	}
+
+	policy.Ancestors = append(policy.Ancestors, ancestor)
+
+	if len(policy.InvalidForGateways) < len(route.ParentRefs) {
+		route.Policies = append(route.Policies, policy)
+	}
+}
+
 // payloadProcessorResolverMissing reports whether the policy is a PayloadProcessor whose ExtProcess
 // backend is an ExternalName Service, but the given effective NginxProxy has no DNS resolver
 // configured. Such a configuration cannot re-resolve the external hostname per request, so the policy
@@ -688,6 +761,7 @@ func processPolicies(
 	pols map[PolicyKey]policies.Policy,
 	validator validation.PolicyValidator,
 	routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
 	services map[types.NamespacedName]*ReferencedService,
 	gws map[types.NamespacedName]*Gateway,
 	wafInput *WAFProcessingInput,
@@ -719,6 +793,10 @@ func processPolicies(
 				} else {
 					continue
 				}
+			case tcpGroupKind, tlsGroupKind:
+				if _, exists := l4Routes[l4RouteKeyForKind(ref.Kind, refNsName)]; !exists {
+					continue
+				}
 			case serviceGroupKind:
 				if _, exists := services[refNsName]; !exists {
 					continue
@@ -1004,6 +1082,7 @@ func refGroupKind(group v1.Group, kind v1.Kind) string {
 func addPolicyAffectedStatusToTargetRefs(
 	processedPolicies map[PolicyKey]*Policy,
 	routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
 	gws map[types.NamespacedName]*Gateway,
 ) {
 	for policyKey, policy := range processedPolicies {
@@ -1031,6 +1110,15 @@ func addPolicyAffectedStatusToTargetRefs(
 				// set the policy status on L7 routes.
 				policyKind := policyKey.GVK.Kind
 				addStatusToTargetRefs(policyKind, &l7route.Conditions)
+			case kinds.TCPRoute, kinds.TLSRoute:
+				l4route, exists := l4Routes[l4RouteKeyForKind(ref.Kind, ref.Nsname)]
+				if !exists {
+					continue
+				}
+
+				// set the policy status on L4 routes.
+				policyKind := policyKey.GVK.Kind
+				addStatusToTargetRefs(policyKind, &l4route.Conditions)
 			default:
 				continue
 			}
@@ -99,6 +99,12 @@ func TestAttachPolicies(t *testing.T) {
 		}
 	}
 
+	expectNoL4RoutePolicyAttachment := func(g *WithT, graph *Graph) {
+		for _, r := range graph.L4Routes {
+			g.Expect(r.Policies).To(BeNil())
+		}
+	}
+
 	expectNoSvcPolicyAttachment := func(g *WithT, graph *Graph) {
 		for _, r := range graph.ReferencedServices {
 			g.Expect(r.Policies).To(BeNil())
@@ -119,6 +125,12 @@ func TestAttachPolicies(t *testing.T) {
 		}
 	}
 
+	expectL4RoutePolicyAttachment := func(g *WithT, graph *Graph) {
+		for _, r := range graph.L4Routes {
+			g.Expect(r.Policies).To(HaveLen(1))
+		}
+	}
+
 	expectSvcPolicyAttachment := func(g *WithT, graph *Graph) {
 		for _, r := range graph.ReferencedServices {
 			g.Expect(r.Policies).To(HaveLen(1))
@@ -129,12 +141,14 @@ func TestAttachPolicies(t *testing.T) {
 		expectNoGatewayPolicyAttachment,
 		expectNoSvcPolicyAttachment,
 		expectNoRoutePolicyAttachment,
+		expectNoL4RoutePolicyAttachment,
 	}
 
 	expectAllAttachmentList := []func(g *WithT, graph *Graph){
 		expectGatewayPolicyAttachment,
 		expectSvcPolicyAttachment,
 		expectRoutePolicyAttachment,
+		expectL4RoutePolicyAttachment,
 	}
 
 	getPolicies := func() map[PolicyKey]*Policy {
@@ -146,6 +160,48 @@ func TestAttachPolicies(t *testing.T) {
 			),
 			createTestPolicyKey(policyGVK, "grpc-route-policy1"): createPolicy([]string{"grpc-route"}, kinds.GRPCRoute),
 			createTestPolicyKey(policyGVK, "svc-policy"):         createPolicy([]string{"svc-1"}, kinds.Service),
+			createTestPolicyKey(policyGVK, "tcp-route-policy"):   createPolicy([]string{"tcp-route"}, kinds.TCPRoute),
+			createTestPolicyKey(policyGVK, "tls-route-policy"):   createPolicy([]string{"tls-route"}, kinds.TLSRoute),
+		}
+	}
+
+	getL4Routes := func() map[L4RouteKey]*L4Route {
+		return map[L4RouteKey]*L4Route{
+			{NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tcp-route"}, RouteType: RouteTypeTCP}: {
+				Source: &v1.TCPRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tcp-route",
+						Namespace: testNs,
+					},
+				},
+				ParentRefs: []ParentRef{
+					{
+						Attachment: &ParentRefAttachmentStatus{
+							Attached: true,
+						},
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+			},
+			{NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tls-route"}, RouteType: RouteTypeTLS}: {
+				Source: &v1.TLSRoute{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "tls-route",
+						Namespace: testNs,
+					},
+				},
+				RouteType: RouteTypeTLS,
+				ParentRefs: []ParentRef{
+					{
+						Attachment: &ParentRefAttachmentStatus{
+							Attached: true,
+						},
+					},
+				},
+				Valid:      true,
+				Attachable: true,
+			},
 		}
 	}
 
@@ -199,6 +255,7 @@ func TestAttachPolicies(t *testing.T) {
 	tests := []struct {
 		gateway     map[types.NamespacedName]*Gateway
 		routes      map[RouteKey]*L7Route
+		l4Routes    map[L4RouteKey]*L4Route
 		svcs        map[types.NamespacedName]*ReferencedService
 		ngfPolicies map[PolicyKey]*Policy
 		name        string
@@ -207,6 +264,7 @@ func TestAttachPolicies(t *testing.T) {
 		{
 			name:        "nil Gateway; no policies attach",
 			routes:      getRoutes(),
+			l4Routes:    getL4Routes(),
 			ngfPolicies: getPolicies(),
 			expects:     expectNoAttachmentList,
 		},
@@ -235,6 +293,7 @@ func TestAttachPolicies(t *testing.T) {
 		{
 			name:        "all policies attach",
 			routes:      getRoutes(),
+			l4Routes:    getL4Routes(),
 			svcs:        getServices(),
 			ngfPolicies: getPolicies(),
 			gateway:     getGateways(),
@@ -250,6 +309,7 @@ func TestAttachPolicies(t *testing.T) {
 			graph := &Graph{
 				Gateways:           test.gateway,
 				Routes:             test.routes,
+				L4Routes:           test.l4Routes,
 				ReferencedServices: test.svcs,
 				NGFPolicies:        test.ngfPolicies,
 			}
@@ -522,6 +582,190 @@ func TestAttachPolicyToRoute(t *testing.T) {
 	}
 }
 
+func TestAttachPolicyToL4Route(t *testing.T) {
+	t.Parallel()
+	routeNsName := types.NamespacedName{Namespace: testNs, Name: "tcp-route"}
+	gwNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
+
+	createTCPRoute := func(valid, attachable, parentRefs bool) *L4Route {
+		route := &L4Route{
+			Source: &v1.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      routeNsName.Name,
+					Namespace: routeNsName.Namespace,
+				},
+			},
+			Valid:      valid,
+			Attachable: attachable,
+			RouteType:  RouteTypeTCP,
+		}
+
+		if parentRefs {
+			route.ParentRefs = []ParentRef{
+				{
+					Kind:                kinds.Gateway,
+					GatewayNsName:       gwNsName,
+					EffectiveNginxProxy: &EffectiveNginxProxy{},
+					Attachment: &ParentRefAttachmentStatus{
+						Attached: true,
+					},
+				},
+			}
+		}
+
+		return route
+	}
+
+	createTLSRoute := func() *L4Route {
+		route := createTCPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/)
+		route.Source = &v1.TLSRoute{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      routeNsName.Name,
+				Namespace: routeNsName.Namespace,
+			},
+		}
+		route.RouteType = RouteTypeTLS
+
+		return route
+	}
+
+	expAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind](kinds.TCPRoute),
+		Namespace: (*v1.Namespace)(&routeNsName.Namespace),
+		Name:      v1.ObjectName(routeNsName.Name),
+	}
+
+	expTLSAncestor := v1.ParentReference{
+		Group:     helpers.GetPointer[v1.Group](v1.GroupName),
+		Kind:      helpers.GetPointer[v1.Kind](kinds.TLSRoute),
+		Namespace: (*v1.Namespace)(&routeNsName.Namespace),
+		Name:      v1.ObjectName(routeNsName.Name),
+	}
+
+	validatorError := &policiesfakes.FakeValidator{
+		ValidateGlobalSettingsStub: func(_ policies.Policy, _ *policies.GlobalSettings) []conditions.Condition {
+			return []conditions.Condition{
+				conditions.NewPolicyNotAcceptedNginxProxyNotSet(conditions.PolicyMessageTelemetryNotEnabled),
+			}
+		},
+	}
+
+	tests := []struct {
+		route        *L4Route
+		policy       *Policy
+		validator    policies.Validator
+		name         string
+		expAncestors []PolicyAncestor
+		expAttached  bool
+	}{
+		{
+			name:      "policy attaches to tcp route",
+			route:     createTCPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			policy: &Policy{
+				Source:             &policiesfakes.FakePolicy{},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: expAncestor},
+			},
+			expAttached: true,
+		},
+		{
+			name:      "policy attaches to tls route",
+			route:     createTLSRoute(),
+			validator: &policiesfakes.FakeValidator{},
+			policy: &Policy{
+				Source:             &policiesfakes.FakePolicy{},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: []PolicyAncestor{
+				{Ancestor: expTLSAncestor},
+			},
+			expAttached: true,
+		},
+		{
+			name:      "invalid for all gateways; policy does not attach",
+			route:     createTCPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: validatorError,
+			policy: &Policy{
+				Source:             &policiesfakes.FakePolicy{},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor: expAncestor,
+					Conditions: []conditions.Condition{
+						conditions.NewPolicyNotAcceptedNginxProxyNotSet(conditions.PolicyMessageTelemetryNotEnabled),
+					},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:      "invalid route; policy does not attach",
+			route:     createTCPRoute(false /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			policy: &Policy{
+				Source:             &policiesfakes.FakePolicy{},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   expAncestor,
+					Conditions: []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:      "route has no parent refs; policy does not attach",
+			route:     createTCPRoute(true /*valid*/, true /*attachable*/, false /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			policy: &Policy{
+				Source:             &policiesfakes.FakePolicy{},
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: []PolicyAncestor{
+				{
+					Ancestor:   expAncestor,
+					Conditions: []conditions.Condition{conditions.NewPolicyTargetNotFound("The TargetRef is invalid")},
+				},
+			},
+			expAttached: false,
+		},
+		{
+			name:      "max ancestors; policy does not attach",
+			route:     createTCPRoute(true /*valid*/, true /*attachable*/, true /*parentRefs*/),
+			validator: &policiesfakes.FakeValidator{},
+			policy: &Policy{
+				Source:             createTestPolicyWithAncestors(16),
+				InvalidForGateways: map[types.NamespacedName]struct{}{},
+			},
+			expAncestors: nil,
+			expAttached:  false,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			attachPolicyToL4Route(test.policy, test.route, test.validator, "nginx-gateway", logr.Discard())
+
+			if test.expAttached {
+				g.Expect(test.route.Policies).To(HaveLen(1))
+			} else {
+				g.Expect(test.route.Policies).To(BeEmpty())
+			}
+
+			g.Expect(test.policy.Ancestors).To(BeEquivalentTo(test.expAncestors))
+		})
+	}
+}
+
 func TestAttachPolicyToGateway(t *testing.T) {
 	t.Parallel()
 	gatewayNsName := types.NamespacedName{Namespace: testNs, Name: "gateway"}
@@ -1005,6 +1249,8 @@ func TestProcessPolicies(t *testing.T) {
 	gatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "gw")
 	gatewayRef2 := createTestRef(kinds.Gateway, v1.GroupName, "gw2")
 	svcRef := createTestRef(kinds.Service, "core", "svc")
+	tcpRef := createTestRef(kinds.TCPRoute, v1.GroupName, "tcp")
+	tlsRef := createTestRef(kinds.TLSRoute, v1.GroupName, "tls")
 
 	// These refs reference objects that do not belong to NGF.
 	// Policies that contain these refs should NOT be processed.
@@ -1013,6 +1259,7 @@ func TestProcessPolicies(t *testing.T) {
 	gatewayWrongGroupRef := createTestRef(kinds.Gateway, "WrongGroup", "gw")
 	nonNGFGatewayRef := createTestRef(kinds.Gateway, v1.GroupName, "not-ours")
 	svcDoesNotExistRef := createTestRef(kinds.Service, "core", "dne")
+	tcpDoesNotExistRef := createTestRef(kinds.TCPRoute, v1.GroupName, "dne")
 
 	pol1, pol1Key := createTestPolicyAndKey(policyGVK, "pol1", hrRef)
 	pol2, pol2Key := createTestPolicyAndKey(policyGVK, "pol2", grpcRef)
@@ -1024,6 +1271,9 @@ func TestProcessPolicies(t *testing.T) {
 	pol8, pol8Key := createTestPolicyAndKey(policyGVK, "pol8", nonNGFGatewayRef)
 	pol9, pol9Key := createTestPolicyAndKey(policyGVK, "pol9", svcDoesNotExistRef)
 	pol10, pol10Key := createTestPolicyAndKey(policyGVK, "pol10", svcRef)
+	pol11, pol11Key := createTestPolicyAndKey(policyGVK, "pol11", tcpRef)
+	pol12, pol12Key := createTestPolicyAndKey(policyGVK, "pol12", tcpDoesNotExistRef)
+	pol13, pol13Key := createTestPolicyAndKey(policyGVK, "pol13", tlsRef)
 
 	pol1Conflict, pol1ConflictKey := createTestPolicyAndKey(policyGVK, "pol1-conflict", hrRef)
 
@@ -1053,6 +1303,9 @@ func TestProcessPolicies(t *testing.T) {
 				pol8Key:  pol8,
 				pol9Key:  pol9,
 				pol10Key: pol10,
+				pol11Key: pol11,
+				pol12Key: pol12,
+				pol13Key: pol13,
 			},
 			expProcessedPolicies: map[PolicyKey]*Policy{
 				pol1Key: {
@@ -1120,6 +1373,32 @@ func TestProcessPolicies(t *testing.T) {
 					InvalidForGateways: map[types.NamespacedName]struct{}{},
 					Valid:              true,
 				},
+				pol11Key: {
+					Source: pol11,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "tcp"},
+							Kind:   kinds.TCPRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors:          []PolicyAncestor{},
+					InvalidForGateways: map[types.NamespacedName]struct{}{},
+					Valid:              true,
+				},
+				pol13Key: {
+					Source: pol13,
+					TargetRefs: []PolicyTargetRef{
+						{
+							Nsname: types.NamespacedName{Namespace: testNs, Name: "tls"},
+							Kind:   kinds.TLSRoute,
+							Group:  v1.GroupName,
+						},
+					},
+					Ancestors:          []PolicyAncestor{},
+					InvalidForGateways: map[types.NamespacedName]struct{}{},
+					Valid:              true,
+				},
 			},
 		},
 		{
@@ -1254,6 +1533,25 @@ func TestProcessPolicies(t *testing.T) {
 		},
 	}
 
+	l4Routes := map[L4RouteKey]*L4Route{
+		{RouteType: RouteTypeTCP, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tcp"}}: {
+			Source: &v1.TCPRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tcp",
+					Namespace: testNs,
+				},
+			},
+		},
+		{RouteType: RouteTypeTLS, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tls"}}: {
+			Source: &v1.TLSRoute{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "tls",
+					Namespace: testNs,
+				},
+			},
+		},
+	}
+
 	services := map[types.NamespacedName]*ReferencedService{
 		{Namespace: testNs, Name: "svc"}: {},
 	}
@@ -1269,6 +1567,7 @@ func TestProcessPolicies(t *testing.T) {
 				test.policies,
 				test.validator,
 				routes,
+				l4Routes,
 				services,
 				gateways,
 				nil,
@@ -1604,6 +1903,7 @@ func TestProcessPolicies_RouteOverlap(t *testing.T) {
 				test.validator,
 				test.routes,
 				nil,
+				nil,
 				gateways,
 				nil,
 				nil,
@@ -2097,6 +2397,20 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 		types.NamespacedName{Namespace: testNs, Name: "gr2"},
 	)
 
+	rlpGVK := schema.GroupVersionKind{Group: "Group", Version: "Version", Kind: kinds.RateLimitPolicy}
+	tcp1Ref := createTestRef(kinds.TCPRoute, v1.GroupName, "tcp1")
+	tcp1TargetRef := createTestPolicyTargetRef(
+		kinds.TCPRoute,
+		types.NamespacedName{Namespace: testNs, Name: "tcp1"},
+	)
+
+	acpGVK := schema.GroupVersionKind{Group: "Group", Version: "Version", Kind: kinds.AccessControlPolicy}
+	tls1Ref := createTestRef(kinds.TLSRoute, v1.GroupName, "tls1")
+	tls1TargetRef := createTestPolicyTargetRef(
+		kinds.TLSRoute,
+		types.NamespacedName{Namespace: testNs, Name: "tls1"},
+	)
+
 	invalidRef := createTestRef(kinds.HTTPRoute, v1.GroupName, "invalid")
 	invalidTargetRef := createTestPolicyTargetRef(
 		"invalidKind",
@@ -2107,6 +2421,7 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 		policies           map[PolicyKey]*Policy
 		gws                map[types.NamespacedName]*Gateway
 		routes             map[RouteKey]*L7Route
+		l4Routes           map[L4RouteKey]*L4Route
 		expectedConditions map[types.NamespacedName][]conditions.Condition
 		name               string
 		missingKeys        bool
@@ -2373,6 +2688,54 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 			},
 			missingKeys: true,
 		},
+		{
+			name: "rate limit policy affected condition added to tcproute",
+			policies: map[PolicyKey]*Policy{
+				createTestPolicyKey(rlpGVK, "rateLimitPolicy1"): {
+					Source:     createTestPolicy(rlpGVK, "rateLimitPolicy1", tcp1Ref),
+					TargetRefs: []PolicyTargetRef{tcp1TargetRef},
+				},
+			},
+			l4Routes: map[L4RouteKey]*L4Route{
+				{RouteType: RouteTypeTCP, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tcp1"}}: {
+					Source: &v1.TCPRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "tcp1",
+							Namespace: testNs,
+						},
+					},
+				},
+			},
+			expectedConditions: map[types.NamespacedName][]conditions.Condition{
+				{Namespace: testNs, Name: "tcp1"}: {
+					conditions.NewRateLimitPolicyAffected(),
+				},
+			},
+		},
+		{
+			name: "access control policy affected condition added to tlsroute",
+			policies: map[PolicyKey]*Policy{
+				createTestPolicyKey(acpGVK, "accessControlPolicy1"): {
+					Source:     createTestPolicy(acpGVK, "accessControlPolicy1", tls1Ref),
+					TargetRefs: []PolicyTargetRef{tls1TargetRef},
+				},
+			},
+			l4Routes: map[L4RouteKey]*L4Route{
+				{RouteType: RouteTypeTLS, NamespacedName: types.NamespacedName{Namespace: testNs, Name: "tls1"}}: {
+					Source: &v1.TLSRoute{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "tls1",
+							Namespace: testNs,
+						},
+					},
+				},
+			},
+			expectedConditions: map[types.NamespacedName][]conditions.Condition{
+				{Namespace: testNs, Name: "tls1"}: {
+					conditions.NewAccessControlPolicyAffected(),
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -2380,7 +2743,7 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			addPolicyAffectedStatusToTargetRefs(test.policies, test.routes, test.gws)
+			addPolicyAffectedStatusToTargetRefs(test.policies, test.routes, test.l4Routes, test.gws)
 
 			for _, pols := range test.policies {
 				for _, targetRefs := range pols.TargetRefs {
@@ -2403,6 +2766,10 @@ func TestAddPolicyAffectedStatusOnTargetRefs(t *testing.T) {
 						} else {
 							g.Expect(test.expectedConditions[types.NamespacedName{Namespace: testNs, Name: "hr1"}]).To(BeEmpty())
 						}
+					case kinds.TCPRoute, kinds.TLSRoute:
+						route := test.l4Routes[l4RouteKeyForKind(targetRefs.Kind, targetRefs.Nsname)]
+						g.Expect(route).ToNot(BeNil())
+						g.Expect(route.Conditions).To(ContainElements(test.expectedConditions[targetRefs.Nsname]))
 					}
 				}
 			}
@@ -2698,7 +3065,7 @@ func TestNGFPolicyAncestorLimitHandling(t *testing.T) {
 
 	// Process policies which should trigger ancestor limit handling
 	processedPolicies, _ := processPolicies(
-		t.Context(), logr.Discard(), testPolicies, validator, routes, referencedServices, gateways, nil, nil,
+		t.Context(), logr.Discard(), testPolicies, validator, routes, nil, referencedServices, gateways, nil, nil,
 	)
 
 	// Create a graph and attach policies to trigger ancestor limit handling
@@ -73,6 +73,15 @@ func toInferencePool(nsname types.NamespacedName) toResource {
 	}
 }
 
+func toServiceImport(nsname types.NamespacedName) toResource {
+	return toResource{
+		group:     kinds.ServiceImportGVK.Group,
+		kind:      kinds.ServiceImport,
+		name:      nsname.Name,
+		namespace: nsname.Namespace,
+	}
+}
+
 func fromGateway(namespace string) fromResource {
 	return fromResource{
 		group:     v1.GroupName,
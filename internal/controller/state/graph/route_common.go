@@ -2,6 +2,7 @@ package graph
 
 import (
 	"fmt"
+	"reflect"
 	"sort"
 	"strings"
 
@@ -14,6 +15,8 @@ import (
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/ngfsort"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/nginx/config/policies/upstreamsettings"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
@@ -109,6 +112,8 @@ type L4Route struct {
 	ParentRefs []ParentRef
 	// Conditions define the conditions to be reported in the status of the Route.
 	Conditions []conditions.Condition
+	// Policies holds the policies that are attached to the Route.
+	Policies []*Policy
 	// Spec is the L4RouteSpec of the Route
 	Spec L4RouteSpec
 	// Valid indicates if the Route is valid.
@@ -176,6 +181,10 @@ type RouteRule struct {
 	RouteBackendRefs []RouteBackendRef
 	// BackendRefs is an internal representation of a backendRef in a Route.
 	BackendRefs []BackendRef
+	// Timeouts holds the timeouts for the rule. Only applicable to HTTPRoutes.
+	Timeouts *RouteTimeouts
+	// Retry holds the retry configuration for the rule. Only applicable to HTTPRoutes.
+	Retry *RouteRetry
 	// Filters define processing steps that must be completed during the request or response lifecycle.
 	Filters RouteRuleFilters
 	// ValidMatches indicates if the matches are valid and accepted by the Route.
@@ -222,6 +231,25 @@ type SessionPersistenceConfig struct {
 	Valid bool
 }
 
+// RouteTimeouts holds the timeouts of a route rule, converted to the NGINX duration format.
+// An empty value means that the corresponding timeout is not set or is disabled.
+type RouteTimeouts struct {
+	// Request is the timeout for the Gateway to respond to an HTTP request.
+	Request string
+	// BackendRequest is the timeout for a single request from the Gateway to a backend.
+	BackendRequest string
+}
+
+// RouteRetry holds the retry configuration of a route rule.
+type RouteRetry struct {
+	// Attempts is the maximum number of times a request to a backend is retried.
+	// If nil, the number of retries is not limited by the rule.
+	Attempts *int
+	// Codes are the HTTP response status codes on which a request is retried.
+	// Only codes supported by NGINX are included.
+	Codes []int
+}
+
 // CreateRouteKey takes a client.Object and creates a RouteKey.
 func CreateRouteKey(obj client.Object) RouteKey {
 	nsName := types.NamespacedName{
@@ -300,6 +328,7 @@ func buildL4RoutesForGateways(
 	tcpRoutes map[types.NamespacedName]*v1.TCPRoute,
 	udpRoutes map[types.NamespacedName]*v1.UDPRoute,
 	services map[types.NamespacedName]*apiv1.Service,
+	serviceImports map[types.NamespacedName]*apiv1.Service,
 	backendTLSPolicies map[types.NamespacedName]*BackendTLSPolicy,
 	gws map[types.NamespacedName]*Gateway,
 	resolver *referenceGrantResolver,
@@ -330,6 +359,7 @@ func buildL4RoutesForGateways(
 			route,
 			gws,
 			services,
+			serviceImports,
 			resolver.refAllowedFrom(fromTCPRoute(route.Namespace)),
 			listenerSets,
 		)
@@ -1456,6 +1486,20 @@ func routeKeyForKind(kind v1.Kind, nsname types.NamespacedName) RouteKey {
 	return key
 }
 
+func l4RouteKeyForKind(kind v1.Kind, nsname types.NamespacedName) L4RouteKey {
+	key := L4RouteKey{NamespacedName: nsname}
+	switch kind {
+	case kinds.TCPRoute:
+		key.RouteType = RouteTypeTCP
+	case kinds.TLSRoute:
+		key.RouteType = RouteTypeTLS
+	default:
+		panic(fmt.Sprintf("unsupported route kind: %s", kind))
+	}
+
+	return key
+}
+
 func getSessionPersistenceKey(ruleIdx int, routeNsName types.NamespacedName) string {
 	return fmt.Sprintf("%s_%s_%d", routeNsName.Name, routeNsName.Namespace, ruleIdx)
 }
@@ -1611,10 +1655,13 @@ func getCookiePath(match v1.HTTPRouteMatch) string {
 type l4RouteConfig struct {
 	source           client.Object
 	refGrantResolver func(resource toResource) bool
-	namespace        string
-	routeType        RouteType
-	parentRefs       []v1.ParentReference
-	rules            []l4RouteRule
+	// serviceImports holds the ServiceImports that backendRefs can reference.
+	// It is nil if the route type doesn't support ServiceImport backendRefs.
+	serviceImports map[types.NamespacedName]*apiv1.Service
+	namespace      string
+	routeType      RouteType
+	parentRefs     []v1.ParentReference
+	rules          []l4RouteRule
 }
 
 // l4RouteRule represents a rule in TCPRoute or UDPRoute.
@@ -1681,7 +1728,7 @@ func buildGenericL4Route(
 		if len(rule.backendRefs) > 0 {
 			for refIdx, ref := range rule.backendRefs {
 				br, conds := validateBackendRefL4RouteMulti(
-					config.namespace, ref, services,
+					config.namespace, ref, services, config.serviceImports,
 					config.refGrantResolver, ruleIdx, refIdx,
 				)
 				allBackendRefs = append(allBackendRefs, br)
@@ -1707,6 +1754,7 @@ func validateBackendRefL4RouteMulti(
 	namespace string,
 	ref v1.BackendRef,
 	services map[types.NamespacedName]*apiv1.Service,
+	serviceImports map[types.NamespacedName]*apiv1.Service,
 	refGrantResolver func(resource toResource) bool,
 	ruleIdx int,
 	refIdx int,
@@ -1718,6 +1766,7 @@ func validateBackendRefL4RouteMulti(
 		namespace,
 		refGrantResolver,
 		refPath,
+		serviceImports != nil,
 	); !valid {
 		backendRef := BackendRef{
 			Valid:              false,
@@ -1737,10 +1786,16 @@ func validateBackendRefL4RouteMulti(
 		Name:      string(ref.Name),
 	}
 
+	isServiceImport := isServiceImportBackendRef(ref)
+	backendServices := services
+	if isServiceImport {
+		backendServices = serviceImports
+	}
+
 	svcPort, err := getPortFromRef(
 		ref,
 		svcNsName,
-		services,
+		backendServices,
 		refPath,
 	)
 
@@ -1759,6 +1814,7 @@ func validateBackendRefL4RouteMulti(
 		ServicePort:        svcPort,
 		Weight:             weight,
 		Valid:              true,
+		IsServiceImport:    isServiceImport,
 		InvalidForGateways: make(map[types.NamespacedName]conditions.Condition),
 	}
 
@@ -1773,3 +1829,57 @@ func validateBackendRefL4RouteMulti(
 
 	return backendRef, nil
 }
+
+// validateStreamSettingsConflicts checks that the backends of each TCPRoute and UDPRoute have the same
+// UpstreamSettingsPolicy stream settings. The stream settings are applied to the server of the Route, so they are
+// ignored if its backends have different settings.
+func validateStreamSettingsConflicts(
+	routes map[L4RouteKey]*L4Route,
+	services map[types.NamespacedName]*ReferencedService,
+) {
+	for _, route := range routes {
+		if route.RouteType != RouteTypeTCP && route.RouteType != RouteTypeUDP {
+			continue
+		}
+
+		var settings *upstreamsettings.StreamSettings
+		for _, br := range route.Spec.GetBackendRefs() {
+			if !br.Valid {
+				continue
+			}
+
+			brSettings := backendStreamSettings(br, services)
+			if settings == nil {
+				settings = &brSettings
+				continue
+			}
+
+			if !reflect.DeepEqual(*settings, brSettings) {
+				msg := "UpstreamSettingsPolicy stream settings, because the backendRefs have different stream settings"
+				route.Conditions = append(route.Conditions, conditions.NewRouteAcceptedUnsupportedField(msg))
+				break
+			}
+		}
+	}
+}
+
+// backendStreamSettings returns the stream settings of the valid UpstreamSettingsPolicies of the Service of a
+// backend.
+func backendStreamSettings(
+	br BackendRef,
+	services map[types.NamespacedName]*ReferencedService,
+) upstreamsettings.StreamSettings {
+	svc, exists := services[br.SvcNsName]
+	if !exists || br.IsServiceImport {
+		return upstreamsettings.StreamSettings{}
+	}
+
+	pols := make([]policies.Policy, 0, len(svc.Policies))
+	for _, pol := range svc.Policies {
+		if pol.Valid {
+			pols = append(pols, pol.Source)
+		}
+	}
+
+	return upstreamsettings.NewProcessor().Process(pols).Stream
+}
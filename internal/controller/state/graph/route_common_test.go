@@ -13,6 +13,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 
+	ngfAPIv1alpha1 "github.com/nginx/nginx-gateway-fabric/v2/apis/v1alpha1"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/conditions"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/validation/validationfakes"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -3521,6 +3522,7 @@ func TestBuildL4RoutesForGatewaysNoGateways(t *testing.T) {
 		nil, // tcpRoutes
 		nil, // udpRoutes
 		services,
+		nil, // serviceImports
 		nil, // backendTLSPolicies
 		nil, // gateways
 		refGrantResolver,
@@ -3614,6 +3616,7 @@ func TestBuildL4RoutesForGatewaysTCPAndUDP(t *testing.T) {
 				tt.udpRoutes,
 				services,
 				nil,
+				nil,
 				gateways,
 				refGrantResolver,
 				nil,
@@ -6260,3 +6263,101 @@ func TestL4RouteSpec_GetBackendRefs(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStreamSettingsConflicts(t *testing.T) {
+	t.Parallel()
+
+	svc1 := types.NamespacedName{Namespace: "test", Name: "svc1"}
+	svc2 := types.NamespacedName{Namespace: "test", Name: "svc2"}
+	svc3 := types.NamespacedName{Namespace: "test", Name: "svc3"}
+
+	streamPolicy := func(name string, valid bool, timeout ngfAPIv1alpha1.Duration) *Policy {
+		return &Policy{
+			Source: &ngfAPIv1alpha1.UpstreamSettingsPolicy{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+				Spec: ngfAPIv1alpha1.UpstreamSettingsPolicySpec{
+					Stream: &ngfAPIv1alpha1.UpstreamStreamSettings{Timeout: &timeout},
+				},
+			},
+			Valid: valid,
+		}
+	}
+
+	services := map[types.NamespacedName]*ReferencedService{
+		svc1: {Policies: []*Policy{streamPolicy("usp1", true, "10s")}},
+		svc2: {Policies: []*Policy{streamPolicy("usp2", true, "10s")}},
+		svc3: {Policies: []*Policy{streamPolicy("usp3", true, "20s"), streamPolicy("invalid", false, "10s")}},
+	}
+
+	conflictCond := conditions.NewRouteAcceptedUnsupportedField(
+		"UpstreamSettingsPolicy stream settings, because the backendRefs have different stream settings",
+	)
+
+	tests := []struct {
+		name          string
+		routeType     RouteType
+		expConditions []conditions.Condition
+		backendRefs   []BackendRef
+	}{
+		{
+			name:      "same settings",
+			routeType: RouteTypeTCP,
+			backendRefs: []BackendRef{
+				{SvcNsName: svc1, Valid: true},
+				{SvcNsName: svc2, Valid: true},
+			},
+		},
+		{
+			name:      "different settings",
+			routeType: RouteTypeTCP,
+			backendRefs: []BackendRef{
+				{SvcNsName: svc1, Valid: true},
+				{SvcNsName: svc3, Valid: true},
+			},
+			expConditions: []conditions.Condition{conflictCond},
+		},
+		{
+			name:      "different settings for a UDP route",
+			routeType: RouteTypeUDP,
+			backendRefs: []BackendRef{
+				{SvcNsName: svc1, Valid: true},
+				{SvcNsName: types.NamespacedName{Namespace: "test", Name: "no-policy"}, Valid: true},
+			},
+			expConditions: []conditions.Condition{conflictCond},
+		},
+		{
+			name:      "invalid backend is ignored",
+			routeType: RouteTypeTCP,
+			backendRefs: []BackendRef{
+				{SvcNsName: svc1, Valid: true},
+				{SvcNsName: svc3, Valid: false},
+			},
+		},
+		{
+			name:      "TLS route is ignored",
+			routeType: RouteTypeTLS,
+			backendRefs: []BackendRef{
+				{SvcNsName: svc1, Valid: true},
+				{SvcNsName: svc3, Valid: true},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			route := &L4Route{
+				RouteType: test.routeType,
+				Spec:      L4RouteSpec{BackendRefs: test.backendRefs},
+			}
+			routes := map[L4RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "route"}}: route,
+			}
+
+			validateStreamSettingsConflicts(routes, services)
+			g.Expect(route.Conditions).To(Equal(test.expConditions))
+		})
+	}
+}
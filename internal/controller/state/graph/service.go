@@ -129,7 +129,8 @@ func addServiceFromL4Route(
 
 	for _, br := range backendRefs {
 		svcNsName := br.SvcNsName
-		if svcNsName == (types.NamespacedName{}) {
+		// ServiceImports are tracked separately in buildReferencedServiceImports.
+		if svcNsName == (types.NamespacedName{}) || br.IsServiceImport {
 			continue
 		}
 
@@ -147,7 +148,8 @@ func addServicesFromL7RouteRules(
 ) {
 	for _, rule := range routeRules {
 		for _, ref := range rule.BackendRefs {
-			if ref.SvcNsName == (types.NamespacedName{}) {
+			// ServiceImports are tracked separately in buildReferencedServiceImports.
+			if ref.SvcNsName == (types.NamespacedName{}) || ref.IsServiceImport {
 				continue
 			}
 
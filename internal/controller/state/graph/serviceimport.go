@@ -0,0 +1,114 @@
+package graph
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+// processServiceImports converts the Multi-Cluster Services ServiceImports into Services that hold the ports of
+// the ServiceImports, so that the ports of backendRefs to ServiceImports are resolved like the ports of
+// backendRefs to Services. ServiceImports with invalid ports are skipped, which results in a BackendNotFound
+// condition for the Routes that reference them.
+// The returned map is never nil, because a nil map means that the Route type doesn't support ServiceImports.
+func processServiceImports(
+	serviceImports map[types.NamespacedName]*unstructured.Unstructured,
+) map[types.NamespacedName]*v1.Service {
+	processed := make(map[types.NamespacedName]*v1.Service, len(serviceImports))
+
+	for nsname, si := range serviceImports {
+		ports, err := getServiceImportPorts(si)
+		if err != nil {
+			continue
+		}
+
+		processed[nsname] = &v1.Service{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      si.GetName(),
+				Namespace: si.GetNamespace(),
+			},
+			Spec: v1.ServiceSpec{
+				Ports: ports,
+			},
+		}
+	}
+
+	return processed
+}
+
+// getServiceImportPorts returns the ports of the spec of a ServiceImport, which have the same fields as the
+// ports of a Service.
+func getServiceImportPorts(si *unstructured.Unstructured) ([]v1.ServicePort, error) {
+	rawPorts, _, err := unstructured.NestedSlice(si.Object, "spec", "ports")
+	if err != nil {
+		return nil, err
+	}
+
+	ports := make([]v1.ServicePort, 0, len(rawPorts))
+	for _, rawPort := range rawPorts {
+		portMap, ok := rawPort.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		var port v1.ServicePort
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(portMap, &port); err != nil {
+			return nil, err
+		}
+
+		ports = append(ports, port)
+	}
+
+	return ports, nil
+}
+
+// isServiceImportBackendRef returns true if the backendRef references a ServiceImport.
+func isServiceImportBackendRef(ref gatewayv1.BackendRef) bool {
+	return ref.Kind != nil && *ref.Kind == kinds.ServiceImport
+}
+
+// buildReferencedServiceImports builds the set of ServiceImports that are referenced by the Routes,
+// including the ServiceImports that do not exist.
+func buildReferencedServiceImports(
+	l7routes map[RouteKey]*L7Route,
+	l4Routes map[L4RouteKey]*L4Route,
+) map[types.NamespacedName]struct{} {
+	referenced := make(map[types.NamespacedName]struct{})
+
+	addBackendRefs := func(refs []BackendRef) {
+		for _, ref := range refs {
+			if ref.IsServiceImport && ref.SvcNsName != (types.NamespacedName{}) {
+				referenced[ref.SvcNsName] = struct{}{}
+			}
+		}
+	}
+
+	for _, route := range l7routes {
+		if !route.Valid {
+			continue
+		}
+
+		for _, rule := range route.Spec.Rules {
+			addBackendRefs(rule.BackendRefs)
+		}
+	}
+
+	for _, route := range l4Routes {
+		if !route.Valid {
+			continue
+		}
+
+		addBackendRefs(route.Spec.GetBackendRefs())
+	}
+
+	if len(referenced) == 0 {
+		return nil
+	}
+
+	return referenced
+}
@@ -0,0 +1,185 @@
+package graph
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
+)
+
+func createServiceImport(name string, ports ...any) *unstructured.Unstructured {
+	si := kinds.NewServiceImportObject()
+	si.SetNamespace("test")
+	si.SetName(name)
+	si.Object["spec"] = map[string]any{
+		"type":  "ClusterSetIP",
+		"ports": ports,
+	}
+
+	return si
+}
+
+func TestProcessServiceImports(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	serviceImports := map[types.NamespacedName]*unstructured.Unstructured{
+		{Namespace: "test", Name: "valid"}: createServiceImport(
+			"valid",
+			map[string]any{"name": "http", "protocol": "TCP", "port": int64(80)},
+			map[string]any{"name": "grpc", "protocol": "TCP", "appProtocol": "kubernetes.io/h2c", "port": int64(81)},
+		),
+		{Namespace: "test", Name: "invalid-port"}: createServiceImport(
+			"invalid-port",
+			map[string]any{"name": "http", "port": "eighty"},
+		),
+		{Namespace: "test", Name: "no-ports"}: createServiceImport("no-ports"),
+	}
+
+	expected := map[types.NamespacedName]*v1.Service{
+		{Namespace: "test", Name: "valid"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "valid"},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{
+					{Name: "http", Protocol: v1.ProtocolTCP, Port: 80},
+					{
+						Name:        "grpc",
+						Protocol:    v1.ProtocolTCP,
+						AppProtocol: helpers.GetPointer("kubernetes.io/h2c"),
+						Port:        81,
+					},
+				},
+			},
+		},
+		{Namespace: "test", Name: "no-ports"}: {
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "no-ports"},
+			Spec: v1.ServiceSpec{
+				Ports: []v1.ServicePort{},
+			},
+		},
+	}
+
+	g.Expect(processServiceImports(serviceImports)).To(Equal(expected))
+	g.Expect(processServiceImports(nil)).ToNot(BeNil())
+}
+
+func TestIsServiceImportBackendRef(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		kind     *gatewayv1.Kind
+		name     string
+		expected bool
+	}{
+		{
+			name:     "ServiceImport",
+			kind:     helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport),
+			expected: true,
+		},
+		{
+			name: "Service",
+			kind: helpers.GetPointer[gatewayv1.Kind](kinds.Service),
+		},
+		{
+			name: "nil kind",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			ref := gatewayv1.BackendRef{
+				BackendObjectReference: gatewayv1.BackendObjectReference{Kind: test.kind},
+			}
+			g.Expect(isServiceImportBackendRef(ref)).To(Equal(test.expected))
+		})
+	}
+}
+
+func TestBuildReferencedServiceImports(t *testing.T) {
+	t.Parallel()
+
+	svcImportRef := BackendRef{
+		SvcNsName:       types.NamespacedName{Namespace: "test", Name: "imported"},
+		IsServiceImport: true,
+	}
+	svcRef := BackendRef{
+		SvcNsName: types.NamespacedName{Namespace: "test", Name: "service"},
+	}
+
+	l7Route := func(valid bool, refs ...BackendRef) *L7Route {
+		return &L7Route{
+			Valid: valid,
+			Spec: L7RouteSpec{
+				Rules: []RouteRule{{BackendRefs: refs}},
+			},
+		}
+	}
+
+	l4Route := func(refs ...BackendRef) *L4Route {
+		return &L4Route{
+			Valid: true,
+			Spec:  L4RouteSpec{BackendRefs: refs},
+		}
+	}
+
+	tests := []struct {
+		l7Routes map[RouteKey]*L7Route
+		l4Routes map[L4RouteKey]*L4Route
+		expected map[types.NamespacedName]struct{}
+		name     string
+	}{
+		{
+			name: "ServiceImports referenced by L7 and L4 routes",
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "http"}}: l7Route(
+					true,
+					svcImportRef,
+					svcRef,
+				),
+			},
+			l4Routes: map[L4RouteKey]*L4Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "tcp"}}: l4Route(
+					BackendRef{
+						SvcNsName:       types.NamespacedName{Namespace: "other", Name: "imported"},
+						IsServiceImport: true,
+					},
+				),
+			},
+			expected: map[types.NamespacedName]struct{}{
+				{Namespace: "test", Name: "imported"}:  {},
+				{Namespace: "other", Name: "imported"}: {},
+			},
+		},
+		{
+			name: "invalid routes are ignored",
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "http"}}: l7Route(false, svcImportRef),
+			},
+		},
+		{
+			name: "no ServiceImports",
+			l7Routes: map[RouteKey]*L7Route{
+				{NamespacedName: types.NamespacedName{Namespace: "test", Name: "http"}}: l7Route(true, svcRef),
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			g.Expect(buildReferencedServiceImports(test.l7Routes, test.l4Routes)).To(Equal(test.expected))
+		})
+	}
+}
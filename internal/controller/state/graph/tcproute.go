@@ -10,6 +10,7 @@ func buildTCPRoute(
 	tcpRoute *gatewayv1.TCPRoute,
 	gws map[types.NamespacedName]*Gateway,
 	services map[types.NamespacedName]*apiv1.Service,
+	serviceImports map[types.NamespacedName]*apiv1.Service,
 	refGrantResolver func(resource toResource) bool,
 	listenerSets map[types.NamespacedName]*ListenerSet,
 ) *L4Route {
@@ -29,6 +30,7 @@ func buildTCPRoute(
 		rules:            rules,
 		routeType:        RouteTypeTCP,
 		refGrantResolver: refGrantResolver,
+		serviceImports:   serviceImports,
 	}
 
 	return buildGenericL4Route(config, gws, services, listenerSets)
@@ -323,12 +323,33 @@ func TestBuildTCPRoute(t *testing.T) {
 		return mod(createSvc("svc1"))
 	}
 
+	serviceImportTCPR := createTCPRoute(
+		[]gatewayv1.TCPRouteRule{
+			{
+				BackendRefs: []gatewayv1.BackendRef{
+					{
+						BackendObjectReference: gatewayv1.BackendObjectReference{
+							Group: helpers.GetPointer[gatewayv1.Group](gatewayv1.Group(kinds.ServiceImportGVK.Group)),
+							Kind:  helpers.GetPointer[gatewayv1.Kind](kinds.ServiceImport),
+							Name:  "svc1",
+							Port:  helpers.GetPointer[gatewayv1.PortNumber](80),
+						},
+					},
+				},
+			},
+		},
+		[]gatewayv1.ParentReference{
+			gatewayParentRef,
+		},
+	)
+
 	tests := []struct {
-		gateways map[types.NamespacedName]*Gateway
-		services map[types.NamespacedName]*apiv1.Service
-		route    *gatewayv1.TCPRoute
-		expected *L4Route
-		name     string
+		gateways       map[types.NamespacedName]*Gateway
+		services       map[types.NamespacedName]*apiv1.Service
+		serviceImports map[types.NamespacedName]*apiv1.Service
+		route          *gatewayv1.TCPRoute
+		expected       *L4Route
+		name           string
 	}{
 		{
 			name:  "duplicate parent refs",
@@ -657,6 +678,74 @@ func TestBuildTCPRoute(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "valid TCP route with ServiceImport backend",
+			route: serviceImportTCPR,
+			gateways: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gateway"}: createGateway(),
+			},
+			services: map[types.NamespacedName]*apiv1.Service{
+				{Namespace: "test", Name: "svc1"}: createSvc("svc2"),
+			},
+			serviceImports: map[types.NamespacedName]*apiv1.Service{
+				{Namespace: "test", Name: "svc1"}: createSvc("svc1"),
+			},
+			expected: &L4Route{
+				Source:     serviceImportTCPR,
+				RouteType:  RouteTypeTCP,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{gatewayParentRefGraph},
+				Spec: L4RouteSpec{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName: types.NamespacedName{Namespace: "test", Name: "svc1"},
+							ServicePort: apiv1.ServicePort{
+								Port: 80,
+							},
+							Weight:             1,
+							Valid:              true,
+							IsServiceImport:    true,
+							InvalidForGateways: make(map[types.NamespacedName]conditions.Condition),
+						},
+					},
+				},
+			},
+		},
+		{
+			name:  "ServiceImport backend that does not exist",
+			route: serviceImportTCPR,
+			gateways: map[types.NamespacedName]*Gateway{
+				{Namespace: "test", Name: "gateway"}: createGateway(),
+			},
+			services: map[types.NamespacedName]*apiv1.Service{
+				{Namespace: "test", Name: "svc1"}: createSvc("svc1"),
+			},
+			serviceImports: map[types.NamespacedName]*apiv1.Service{},
+			expected: &L4Route{
+				Source:     serviceImportTCPR,
+				RouteType:  RouteTypeTCP,
+				Valid:      true,
+				Attachable: true,
+				ParentRefs: []ParentRef{gatewayParentRefGraph},
+				Conditions: []conditions.Condition{
+					conditions.NewRouteBackendRefRefBackendNotFound(
+						"spec.rules[0].backendRefs[0].name: Not found: \"svc1\"",
+					),
+				},
+				Spec: L4RouteSpec{
+					BackendRefs: []BackendRef{
+						{
+							SvcNsName:          types.NamespacedName{Namespace: "test", Name: "svc1"},
+							Weight:             1,
+							Valid:              false,
+							IsServiceImport:    true,
+							InvalidForGateways: make(map[types.NamespacedName]conditions.Condition),
+						},
+					},
+				},
+			},
+		},
 	}
 
 	refGrantResolver := func(_ toResource) bool {
@@ -668,7 +757,14 @@ func TestBuildTCPRoute(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			result := buildTCPRoute(test.route, test.gateways, test.services, refGrantResolver, listenerSets)
+			result := buildTCPRoute(
+				test.route,
+				test.gateways,
+				test.services,
+				test.serviceImports,
+				refGrantResolver,
+				listenerSets,
+			)
 			g.Expect(helpers.Diff(test.expected, result)).To(BeEmpty())
 		})
 	}
@@ -90,6 +90,7 @@ func validateBackendRefTLSRoute(
 		gtr.Namespace,
 		refGrantResolver,
 		refPath,
+		false, /* serviceImportAllowed */
 	); !valid {
 		backendRef := BackendRef{
 			Valid:              false,
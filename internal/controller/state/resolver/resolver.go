@@ -32,12 +32,25 @@ type ServiceResolver interface {
 		svcPort v1.ServicePort,
 		allowedAddressType []discoveryV1.AddressType,
 	) ([]Endpoint, error)
+	// ResolveServiceImport resolves a Multi-Cluster Services ServiceImport's NamespacedName and ServicePort
+	// to a list of Endpoints, using the EndpointSlices derived from the ServiceImport.
+	ResolveServiceImport(
+		ctx context.Context,
+		logger logr.Logger,
+		svcImportNsName types.NamespacedName,
+		svcPort v1.ServicePort,
+		allowedAddressType []discoveryV1.AddressType,
+	) ([]Endpoint, error)
 }
 
 // Endpoint is the internal representation of a Kubernetes endpoint.
 type Endpoint struct {
 	// Address is the IP address or DNS name of the endpoint.
 	Address string
+	// ZoneHints are the zones of the EndpointSlice hints of the endpoint, which are set by Kubernetes for
+	// topology-aware routing, for example for Services with the trafficDistribution PreferClose.
+	// Only set if all ready endpoints of the Service have hints, as Kubernetes requires.
+	ZoneHints []string
 	// Port is the port of the endpoint.
 	Port int32
 	// IPv6 is true if the endpoint is an IPv6 address.
@@ -46,6 +59,21 @@ type Endpoint struct {
 	Resolve bool
 	// Weight is the weight for load balancing, used for TCPRoute/UDPRoute multi-backend support.
 	Weight int32
+	// Backup is true if the endpoint only receives traffic when the other endpoints of the upstream are
+	// unavailable, used for topology-aware routing.
+	Backup bool
+	// Drain is true if the endpoint is terminating but still serving, while other endpoints of the Service are
+	// ready. NGINX Plus drains such endpoints, so that they only receive the requests of existing sessions,
+	// and NGINX OSS doesn't use them. If no endpoints are ready, the terminating endpoints that are still serving
+	// are used as a fallback, like kube-proxy does, and are not marked to drain.
+	Drain bool
+}
+
+// endpointKey identifies an Endpoint in a set of Endpoints.
+type endpointKey struct {
+	Address string
+	Port    int32
+	IPv6    bool
 }
 
 // ServiceResolverImpl implements ServiceResolver.
@@ -66,24 +94,66 @@ func (e *ServiceResolverImpl) Resolve(
 	svcNsName types.NamespacedName,
 	svcPort v1.ServicePort,
 	allowedAddressType []discoveryV1.AddressType,
+) ([]Endpoint, error) {
+	// We list EndpointSlices using the Service Name Index Field we added as an index to the EndpointSlice cache.
+	// This allows us to perform a quick lookup of all EndpointSlices for a Service.
+	return e.resolve(
+		ctx,
+		logger,
+		svcNsName,
+		svcPort,
+		allowedAddressType,
+		index.KubernetesServiceNameIndexField,
+		kinds.Service,
+	)
+}
+
+// ResolveServiceImport resolves a ServiceImport's NamespacedName and ServicePort to a list of Endpoints.
+// Returns an error if the ServiceImport or ServicePort cannot be resolved.
+func (e *ServiceResolverImpl) ResolveServiceImport(
+	ctx context.Context,
+	logger logr.Logger,
+	svcImportNsName types.NamespacedName,
+	svcPort v1.ServicePort,
+	allowedAddressType []discoveryV1.AddressType,
+) ([]Endpoint, error) {
+	// The EndpointSlices derived from a ServiceImport are labeled with the ServiceImport name by the
+	// Multi-Cluster Services implementation, and are indexed by that label.
+	return e.resolve(
+		ctx,
+		logger,
+		svcImportNsName,
+		svcPort,
+		allowedAddressType,
+		index.MultiClusterServiceNameIndexField,
+		kinds.ServiceImport,
+	)
+}
+
+func (e *ServiceResolverImpl) resolve(
+	ctx context.Context,
+	logger logr.Logger,
+	svcNsName types.NamespacedName,
+	svcPort v1.ServicePort,
+	allowedAddressType []discoveryV1.AddressType,
+	indexField string,
+	kind string,
 ) ([]Endpoint, error) {
 	if svcPort.Port == 0 || svcNsName.Name == "" || svcNsName.Namespace == "" {
 		panic(fmt.Errorf("expected the following fields to be non-empty: name: %s, ns: %s, port: %d",
 			svcNsName.Name, svcNsName.Namespace, svcPort.Port))
 	}
 
-	// We list EndpointSlices using the Service Name Index Field we added as an index to the EndpointSlice cache.
-	// This allows us to perform a quick lookup of all EndpointSlices for a Service.
 	var endpointSliceList discoveryV1.EndpointSliceList
 	err := e.reader.List(
 		ctx,
 		&endpointSliceList,
-		client.MatchingFields{index.KubernetesServiceNameIndexField: svcNsName.Name},
+		client.MatchingFields{indexField: svcNsName.Name},
 		client.InNamespace(svcNsName.Namespace),
 	)
 
 	if err != nil || len(endpointSliceList.Items) == 0 {
-		return nil, fmt.Errorf("no endpoints found for Service %s", svcNsName)
+		return nil, fmt.Errorf("no endpoints found for %s %s", kind, svcNsName)
 	}
 
 	return resolveEndpoints(
@@ -96,14 +166,14 @@ func (e *ServiceResolverImpl) Resolve(
 	)
 }
 
-type initEndpointSetFunc func(logr.Logger, []discoveryV1.EndpointSlice) map[Endpoint]struct{}
+type initEndpointSetFunc func(logr.Logger, []discoveryV1.EndpointSlice) map[endpointKey]Endpoint
 
 func initEndpointSetWithCalculatedSize(
 	logger logr.Logger,
 	endpointSlices []discoveryV1.EndpointSlice,
-) map[Endpoint]struct{} {
+) map[endpointKey]Endpoint {
 	// performance optimization to reduce the cost of growing the map. See the benchamarks for performance comparison.
-	return make(map[Endpoint]struct{}, calculateReadyEndpoints(logger, endpointSlices))
+	return make(map[endpointKey]Endpoint, calculateReadyEndpoints(logger, endpointSlices))
 }
 
 func calculateReadyEndpoints(logger logr.Logger, endpointSlices []discoveryV1.EndpointSlice) int {
@@ -111,7 +181,7 @@ func calculateReadyEndpoints(logger logr.Logger, endpointSlices []discoveryV1.En
 
 	for _, eps := range endpointSlices {
 		for _, endpoint := range eps.Endpoints {
-			if !endpointReady(endpoint) {
+			if !endpointReady(endpoint) && !endpointTerminatingAndServing(endpoint) {
 				logger.V(1).Info("ignoring endpoint that is not ready", "endpoint", endpoint)
 				continue
 			}
@@ -140,34 +210,77 @@ func resolveEndpoints(
 	// Endpoints may be duplicated across multiple EndpointSlices.
 	// Using a set to prevent returning duplicate endpoints.
 	endpointSet := initEndpointsSet(logger, filteredSlices)
+	allHinted := true
+	anyReady := false
 
 	for _, eps := range filteredSlices {
 		ipv6 := eps.AddressType == discoveryV1.AddressTypeIPv6
 		for _, endpoint := range eps.Endpoints {
-			if !endpointReady(endpoint) {
+			ready := endpointReady(endpoint)
+			if !ready && !endpointTerminatingAndServing(endpoint) {
 				logger.V(1).Info("ignoring endpoint that is not ready", "endpoint", endpoint)
 				continue
 			}
+			anyReady = anyReady || ready
 
 			// We don't check for a zero port value here because we are only working with EndpointSlices
 			// that have a matching port.
 			endpointPort := findPort(eps.Ports, svcPort)
+			zoneHints := getZoneHints(endpoint)
+			// Like kube-proxy, only the hints of the ready endpoints are checked, since the terminating endpoints
+			// normally have none. Otherwise, the hints would be ignored during every rolling update.
+			if ready {
+				allHinted = allHinted && len(zoneHints) > 0
+			}
 
 			for _, address := range endpoint.Addresses {
-				ep := Endpoint{Address: address, Port: endpointPort, IPv6: ipv6}
-				endpointSet[ep] = struct{}{}
+				key := endpointKey{Address: address, Port: endpointPort, IPv6: ipv6}
+				// a duplicate endpoint that is ready in another EndpointSlice is not drained
+				if existing, exists := endpointSet[key]; exists && !existing.Drain {
+					continue
+				}
+				endpointSet[key] = Endpoint{
+					Address:   address,
+					Port:      endpointPort,
+					IPv6:      ipv6,
+					ZoneHints: zoneHints,
+					Drain:     !ready,
+				}
 			}
 		}
 	}
 
 	endpoints := make([]Endpoint, 0, len(endpointSet))
-	for ep := range endpointSet {
+	for _, ep := range endpointSet {
+		// Like kube-proxy, the hints are only used if all endpoints have them, otherwise the endpoints of
+		// the zones without hints would not receive traffic.
+		if !allHinted {
+			ep.ZoneHints = nil
+		}
+		// The terminating endpoints are only drained if other endpoints can receive the traffic.
+		if !anyReady {
+			ep.Drain = false
+		}
 		endpoints = append(endpoints, ep)
 	}
 
 	return endpoints, nil
 }
 
+// getZoneHints returns the zones of the hints of an endpoint.
+func getZoneHints(endpoint discoveryV1.Endpoint) []string {
+	if endpoint.Hints == nil || len(endpoint.Hints.ForZones) == 0 {
+		return nil
+	}
+
+	zones := make([]string, 0, len(endpoint.Hints.ForZones))
+	for _, zone := range endpoint.Hints.ForZones {
+		zones = append(zones, zone.Name)
+	}
+
+	return zones
+}
+
 // getDefaultPort returns the default port for a ServicePort.
 // This default port is used when the EndpointPort has a nil port which indicates all ports are valid.
 // If the ServicePort has a non-zero integer TargetPort, the TargetPort integer value is returned.
@@ -202,6 +315,15 @@ func endpointReady(endpoint discoveryV1.Endpoint) bool {
 	return ready == nil || *ready
 }
 
+// endpointTerminatingAndServing returns true if the endpoint is terminating but can still serve traffic,
+// for example during the graceful shutdown of a Pod in a rolling update.
+func endpointTerminatingAndServing(endpoint discoveryV1.Endpoint) bool {
+	terminating := endpoint.Conditions.Terminating
+	serving := endpoint.Conditions.Serving
+
+	return terminating != nil && *terminating && serving != nil && *serving
+}
+
 func filterEndpointSliceList(
 	endpointSliceList discoveryV1.EndpointSliceList,
 	port v1.ServicePort,
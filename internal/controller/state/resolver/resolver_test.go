@@ -371,6 +371,63 @@ func TestEndpointReady(t *testing.T) {
 	}
 }
 
+func TestEndpointTerminatingAndServing(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		endpoint discoveryV1.Endpoint
+		msg      string
+		expected bool
+	}{
+		{
+			msg: "terminating and serving",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Ready:       helpers.GetPointer(false),
+					Serving:     helpers.GetPointer(true),
+					Terminating: helpers.GetPointer(true),
+				},
+			},
+			expected: true,
+		},
+		{
+			msg: "terminating and not serving",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Ready:       helpers.GetPointer(false),
+					Serving:     helpers.GetPointer(false),
+					Terminating: helpers.GetPointer(true),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "serving and not terminating",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{
+					Ready:       helpers.GetPointer(true),
+					Serving:     helpers.GetPointer(true),
+					Terminating: helpers.GetPointer(false),
+				},
+			},
+			expected: false,
+		},
+		{
+			msg: "nil conditions",
+			endpoint: discoveryV1.Endpoint{
+				Conditions: discoveryV1.EndpointConditions{},
+			},
+			expected: false,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+			g.Expect(endpointTerminatingAndServing(tc.endpoint)).To(Equal(tc.expected))
+		})
+	}
+}
+
 func TestFindPort(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
@@ -536,13 +593,27 @@ func TestCalculateReadyEndpoints(t *testing.T) {
 						Ready: helpers.GetPointer(true),
 					},
 				},
+				{
+					Addresses: []string{"3.0.0.1"},
+					Conditions: discoveryV1.EndpointConditions{
+						Ready:       helpers.GetPointer(false),
+						Serving:     helpers.GetPointer(true),
+						Terminating: helpers.GetPointer(true),
+					},
+				},
+				{
+					Addresses: []string{"4.0.0.1"},
+					Conditions: discoveryV1.EndpointConditions{
+						Ready: helpers.GetPointer(false),
+					},
+				},
 			},
 		},
 	}
 
 	result := calculateReadyEndpoints(logr.Discard(), slices)
 
-	g.Expect(result).To(Equal(7))
+	g.Expect(result).To(Equal(8))
 }
 
 func generateEndpointSliceList(n int) discoveryV1.EndpointSliceList {
@@ -603,8 +674,8 @@ func BenchmarkResolve(b *testing.B) {
 		Name:      "default-name",
 	}
 
-	initEndpointSet := func(logr.Logger, []discoveryV1.EndpointSlice) map[Endpoint]struct{} {
-		return make(map[Endpoint]struct{})
+	initEndpointSet := func(logr.Logger, []discoveryV1.EndpointSlice) map[endpointKey]Endpoint {
+		return make(map[endpointKey]Endpoint)
 	}
 
 	for _, count := range counts {
@@ -640,3 +711,208 @@ func bench(b *testing.B, svcNsName types.NamespacedName,
 		}
 	}
 }
+
+func TestResolveEndpointsZoneHints(t *testing.T) {
+	t.Parallel()
+
+	hintedEndpoint := func(address, zone string) discoveryV1.Endpoint {
+		return discoveryV1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+			Hints: &discoveryV1.EndpointHints{
+				ForZones: []discoveryV1.ForZone{{Name: zone}},
+			},
+		}
+	}
+
+	endpointSliceList := func(endpoints ...discoveryV1.Endpoint) discoveryV1.EndpointSliceList {
+		return discoveryV1.EndpointSliceList{
+			Items: []discoveryV1.EndpointSlice{
+				{
+					AddressType: discoveryV1.AddressTypeIPv4,
+					Endpoints:   endpoints,
+					Ports: []discoveryV1.EndpointPort{
+						{
+							Name: &svcPortName,
+							Port: helpers.GetPointer[int32](80),
+						},
+					},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		sliceList    discoveryV1.EndpointSliceList
+		expEndpoints []Endpoint
+	}{
+		{
+			name: "all endpoints have hints",
+			sliceList: endpointSliceList(
+				hintedEndpoint("10.0.0.1", "zone-a"),
+				hintedEndpoint("10.0.0.2", "zone-b"),
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80, ZoneHints: []string{"zone-a"}},
+				{Address: "10.0.0.2", Port: 80, ZoneHints: []string{"zone-b"}},
+			},
+		},
+		{
+			name: "hints are ignored if an endpoint has no hints",
+			sliceList: endpointSliceList(
+				hintedEndpoint("10.0.0.1", "zone-a"),
+				discoveryV1.Endpoint{
+					Addresses:  []string{"10.0.0.2"},
+					Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+				},
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80},
+			},
+		},
+		{
+			name: "terminating endpoints without hints are ignored",
+			sliceList: endpointSliceList(
+				hintedEndpoint("10.0.0.1", "zone-a"),
+				hintedEndpoint("10.0.0.2", "zone-b"),
+				discoveryV1.Endpoint{
+					Addresses: []string{"10.0.0.3"},
+					Conditions: discoveryV1.EndpointConditions{
+						Ready:       helpers.GetPointer(false),
+						Serving:     helpers.GetPointer(true),
+						Terminating: helpers.GetPointer(true),
+					},
+				},
+			),
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80, ZoneHints: []string{"zone-a"}},
+				{Address: "10.0.0.2", Port: 80, ZoneHints: []string{"zone-b"}},
+				{Address: "10.0.0.3", Port: 80, Drain: true},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			endpoints, err := resolveEndpoints(
+				logr.Discard(),
+				types.NamespacedName{Namespace: "test", Name: "svc"},
+				v1.ServicePort{Name: svcPortName, Port: 80},
+				tc.sliceList,
+				initEndpointSetWithCalculatedSize,
+				dualAddressType,
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(endpoints).To(ConsistOf(tc.expEndpoints))
+		})
+	}
+}
+
+func TestResolveEndpointsTerminating(t *testing.T) {
+	t.Parallel()
+
+	readyEndpoint := func(address string) discoveryV1.Endpoint {
+		return discoveryV1.Endpoint{
+			Addresses:  []string{address},
+			Conditions: discoveryV1.EndpointConditions{Ready: helpers.GetPointer(true)},
+		}
+	}
+
+	terminatingEndpoint := func(address string, serving bool) discoveryV1.Endpoint {
+		return discoveryV1.Endpoint{
+			Addresses: []string{address},
+			Conditions: discoveryV1.EndpointConditions{
+				Ready:       helpers.GetPointer(false),
+				Serving:     helpers.GetPointer(serving),
+				Terminating: helpers.GetPointer(true),
+			},
+		}
+	}
+
+	endpointSlice := func(endpoints ...discoveryV1.Endpoint) discoveryV1.EndpointSlice {
+		return discoveryV1.EndpointSlice{
+			AddressType: discoveryV1.AddressTypeIPv4,
+			Endpoints:   endpoints,
+			Ports: []discoveryV1.EndpointPort{
+				{
+					Name: &svcPortName,
+					Port: helpers.GetPointer[int32](80),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		sliceList    discoveryV1.EndpointSliceList
+		expEndpoints []Endpoint
+	}{
+		{
+			name: "terminating endpoints that are serving are drained",
+			sliceList: discoveryV1.EndpointSliceList{
+				Items: []discoveryV1.EndpointSlice{
+					endpointSlice(
+						readyEndpoint("10.0.0.1"),
+						terminatingEndpoint("10.0.0.2", true),
+						terminatingEndpoint("10.0.0.3", false),
+					),
+				},
+			},
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80, Drain: true},
+			},
+		},
+		{
+			name: "terminating endpoints that are serving are used if no endpoints are ready",
+			sliceList: discoveryV1.EndpointSliceList{
+				Items: []discoveryV1.EndpointSlice{
+					endpointSlice(
+						terminatingEndpoint("10.0.0.1", true),
+						terminatingEndpoint("10.0.0.2", true),
+						terminatingEndpoint("10.0.0.3", false),
+					),
+				},
+			},
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+				{Address: "10.0.0.2", Port: 80},
+			},
+		},
+		{
+			name: "duplicate endpoint that is ready in another slice is not drained",
+			sliceList: discoveryV1.EndpointSliceList{
+				Items: []discoveryV1.EndpointSlice{
+					endpointSlice(readyEndpoint("10.0.0.1")),
+					endpointSlice(terminatingEndpoint("10.0.0.1", true)),
+				},
+			},
+			expEndpoints: []Endpoint{
+				{Address: "10.0.0.1", Port: 80},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			endpoints, err := resolveEndpoints(
+				logr.Discard(),
+				types.NamespacedName{Namespace: "test", Name: "svc"},
+				v1.ServicePort{Name: svcPortName, Port: 80},
+				tc.sliceList,
+				initEndpointSetWithCalculatedSize,
+				dualAddressType,
+			)
+			g.Expect(err).ToNot(HaveOccurred())
+			g.Expect(endpoints).To(ConsistOf(tc.expEndpoints))
+		})
+	}
+}
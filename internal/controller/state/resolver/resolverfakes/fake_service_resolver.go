@@ -30,6 +30,23 @@ type FakeServiceResolver struct {
 		result1 []resolver.Endpoint
 		result2 error
 	}
+	ResolveServiceImportStub        func(context.Context, logr.Logger, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, error)
+	resolveServiceImportMutex       sync.RWMutex
+	resolveServiceImportArgsForCall []struct {
+		arg1 context.Context
+		arg2 logr.Logger
+		arg3 types.NamespacedName
+		arg4 v1.ServicePort
+		arg5 []v1a.AddressType
+	}
+	resolveServiceImportReturns struct {
+		result1 []resolver.Endpoint
+		result2 error
+	}
+	resolveServiceImportReturnsOnCall map[int]struct {
+		result1 []resolver.Endpoint
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -107,6 +124,79 @@ func (fake *FakeServiceResolver) ResolveReturnsOnCall(i int, result1 []resolver.
 	}{result1, result2}
 }
 
+func (fake *FakeServiceResolver) ResolveServiceImport(arg1 context.Context, arg2 logr.Logger, arg3 types.NamespacedName, arg4 v1.ServicePort, arg5 []v1a.AddressType) ([]resolver.Endpoint, error) {
+	var arg5Copy []v1a.AddressType
+	if arg5 != nil {
+		arg5Copy = make([]v1a.AddressType, len(arg5))
+		copy(arg5Copy, arg5)
+	}
+	fake.resolveServiceImportMutex.Lock()
+	ret, specificReturn := fake.resolveServiceImportReturnsOnCall[len(fake.resolveServiceImportArgsForCall)]
+	fake.resolveServiceImportArgsForCall = append(fake.resolveServiceImportArgsForCall, struct {
+		arg1 context.Context
+		arg2 logr.Logger
+		arg3 types.NamespacedName
+		arg4 v1.ServicePort
+		arg5 []v1a.AddressType
+	}{arg1, arg2, arg3, arg4, arg5Copy})
+	stub := fake.ResolveServiceImportStub
+	fakeReturns := fake.resolveServiceImportReturns
+	fake.recordInvocation("ResolveServiceImport", []interface{}{arg1, arg2, arg3, arg4, arg5Copy})
+	fake.resolveServiceImportMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3, arg4, arg5)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeServiceResolver) ResolveServiceImportCallCount() int {
+	fake.resolveServiceImportMutex.RLock()
+	defer fake.resolveServiceImportMutex.RUnlock()
+	return len(fake.resolveServiceImportArgsForCall)
+}
+
+func (fake *FakeServiceResolver) ResolveServiceImportCalls(stub func(context.Context, logr.Logger, types.NamespacedName, v1.ServicePort, []v1a.AddressType) ([]resolver.Endpoint, error)) {
+	fake.resolveServiceImportMutex.Lock()
+	defer fake.resolveServiceImportMutex.Unlock()
+	fake.ResolveServiceImportStub = stub
+}
+
+func (fake *FakeServiceResolver) ResolveServiceImportArgsForCall(i int) (context.Context, logr.Logger, types.NamespacedName, v1.ServicePort, []v1a.AddressType) {
+	fake.resolveServiceImportMutex.RLock()
+	defer fake.resolveServiceImportMutex.RUnlock()
+	argsForCall := fake.resolveServiceImportArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
+}
+
+func (fake *FakeServiceResolver) ResolveServiceImportReturns(result1 []resolver.Endpoint, result2 error) {
+	fake.resolveServiceImportMutex.Lock()
+	defer fake.resolveServiceImportMutex.Unlock()
+	fake.ResolveServiceImportStub = nil
+	fake.resolveServiceImportReturns = struct {
+		result1 []resolver.Endpoint
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeServiceResolver) ResolveServiceImportReturnsOnCall(i int, result1 []resolver.Endpoint, result2 error) {
+	fake.resolveServiceImportMutex.Lock()
+	defer fake.resolveServiceImportMutex.Unlock()
+	fake.ResolveServiceImportStub = nil
+	if fake.resolveServiceImportReturnsOnCall == nil {
+		fake.resolveServiceImportReturnsOnCall = make(map[int]struct {
+			result1 []resolver.Endpoint
+			result2 error
+		})
+	}
+	fake.resolveServiceImportReturnsOnCall[i] = struct {
+		result1 []resolver.Endpoint
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeServiceResolver) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -48,7 +48,7 @@ func createSlice(
 					"1.0.0.1",
 					"1.0.0.2",
 					"1.0.0.3",
-				}, // these endpoints should be ignored because they are not ready
+				}, // these endpoints are drained because they are terminating but still serving
 				Conditions: discoveryV1.EndpointConditions{
 					Ready:       helpers.GetPointer(false),
 					Serving:     helpers.GetPointer(true),
@@ -83,6 +83,11 @@ func createFakeK8sClient(initObjs ...client.Object) (client.Client, error) {
 		WithScheme(scheme).
 		WithObjects(initObjs...).
 		WithIndex(&discoveryV1.EndpointSlice{}, index.KubernetesServiceNameIndexField, index.ServiceNameIndexFunc).
+		WithIndex(
+			&discoveryV1.EndpointSlice{},
+			index.MultiClusterServiceNameIndexField,
+			index.MultiClusterServiceNameIndexFunc,
+		).
 		Build()
 
 	return fakeK8sClient, nil
@@ -246,6 +251,54 @@ var _ = Describe("ServiceResolver", func() {
 					Port:    8080,
 					IPv6:    true,
 				},
+				{
+					Address: "1.0.0.1",
+					Port:    8080,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.2",
+					Port:    8080,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.3",
+					Port:    8080,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.1",
+					Port:    8081,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.2",
+					Port:    8081,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.3",
+					Port:    8081,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.1",
+					Port:    8080,
+					IPv6:    true,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.2",
+					Port:    8080,
+					IPv6:    true,
+					Drain:   true,
+				},
+				{
+					Address: "1.0.0.3",
+					Port:    8080,
+					IPv6:    true,
+					Drain:   true,
+				},
 			}
 
 			endpoints, err := serviceResolver.Resolve(
@@ -314,4 +367,77 @@ var _ = Describe("ServiceResolver", func() {
 			Expect(resolve).Should(Panic())
 		})
 	})
+	Describe("ResolveServiceImport", Ordered, func() {
+		// the EndpointSlice of the local Service has the same name as the ServiceImport, but must not be used.
+		localSlice := createSlice(
+			"local-slice",
+			addresses1,
+			8080,
+			httpPortName,
+			discoveryV1.AddressTypeIPv4,
+		)
+
+		derivedSlice := &discoveryV1.EndpointSlice{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "derived-slice",
+				Namespace: "test",
+				Labels: map[string]string{
+					index.MultiClusterServiceNameLabel: "svc",
+				},
+			},
+			AddressType: discoveryV1.AddressTypeIPv4,
+			Endpoints: []discoveryV1.Endpoint{
+				{
+					Addresses: addresses2,
+					Conditions: discoveryV1.EndpointConditions{
+						Ready: helpers.GetPointer(true),
+					},
+				},
+			},
+			Ports: []discoveryV1.EndpointPort{
+				{
+					Name: &httpPortName,
+					Port: helpers.GetPointer[int32](8081),
+				},
+			},
+		}
+
+		BeforeAll(func() {
+			var err error
+			fakeK8sClient, err = createFakeK8sClient(localSlice, derivedSlice)
+			Expect(err).ToNot(HaveOccurred())
+
+			serviceResolver = resolver.NewServiceResolverImpl(fakeK8sClient)
+		})
+		It("resolves a ServiceImport using its derived endpoint slices", func() {
+			expectedEndpoints := []resolver.Endpoint{
+				{Address: "10.0.0.1", Port: 8081},
+				{Address: "10.0.0.2", Port: 8081},
+				{Address: "10.0.0.3", Port: 8081},
+			}
+
+			endpoints, err := serviceResolver.ResolveServiceImport(
+				context.TODO(),
+				logr.Discard(),
+				svcNsName,
+				svcPort,
+				dualAddressType,
+			)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(endpoints).To(ConsistOf(expectedEndpoints))
+		})
+		It("returns an error if there are no derived endpoint slices for the ServiceImport", func() {
+			Expect(fakeK8sClient.Delete(context.TODO(), derivedSlice)).To(Succeed())
+
+			endpoints, err := serviceResolver.ResolveServiceImport(
+				context.TODO(),
+				logr.Discard(),
+				svcNsName,
+				svcPort,
+				dualAddressType,
+			)
+			Expect(err).To(MatchError("no endpoints found for ServiceImport test/svc"))
+			Expect(endpoints).To(BeNil())
+		})
+	})
 })
@@ -239,7 +239,12 @@ type changeTrackingUpdater struct {
 	extractGVK    kinds.MustExtractGVK
 	supportedGVKs gvkList
 
+	// changes holds the objects that triggered a change since the last reset.
+	changes []graph.ChangedObject
+
 	changed bool
+	// forced is true when a change was forced without any changed objects.
+	forced bool
 }
 
 func newChangeTrackingUpdater(
@@ -301,10 +306,19 @@ func (s *changeTrackingUpdater) upsert(obj client.Object) (changed bool) {
 }
 
 func (s *changeTrackingUpdater) Upsert(obj client.Object) {
-	s.assertSupportedGVK(s.extractGVK(obj))
+	gvk := s.extractGVK(obj)
+	s.assertSupportedGVK(gvk)
 
 	changingUpsert := s.upsert(obj)
 
+	if changingUpsert {
+		s.changes = append(s.changes, graph.ChangedObject{
+			Object: obj,
+			GVK:    gvk,
+			NsName: client.ObjectKeyFromObject(obj),
+		})
+	}
+
 	s.changed = s.changed || changingUpsert
 }
 
@@ -328,10 +342,19 @@ func (s *changeTrackingUpdater) delete(objType ngftypes.ObjectType, nsname types
 }
 
 func (s *changeTrackingUpdater) Delete(objType ngftypes.ObjectType, nsname types.NamespacedName) {
-	s.assertSupportedGVK(s.extractGVK(objType))
+	gvk := s.extractGVK(objType)
+	s.assertSupportedGVK(gvk)
 
 	changingDelete := s.delete(objType, nsname)
 
+	if changingDelete {
+		s.changes = append(s.changes, graph.ChangedObject{
+			Object: objType,
+			GVK:    gvk,
+			NsName: nsname,
+		})
+	}
+
 	s.changed = s.changed || changingDelete
 }
 
@@ -348,4 +371,20 @@ func (s *changeTrackingUpdater) getAndResetChangedStatus() bool {
 // a graph rebuild without touching the object stores.
 func (s *changeTrackingUpdater) forceRebuild() {
 	s.changed = true
+	s.forced = true
+}
+
+// getAndResetChanges returns the objects that triggered a change based on the previous updates (Upserts/Deletes).
+// It returns nil if a rebuild was forced, because the forced change can't be attributed to any object.
+// It also resets the tracked changes.
+func (s *changeTrackingUpdater) getAndResetChanges() []graph.ChangedObject {
+	changes := s.changes
+	if s.forced {
+		changes = nil
+	}
+
+	s.changes = nil
+	s.forced = false
+
+	return changes
 }
@@ -7,9 +7,11 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	gatewayv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gatewayv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 
+	"github.com/nginx/nginx-gateway-fabric/v2/internal/controller/state/graph"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/kinds"
 )
@@ -185,3 +187,45 @@ func TestRefGrantTrackingCfg(t *testing.T) {
 		})
 	}
 }
+
+func TestChangeTrackingUpdaterGetAndResetChanges(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	mustExtractGVK := kinds.NewMustExtractGKV(scheme)
+	gatewayGVK := mustExtractGVK(&gatewayv1.Gateway{})
+	routeGVK := mustExtractGVK(&gatewayv1.HTTPRoute{})
+
+	updater := newChangeTrackingUpdater(
+		mustExtractGVK,
+		[]changeTrackingUpdaterObjectTypeCfg{
+			{
+				gvk:   gatewayGVK,
+				store: newObjectStoreMapAdapter(make(map[types.NamespacedName]*gatewayv1.Gateway)),
+			},
+			{
+				gvk:   routeGVK,
+				store: newObjectStoreMapAdapter(make(map[types.NamespacedName]*gatewayv1.HTTPRoute)),
+			},
+		},
+	)
+
+	gw := &gatewayv1.Gateway{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gw"}}
+	routeNsName := types.NamespacedName{Namespace: "test", Name: "route"}
+
+	updater.Upsert(gw)
+	// deleting a non-existent object doesn't change the state
+	updater.Delete(&gatewayv1.HTTPRoute{}, routeNsName)
+
+	g.Expect(updater.getAndResetChangedStatus()).To(BeTrue())
+	g.Expect(updater.getAndResetChanges()).To(Equal([]graph.ChangedObject{
+		{Object: gw, GVK: gatewayGVK, NsName: client.ObjectKeyFromObject(gw)},
+	}))
+	g.Expect(updater.getAndResetChanges()).To(BeEmpty())
+
+	updater.Delete(&gatewayv1.Gateway{}, client.ObjectKeyFromObject(gw))
+	updater.forceRebuild()
+
+	g.Expect(updater.getAndResetChangedStatus()).To(BeTrue())
+	g.Expect(updater.getAndResetChanges()).To(BeNil())
+}
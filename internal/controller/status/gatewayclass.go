@@ -8,8 +8,9 @@ import (
 )
 
 // supportedFeatures returns the list of features supported by NGINX Gateway Fabric.
+// Features that are only supported with experimental features enabled are included if experimental is true.
 // The list must be sorted in ascending alphabetical order.
-func supportedFeatures() []gatewayv1.SupportedFeature {
+func supportedFeatures(experimental bool) []gatewayv1.SupportedFeature {
 	featureNames := []features.FeatureName{
 		// Core features
 		features.SupportGateway,
@@ -40,6 +41,9 @@ func supportedFeatures() []gatewayv1.SupportedFeature {
 		// HTTPRoute extended
 		features.SupportHTTPRouteBackendProtocolWebSocket,
 		features.SupportHTTPRouteBackendProtocolH2C,
+		// HTTPRouteRequestTimeout isn't claimed: NGINX has no deadline for a whole request,
+		// so timeouts.request only bounds each phase of proxying and the retries.
+		features.SupportHTTPRouteBackendTimeout,
 		features.SupportHTTPRouteDestinationPortMatching,
 		features.SupportHTTPRouteHostRewrite,
 		features.SupportHTTPRouteMethodMatching,
@@ -65,6 +69,16 @@ func supportedFeatures() []gatewayv1.SupportedFeature {
 		features.SupportUDPRoute,
 	}
 
+	if experimental {
+		// HTTPRoute retries
+		featureNames = append(
+			featureNames,
+			features.SupportHTTPRouteRetry,
+			features.SupportHTTPRouteRetryBackendTimeout,
+			features.SupportHTTPRouteRetryConnectionError,
+		)
+	}
+
 	// Sort alphabetically by feature name
 	sort.Slice(featureNames, func(i, j int) bool {
 		return string(featureNames[i]) < string(featureNames[j])
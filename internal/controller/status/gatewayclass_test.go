@@ -25,6 +25,7 @@ func TestSupportedFeatures(t *testing.T) {
 		gatewayv1.FeatureName(features.SupportHTTPRoute),
 		gatewayv1.FeatureName(features.SupportHTTPRouteBackendProtocolWebSocket),
 		gatewayv1.FeatureName(features.SupportHTTPRouteBackendProtocolH2C),
+		gatewayv1.FeatureName(features.SupportHTTPRouteBackendTimeout),
 		gatewayv1.FeatureName(features.SupportHTTPRouteDestinationPortMatching),
 		gatewayv1.FeatureName(features.SupportHTTPRouteHostRewrite),
 		gatewayv1.FeatureName(features.SupportHTTPRouteMethodMatching),
@@ -54,15 +55,28 @@ func TestSupportedFeatures(t *testing.T) {
 		gatewayv1.FeatureName(features.SupportUDPRoute),
 	}
 
+	experimentalFeatures := []gatewayv1.FeatureName{
+		gatewayv1.FeatureName(features.SupportHTTPRouteRetry),
+		gatewayv1.FeatureName(features.SupportHTTPRouteRetryBackendTimeout),
+		gatewayv1.FeatureName(features.SupportHTTPRouteRetryConnectionError),
+	}
+
 	tests := []struct {
 		name               string
 		expectedFeatures   []gatewayv1.FeatureName
 		unexpectedFeatures []gatewayv1.FeatureName
+		experimental       bool
 	}{
 		{
 			name:               "standard features",
 			expectedFeatures:   standardFeatures,
+			unexpectedFeatures: experimentalFeatures,
+		},
+		{
+			name:               "experimental features",
+			expectedFeatures:   slices.Concat(standardFeatures, experimentalFeatures),
 			unexpectedFeatures: []gatewayv1.FeatureName{},
+			experimental:       true,
 		},
 	}
 
@@ -71,7 +85,7 @@ func TestSupportedFeatures(t *testing.T) {
 			t.Parallel()
 			g := NewWithT(t)
 
-			features := supportedFeatures()
+			features := supportedFeatures(tc.experimental)
 
 			g.Expect(features).To(HaveLen(len(tc.expectedFeatures)))
 
@@ -1,9 +1,13 @@
 package status
 
 import (
+	"errors"
 	"fmt"
+	"maps"
 	"net"
 	"reflect"
+	"slices"
+	"strings"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -219,10 +223,12 @@ func prepareRouteStatus(
 }
 
 // PrepareGatewayClassRequests prepares status UpdateRequests for the given GatewayClasses.
+// experimental indicates if experimental features are enabled, which affects the supported features.
 func PrepareGatewayClassRequests(
 	gc *graph.GatewayClass,
 	ignoredGwClasses map[types.NamespacedName]*v1.GatewayClass,
 	transitionTime metav1.Time,
+	experimental bool,
 ) []UpdateRequest {
 	var reqs []UpdateRequest
 
@@ -243,7 +249,7 @@ func PrepareGatewayClassRequests(
 		var suppFeatures []v1.SupportedFeature
 		// Skip reporting supported features if we are in BestEffort mode
 		if !gc.BestEffort {
-			suppFeatures = supportedFeatures()
+			suppFeatures = supportedFeatures(experimental)
 		}
 
 		req := UpdateRequest{
@@ -263,7 +269,7 @@ func PrepareGatewayClassRequests(
 		// Skip reporting supported features if we are in BestEffort mode
 		// If gc is nil, we can safely populate supported features
 		if gc == nil || !gc.BestEffort {
-			ignoredSuppFeatures = supportedFeatures()
+			ignoredSuppFeatures = supportedFeatures(experimental)
 		}
 
 		req := UpdateRequest{
@@ -291,11 +297,15 @@ func PrepareGatewayRequests(
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
 	nginxReloadRes graph.NginxReloadResult,
+	dataPlaneHealth graph.DataPlaneHealth,
 ) []UpdateRequest {
 	reqs := make([]UpdateRequest, 0, 1)
 
 	if gateway != nil {
-		reqs = append(reqs, prepareGatewayRequest(gateway, transitionTime, gwAddresses, nginxReloadRes))
+		reqs = append(
+			reqs,
+			prepareGatewayRequest(gateway, transitionTime, gwAddresses, nginxReloadRes, dataPlaneHealth),
+		)
 	}
 
 	return reqs
@@ -306,6 +316,7 @@ func prepareGatewayRequest(
 	transitionTime metav1.Time,
 	gwAddresses []v1.GatewayStatusAddress,
 	nginxReloadRes graph.NginxReloadResult,
+	dataPlaneHealth graph.DataPlaneHealth,
 ) UpdateRequest {
 	if !gateway.Valid {
 		conds := conditions.ConvertConditions(
@@ -371,7 +382,23 @@ func prepareGatewayRequest(
 		gwConds = append(gwConds, conditions.NewGatewayAcceptedListenersNotValid())
 	}
 
-	if nginxReloadRes.Error != nil {
+	if errors.Is(nginxReloadRes.Error, graph.ErrConfigRolloutAborted) {
+		msg := fmt.Sprintf("%s: %s", conditions.GatewayMessageRolloutAborted, nginxReloadRes.Error.Error())
+		gwConds = append(
+			gwConds,
+			conditions.NewGatewayNotProgrammedRolloutAborted(msg),
+		)
+	} else if errors.Is(nginxReloadRes.Error, graph.ErrPinnedConfigVersionNotFound) {
+		msg := fmt.Sprintf(
+			"%s: %s",
+			conditions.GatewayMessagePinnedConfigVersionNotFound,
+			nginxReloadRes.Error.Error(),
+		)
+		gwConds = append(
+			gwConds,
+			conditions.NewGatewayNotProgrammedPinnedConfigVersionNotFound(msg),
+		)
+	} else if nginxReloadRes.Error != nil {
 		msg := fmt.Sprintf("%s: %s", conditions.GatewayMessageFailedNginxReload, nginxReloadRes.Error.Error())
 		gwConds = append(
 			gwConds,
@@ -379,6 +406,10 @@ func prepareGatewayRequest(
 		)
 	}
 
+	if dataPlaneHealth.Reported {
+		gwConds = append(gwConds, newDataPlaneHealthCondition(dataPlaneHealth))
+	}
+
 	// Set the unprogrammed conditions here, because those do not make the gateway invalid.
 	// We set the unaccepted conditions elsewhere, because those do make the gateway invalid.
 	for _, address := range gateway.Source.Spec.Addresses {
@@ -412,6 +443,30 @@ func prepareGatewayRequest(
 	}
 }
 
+// newDataPlaneHealthCondition returns the DataPlaneHealthy condition for the reported data plane health.
+// Unhealthy Pods are listed in the message sorted by name, so that the condition is stable across updates.
+func newDataPlaneHealthCondition(health graph.DataPlaneHealth) conditions.Condition {
+	if len(health.UnhealthyPods) == 0 {
+		return conditions.NewGatewayDataPlaneHealthy()
+	}
+
+	pods := slices.Sorted(maps.Keys(health.UnhealthyPods))
+
+	unhealthy := make([]string, 0, len(pods))
+	for _, pod := range pods {
+		desc := health.UnhealthyPods[pod]
+		if desc == "" {
+			unhealthy = append(unhealthy, pod)
+			continue
+		}
+		unhealthy = append(unhealthy, fmt.Sprintf("%s (%s)", pod, desc))
+	}
+
+	msg := fmt.Sprintf("The data plane is unhealthy in the following Pods: %s", strings.Join(unhealthy, "; "))
+
+	return conditions.NewGatewayDataPlaneUnhealthy(msg)
+}
+
 // settingsPolicyKinds are the NGF custom policy kinds that report a GEP-713 "Programmed" condition
 // indicating whether their settings have been programmed into the NGINX data plane.
 var settingsPolicyKinds = map[string]struct{}{
@@ -420,6 +475,9 @@ var settingsPolicyKinds = map[string]struct{}{
 	kinds.ObservabilityPolicy:    {},
 	kinds.ProxySettingsPolicy:    {},
 	kinds.RateLimitPolicy:        {},
+	kinds.CachePolicy:            {},
+	kinds.AccessControlPolicy:    {},
+	kinds.AccessLogPolicy:        {},
 	kinds.SnippetsPolicy:         {},
 	kinds.PayloadProcessor:       {},
 }
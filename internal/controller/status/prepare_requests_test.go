@@ -808,7 +808,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 							Message:            conditions.GatewayClassMessageGatewayClassConflict,
 						},
 					},
-					SupportedFeatures: supportedFeatures(),
+					SupportedFeatures: supportedFeatures(false),
 				},
 				{Name: "ignored-2"}: {
 					Conditions: []metav1.Condition{
@@ -821,7 +821,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 							Message:            conditions.GatewayClassMessageGatewayClassConflict,
 						},
 					},
-					SupportedFeatures: supportedFeatures(),
+					SupportedFeatures: supportedFeatures(false),
 				},
 			},
 		},
@@ -855,7 +855,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 							Message:            "The Gateway API CRD versions are supported",
 						},
 					},
-					SupportedFeatures: supportedFeatures(),
+					SupportedFeatures: supportedFeatures(false),
 				},
 			},
 		},
@@ -927,7 +927,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 							Message:            "The Gateway API CRD versions are not recommended. Recommended version is v1.4.0",
 						},
 					},
-					SupportedFeatures: supportedFeatures(),
+					SupportedFeatures: supportedFeatures(false),
 				},
 			},
 		},
@@ -1013,7 +1013,7 @@ func TestBuildGatewayClassStatuses(t *testing.T) {
 
 			updater := NewUpdater(k8sClient, logr.Discard())
 
-			reqs := PrepareGatewayClassRequests(test.gc, test.ignoredClasses, transitionTime)
+			reqs := PrepareGatewayClassRequests(test.gc, test.ignoredClasses, transitionTime, false)
 
 			g.Expect(reqs).To(HaveLen(expectedTotalReqs))
 
@@ -1094,10 +1094,11 @@ func TestBuildGatewayStatuses(t *testing.T) {
 	routeKey := graph.RouteKey{NamespacedName: types.NamespacedName{Namespace: "test", Name: "hr-1"}}
 
 	tests := []struct {
-		nginxReloadRes graph.NginxReloadResult
-		gateway        *graph.Gateway
-		expected       map[types.NamespacedName]v1.GatewayStatus
-		name           string
+		nginxReloadRes  graph.NginxReloadResult
+		dataPlaneHealth graph.DataPlaneHealth
+		gateway         *graph.Gateway
+		expected        map[types.NamespacedName]v1.GatewayStatus
+		name            string
 	}{
 		{
 			name:     "nil gateway and no ignored gateways",
@@ -1136,6 +1137,95 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "valid gateway; data plane healthy",
+			gateway: &graph.Gateway{
+				Source:    createGateway(),
+				Listeners: []*graph.Listener{},
+				Valid:     true,
+			},
+			dataPlaneHealth: graph.DataPlaneHealth{Reported: true},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "The Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "The Gateway is programmed",
+						},
+						{
+							Type:               string(conditions.GatewayConditionDataPlaneHealthy),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayReasonDataPlaneHealthy),
+							Message:            "All nginx instances report a healthy data plane",
+						},
+					},
+					AttachedListenerSets: helpers.GetPointer(int32(0)),
+				},
+			},
+		},
+		{
+			name: "valid gateway; data plane unhealthy",
+			gateway: &graph.Gateway{
+				Source:    createGateway(),
+				Listeners: []*graph.Listener{},
+				Valid:     true,
+			},
+			dataPlaneHealth: graph.DataPlaneHealth{
+				Reported: true,
+				UnhealthyPods: map[string]string{
+					"nginx-pod-2": "",
+					"nginx-pod-1": "nginx is not running",
+				},
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "The Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonProgrammed),
+							Message:            "The Gateway is programmed",
+						},
+						{
+							Type:               string(conditions.GatewayConditionDataPlaneHealthy),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayReasonDataPlaneUnhealthy),
+							Message: "The data plane is unhealthy in the following Pods: " +
+								"nginx-pod-1 (nginx is not running); nginx-pod-2",
+						},
+					},
+					AttachedListenerSets: helpers.GetPointer(int32(0)),
+				},
+			},
+		},
 		{
 			name: "valid gateway; all valid listeners",
 			gateway: &graph.Gateway{
@@ -1535,6 +1625,186 @@ func TestBuildGatewayStatuses(t *testing.T) {
 			},
 			nginxReloadRes: graph.NginxReloadResult{Error: errors.New("test error")},
 		},
+		{
+			name: "staged rollout aborted; gateway/listener not programmed",
+			gateway: &graph.Gateway{
+				Source:     createGateway(),
+				Valid:      true,
+				Conditions: conditions.NewDefaultGatewayConditions(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "The Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayReasonRolloutAborted),
+							Message: fmt.Sprintf(
+								"%s: %s: test error",
+								conditions.GatewayMessageRolloutAborted,
+								graph.ErrConfigRolloutAborted,
+							),
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(v1.ListenerConditionAccepted),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonAccepted),
+									Message:            "The Listener is accepted",
+								},
+								{
+									Type:               string(v1.ListenerConditionResolvedRefs),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonResolvedRefs),
+									Message:            "All references are resolved",
+								},
+								{
+									Type:               string(v1.ListenerConditionConflicted),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonNoConflicts),
+									Message:            "No conflicts",
+								},
+								{
+									Type:               string(v1.ListenerConditionProgrammed),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonInvalid),
+									Message: fmt.Sprintf(
+										"%s: %s: test error",
+										conditions.ListenerMessageFailedNginxReload,
+										graph.ErrConfigRolloutAborted,
+									),
+								},
+							},
+						},
+					},
+					AttachedListenerSets: helpers.GetPointer(int32(0)),
+				},
+			},
+			nginxReloadRes: graph.NginxReloadResult{
+				Error: fmt.Errorf("%w: %w", graph.ErrConfigRolloutAborted, errors.New("test error")),
+			},
+		},
+		{
+			name: "pinned config version not found; gateway/listener not programmed",
+			gateway: &graph.Gateway{
+				Source:     createGateway(),
+				Valid:      true,
+				Conditions: conditions.NewDefaultGatewayConditions(),
+				Listeners: []*graph.Listener{
+					{
+						Name:   "listener-valid",
+						Valid:  true,
+						Routes: map[graph.RouteKey]*graph.L7Route{routeKey: {}},
+					},
+				},
+			},
+			expected: map[types.NamespacedName]v1.GatewayStatus{
+				{Namespace: "test", Name: "gateway"}: {
+					Addresses: addr,
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(v1.GatewayConditionAccepted),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(v1.GatewayReasonAccepted),
+							Message:            "The Gateway is accepted",
+						},
+						{
+							Type:               string(v1.GatewayConditionProgrammed),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+							LastTransitionTime: transitionTime,
+							Reason:             string(conditions.GatewayReasonPinnedConfigVersionNotFound),
+							Message: fmt.Sprintf(
+								"%s: %s: abc123",
+								conditions.GatewayMessagePinnedConfigVersionNotFound,
+								graph.ErrPinnedConfigVersionNotFound,
+							),
+						},
+					},
+					Listeners: []v1.ListenerStatus{
+						{
+							Name:           "listener-valid",
+							AttachedRoutes: 1,
+							Conditions: []metav1.Condition{
+								{
+									Type:               string(v1.ListenerConditionAccepted),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonAccepted),
+									Message:            "The Listener is accepted",
+								},
+								{
+									Type:               string(v1.ListenerConditionResolvedRefs),
+									Status:             metav1.ConditionTrue,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonResolvedRefs),
+									Message:            "All references are resolved",
+								},
+								{
+									Type:               string(v1.ListenerConditionConflicted),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonNoConflicts),
+									Message:            "No conflicts",
+								},
+								{
+									Type:               string(v1.ListenerConditionProgrammed),
+									Status:             metav1.ConditionFalse,
+									ObservedGeneration: 2,
+									LastTransitionTime: transitionTime,
+									Reason:             string(v1.ListenerReasonInvalid),
+									Message: fmt.Sprintf(
+										"%s: %s: abc123",
+										conditions.ListenerMessageFailedNginxReload,
+										graph.ErrPinnedConfigVersionNotFound,
+									),
+								},
+							},
+						},
+					},
+					AttachedListenerSets: helpers.GetPointer(int32(0)),
+				},
+			},
+			nginxReloadRes: graph.NginxReloadResult{
+				Error: fmt.Errorf("%w: %s", graph.ErrPinnedConfigVersionNotFound, "abc123"),
+			},
+		},
 		{
 			name: "valid gateway with valid parametersRef; all valid listeners",
 			gateway: &graph.Gateway{
@@ -1983,6 +2253,7 @@ func TestBuildGatewayStatuses(t *testing.T) {
 				transitionTime,
 				addr,
 				test.nginxReloadRes,
+				test.dataPlaneHealth,
 			)
 
 			g.Expect(reqs).To(HaveLen(expectedTotalReqs))
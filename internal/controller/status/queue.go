@@ -4,6 +4,7 @@ import (
 	"context"
 	"sync"
 
+	"go.opentelemetry.io/otel/trace"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 )
@@ -35,7 +36,10 @@ type QueueObject struct {
 	IngressLinkAddress string
 	Error              error
 	Deployment         Deployment
-	UpdateType         UpdateType
+	// SpanContext is the span context of the configuration update that triggered the status update,
+	// so that the status update is traced as part of it.
+	SpanContext trace.SpanContext
+	UpdateType  UpdateType
 	// NginxConfigPushed indicates that an NGINX configuration push was attempted for this update.
 	// When false the update is a status-only change (e.g. a WAF poll result) and the
 	// "NGINX configuration was successfully updated" log should be suppressed.
@@ -71,6 +75,14 @@ func (q *Queue) Enqueue(item *QueueObject) {
 	}
 }
 
+// Len returns the number of items in the queue.
+func (q *Queue) Len() int {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+
+	return len(q.items)
+}
+
 // Dequeue removes and returns the front item from the queue.
 // It blocks if the queue is empty or when the context is canceled.
 func (q *Queue) Dequeue(ctx context.Context) *QueueObject {
@@ -35,6 +35,7 @@ func TestEnqueue(t *testing.T) {
 
 	g.Expect(q.items).To(HaveLen(1))
 	g.Expect(q.items[0]).To(Equal(item))
+	g.Expect(q.Len()).To(Equal(1))
 }
 
 func TestDequeue(t *testing.T) {
@@ -58,6 +59,7 @@ func TestDequeue(t *testing.T) {
 	dequeuedItem := q.Dequeue(ctx)
 	g.Expect(dequeuedItem).To(Equal(item))
 	g.Expect(q.items).To(BeEmpty())
+	g.Expect(q.Len()).To(BeZero())
 }
 
 func TestDequeueEmptyQueue(t *testing.T) {
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -52,6 +53,8 @@ type Setter func(client.Object) (wasSet bool)
 type Updater struct {
 	client client.Client
 	logger logr.Logger
+	// writeErrors is the number of status writes that failed after all retries.
+	writeErrors atomic.Uint64
 }
 
 var ErrFailedAssert = errors.New("type assertion failed")
@@ -64,6 +67,11 @@ func NewUpdater(c client.Client, logger logr.Logger) *Updater {
 	}
 }
 
+// WriteErrors returns the number of status writes that failed after all retries.
+func (u *Updater) WriteErrors() uint64 {
+	return u.writeErrors.Load()
+}
+
 // Update updates the status of the resources from the requests.
 func (u *Updater) Update(ctx context.Context, reqs ...UpdateRequest) {
 	for _, r := range reqs {
@@ -109,6 +117,7 @@ func (u *Updater) writeStatuses(
 		NewRetryUpdateFunc(u.client, u.client.Status(), nsname, obj, u.logger, statusSetter),
 	)
 	if err != nil && !errors.Is(err, context.Canceled) {
+		u.writeErrors.Add(1)
 		u.logger.Error(
 			err,
 			"Failed to update status",
@@ -2,6 +2,7 @@ package status
 
 import (
 	"context"
+	"errors"
 
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
@@ -11,6 +12,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 	v1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	"github.com/nginx/nginx-gateway-fabric/v2/internal/framework/helpers"
@@ -199,7 +201,39 @@ var _ = Describe("Updater", func() {
 					// condType from the last successful update should be present
 					testStatus(name, "TestAll")
 				}
+
+				Expect(updater.WriteErrors()).To(BeZero())
 			})
 		})
 	})
+
+	When("the status write fails", func() {
+		It("should count the write error", func() {
+			scheme := runtime.NewScheme()
+			Expect(v1.Install(scheme)).Should(Succeed())
+
+			failingClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithStatusSubresource(&v1.GatewayClass{}).
+				WithInterceptorFuncs(interceptor.Funcs{
+					SubResourceUpdate: func(
+						context.Context,
+						client.Client,
+						string,
+						client.Object,
+						...client.SubResourceUpdateOption,
+					) error {
+						return errors.New("update error")
+					},
+				}).
+				Build()
+
+			Expect(failingClient.Create(context.Background(), createGC("failing"))).Should(Succeed())
+
+			updater := NewUpdater(failingClient, logr.Discard())
+			updater.Update(context.Background(), prepareReq("failing", "TestFailure", updateNeeded))
+
+			Expect(updater.WriteErrors()).To(Equal(uint64(1)))
+		})
+	})
 })
@@ -13,12 +13,19 @@ const (
 	KubernetesServiceNameIndexField = "k8sServiceName"
 	// KubernetesServiceNameLabel is the label used to identify the Kubernetes service name on an EndpointSlice.
 	KubernetesServiceNameLabel = "kubernetes.io/service-name"
+	// MultiClusterServiceNameIndexField is the name of the Index Field used to index EndpointSlices by the
+	// ServiceImports they are derived from.
+	MultiClusterServiceNameIndexField = "mcsServiceName"
+	// MultiClusterServiceNameLabel is the label used by the Multi-Cluster Services API to identify the ServiceImport
+	// name on an EndpointSlice.
+	MultiClusterServiceNameLabel = "multicluster.kubernetes.io/service-name"
 )
 
 // CreateEndpointSliceFieldIndices creates a FieldIndices map for the EndpointSlice resource.
 func CreateEndpointSliceFieldIndices() FieldIndices {
 	return FieldIndices{
-		KubernetesServiceNameIndexField: ServiceNameIndexFunc,
+		KubernetesServiceNameIndexField:   ServiceNameIndexFunc,
+		MultiClusterServiceNameIndexField: MultiClusterServiceNameIndexFunc,
 	}
 }
 
@@ -39,6 +46,23 @@ func ServiceNameIndexFunc(obj client.Object) []string {
 	return []string{name}
 }
 
+// MultiClusterServiceNameIndexFunc is a client.IndexerFunc that parses a Kubernetes object and returns the value of
+// the Multi-Cluster Services service-name label.
+// Used to index EndpointSlices by the ServiceImports they are derived from.
+func MultiClusterServiceNameIndexFunc(obj client.Object) []string {
+	slice, ok := obj.(*discoveryV1.EndpointSlice)
+	if !ok {
+		panic(fmt.Sprintf("expected an EndpointSlice; got %T", obj))
+	}
+
+	name := GetServiceImportNameFromEndpointSlice(slice)
+	if name == "" {
+		return nil
+	}
+
+	return []string{name}
+}
+
 // GetServiceNameFromEndpointSlice returns the value of the Kubernetes service-name label from an EndpointSlice.
 func GetServiceNameFromEndpointSlice(slice *discoveryV1.EndpointSlice) string {
 	if slice.Labels == nil {
@@ -47,3 +71,13 @@ func GetServiceNameFromEndpointSlice(slice *discoveryV1.EndpointSlice) string {
 
 	return slice.Labels[KubernetesServiceNameLabel]
 }
+
+// GetServiceImportNameFromEndpointSlice returns the value of the Multi-Cluster Services service-name label
+// from an EndpointSlice.
+func GetServiceImportNameFromEndpointSlice(slice *discoveryV1.EndpointSlice) string {
+	if slice.Labels == nil {
+		return ""
+	}
+
+	return slice.Labels[MultiClusterServiceNameLabel]
+}
@@ -62,3 +62,56 @@ func TestServiceNameIndexFuncPanics(t *testing.T) {
 
 	ServiceNameIndexFunc(&v1.Namespace{})
 }
+
+func TestMultiClusterServiceNameIndexFunc(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		msg       string
+		obj       client.Object
+		expOutput []string
+	}{
+		{
+			msg: "normal case",
+			obj: &discoveryV1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{MultiClusterServiceNameLabel: "test-svc"},
+				},
+			},
+			expOutput: []string{"test-svc"},
+		},
+		{
+			msg:       "nil labels",
+			obj:       &discoveryV1.EndpointSlice{},
+			expOutput: nil,
+		},
+		{
+			msg: "only kubernetes service-name label",
+			obj: &discoveryV1.EndpointSlice{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: map[string]string{KubernetesServiceNameLabel: "test-svc"},
+				},
+			},
+			expOutput: nil,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.msg, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			output := MultiClusterServiceNameIndexFunc(tc.obj)
+			g.Expect(output).To(Equal(tc.expOutput))
+		})
+	}
+}
+
+func TestMultiClusterServiceNameIndexFuncPanics(t *testing.T) {
+	t.Parallel()
+	defer func() {
+		g := NewWithT(t)
+		g.Expect(recover()).ToNot(BeNil())
+	}()
+
+	MultiClusterServiceNameIndexFunc(&v1.Namespace{})
+}
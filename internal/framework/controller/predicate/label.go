@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	k8spredicate "sigs.k8s.io/controller-runtime/pkg/predicate"
 )
 
@@ -16,3 +17,36 @@ func NginxLabelPredicate(selector metav1.LabelSelector) k8spredicate.Predicate {
 
 	return labelPredicate
 }
+
+// LabelPredicate implements a predicate function based on the Label.
+//
+// This predicate will skip the following events:
+// 1. Create events that do not contain the Label.
+// 2. Update events where the Label value has not changed.
+type LabelPredicate struct {
+	k8spredicate.Funcs
+	Label string
+}
+
+// Create filters CreateEvents based on the Label.
+func (lp LabelPredicate) Create(e event.CreateEvent) bool {
+	if e.Object == nil {
+		return false
+	}
+
+	_, ok := e.Object.GetLabels()[lp.Label]
+	return ok
+}
+
+// Update filters UpdateEvents based on the Label.
+func (lp LabelPredicate) Update(e event.UpdateEvent) bool {
+	if e.ObjectOld == nil || e.ObjectNew == nil {
+		// this case should not happen
+		return false
+	}
+
+	oldLabelVal := e.ObjectOld.GetLabels()[lp.Label]
+	newLabelVal := e.ObjectNew.GetLabels()[lp.Label]
+
+	return oldLabelVal != newLabelVal
+}
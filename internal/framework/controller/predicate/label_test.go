@@ -0,0 +1,178 @@
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestLabelPredicate_Create(t *testing.T) {
+	t.Parallel()
+	label := "test"
+
+	tests := []struct {
+		event     event.CreateEvent
+		name      string
+		expUpdate bool
+	}{
+		{
+			name: "object has label",
+			event: event.CreateEvent{
+				Object: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "one",
+						},
+					},
+				},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "object does not have label",
+			event: event.CreateEvent{
+				Object: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							"diff": "one",
+						},
+					},
+				},
+			},
+			expUpdate: false,
+		},
+		{
+			name:      "object does not have any labels",
+			event:     event.CreateEvent{Object: &metav1.PartialObjectMetadata{}},
+			expUpdate: false,
+		},
+		{
+			name:      "object is nil",
+			event:     event.CreateEvent{Object: nil},
+			expUpdate: false,
+		},
+	}
+
+	p := LabelPredicate{Label: label}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			update := p.Create(test.event)
+			g.Expect(update).To(Equal(test.expUpdate))
+		})
+	}
+}
+
+func TestLabelPredicate_Update(t *testing.T) {
+	t.Parallel()
+	label := "test"
+
+	tests := []struct {
+		event     event.UpdateEvent
+		name      string
+		expUpdate bool
+	}{
+		{
+			name: "label changed",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "one",
+						},
+					},
+				},
+				ObjectNew: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "two",
+						},
+					},
+				},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "label deleted",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "one",
+						},
+					},
+				},
+				ObjectNew: &metav1.PartialObjectMetadata{},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "label added",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{},
+				ObjectNew: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "one",
+						},
+					},
+				},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "label unchanged",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label: "one",
+						},
+					},
+				},
+				ObjectNew: &metav1.PartialObjectMetadata{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels: map[string]string{
+							label:  "one",
+							"diff": "two",
+						},
+					},
+				},
+			},
+			expUpdate: false,
+		},
+		{
+			name: "old object is nil",
+			event: event.UpdateEvent{
+				ObjectOld: nil,
+				ObjectNew: &metav1.PartialObjectMetadata{},
+			},
+			expUpdate: false,
+		},
+		{
+			name: "new object is nil",
+			event: event.UpdateEvent{
+				ObjectOld: &metav1.PartialObjectMetadata{},
+				ObjectNew: nil,
+			},
+			expUpdate: false,
+		},
+	}
+
+	p := LabelPredicate{Label: label}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			update := p.Update(test.event)
+			g.Expect(update).To(Equal(test.expUpdate))
+		})
+	}
+}
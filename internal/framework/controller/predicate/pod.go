@@ -0,0 +1,47 @@
+package predicate
+
+import (
+	apiv1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// PodNodeNamePredicate implements a predicate function based on the Node of a Pod.
+//
+// This predicate will skip the following events:
+// 1. Create events of Pods that are not scheduled to a Node.
+// 2. Update events where the Node of the Pod has not changed.
+// 3. Delete events.
+type PodNodeNamePredicate struct {
+	predicate.Funcs
+}
+
+// Create filters CreateEvents based on the Node of the Pod.
+func (PodNodeNamePredicate) Create(e event.CreateEvent) bool {
+	pod, ok := e.Object.(*apiv1.Pod)
+	if !ok {
+		return false
+	}
+
+	return pod.Spec.NodeName != ""
+}
+
+// Update filters UpdateEvents based on the Node of the Pod.
+func (PodNodeNamePredicate) Update(e event.UpdateEvent) bool {
+	podOld, ok := e.ObjectOld.(*apiv1.Pod)
+	if !ok {
+		return false
+	}
+
+	podNew, ok := e.ObjectNew.(*apiv1.Pod)
+	if !ok {
+		return false
+	}
+
+	return podOld.Spec.NodeName != podNew.Spec.NodeName
+}
+
+// Delete skips DeleteEvents. A Node that no longer runs a Pod doesn't need to be removed right away.
+func (PodNodeNamePredicate) Delete(_ event.DeleteEvent) bool {
+	return false
+}
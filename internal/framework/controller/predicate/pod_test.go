@@ -0,0 +1,116 @@
+package predicate
+
+import (
+	"testing"
+
+	. "github.com/onsi/gomega"
+	apiv1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+)
+
+func TestPodNodeNamePredicate_Create(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		event     event.CreateEvent
+		name      string
+		expUpdate bool
+	}{
+		{
+			name: "pod is scheduled",
+			event: event.CreateEvent{
+				Object: &apiv1.Pod{Spec: apiv1.PodSpec{NodeName: "node"}},
+			},
+			expUpdate: true,
+		},
+		{
+			name:      "pod is not scheduled",
+			event:     event.CreateEvent{Object: &apiv1.Pod{}},
+			expUpdate: false,
+		},
+		{
+			name:      "object is not a pod",
+			event:     event.CreateEvent{Object: &apiv1.Service{}},
+			expUpdate: false,
+		},
+	}
+
+	p := PodNodeNamePredicate{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			update := p.Create(test.event)
+			g.Expect(update).To(Equal(test.expUpdate))
+		})
+	}
+}
+
+func TestPodNodeNamePredicate_Update(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		event     event.UpdateEvent
+		name      string
+		expUpdate bool
+	}{
+		{
+			name: "pod is scheduled",
+			event: event.UpdateEvent{
+				ObjectOld: &apiv1.Pod{},
+				ObjectNew: &apiv1.Pod{Spec: apiv1.PodSpec{NodeName: "node"}},
+			},
+			expUpdate: true,
+		},
+		{
+			name: "node unchanged",
+			event: event.UpdateEvent{
+				ObjectOld: &apiv1.Pod{Spec: apiv1.PodSpec{NodeName: "node"}},
+				ObjectNew: &apiv1.Pod{
+					Spec:   apiv1.PodSpec{NodeName: "node"},
+					Status: apiv1.PodStatus{Phase: apiv1.PodRunning},
+				},
+			},
+			expUpdate: false,
+		},
+		{
+			name: "old object is not a pod",
+			event: event.UpdateEvent{
+				ObjectOld: &apiv1.Service{},
+				ObjectNew: &apiv1.Pod{Spec: apiv1.PodSpec{NodeName: "node"}},
+			},
+			expUpdate: false,
+		},
+		{
+			name: "new object is not a pod",
+			event: event.UpdateEvent{
+				ObjectOld: &apiv1.Pod{},
+				ObjectNew: &apiv1.Service{},
+			},
+			expUpdate: false,
+		},
+	}
+
+	p := PodNodeNamePredicate{}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+			g := NewWithT(t)
+
+			update := p.Update(test.event)
+			g.Expect(update).To(Equal(test.expUpdate))
+		})
+	}
+}
+
+func TestPodNodeNamePredicate_Delete(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	p := PodNodeNamePredicate{}
+
+	g.Expect(p.Delete(event.DeleteEvent{Object: &apiv1.Pod{Spec: apiv1.PodSpec{NodeName: "node"}}})).To(BeFalse())
+}
@@ -106,7 +106,9 @@ func TestRegister(t *testing.T) {
 		return true, ""
 	}
 
-	fieldIndexes := index.CreateEndpointSliceFieldIndices()
+	fieldIndexes := index.FieldIndices{
+		index.KubernetesServiceNameIndexField: index.ServiceNameIndexFunc,
+	}
 
 	eventCh := make(chan<- any)
 
@@ -9,8 +9,10 @@ import (
 const (
 	// inferencePoolServiceSuffix is the suffix of the headless Service name for an InferencePool.
 	inferencePoolServiceSuffix = "pool-svc"
-	MaxServiceNameLen          = 63
-	hashLen                    = 8
+	// zoneSyncServiceSuffix is the suffix of the headless Service name used for NGINX Plus zone synchronization.
+	zoneSyncServiceSuffix = "zone-sync"
+	MaxServiceNameLen     = 63
+	hashLen               = 8
 )
 
 // CreateNginxResourceName creates the base resource name for all nginx resources
@@ -25,6 +27,12 @@ func CreateInferencePoolServiceName(name string) string {
 	return truncateAndHashName(name, inferencePoolServiceSuffix)
 }
 
+// CreateZoneSyncServiceName creates the name for a headless Service that
+// we create for the NGINX Plus replicas of a Gateway to synchronize their shared memory zones.
+func CreateZoneSyncServiceName(name string) string {
+	return truncateAndHashName(name, zoneSyncServiceSuffix)
+}
+
 // truncateAndHashName truncates the input name to fit within maxLen,
 // appending a hash for uniqueness if needed.
 func truncateAndHashName(name string, suffix string) string {
@@ -98,6 +98,17 @@ func TestCreateInferencePoolServiceName(t *testing.T) {
 	}
 }
 
+func TestCreateZoneSyncServiceName(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	g.Expect(CreateZoneSyncServiceName("gateway-nginx")).To(Equal("gateway-nginx-zone-sync"))
+
+	serviceName := CreateZoneSyncServiceName(strings.Repeat("a", 64))
+	g.Expect(len(serviceName)).To(BeNumerically("<=", MaxServiceNameLen))
+	g.Expect(serviceName).To(HaveSuffix("-zone-sync"))
+}
+
 func TestCreateNginxResourceName_OversizeSuffix(t *testing.T) {
 	t.Parallel()
 	g := NewWithT(t)
@@ -109,6 +109,33 @@ func NewAPLogConfList() *unstructured.UnstructuredList {
 	return list
 }
 
+// Multi-Cluster Services API kinds: https://github.com/kubernetes-sigs/mcs-api
+const (
+	// ServiceImport is the ServiceImport kind from the multicluster.x-k8s.io API group.
+	ServiceImport = "ServiceImport"
+)
+
+// ServiceImportGVK is the GroupVersionKind for the ServiceImport resource.
+var ServiceImportGVK = schema.GroupVersionKind{Group: "multicluster.x-k8s.io", Version: "v1alpha1", Kind: ServiceImport}
+
+// NewServiceImportObject returns a new unstructured ServiceImport with the correct GVK set.
+func NewServiceImportObject() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(ServiceImportGVK)
+	return obj
+}
+
+// NewServiceImportList returns a new unstructured list for ServiceImport resources.
+func NewServiceImportList() *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   ServiceImportGVK.Group,
+		Version: ServiceImportGVK.Version,
+		Kind:    ServiceImport + "List",
+	})
+	return list
+}
+
 // NGINX Gateway Fabric kinds.
 const (
 	// ClientSettingsPolicy is the ClientSettingsPolicy kind.
@@ -133,6 +160,12 @@ const (
 	WAFPolicy = "WAFPolicy"
 	// PayloadProcessor is the PayloadProcessor kind.
 	PayloadProcessor = "PayloadProcessor"
+	// CachePolicy is the CachePolicy kind.
+	CachePolicy = "CachePolicy"
+	// AccessControlPolicy is the AccessControlPolicy kind.
+	AccessControlPolicy = "AccessControlPolicy"
+	// AccessLogPolicy is the AccessLogPolicy kind.
+	AccessLogPolicy = "AccessLogPolicy"
 )
 
 // MustExtractGVK is a function that extracts the GroupVersionKind (GVK) of a client.object.
@@ -16,3 +16,32 @@ const (
 	// we can make this configurable via the NginxProxy resource.
 	GoShimPort = 54800 // why 54800? Sum "nginx" in ASCII and multiply by 100.
 )
+
+// Fields used for communication with the ext_authz shim when using an ExternalAuth filter with the GRPC protocol.
+const (
+	// ExtAuthzServerHeader is the HTTP header used to specify the <host>:<port> of the ext_authz gRPC server.
+	ExtAuthzServerHeader = "X-Ext-Authz-Server"
+	// ExtAuthzTLSHeader is the HTTP header used to enable TLS for the connection to the ext_authz gRPC server.
+	ExtAuthzTLSHeader = "X-Ext-Authz-Tls"
+	// ExtAuthzTLSServerNameHeader is the HTTP header used to specify the name to verify in the certificate of
+	// the ext_authz gRPC server.
+	ExtAuthzTLSServerNameHeader = "X-Ext-Authz-Tls-Server-Name"
+	// ExtAuthzTLSTrustedCAHeader is the HTTP header used to specify the CA certificate file to verify the
+	// ext_authz gRPC server. If not set, the system CA certificates are used.
+	ExtAuthzTLSTrustedCAHeader = "X-Ext-Authz-Tls-Trusted-Ca"
+	// ExtAuthzMethodHeader is the HTTP header used to specify the method of the client request.
+	ExtAuthzMethodHeader = "X-Ext-Authz-Method"
+	// ExtAuthzPathHeader is the HTTP header used to specify the path of the client request.
+	ExtAuthzPathHeader = "X-Ext-Authz-Path"
+	// ExtAuthzSchemeHeader is the HTTP header used to specify the scheme of the client request.
+	ExtAuthzSchemeHeader = "X-Ext-Authz-Scheme"
+	// ExtAuthzProtocolHeader is the HTTP header used to specify the protocol of the client request.
+	ExtAuthzProtocolHeader = "X-Ext-Authz-Protocol"
+	// ExtAuthzClientAddressHeader is the HTTP header used to specify the address of the client.
+	ExtAuthzClientAddressHeader = "X-Ext-Authz-Client-Address"
+	// ExtAuthzHeaderPrefix is the prefix of the HTTP headers used to communicate with the ext_authz shim.
+	// These headers are not forwarded to the ext_authz gRPC server.
+	ExtAuthzHeaderPrefix = "X-Ext-Authz-"
+	// ExtAuthzShimPort is the port for the Go ext_authz shim server to listen on.
+	ExtAuthzShimPort = GoShimPort + 1
+)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"maps"
 	"sync"
+	"time"
 
 	"github.com/go-logr/logr"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -61,6 +62,12 @@ type Manager interface {
 	HasPoller(policyNsName types.NamespacedName) bool
 }
 
+// MetricsCollector collects metrics about the fetches of WAF bundles by the pollers.
+type MetricsCollector interface {
+	// ObserveWAFBundleFetch records the duration and the result of a fetch of a bundle of the given type.
+	ObserveWAFBundleFetch(bundleType string, duration time.Duration, err error)
+}
+
 // pollerManager manages the lifecycle of all WAF bundle pollers.
 // It creates, tracks, and stops pollers as WAFPolicies are created, updated, or deleted.
 type pollerManager struct {
@@ -78,6 +85,7 @@ type pollerManager struct {
 	// Used to produce user-visible condition messages without exposing internal key formats.
 	bundleKeyToDescription map[graph.WAFBundleKey]string
 	statusCallback         func(targets []types.NamespacedName)
+	metricsCollector       MetricsCollector
 	// eventCh is the send side of the main event loop channel.
 	// A WAFBundleReconcileEvent is sent when a previously-pending bundle is first fetched successfully,
 	// triggering an immediate re-reconcile so the Gateway config push can proceed.
@@ -99,7 +107,9 @@ type ManagerConfig struct {
 	Fetcher        fetch.Fetcher
 	Deployments    agent.DeploymentStorer
 	StatusCallback func(targets []types.NamespacedName)
-	EventCh        chan<- any
+	// MetricsCollector collects metrics about the bundle fetches. Optional.
+	MetricsCollector MetricsCollector
+	EventCh          chan<- any
 	// Ctx is the root context for the manager lifetime.
 	// It is used to cancel goroutines that inject events into the event loop on shutdown.
 	Ctx    context.Context
@@ -124,6 +134,7 @@ func NewManager(cfg ManagerConfig) Manager {
 		bundleKeyToPolicy:      make(map[graph.WAFBundleKey]types.NamespacedName),
 		bundleKeyToDescription: make(map[graph.WAFBundleKey]string),
 		statusCallback:         cfg.StatusCallback,
+		metricsCollector:       cfg.MetricsCollector,
 		eventCh:                cfg.EventCh,
 		ctx:                    cfg.Ctx,
 	}
@@ -222,6 +233,7 @@ func (m *pollerManager) startPoller(ctx context.Context, cfg Config) {
 		initialChecksums:     cfg.InitialChecksums,
 		statusCallback:       wrappedCallback,
 		bundleUpdateCallback: m.cacheBundleUpdate,
+		metricsCollector:     m.metricsCollector,
 	})
 
 	m.pollers[cfg.PolicyNsName] = &pollerEntry{
@@ -28,6 +28,15 @@ const (
 	LogProfileBundle
 )
 
+// String returns the name of the bundle type.
+func (t BundleType) String() string {
+	if t == LogProfileBundle {
+		return "log-profile"
+	}
+
+	return "policy"
+}
+
 // BundleSource represents a single bundle source that needs polling.
 // This can be either the main policy bundle or a log bundle.
 type BundleSource struct {
@@ -65,6 +74,7 @@ type poller struct {
 		err error,
 	)
 	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string)
+	metricsCollector     MetricsCollector
 	policyNsName         types.NamespacedName
 	logger               logr.Logger
 	sources              []BundleSource
@@ -84,6 +94,7 @@ type pollerConfig struct {
 		err error,
 	)
 	bundleUpdateCallback func(bundleKey graph.WAFBundleKey, data []byte, checksum string)
+	metricsCollector     MetricsCollector
 	policyNsName         types.NamespacedName
 	logger               logr.Logger
 	sources              []BundleSource
@@ -112,6 +123,7 @@ func newPoller(cfg pollerConfig) *poller {
 		bundleStates:         states,
 		statusCallback:       cfg.statusCallback,
 		bundleUpdateCallback: cfg.bundleUpdateCallback,
+		metricsCollector:     cfg.metricsCollector,
 	}
 }
 
@@ -335,11 +347,14 @@ func (p *poller) checksumChanged(ctx context.Context, src BundleSource, lastChec
 	var checksum string
 	var err error
 
+	start := time.Now()
 	if src.Type == LogProfileBundle {
 		checksum, err = p.fetcher.FetchLogProfileBundleChecksum(ctx, src.Request)
 	} else {
 		checksum, err = p.fetcher.FetchPolicyBundleChecksum(ctx, src.Request)
 	}
+	p.observeFetch(src, start, err)
+
 	if err != nil {
 		return false, "", err
 	}
@@ -350,10 +365,25 @@ func (p *poller) checksumChanged(ctx context.Context, src BundleSource, lastChec
 // fetchBundle downloads the full bundle for the given source using req (which may carry a
 // conditional token for HTTP sources).
 func (p *poller) fetchBundle(ctx context.Context, src BundleSource, req fetch.Request) (fetch.Result, error) {
+	var result fetch.Result
+	var err error
+
+	start := time.Now()
 	if src.Type == LogProfileBundle {
-		return p.fetcher.FetchLogProfileBundle(ctx, req)
+		result, err = p.fetcher.FetchLogProfileBundle(ctx, req)
+	} else {
+		result, err = p.fetcher.FetchPolicyBundle(ctx, req)
+	}
+	p.observeFetch(src, start, err)
+
+	return result, err
+}
+
+// observeFetch records the duration and the result of a fetch from the bundle source.
+func (p *poller) observeFetch(src BundleSource, start time.Time, err error) {
+	if p.metricsCollector != nil {
+		p.metricsCollector.ObserveWAFBundleFetch(src.Type.String(), time.Since(start), err)
 	}
-	return p.fetcher.FetchPolicyBundle(ctx, req)
 }
 
 // pushBundleToDeployments pushes the bundle to all target deployments.
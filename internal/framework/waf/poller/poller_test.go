@@ -1340,3 +1340,59 @@ func Test_poller_pollSourceLogProfileNIMChecksumUnchanged(t *testing.T) {
 	g.Expect(fetcher.FetchLogProfileBundleCallCount()).To(Equal(1))
 	g.Expect(deployments.GetCallCount()).To(BeZero())
 }
+
+type fetchObservation struct {
+	err        error
+	bundleType string
+}
+
+type fakeMetricsCollector struct {
+	observations []fetchObservation
+}
+
+func (f *fakeMetricsCollector) ObserveWAFBundleFetch(bundleType string, _ time.Duration, err error) {
+	f.observations = append(f.observations, fetchObservation{bundleType: bundleType, err: err})
+}
+
+func Test_poller_pollSourceObservesFetches(t *testing.T) {
+	t.Parallel()
+	g := NewWithT(t)
+
+	fetchErr := errors.New("network error")
+
+	fetcher := &fetchfakes.FakeFetcher{}
+	fetcher.FetchPolicyBundleReturns(fetch.Result{}, fetchErr)
+	fetcher.FetchLogProfileBundleReturns(fetch.Result{Data: []byte("bundle"), Checksum: "abc123"}, nil)
+
+	collector := &fakeMetricsCollector{}
+
+	poller := newPoller(pollerConfig{
+		logger:       logr.Discard(),
+		policyNsName: types.NamespacedName{Namespace: "default", Name: "test"},
+		sources: []BundleSource{
+			{
+				BundleKey: graph.WAFBundleKey("default_test"),
+				Request:   fetch.Request{URL: "http://example.com/bundle.tgz"},
+				Interval:  5 * time.Minute,
+			},
+			{
+				BundleKey: graph.WAFBundleKey("default_test_log"),
+				Request:   fetch.Request{URL: "https://nim.example.com", LogProfileName: "default"},
+				Type:      LogProfileBundle,
+				Interval:  5 * time.Minute,
+			},
+		},
+		fetcher:          fetcher,
+		deployments:      &agentfakes.FakeDeploymentStorer{},
+		metricsCollector: collector,
+	})
+
+	for _, src := range poller.sources {
+		poller.pollSource(t.Context(), src)
+	}
+
+	g.Expect(collector.observations).To(Equal([]fetchObservation{
+		{bundleType: "policy", err: fetchErr},
+		{bundleType: "log-profile", err: nil},
+	}))
+}